@@ -0,0 +1,184 @@
+package ssz
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+var ErrBitfieldLength = fmt.Errorf("bitfields do not have the same length")
+
+// Bitlist is a list of bits (SSZ Bitlist[N]). The bits are stored in little endian
+// order and the bit after the last one is set to one to delimit the length of the list.
+type Bitlist []byte
+
+// NewBitlist creates a bitlist with n bits set to zero
+func NewBitlist(n uint64) Bitlist {
+	b := make(Bitlist, n/8+1)
+	b[len(b)-1] = 1 << (n % 8)
+	return b
+}
+
+// Len returns the number of bits in the bitlist
+func (b Bitlist) Len() uint64 {
+	return BitlistLen(b)
+}
+
+// BitAt returns the value of the bit at index i
+func (b Bitlist) BitAt(i uint64) bool {
+	if i >= b.Len() {
+		return false
+	}
+	return bitAt(b, i)
+}
+
+// SetBitAt sets the bit at index i. It does nothing if the index is out of bounds.
+func (b Bitlist) SetBitAt(i uint64, val bool) {
+	if i >= b.Len() {
+		return
+	}
+	setBitAt(b, i, val)
+}
+
+// Count returns the number of bits set to one
+func (b Bitlist) Count() uint64 {
+	if len(b) == 0 {
+		return 0
+	}
+	// do not count the delimiter bit
+	return count(b) - 1
+}
+
+// Or returns the union of two bitlists with the same length
+func (b Bitlist) Or(c Bitlist) (Bitlist, error) {
+	if b.Len() != c.Len() {
+		return nil, ErrBitfieldLength
+	}
+	res := make(Bitlist, len(b))
+	for i := range b {
+		res[i] = b[i] | c[i]
+	}
+	return res, nil
+}
+
+// Overlaps returns true if both bitlists have a bit set to one at the same index
+func (b Bitlist) Overlaps(c Bitlist) (bool, error) {
+	if b.Len() != c.Len() {
+		return false, ErrBitfieldLength
+	}
+	if len(b) == 0 {
+		return false, nil
+	}
+	// the delimiter bit is at the same position in both bitlists
+	last := len(b) - 1
+	delimiter := byte(1) << (bits.Len8(b[last]) - 1)
+	return overlaps(b[:last], c[:last]) || (b[last]&c[last])&^delimiter != 0, nil
+}
+
+// Contains returns true if all the bits set to one in c are also set in b
+func (b Bitlist) Contains(c Bitlist) (bool, error) {
+	if b.Len() != c.Len() {
+		return false, ErrBitfieldLength
+	}
+	return contains(b, c), nil
+}
+
+// Bitvector is a vector of bits (SSZ Bitvector[N]) stored in little endian order.
+// The number of bits is not part of the encoding, sszgen reads it from the ssz-size tag
+// and the bits after N in the last byte must be zero.
+type Bitvector []byte
+
+// NewBitvector creates a bitvector with n bits set to zero
+func NewBitvector(n uint64) Bitvector {
+	return make(Bitvector, (n+7)/8)
+}
+
+// Len returns the number of bits that fit in the bitvector
+func (b Bitvector) Len() uint64 {
+	return uint64(len(b)) * 8
+}
+
+// BitAt returns the value of the bit at index i
+func (b Bitvector) BitAt(i uint64) bool {
+	if i >= b.Len() {
+		return false
+	}
+	return bitAt(b, i)
+}
+
+// SetBitAt sets the bit at index i. It does nothing if the index is out of bounds.
+func (b Bitvector) SetBitAt(i uint64, val bool) {
+	if i >= b.Len() {
+		return
+	}
+	setBitAt(b, i, val)
+}
+
+// Count returns the number of bits set to one
+func (b Bitvector) Count() uint64 {
+	return count(b)
+}
+
+// Or returns the union of two bitvectors with the same length
+func (b Bitvector) Or(c Bitvector) (Bitvector, error) {
+	if b.Len() != c.Len() {
+		return nil, ErrBitfieldLength
+	}
+	res := make(Bitvector, len(b))
+	for i := range b {
+		res[i] = b[i] | c[i]
+	}
+	return res, nil
+}
+
+// Overlaps returns true if both bitvectors have a bit set to one at the same index
+func (b Bitvector) Overlaps(c Bitvector) (bool, error) {
+	if b.Len() != c.Len() {
+		return false, ErrBitfieldLength
+	}
+	return overlaps(b, c), nil
+}
+
+// Contains returns true if all the bits set to one in c are also set in b
+func (b Bitvector) Contains(c Bitvector) (bool, error) {
+	if b.Len() != c.Len() {
+		return false, ErrBitfieldLength
+	}
+	return contains(b, c), nil
+}
+
+func bitAt(b []byte, i uint64) bool {
+	return b[i/8]&(1<<(i%8)) != 0
+}
+
+func setBitAt(b []byte, i uint64, val bool) {
+	if val {
+		b[i/8] |= 1 << (i % 8)
+	} else {
+		b[i/8] &^= 1 << (i % 8)
+	}
+}
+
+func count(b []byte) (num uint64) {
+	for _, i := range b {
+		num += uint64(bits.OnesCount8(i))
+	}
+	return
+}
+
+func overlaps(b, c []byte) bool {
+	for i := range b {
+		if b[i]&c[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(b, c []byte) bool {
+	for i := range b {
+		if b[i]&c[i] != c[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+package ssz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitlist_Bits(t *testing.T) {
+	b := NewBitlist(10)
+	require.Equal(t, Bitlist{0x00, 0x04}, b)
+	require.Equal(t, uint64(10), b.Len())
+	require.Equal(t, uint64(0), b.Count())
+	require.NoError(t, ValidateBitlist(b, 10))
+
+	b.SetBitAt(1, true)
+	b.SetBitAt(9, true)
+	require.True(t, b.BitAt(1))
+	require.True(t, b.BitAt(9))
+	require.False(t, b.BitAt(2))
+	require.Equal(t, uint64(2), b.Count())
+
+	// out of bounds does not modify the delimiter bit
+	b.SetBitAt(10, true)
+	require.False(t, b.BitAt(10))
+	require.Equal(t, uint64(10), b.Len())
+
+	b.SetBitAt(9, false)
+	require.False(t, b.BitAt(9))
+	require.Equal(t, uint64(1), b.Count())
+}
+
+func TestBitlist_Ops(t *testing.T) {
+	b := NewBitlist(10)
+	b.SetBitAt(1, true)
+
+	c := NewBitlist(10)
+	c.SetBitAt(9, true)
+
+	overlaps, err := b.Overlaps(c)
+	require.NoError(t, err)
+	require.False(t, overlaps)
+
+	res, err := b.Or(c)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Count())
+	require.Equal(t, uint64(10), res.Len())
+
+	contains, err := res.Contains(c)
+	require.NoError(t, err)
+	require.True(t, contains)
+
+	contains, err = b.Contains(c)
+	require.NoError(t, err)
+	require.False(t, contains)
+
+	overlaps, err = res.Overlaps(c)
+	require.NoError(t, err)
+	require.True(t, overlaps)
+
+	_, err = b.Or(NewBitlist(11))
+	require.ErrorIs(t, err, ErrBitfieldLength)
+}
+
+func TestBitvector_Bits(t *testing.T) {
+	b := NewBitvector(12)
+	require.Len(t, b, 2)
+	require.NoError(t, ValidateBitvector(b, 12))
+
+	b.SetBitAt(0, true)
+	b.SetBitAt(11, true)
+	require.True(t, b.BitAt(11))
+	require.Equal(t, uint64(2), b.Count())
+	require.NoError(t, ValidateBitvector(b, 12))
+
+	// bits after the length must be zero
+	b.SetBitAt(12, true)
+	require.Error(t, ValidateBitvector(b, 12))
+
+	// the number of bytes must match the length
+	require.Error(t, ValidateBitvector(b, 17))
+}
+
+func TestBitvector_Ops(t *testing.T) {
+	b := Bitvector{0x01, 0x00}
+	c := Bitvector{0x03, 0x80}
+
+	res, err := b.Or(c)
+	require.NoError(t, err)
+	require.Equal(t, c, res)
+
+	overlaps, err := b.Overlaps(c)
+	require.NoError(t, err)
+	require.True(t, overlaps)
+
+	contains, err := c.Contains(b)
+	require.NoError(t, err)
+	require.True(t, contains)
+
+	contains, err = b.Contains(c)
+	require.NoError(t, err)
+	require.False(t, contains)
+
+	_, err = b.Overlaps(Bitvector{0x01})
+	require.ErrorIs(t, err, ErrBitfieldLength)
+}
@@ -0,0 +1,348 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// HashCache caches the roots of the fields of a container between the calls
+// to HashTreeRootWith with a Hasher or a ContextWalker of a Hasher. The
+// containers generated with a HashCache field only hash again the fields
+// marked as dirty, and their lists and vectors only hash again the chunks of
+// the dirty elements. Other HashWalkers walk all the fields. The zero value
+// is an empty cache. It is not safe for concurrent use. A copy of a container
+// clones the cache the first time it is used.
+type HashCache struct {
+	tree   *TreeHash
+	fields []cachedField
+
+	// self is the address of the cache that owns the fields, which
+	// is not the one of the cache once the container is copied
+	self *HashCache
+}
+
+type cachedField struct {
+	// root is the root of the field if valid is set
+	root  [32]byte
+	valid bool
+
+	// dirty are the indices of the changed elements of a list or a vector
+	dirty []int
+
+	// chunks is the merkle tree of the chunks of a list or a vector
+	chunks *chunkTree
+}
+
+// Reset marks all the fields as dirty
+func (c *HashCache) Reset() {
+	c.own()
+	c.fields = c.fields[:0]
+}
+
+// own clones the fields if the cache was copied with its container,
+// since the fields and their chunk trees are still used by the original
+func (c *HashCache) own() {
+	if c.self == c {
+		return
+	}
+	if c.self != nil {
+		fields := make([]cachedField, len(c.fields))
+		for i, f := range c.fields {
+			fields[i] = cachedField{
+				root:   f.root,
+				valid:  f.valid,
+				dirty:  append([]int(nil), f.dirty...),
+				chunks: f.chunks.clone(),
+			}
+		}
+		c.fields = fields
+	}
+	c.self = c
+}
+
+// MarkDirty marks a field as changed. If indices are given, only the
+// elements at those indices of a list or a vector field changed.
+func (c *HashCache) MarkDirty(field int, indices ...int) {
+	c.own()
+	if field >= len(c.fields) {
+		// the field is not cached yet
+		return
+	}
+	f := &c.fields[field]
+	f.valid = false
+	if len(indices) == 0 {
+		f.chunks = nil
+		f.dirty = f.dirty[:0]
+		return
+	}
+	if f.chunks != nil {
+		f.dirty = append(f.dirty, indices...)
+	}
+}
+
+// field returns the cache of a field if the walker is a Hasher
+func (c *HashCache) field(hh HashWalker, field int) (*Hasher, *cachedField) {
+	h, ok := asHasher(hh)
+	if !ok {
+		return nil, nil
+	}
+	c.own()
+	if c.tree == nil || c.tree.zeroHashes[1] != h.tree.zeroHashes[1] {
+		// the roots are only valid for the same hash function
+		c.fields = c.fields[:0]
+	}
+	c.tree = h.tree
+	for len(c.fields) <= field {
+		c.fields = append(c.fields, cachedField{})
+	}
+	return h, &c.fields[field]
+}
+
+// PutField appends the cached root of a field to the hasher. It returns
+// false if the field is dirty, in which case it has to be hashed and then
+// cached with SetField.
+func (c *HashCache) PutField(hh HashWalker, field int) bool {
+	h, f := c.field(hh, field)
+	if f == nil || !f.valid {
+		return false
+	}
+	h.buf = append(h.buf, f.root[:]...)
+	return true
+}
+
+// SetField caches the root of a field that was just hashed. The root is
+// not cached if the walk stopped with the error of a ContextWalker.
+func (c *HashCache) SetField(hh HashWalker, field int) {
+	if _, f := c.field(hh, field); f != nil {
+		if walkerErr(hh) != nil {
+			f.chunks, f.valid = nil, false
+			return
+		}
+		copy(f.root[:], hh.Hash())
+		f.valid = true
+	}
+}
+
+// PutList appends the root of a list field with num elements to the hasher.
+// elemSize is the size of the basic elements packed in the chunks or zero if
+// each element takes a chunk, and limit is the maximum number of chunks.
+// fn appends the elements in [start, end) to the hasher. With a Hasher, it
+// is only called for the chunks with dirty elements.
+func (c *HashCache) PutList(hh HashWalker, field, num int, elemSize, limit uint64, fn func(start, end int) error) error {
+	return c.putChunks(hh, field, num, elemSize, limit, true, fn)
+}
+
+// PutVector is the same as PutList for a vector field with num elements
+func (c *HashCache) PutVector(hh HashWalker, field, num int, elemSize uint64, fn func(start, end int) error) error {
+	return c.putChunks(hh, field, num, elemSize, 0, false, fn)
+}
+
+func (c *HashCache) putChunks(hh HashWalker, field, num int, elemSize, limit uint64, isList bool, fn func(start, end int) error) error {
+	h, f := c.field(hh, field)
+	if f == nil {
+		// the walker needs all the elements
+		indx := hh.Index()
+		if err := fn(0, num); err != nil {
+			return err
+		}
+		hh.FillUpTo32()
+		if isList {
+			hh.MerkleizeWithMixin(indx, uint64(num), limit)
+		} else {
+			hh.Merkleize(indx)
+		}
+		return nil
+	}
+	if f.valid && f.chunks != nil && f.chunks.num == num {
+		h.buf = append(h.buf, f.root[:]...)
+		return nil
+	}
+
+	perChunk := 1
+	if elemSize != 0 {
+		perChunk = 32 / int(elemSize)
+	}
+	numChunks := (num + perChunk - 1) / perChunk
+	if !isList {
+		limit = uint64(numChunks)
+	}
+	if uint64(numChunks) > limit && limit != 0 {
+		return fmt.Errorf("%w: %d chunks for a limit of %d", ErrIncorrectListSize, numChunks, limit)
+	}
+
+	var dirty []int
+	if t := f.chunks; t == nil {
+		f.chunks = &chunkTree{}
+		dirty = make([]int, numChunks)
+		for i := range dirty {
+			dirty[i] = i
+		}
+	} else {
+		for _, i := range f.dirty {
+			if chunk := i / perChunk; chunk < numChunks {
+				dirty = append(dirty, chunk)
+			}
+		}
+		if num != t.num {
+			// the last chunk changes and the new ones are added
+			from := len(t.levels[0])/32 - 1
+			if numChunks-1 < from {
+				from = numChunks - 1
+			}
+			for i := from; i < numChunks; i++ {
+				if i >= 0 {
+					dirty = append(dirty, i)
+				}
+			}
+		}
+		sort.Ints(dirty)
+	}
+	f.dirty = f.dirty[:0]
+
+	t := f.chunks
+	t.resize(numChunks)
+	for k := 0; k < len(dirty); {
+		// the elements of the contiguous dirty chunks are appended together,
+		// so that PutObjects hashes the objects of the range concurrently
+		first, last := dirty[k], dirty[k]
+		for k++; k < len(dirty) && dirty[k] <= last+1; k++ {
+			last = dirty[k]
+		}
+		start := first * perChunk
+		end := (last + 1) * perChunk
+		if end > num {
+			end = num
+		}
+
+		indx := h.Index()
+		if err := fn(start, end); err != nil {
+			h.buf = h.buf[:indx]
+			f.chunks, f.valid = nil, false
+			return err
+		}
+		h.FillUpTo32()
+		if size := len(h.buf) - indx; size != (last-first+1)*32 {
+			h.buf = h.buf[:indx]
+			f.chunks, f.valid = nil, false
+			return fmt.Errorf("expected %d chunks for the elements [%d, %d) but got %d bytes", last-first+1, start, end, size)
+		}
+		copy(t.levels[0][first*32:], h.buf[indx:])
+		h.buf = h.buf[:indx]
+	}
+	if err := walkerErr(hh); err != nil {
+		// the objects of the chunks are not merkleized
+		f.chunks, f.valid = nil, false
+		return err
+	}
+	t.num = num
+
+	root, err := t.update(c.tree, dirty, int(getDepth(limit)))
+	if err == nil && isList {
+		size := make([]byte, 32)
+		binary.LittleEndian.PutUint64(size, uint64(num))
+		root, err = c.tree.hashPair(root, size)
+	}
+	if err != nil {
+		f.chunks, f.valid = nil, false
+		return err
+	}
+	copy(f.root[:], root)
+	f.valid = true
+
+	h.buf = append(h.buf, f.root[:]...)
+	return nil
+}
+
+// chunkTree is the merkle tree of the chunks of a list or a vector, which
+// only hashes again the branches of the chunks that change
+type chunkTree struct {
+	// num is the number of elements in the chunks
+	num int
+
+	// levels[0] are the chunks and levels[i+1] the hashes of the pairs in levels[i]
+	levels [][]byte
+}
+
+// clone returns a copy of the tree
+func (t *chunkTree) clone() *chunkTree {
+	if t == nil {
+		return nil
+	}
+	cp := &chunkTree{num: t.num, levels: make([][]byte, len(t.levels))}
+	for i, level := range t.levels {
+		cp.levels[i] = append([]byte(nil), level...)
+	}
+	return cp
+}
+
+// resize sets the number of chunks of the tree
+func (t *chunkTree) resize(numChunks int) {
+	size := numChunks
+	for i := 0; ; i++ {
+		if i == len(t.levels) {
+			t.levels = append(t.levels, nil)
+		}
+		if n := size * 32; n <= cap(t.levels[i]) {
+			t.levels[i] = t.levels[i][:n]
+		} else {
+			t.levels[i] = append(t.levels[i], make([]byte, n-len(t.levels[i]))...)
+		}
+		if size <= 1 {
+			t.levels = t.levels[:i+1]
+			return
+		}
+		size = (size + 1) / 2
+	}
+}
+
+// update hashes again the branches of the sorted dirty chunks and
+// returns the root of the tree with the given depth
+func (t *chunkTree) update(th *TreeHash, dirty []int, depth int) ([]byte, error) {
+	var buf []byte
+	for k := 0; k+1 < len(t.levels); k++ {
+		level, size := t.levels[k], len(t.levels[k])/32
+
+		parents := make([]int, 0, len(dirty))
+		for _, i := range dirty {
+			if p := i / 2; len(parents) == 0 || parents[len(parents)-1] != p {
+				parents = append(parents, p)
+			}
+		}
+		if len(parents) == 0 {
+			break
+		}
+
+		buf = buf[:0]
+		for _, p := range parents {
+			buf = append(buf, level[2*p*32:(2*p+1)*32]...)
+			if 2*p+1 < size {
+				buf = append(buf, level[(2*p+1)*32:(2*p+2)*32]...)
+			} else {
+				buf = append(buf, th.zeroHashes[k][:]...)
+			}
+		}
+		if err := th.fn(buf, buf); err != nil {
+			return nil, err
+		}
+
+		next := t.levels[k+1]
+		for i, p := range parents {
+			copy(next[p*32:(p+1)*32], buf[i*32:(i+1)*32])
+		}
+		dirty = parents
+	}
+
+	if len(t.levels[0]) == 0 {
+		return th.ZeroHash(depth), nil
+	}
+	top := len(t.levels) - 1
+	root := append([]byte{}, t.levels[top]...)
+	for k := top; k < depth; k++ {
+		var err error
+		if root, err = th.hashPair(root, th.zeroHashes[k][:]); err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
@@ -0,0 +1,158 @@
+package ssz
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashCache_PutList(t *testing.T) {
+	putList := func(c *HashCache, hh HashWalker, vals []uint64) []byte {
+		err := c.PutList(hh, 1, len(vals), 8, 1024, func(start, end int) error {
+			for _, v := range vals[start:end] {
+				hh.AppendUint64(v)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		return append([]byte{}, hh.Hash()...)
+	}
+
+	r := rand.New(rand.NewSource(1))
+
+	var c HashCache
+	vals := []uint64{}
+	for i := 0; i < 200; i++ {
+		switch r.Intn(3) {
+		case 0:
+			// grow
+			for n := r.Intn(50); n > 0 && len(vals) < 4096; n-- {
+				vals = append(vals, r.Uint64())
+			}
+		case 1:
+			// shrink
+			vals = vals[:r.Intn(len(vals)+1)]
+		case 2:
+			// change some of the values
+			for n := r.Intn(5); n > 0 && len(vals) != 0; n-- {
+				j := r.Intn(len(vals))
+				vals[j] = r.Uint64()
+				c.MarkDirty(1, j)
+			}
+		}
+
+		expected := NewHasher()
+		expected.PutUint64Array(vals, 4096)
+
+		// the walkers that are not a Hasher walk all the values
+		w := &Wrapper{}
+		putList(&c, w, vals)
+		require.Equal(t, expected.Hash(), w.Node().Hash())
+
+		require.Equal(t, expected.Hash(), putList(&c, NewHasher(), vals))
+	}
+}
+
+func TestHashCache_TreeHash(t *testing.T) {
+	var c HashCache
+	vals := []uint64{1, 2, 3, 4, 5}
+	fn := func(hh HashWalker) error {
+		return c.PutVector(hh, 0, len(vals), 8, func(start, end int) error {
+			for _, v := range vals[start:end] {
+				hh.AppendUint64(v)
+			}
+			return nil
+		})
+	}
+
+	hh := NewHasher()
+	require.NoError(t, fn(hh))
+	root := append([]byte{}, hh.Hash()...)
+
+	// the cache is not used with a different hash function
+	other := NewHasherWithHashFn(prefixHashFn)
+	require.NoError(t, fn(other))
+	require.NotEqual(t, root, other.Hash())
+
+	hh.Reset()
+	require.NoError(t, fn(hh))
+	require.Equal(t, root, hh.Hash())
+}
+
+func TestHashCache_DirtyRanges(t *testing.T) {
+	var c HashCache
+	roots := make([][]byte, 16)
+	for i := range roots {
+		roots[i] = make([]byte, 32)
+		roots[i][0] = byte(i)
+	}
+
+	var ranges [][2]int
+	putVector := func(hh HashWalker) []byte {
+		ranges = nil
+		err := c.PutVector(hh, 0, len(roots), 0, func(start, end int) error {
+			ranges = append(ranges, [2]int{start, end})
+			for _, r := range roots[start:end] {
+				hh.Append(r)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		return append([]byte{}, hh.Hash()...)
+	}
+
+	putVector(NewHasher())
+	require.Equal(t, [][2]int{{0, 16}}, ranges)
+
+	// the contiguous dirty elements are appended together
+	for _, i := range []int{5, 3, 4, 4, 10} {
+		roots[i][1] = 1
+		c.MarkDirty(0, i)
+	}
+	root := putVector(NewHasher())
+	require.Equal(t, [][2]int{{3, 6}, {10, 11}}, ranges)
+
+	expected := NewHasher()
+	for _, r := range roots {
+		expected.Append(r)
+	}
+	expected.Merkleize(0)
+	require.Equal(t, expected.Hash(), root)
+}
+
+func TestHashCache_HashError(t *testing.T) {
+	errHash := errors.New("hash error")
+	fail := false
+	hh := NewHasherWithHashFn(func(dst, input []byte) error {
+		if fail {
+			return errHash
+		}
+		return prefixHashFn(dst, input)
+	})
+
+	var c HashCache
+	vals := make([]uint64, 100)
+	putList := func() error {
+		hh.Reset()
+		return c.PutList(hh, 0, len(vals), 8, 1024, func(start, end int) error {
+			for _, v := range vals[start:end] {
+				hh.AppendUint64(v)
+			}
+			return nil
+		})
+	}
+
+	fail = true
+	require.ErrorIs(t, putList(), errHash)
+
+	// the chunks are hashed again once the hash function works
+	fail = false
+	require.NoError(t, putList())
+	root := append([]byte{}, hh.Hash()...)
+
+	expected := NewHasherWithHashFn(prefixHashFn)
+	expected.PutUint64Array(vals, 4096)
+	require.Equal(t, expected.Hash(), root)
+}
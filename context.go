@@ -0,0 +1,225 @@
+package ssz
+
+import (
+	"context"
+	"fmt"
+)
+
+var _ ExtendedHashWalker = (*ContextWalker)(nil)
+
+// HashWithContext hashes a HashRoot object with a Hasher from the default
+// HasherPool. It returns the error of the context if it is done before the
+// object is hashed.
+func HashWithContext(ctx context.Context, v HashRootProof) ([32]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return [32]byte{}, err
+	}
+	hh := DefaultHasherPool.Get()
+	defer DefaultHasherPool.Put(hh)
+
+	w := NewContextWalker(ctx, hh)
+	if err := v.HashTreeRootWith(w); err != nil {
+		return [32]byte{}, err
+	}
+	if err := w.Err(); err != nil {
+		return [32]byte{}, err
+	}
+	return hh.HashRoot()
+}
+
+// ProofTreeWithContext builds the tree of a HashRoot object. It returns the
+// error of the context if it is done before the tree is built.
+func ProofTreeWithContext(ctx context.Context, v HashRootProof) (*Node, error) {
+	return DefaultTreeHash.ProofTreeWithContext(ctx, v)
+}
+
+// ProofTreeWithContext is the same as ProofTreeWithContext with the TreeHash.
+func (t *TreeHash) ProofTreeWithContext(ctx context.Context, v HashRootProof) (*Node, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	wr := &Wrapper{hash: t}
+
+	w := NewContextWalker(ctx, wr)
+	if err := v.HashTreeRootWith(w); err != nil {
+		return nil, err
+	}
+	if err := w.Err(); err != nil {
+		return nil, err
+	}
+	return wr.Node(), nil
+}
+
+// ContextWalker is a HashWalker that checks a context while it walks an
+// object with another HashWalker. The context is checked every time a list
+// or a container is merkleized and before each object of the lists walked
+// with PutObjects, which stop with the error of the context once it is done.
+// Once the context is done the lists and the containers are not merkleized
+// anymore, Err returns the error and the result of the walk is discarded.
+type ContextWalker struct {
+	HashWalker
+
+	ctx  context.Context
+	done <-chan struct{}
+	err  error
+}
+
+// NewContextWalker creates a ContextWalker that walks with hh
+func NewContextWalker(ctx context.Context, hh HashWalker) *ContextWalker {
+	return &ContextWalker{
+		HashWalker: hh,
+		ctx:        ctx,
+		done:       ctx.Done(),
+	}
+}
+
+// Err returns the error of the context if it is done
+func (c *ContextWalker) Err() error {
+	if c.err == nil {
+		select {
+		case <-c.done:
+			c.err = c.ctx.Err()
+		default:
+		}
+	}
+	return c.err
+}
+
+func (c *ContextWalker) AppendUint128(i Uint128) {
+	AppendUint128(c.HashWalker, i)
+}
+
+func (c *ContextWalker) AppendUint256(i Uint256) {
+	AppendUint256(c.HashWalker, i)
+}
+
+func (c *ContextWalker) PutUint128(i Uint128) {
+	PutUint128(c.HashWalker, i)
+}
+
+func (c *ContextWalker) PutUint256(i Uint256) {
+	PutUint256(c.HashWalker, i)
+}
+
+func (c *ContextWalker) PutZeroChunk() {
+	PutZeroChunk(c.HashWalker)
+}
+
+func (c *ContextWalker) PutUint64Array(b []uint64, maxCapacity ...uint64) {
+	if c.Err() != nil {
+		return
+	}
+	c.HashWalker.PutUint64Array(b, maxCapacity...)
+}
+
+func (c *ContextWalker) PutBitlist(bb []byte, maxSize uint64) {
+	if c.Err() != nil {
+		return
+	}
+	c.HashWalker.PutBitlist(bb, maxSize)
+}
+
+func (c *ContextWalker) Merkleize(indx int) {
+	if c.Err() != nil {
+		return
+	}
+	c.HashWalker.Merkleize(indx)
+}
+
+func (c *ContextWalker) MerkleizeWithMixin(indx int, num, limit uint64) {
+	if c.Err() != nil {
+		return
+	}
+	c.HashWalker.MerkleizeWithMixin(indx, num, limit)
+}
+
+func (c *ContextWalker) MerkleizeProgressive(indx int) {
+	if c.Err() != nil {
+		return
+	}
+	c.HashWalker.(ExtendedHashWalker).MerkleizeProgressive(indx)
+}
+
+func (c *ContextWalker) MerkleizeProgressiveWithMixin(indx int, num uint64) {
+	if c.Err() != nil {
+		return
+	}
+	c.HashWalker.(ExtendedHashWalker).MerkleizeProgressiveWithMixin(indx, num)
+}
+
+func (c *ContextWalker) MerkleizeWithSelector(indx int, selector uint8) {
+	if c.Err() != nil {
+		return
+	}
+	c.HashWalker.(ExtendedHashWalker).MerkleizeWithSelector(indx, selector)
+}
+
+func (c *ContextWalker) MerkleizeOptional(indx int, present bool) {
+	if c.Err() != nil {
+		return
+	}
+	c.HashWalker.(ExtendedHashWalker).MerkleizeOptional(indx, present)
+}
+
+func (c *ContextWalker) MerkleizeStable(indx int, activeFields []byte, limit uint64) {
+	if c.Err() != nil {
+		return
+	}
+	c.HashWalker.(ExtendedHashWalker).MerkleizeStable(indx, activeFields, limit)
+}
+
+// walkerErr returns the error of the context of a ContextWalker
+func walkerErr(hh HashWalker) error {
+	switch obj := hh.(type) {
+	case *ContextWalker:
+		return obj.Err()
+	case *ProfileWalker:
+		return walkerErr(obj.HashWalker)
+	}
+	return nil
+}
+
+// walkerDone returns the done channel of the context of a ContextWalker,
+// which is nil if there is none
+func walkerDone(hh HashWalker) <-chan struct{} {
+	switch obj := hh.(type) {
+	case *ContextWalker:
+		return obj.done
+	case *ProfileWalker:
+		return walkerDone(obj.HashWalker)
+	}
+	return nil
+}
+
+// asExtended returns the HashWalker as an ExtendedHashWalker if it and the
+// walkers it wraps are extended
+func asExtended(hh HashWalker) (ExtendedHashWalker, error) {
+	ext, ok := hh.(ExtendedHashWalker)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedHashWalker, hh)
+	}
+	var inner HashWalker
+	switch obj := hh.(type) {
+	case *ContextWalker:
+		inner = obj.HashWalker
+	case *ProfileWalker:
+		inner = obj.HashWalker
+	}
+	if inner != nil {
+		if _, err := asExtended(inner); err != nil {
+			return nil, err
+		}
+	}
+	return ext, nil
+}
+
+// asHasher returns the Hasher that walks the objects, if any
+func asHasher(hh HashWalker) (*Hasher, bool) {
+	switch obj := hh.(type) {
+	case *Hasher:
+		return obj, true
+	case *ContextWalker:
+		return asHasher(obj.HashWalker)
+	}
+	return nil, false
+}
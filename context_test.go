@@ -0,0 +1,151 @@
+package ssz
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// contextTestObj is a list of objects where the object at cancelAt cancels
+// the context while it is hashed
+type contextTestObj struct {
+	objs     []*proverTestObj
+	cancel   func()
+	cancelAt int
+	hashed   int64
+}
+
+func (c *contextTestObj) HashTreeRootWith(hh HashWalker) error {
+	indx := hh.Index()
+	objs := make([]*contextTestElem, len(c.objs))
+	for i, obj := range c.objs {
+		objs[i] = &contextTestElem{obj: obj, parent: c, indx: i}
+	}
+	if err := PutObjects(hh, objs); err != nil {
+		return err
+	}
+	hh.MerkleizeWithMixin(indx, uint64(len(objs)), 1024)
+	return nil
+}
+
+type contextTestElem struct {
+	obj    *proverTestObj
+	parent *contextTestObj
+	indx   int
+}
+
+func (c *contextTestElem) HashTreeRootWith(hh HashWalker) error {
+	atomic.AddInt64(&c.parent.hashed, 1)
+	if c.indx == c.parent.cancelAt && c.parent.cancel != nil {
+		c.parent.cancel()
+	}
+	return c.obj.HashTreeRootWith(hh)
+}
+
+func newContextTestObj(num int) *contextTestObj {
+	obj := &contextTestObj{cancelAt: -1}
+	for i := 0; i < num; i++ {
+		obj.objs = append(obj.objs, &proverTestObj{a: uint64(i), b: []uint64{1, 2}})
+	}
+	return obj
+}
+
+func TestHashWithContext(t *testing.T) {
+	obj := newContextTestObj(100)
+
+	hh := NewHasher()
+	require.NoError(t, obj.HashTreeRootWith(hh))
+	expected, err := hh.HashRoot()
+	require.NoError(t, err)
+
+	root, err := HashWithContext(context.Background(), obj)
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	tree, err := ProofTreeWithContext(context.Background(), obj)
+	require.NoError(t, err)
+	require.Equal(t, expected[:], tree.Hash())
+}
+
+func TestHashWithContext_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// the objects are not walked if the context is already done
+	obj := newContextTestObj(100)
+	_, err := HashWithContext(ctx, obj)
+	require.ErrorIs(t, err, context.Canceled)
+	_, err = ProofTreeWithContext(ctx, obj)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, int64(0), obj.hashed)
+
+	// the list of objects stops after the context is cancelled
+	for _, fn := range []func(ctx context.Context, obj *contextTestObj) error{
+		func(ctx context.Context, obj *contextTestObj) error {
+			_, err := HashWithContext(ctx, obj)
+			return err
+		},
+		func(ctx context.Context, obj *contextTestObj) error {
+			_, err := ProofTreeWithContext(ctx, obj)
+			return err
+		},
+	} {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		obj := newContextTestObj(100)
+		obj.cancel, obj.cancelAt = cancel, 10
+		require.ErrorIs(t, fn(ctx, obj), context.Canceled)
+		require.Equal(t, int64(11), obj.hashed)
+	}
+}
+
+func TestContextWalker_Err(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	hh := NewHasher()
+	w := NewContextWalker(ctx, hh)
+	w.PutUint64(1)
+	require.NoError(t, w.Err())
+
+	cancel()
+
+	// the values are not merkleized once the context is done
+	indx := w.Index()
+	w.PutUint64(2)
+	w.PutUint64(3)
+	w.Merkleize(indx)
+	require.ErrorIs(t, w.Err(), context.Canceled)
+	require.Equal(t, 96, hh.Index())
+}
+
+func TestContextWalker_Workers(t *testing.T) {
+	obj := newContextTestObj(parallelMinObjects)
+
+	hh := NewHasher()
+	require.NoError(t, obj.HashTreeRootWith(hh))
+	expected, err := hh.HashRoot()
+	require.NoError(t, err)
+
+	// the objects are hashed in the workers of the Hasher
+	workers := NewHasher(WithHashWorkers(4))
+	w := NewContextWalker(context.Background(), workers)
+	require.NoError(t, obj.HashTreeRootWith(w))
+	require.NoError(t, w.Err())
+	require.Len(t, workers.children, 4)
+
+	root, err := workers.HashRoot()
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	// the workers stop after the context is cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	obj = newContextTestObj(parallelMinObjects)
+	obj.cancel, obj.cancelAt = cancel, 10
+
+	workers.Reset()
+	w = NewContextWalker(ctx, workers)
+	require.ErrorIs(t, obj.HashTreeRootWith(w), context.Canceled)
+	require.Less(t, atomic.LoadInt64(&obj.hashed), int64(len(obj.objs)))
+}
@@ -3,6 +3,8 @@ package ssz
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
 	"math/bits"
 	"time"
 )
@@ -25,6 +27,10 @@ var (
 	ErrInvalidVariableOffset = fmt.Errorf("invalid ssz encoding. first variable element offset indexes into fixed value data")
 	ErrOffsetNotIncreasing   = fmt.Errorf("offsets are not increasing")
 	ErrTailNotEmpty          = fmt.Errorf("buffer was not totally consumed")
+	ErrUnionSelector         = fmt.Errorf("invalid union selector")
+	ErrOptionalPresence      = fmt.Errorf("invalid optional presence byte")
+	ErrActiveFields          = fmt.Errorf("invalid active fields bitvector")
+	ErrUintOverflow          = fmt.Errorf("big integer does not fit in the uint")
 )
 
 func ErrBytesLengthFn(name string, found, expected uint64) error {
@@ -39,6 +45,10 @@ func ErrListTooBigFn(name string, found, max uint64) error {
 	return fmt.Errorf("%s (%v): max expected %d and %d found", name, ErrListTooBig, max, found)
 }
 
+func ErrUnionSelectorFn(selector uint8, numOptions int) error {
+	return fmt.Errorf("%v: selector %d and %d options", ErrUnionSelector, selector, numOptions)
+}
+
 // ---- Unmarshal functions ----
 
 func UnmarshalBitList(dst []byte, src []byte, bitLimit uint64) ([]byte, error) {
@@ -52,6 +62,18 @@ func UnmarshalBitList(dst []byte, src []byte, bitLimit uint64) ([]byte, error) {
 	return dst, nil
 }
 
+// UnmarshalBitVector unmarshals a bitvector of bitLen bits from the src input
+func UnmarshalBitVector(dst []byte, src []byte, bitLen uint64) ([]byte, error) {
+	if err := ValidateBitvector(src, bitLen); err != nil {
+		return nil, err
+	}
+	if cap(dst) == 0 {
+		dst = make([]byte, 0, len(src))
+	}
+	dst = append(dst[:0], src...)
+	return dst, nil
+}
+
 func UnmarshalFixedBytes(buf []byte, src []byte) []byte {
 	targetSize := len(buf)
 	copy(buf, src[:targetSize])
@@ -80,7 +102,7 @@ func UnmarshalBytes(src []byte, buf []byte, size uint64) ([]byte, []byte) {
 }
 
 type UnmarshallableType interface {
-	~uint8 | ~uint16 | ~uint32 | ~uint64 | ~bool
+	~uint8 | ~uint16 | ~uint32 | ~uint64 | ~bool | ~[2]uint64 | ~[4]uint64
 }
 
 func UnmarshallValue[T UnmarshallableType](src []byte) (T, []byte) {
@@ -103,6 +125,12 @@ func UnmarshallValue[T UnmarshallableType](src []byte) (T, []byte) {
 	case bool:
 		result = src[0] != 0
 		tail = src[1:]
+	case Uint128:
+		result = unmarshalUint128(src[:16])
+		tail = src[16:]
+	case Uint256:
+		result = unmarshalUint256(src[:32])
+		tail = src[32:]
 	default:
 		panic("unsupported type")
 	}
@@ -131,10 +159,91 @@ func IsValidBool(src []byte) error {
 	return nil
 }
 
+// ProgressiveListLimit is the maximum number of elements decoded in a progressive
+// list (EIP-7916). Progressive lists do not have a limit but the offsets of the
+// encoding are 4 bytes.
+const ProgressiveListLimit = math.MaxUint32
+
+// maxUnionSelector is the highest selector value allowed in a union
+const maxUnionSelector = 127
+
+// ValidateUnionSelector validates that the selector is in the bounds
+// of a union with numOptions options (including None)
+func ValidateUnionSelector(selector uint8, numOptions int) error {
+	if selector > maxUnionSelector || int(selector) >= numOptions {
+		return ErrUnionSelectorFn(selector, numOptions)
+	}
+	return nil
+}
+
+// UnmarshalUnionSelector reads and validates the selector of a union
+func UnmarshalUnionSelector(src []byte, numOptions int) (uint8, []byte, error) {
+	if len(src) == 0 {
+		return 0, nil, ErrSize
+	}
+	selector, tail := UnmarshallValue[uint8](src)
+	if err := ValidateUnionSelector(selector, numOptions); err != nil {
+		return 0, nil, err
+	}
+	return selector, tail, nil
+}
+
+// UnmarshalOptionalPresence reads the presence byte of an optional value.
+// An empty buffer is the None value, otherwise the first byte must be 0x01.
+func UnmarshalOptionalPresence(src []byte) (bool, []byte, error) {
+	if len(src) == 0 {
+		return false, nil, nil
+	}
+	if src[0] != 1 {
+		return false, nil, ErrOptionalPresence
+	}
+	return true, src[1:], nil
+}
+
+// UnmarshalOptionalValue unmarshals an optional basic value of the given size.
+// The field is set to nil if the value is not present.
+func UnmarshalOptionalValue[T UnmarshallableType](field **T, src []byte, size int) error {
+	present, buf, err := UnmarshalOptionalPresence(src)
+	if err != nil {
+		return err
+	}
+	if !present {
+		*field = nil
+		return nil
+	}
+	if len(buf) != size {
+		return ErrSize
+	}
+	if _, ok := any(*new(T)).(bool); ok {
+		if err := IsValidBool(buf); err != nil {
+			return err
+		}
+	}
+	val, _ := UnmarshallValue[T](buf)
+	*field = &val
+	return nil
+}
+
+// UnmarshalActiveFields reads the active fields bitvector of n bits of a stable
+// container or a profile. Only the first numFields fields are known and can be active.
+func UnmarshalActiveFields(src []byte, n, numFields uint64) ([]byte, []byte, error) {
+	size := (n + 7) / 8
+	if uint64(len(src)) < size {
+		return nil, nil, ErrSize
+	}
+	activeFields := src[:size]
+	for i := numFields; i < size*8; i++ {
+		if IsActiveField(activeFields, i) {
+			return nil, nil, fmt.Errorf("%w: field %d is active but there are only %d fields", ErrActiveFields, i, numFields)
+		}
+	}
+	return activeFields, src[size:], nil
+}
+
 // ---- Marshal functions ----
 
 type MarshallableType interface {
-	~uint8 | ~uint16 | ~uint32 | ~uint64 | ~bool
+	~uint8 | ~uint16 | ~uint32 | ~uint64 | ~bool | ~[2]uint64 | ~[4]uint64
 }
 
 func MarshalValue[T MarshallableType](dst []byte, value T) []byte {
@@ -159,16 +268,49 @@ func MarshalValue[T MarshallableType](dst []byte, value T) []byte {
 		} else {
 			return append(dst, 0)
 		}
+	case Uint128:
+		words := any(value).(Uint128)
+		return marshalWords(dst, words[:])
+	case Uint256:
+		words := any(value).(Uint256)
+		return marshalWords(dst, words[:])
 	default:
 		panic("unsupported type")
 	}
 }
 
+func marshalWords(dst []byte, words []uint64) []byte {
+	buf := make([]byte, 8*len(words))
+	for i, word := range words {
+		binary.LittleEndian.PutUint64(buf[8*i:], word)
+	}
+	return append(dst, buf...)
+}
+
 // MarshalTime marshals a time to dst
 func MarshalTime(dst []byte, t time.Time) []byte {
 	return MarshalValue(dst, uint64(t.Unix()))
 }
 
+// ---- active fields functions ----
+
+// NewActiveFields creates the active fields bitvector for n fields
+func NewActiveFields(n uint64) []byte {
+	return make([]byte, (n+7)/8)
+}
+
+// SetActiveField marks the field at indx as active
+func SetActiveField(activeFields []byte, indx uint64, active bool) {
+	if active {
+		activeFields[indx/8] |= 1 << (indx % 8)
+	}
+}
+
+// IsActiveField returns true if the field at indx is active
+func IsActiveField(activeFields []byte, indx uint64) bool {
+	return activeFields[indx/8]&(1<<(indx%8)) != 0
+}
+
 // ---- offset functions ----
 
 // WriteOffset writes an offset to dst
@@ -241,6 +383,22 @@ func ValidateBitlist(buf []byte, bitLimit uint64) error {
 	return nil
 }
 
+// ValidateBitvector validates that the bitvector has the bytes required for bitLen
+// bits and that the bits after bitLen are set to zero
+func ValidateBitvector(buf []byte, bitLen uint64) error {
+	byteLen := (bitLen + 7) / 8
+	if uint64(len(buf)) != byteLen {
+		return fmt.Errorf("unexpected number of bytes, got %d but expected %d", len(buf), byteLen)
+	}
+	if rest := bitLen % 8; rest != 0 {
+		// the padding bits in the last byte must be zero
+		if buf[byteLen-1]>>rest != 0 {
+			return fmt.Errorf("bitvector has bits set after the length")
+		}
+	}
+	return nil
+}
+
 // BitlistLen provides the bitlist length of a byte array
 func BitlistLen(b []byte) uint64 {
 	if len(b) == 0 {
@@ -382,3 +540,72 @@ func (o *OffsetMarker) ReadOffset(buf []byte) (uint64, []byte, error) {
 	o.LastOffset = offset
 	return offset, buf, nil
 }
+
+// ---- uint128 and uint256 ----
+
+// Uint128 is a 128 bits unsigned integer represented as 64 bits words
+// in little endian order
+type Uint128 [2]uint64
+
+// Uint256 is a 256 bits unsigned integer represented as 64 bits words
+// in little endian order (same layout as uint256.Int from holiman/uint256)
+type Uint256 [4]uint64
+
+func unmarshalUint128(src []byte) (u Uint128) {
+	for i := range u {
+		u[i] = binary.LittleEndian.Uint64(src[8*i:])
+	}
+	return
+}
+
+func unmarshalUint256(src []byte) (u Uint256) {
+	for i := range u {
+		u[i] = binary.LittleEndian.Uint64(src[8*i:])
+	}
+	return
+}
+
+// Uint128FromBig converts a big.Int into a Uint128. A nil value is zero.
+func Uint128FromBig(b *big.Int) (u Uint128, err error) {
+	err = bigToWords(u[:], b)
+	return
+}
+
+// Uint256FromBig converts a big.Int into a Uint256. A nil value is zero.
+func Uint256FromBig(b *big.Int) (u Uint256, err error) {
+	err = bigToWords(u[:], b)
+	return
+}
+
+// BigInt returns the Uint128 as a big.Int
+func (u Uint128) BigInt() *big.Int {
+	return wordsToBig(u[:])
+}
+
+// BigInt returns the Uint256 as a big.Int
+func (u Uint256) BigInt() *big.Int {
+	return wordsToBig(u[:])
+}
+
+func bigToWords(words []uint64, b *big.Int) error {
+	if b == nil {
+		return nil
+	}
+	if b.Sign() < 0 || b.BitLen() > 64*len(words) {
+		return ErrUintOverflow
+	}
+	buf := b.FillBytes(make([]byte, 8*len(words)))
+	for i := range words {
+		// the big endian buffer starts with the most significant word
+		words[i] = binary.BigEndian.Uint64(buf[len(buf)-8*(i+1):])
+	}
+	return nil
+}
+
+func wordsToBig(words []uint64) *big.Int {
+	buf := make([]byte, 8*len(words))
+	for i, word := range words {
+		binary.BigEndian.PutUint64(buf[len(buf)-8*(i+1):], word)
+	}
+	return new(big.Int).SetBytes(buf)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -112,6 +113,48 @@ func TestEncode_ExtendUint(t *testing.T) {
 	}
 }
 
+func TestEncode_Uint256(t *testing.T) {
+	// 2^200 + 1
+	num := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 200), big.NewInt(1))
+
+	u, err := Uint256FromBig(num)
+	require.NoError(t, err)
+	require.Equal(t, Uint256{1, 0, 0, 1 << 8}, u)
+	require.Equal(t, num, u.BigInt())
+
+	// encoded in little endian
+	buf := MarshalValue(nil, u)
+	require.Len(t, buf, 32)
+	require.Equal(t, byte(1), buf[0])
+	require.Equal(t, byte(1), buf[25])
+
+	u2, tail := UnmarshallValue[Uint256](buf)
+	require.Equal(t, u, u2)
+	require.Empty(t, tail)
+
+	// the value does not fit in 128 bits
+	_, err = Uint128FromBig(num)
+	require.ErrorIs(t, err, ErrUintOverflow)
+
+	_, err = Uint256FromBig(big.NewInt(-1))
+	require.ErrorIs(t, err, ErrUintOverflow)
+}
+
+func TestEncode_Uint128(t *testing.T) {
+	u := Uint128{0x0102, 0x03}
+
+	buf := MarshalValue(nil, u)
+	require.Equal(t, []byte{0x02, 0x01, 0, 0, 0, 0, 0, 0, 0x03, 0, 0, 0, 0, 0, 0, 0}, buf)
+
+	u2, tail := UnmarshallValue[Uint128](buf)
+	require.Equal(t, u, u2)
+	require.Empty(t, tail)
+
+	u3, err := Uint128FromBig(u.BigInt())
+	require.NoError(t, err)
+	require.Equal(t, u, u3)
+}
+
 func TestUnmarshalDynamic(t *testing.T) {
 	{
 		buf := []byte{}
@@ -0,0 +1,136 @@
+// Package gindex implements the arithmetic of generalized indices as
+// described in the merkle proofs document of the consensus specs.
+//
+// A generalized index identifies a node of a binary merkle tree. The root
+// has index 1 and the children of the node n have indices 2n and 2n+1.
+package gindex
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// Concat returns the generalized index of a node found by following each
+// of the generalized indices starting from the root of the previous one.
+// It returns false if any of the indices is 0, which is not a generalized
+// index, or if the node is deeper than 63 levels and the index overflows.
+// The nodes of any depth are represented with Path.
+func Concat(indices ...uint64) (uint64, bool) {
+	res := uint64(1)
+	for _, index := range indices {
+		if index == 0 {
+			return 0, false
+		}
+		depth := Depth(index)
+		if Depth(res)+depth > 63 {
+			return 0, false
+		}
+		res = res<<depth | (index ^ 1<<depth)
+	}
+	return res, true
+}
+
+// Depth returns the length of the path from the root to the node. The
+// index 0 is not a generalized index and has depth 0 like the root.
+func Depth(index uint64) int {
+	if index == 0 {
+		return 0
+	}
+	return bits.Len64(index) - 1
+}
+
+// Parent returns the generalized index of the parent of a node.
+func Parent(index uint64) uint64 {
+	return index >> 1
+}
+
+// Sibling returns the generalized index of the sibling of a node.
+func Sibling(index uint64) uint64 {
+	return index ^ 1
+}
+
+// IsRight returns true if the node is the right child of its parent.
+func IsRight(index uint64) bool {
+	return index&1 == 1
+}
+
+// IsAncestor returns true if the node at ancestor is found in the path
+// from the root to the node at index. A node is not its own ancestor.
+func IsAncestor(ancestor, index uint64) bool {
+	diff := Depth(index) - Depth(ancestor)
+	if ancestor == 0 || diff <= 0 {
+		return false
+	}
+	return index>>diff == ancestor
+}
+
+// SubtreeIndex returns the generalized index of a node relative to the
+// root of the subtree at root. It is the inverse of Concat(root, sub).
+// It returns false if the node is not found in the subtree.
+func SubtreeIndex(root, index uint64) (uint64, bool) {
+	if root == index && index != 0 {
+		return 1, true
+	}
+	if !IsAncestor(root, index) {
+		return 0, false
+	}
+	depth := Depth(index) - Depth(root)
+	return 1<<depth | index&(1<<depth-1), true
+}
+
+// BranchIndices returns the generalized indices of the sibling nodes
+// required to prove the node at index, from the bottom of the tree up.
+// It returns nil for the index 0.
+func BranchIndices(index uint64) []uint64 {
+	if index == 0 {
+		return nil
+	}
+	res := make([]uint64, 0, Depth(index))
+	for cur := index; cur > 1; cur = Parent(cur) {
+		res = append(res, Sibling(cur))
+	}
+	return res
+}
+
+// PathIndices returns the generalized indices of the nodes in the path from
+// the node at index to the root, excluding the root, from the bottom up.
+// It returns nil for the index 0.
+func PathIndices(index uint64) []uint64 {
+	if index == 0 {
+		return nil
+	}
+	res := make([]uint64, 0, Depth(index))
+	for cur := index; cur > 1; cur = Parent(cur) {
+		res = append(res, cur)
+	}
+	return res
+}
+
+// HelperIndices returns the generalized indices of the nodes required to
+// prove all the given nodes at once, excluding the nodes that can be
+// computed from the nodes themselves. They are sorted in decreasing order.
+// The index 0 is skipped.
+func HelperIndices(indices []uint64) []uint64 {
+	exists := struct{}{}
+	helpers := map[uint64]struct{}{}
+	paths := map[uint64]struct{}{}
+	for _, index := range indices {
+		for _, branch := range BranchIndices(index) {
+			helpers[branch] = exists
+		}
+		for _, path := range PathIndices(index) {
+			paths[path] = exists
+		}
+	}
+
+	res := make([]uint64, 0, len(helpers))
+	for index := range helpers {
+		if _, ok := paths[index]; !ok {
+			res = append(res, index)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] > res[j]
+	})
+	return res
+}
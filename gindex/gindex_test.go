@@ -0,0 +1,106 @@
+package gindex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcat(t *testing.T) {
+	cases := []struct {
+		indices  []uint64
+		expected uint64
+	}{
+		{nil, 1},
+		{[]uint64{1, 5}, 5},
+		{[]uint64{52, 3}, 105},
+		{[]uint64{2, 3, 7}, 0b1_0_1_11},
+		// indices deeper than the precision of a float64
+		{[]uint64{1<<10 | 1, 1<<53 | 1}, 1<<63 | 1<<53 | 1},
+	}
+	for _, c := range cases {
+		index, ok := Concat(c.indices...)
+		require.True(t, ok)
+		require.Equal(t, c.expected, index)
+	}
+
+	// zero is not a generalized index
+	_, ok := Concat(2, 0, 7)
+	require.False(t, ok)
+
+	// the nodes deeper than 63 levels overflow
+	_, ok = Concat(1<<40, 1<<40)
+	require.False(t, ok)
+	_, ok = Concat(1<<11, 1<<53)
+	require.False(t, ok)
+}
+
+func TestDepth(t *testing.T) {
+	require.Equal(t, 0, Depth(1))
+	require.Equal(t, 1, Depth(3))
+	require.Equal(t, 2, Depth(4))
+	require.Equal(t, 63, Depth(1<<64-1))
+	require.Equal(t, 0, Depth(0))
+}
+
+func TestParentSibling(t *testing.T) {
+	require.Equal(t, uint64(4), Parent(9))
+	require.Equal(t, uint64(8), Sibling(9))
+	require.Equal(t, uint64(9), Sibling(8))
+	require.True(t, IsRight(9))
+	require.False(t, IsRight(8))
+}
+
+func TestIsAncestor(t *testing.T) {
+	require.True(t, IsAncestor(1, 9))
+	require.True(t, IsAncestor(2, 9))
+	require.True(t, IsAncestor(4, 9))
+	require.False(t, IsAncestor(9, 9))
+	require.False(t, IsAncestor(3, 9))
+	require.False(t, IsAncestor(9, 4))
+	require.False(t, IsAncestor(0, 9))
+}
+
+func TestSubtreeIndex(t *testing.T) {
+	cases := []struct {
+		root, index uint64
+	}{
+		{1, 9},
+		{2, 9},
+		{52, 105},
+		{25, 412},
+		{1<<10 | 1, 1<<63 | 1<<53 | 1},
+	}
+	for _, c := range cases {
+		sub, ok := SubtreeIndex(c.root, c.index)
+		require.True(t, ok)
+		index, ok := Concat(c.root, sub)
+		require.True(t, ok)
+		require.Equal(t, c.index, index)
+	}
+
+	sub, ok := SubtreeIndex(9, 9)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), sub)
+
+	_, ok = SubtreeIndex(3, 9)
+	require.False(t, ok)
+}
+
+func TestBranchIndices(t *testing.T) {
+	require.Empty(t, BranchIndices(1))
+	require.Equal(t, []uint64{8, 5, 3}, BranchIndices(9))
+	require.Equal(t, []uint64{9, 4, 2}, PathIndices(9))
+	require.Nil(t, BranchIndices(0))
+	require.Nil(t, PathIndices(0))
+}
+
+func TestHelperIndices(t *testing.T) {
+	// same tree as in the VerifyMultiproof example
+	require.Equal(t, []uint64{15, 6, 5}, HelperIndices([]uint64{8, 9, 14}))
+	require.Equal(t, []uint64{8, 5, 3}, HelperIndices([]uint64{9}))
+	require.Empty(t, HelperIndices([]uint64{2, 3}))
+	require.Equal(t, []uint64{5, 3}, HelperIndices([]uint64{2, 4}))
+	require.Empty(t, HelperIndices([]uint64{0}))
+	require.Equal(t, []uint64{8, 5, 3}, HelperIndices([]uint64{9, 0}))
+}
@@ -0,0 +1,251 @@
+package gindex
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"sort"
+	"strings"
+)
+
+// Path is a generalized index of any depth, for the nodes that are
+// too deep in the tree to be represented with an uint64. It holds the
+// directions taken at every level from the root to the node, which are
+// the bits of the generalized index after the leading one. The zero
+// value is the root of the tree.
+//
+// The directions are packed in 64 bit words. The last 1 to 64 levels are
+// kept in an uint64, so the paths are only allocated every 64 levels.
+type Path struct {
+	// head holds the directions of the first levels as big endian
+	// 64 bit words, with the first level in the most significant bit
+	head string
+	// tail holds the directions of the last num levels
+	tail uint64
+	num  uint8
+}
+
+// NewPath returns the path of a generalized index. It panics if the
+// index is zero.
+func NewPath(index uint64) Path {
+	if index == 0 {
+		panic("gindex: zero is not a generalized index")
+	}
+	num := bits.Len64(index) - 1
+	return Path{tail: index ^ 1<<num, num: uint8(num)}
+}
+
+// PathFromBig returns the path of a generalized index of any size.
+func PathFromBig(index *big.Int) (Path, error) {
+	if index.Sign() <= 0 {
+		return Path{}, fmt.Errorf("gindex: invalid generalized index %s", index)
+	}
+	var p Path
+	for i := index.BitLen() - 2; i >= 0; i-- {
+		p = p.child(index.Bit(i) == 1)
+	}
+	return p, nil
+}
+
+// ParsePath parses the decimal representation of a generalized index.
+func ParsePath(s string) (Path, error) {
+	index, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Path{}, fmt.Errorf("gindex: invalid generalized index '%s'", s)
+	}
+	return PathFromBig(index)
+}
+
+// ConcatPaths returns the path of a node found by following each of
+// the paths starting from the node of the previous one.
+func ConcatPaths(paths ...Path) Path {
+	var res Path
+	for _, p := range paths {
+		res = res.Concat(p)
+	}
+	return res
+}
+
+// Concat returns the path found by following sub from the node of p.
+func (p Path) Concat(sub Path) Path {
+	if p.IsRoot() {
+		return sub
+	}
+	for i := 0; i < sub.Depth(); i++ {
+		p = p.child(sub.Step(i))
+	}
+	return p
+}
+
+// Depth returns the length of the path from the root to the node.
+func (p Path) Depth() int {
+	return len(p.head)*8 + int(p.num)
+}
+
+// IsRoot returns true if the path points to the root of the tree.
+func (p Path) IsRoot() bool {
+	return p.num == 0
+}
+
+// IsRight returns true if the node is the right child of its parent.
+func (p Path) IsRight() bool {
+	return p.num != 0 && p.tail&1 == 1
+}
+
+// Step returns true if the i-th step from the root turns right.
+func (p Path) Step(i int) bool {
+	if headLen := len(p.head) * 8; i >= headLen {
+		return p.tail>>(int(p.num)-1-(i-headLen))&1 == 1
+	}
+	return p.word(i/64)>>(63-i%64)&1 == 1
+}
+
+// word returns the i-th word of the head
+func (p Path) word(i int) uint64 {
+	var word uint64
+	for _, b := range []byte(p.head[i*8 : i*8+8]) {
+		word = word<<8 | uint64(b)
+	}
+	return word
+}
+
+// child returns the path of the left or the right child of the node.
+// The tail is moved to the head once it is full.
+func (p Path) child(right bool) Path {
+	bit := uint64(0)
+	if right {
+		bit = 1
+	}
+	if p.num == 64 {
+		var word [8]byte
+		binary.BigEndian.PutUint64(word[:], p.tail)
+		return Path{head: p.head + string(word[:]), tail: bit, num: 1}
+	}
+	return Path{head: p.head, tail: p.tail<<1 | bit, num: p.num + 1}
+}
+
+// Left returns the path of the left child of the node.
+func (p Path) Left() Path {
+	return p.child(false)
+}
+
+// Right returns the path of the right child of the node.
+func (p Path) Right() Path {
+	return p.child(true)
+}
+
+// Parent returns the path of the parent of the node. The parent
+// of the root is the root itself.
+func (p Path) Parent() Path {
+	switch {
+	case p.num == 0:
+		return p
+	case p.num == 1 && p.head != "":
+		// the last word of the head is the new tail
+		last := len(p.head)/8 - 1
+		return Path{head: p.head[:last*8], tail: p.word(last), num: 64}
+	}
+	return Path{head: p.head, tail: p.tail >> 1, num: p.num - 1}
+}
+
+// Sibling returns the path of the sibling of the node. The sibling
+// of the root is the root itself.
+func (p Path) Sibling() Path {
+	if p.num == 0 {
+		return p
+	}
+	p.tail ^= 1
+	return p
+}
+
+// prefix returns the path of the ancestor of the node at the given depth
+func (p Path) prefix(depth int) Path {
+	if depth == 0 {
+		return Path{}
+	}
+	words := (depth - 1) / 64
+	num := depth - words*64
+	if words*8 == len(p.head) {
+		return Path{head: p.head, tail: p.tail >> (int(p.num) - num), num: uint8(num)}
+	}
+	return Path{head: p.head[:words*8], tail: p.word(words) >> (64 - num), num: uint8(num)}
+}
+
+// IsAncestor returns true if p is found in the path from the root to
+// the node at other. A node is not its own ancestor.
+func (p Path) IsAncestor(other Path) bool {
+	return p.Depth() < other.Depth() && other.prefix(p.Depth()) == p
+}
+
+// Index returns the path as an uint64 generalized index and false if
+// it does not fit in 64 bits.
+func (p Path) Index() (uint64, bool) {
+	if p.head != "" || p.num > 63 {
+		return 0, false
+	}
+	return 1<<p.num | p.tail, true
+}
+
+// Big returns the path as a generalized index of any size.
+func (p Path) Big() *big.Int {
+	index := big.NewInt(1)
+	word := new(big.Int)
+	for i := 0; i < len(p.head)/8; i++ {
+		index.Lsh(index, 64)
+		index.Or(index, word.SetUint64(p.word(i)))
+	}
+	index.Lsh(index, uint(p.num))
+	return index.Or(index, word.SetUint64(p.tail))
+}
+
+// String returns the decimal representation of the generalized index.
+func (p Path) String() string {
+	return p.Big().String()
+}
+
+// Cmp compares the generalized indices of two paths and returns -1, 0
+// or +1 if p is lower, equal or greater than other.
+func (p Path) Cmp(other Path) int {
+	if depth, otherDepth := p.Depth(), other.Depth(); depth != otherDepth {
+		if depth < otherDepth {
+			return -1
+		}
+		return 1
+	}
+	// the words are big endian and the tails have the same length
+	if res := strings.Compare(p.head, other.head); res != 0 {
+		return res
+	}
+	switch {
+	case p.tail < other.tail:
+		return -1
+	case p.tail > other.tail:
+		return 1
+	}
+	return 0
+}
+
+// HelperPaths is the same as HelperIndices for paths of any depth.
+func HelperPaths(paths []Path) []Path {
+	exists := struct{}{}
+	helpers := map[Path]struct{}{}
+	computed := map[Path]struct{}{}
+	for _, p := range paths {
+		for cur := p; !cur.IsRoot(); cur = cur.Parent() {
+			helpers[cur.Sibling()] = exists
+			computed[cur] = exists
+		}
+	}
+
+	res := make([]Path, 0, len(helpers))
+	for p := range helpers {
+		if _, ok := computed[p]; !ok {
+			res = append(res, p)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Cmp(res[j]) > 0
+	})
+	return res
+}
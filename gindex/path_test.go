@@ -0,0 +1,185 @@
+package gindex
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPath(t *testing.T) {
+	p := NewPath(9)
+	require.Equal(t, 3, p.Depth())
+	require.Equal(t, "9", p.String())
+	require.True(t, p.IsRight())
+	require.False(t, p.Step(0))
+	require.False(t, p.Step(1))
+	require.True(t, p.Step(2))
+	require.Equal(t, NewPath(4), p.Parent())
+	require.Equal(t, NewPath(8), p.Sibling())
+	require.Equal(t, NewPath(18), p.Left())
+	require.Equal(t, NewPath(19), p.Right())
+	require.True(t, NewPath(2).IsAncestor(p))
+	require.False(t, NewPath(3).IsAncestor(p))
+	require.False(t, p.IsAncestor(p))
+
+	index, ok := p.Index()
+	require.True(t, ok)
+	require.Equal(t, uint64(9), index)
+
+	require.True(t, Path{}.IsRoot())
+	require.Equal(t, NewPath(1), Path{})
+	require.Equal(t, Path{}, Path{}.Parent())
+}
+
+func TestPath_Deep(t *testing.T) {
+	// a list with 2^40 elements inside of a list with 2^40 elements
+	outer := NewPath(1<<41 | 5)
+	inner := NewPath(1<<41 | 7)
+	p := ConcatPaths(NewPath(3), outer, inner)
+	require.Equal(t, 83, p.Depth())
+	require.Equal(t, outer.Concat(inner), ConcatPaths(outer, inner))
+
+	_, ok := p.Index()
+	require.False(t, ok)
+
+	expected := new(big.Int).Lsh(big.NewInt(3), 82)
+	expected.Or(expected, new(big.Int).Lsh(big.NewInt(5), 41))
+	expected.Or(expected, big.NewInt(7))
+	require.Equal(t, expected.String(), p.String())
+
+	parsed, err := ParsePath(expected.String())
+	require.NoError(t, err)
+	require.Equal(t, p, parsed)
+
+	_, err = ParsePath("0")
+	require.Error(t, err)
+	_, err = ParsePath("abc")
+	require.Error(t, err)
+}
+
+func TestPath_Words(t *testing.T) {
+	// the paths cross the words of 64 levels
+	index := big.NewInt(1)
+	p := Path{}
+	for depth := 1; depth <= 200; depth++ {
+		right := depth%3 == 0 || depth%7 == 0
+		parent := p
+		if right {
+			p = p.Right()
+		} else {
+			p = p.Left()
+		}
+		require.Equal(t, depth, p.Depth())
+		require.Equal(t, right, p.IsRight())
+		require.Equal(t, right, p.Step(depth-1))
+		require.Equal(t, parent, p.Parent())
+		require.Equal(t, p, p.Sibling().Sibling())
+		require.NotEqual(t, p, p.Sibling())
+		require.True(t, parent.IsAncestor(p))
+		require.Equal(t, depth > 1, NewPath(2).IsAncestor(p))
+
+		index.Lsh(index, 1)
+		if right {
+			index.SetBit(index, 0, 1)
+		}
+		require.Equal(t, index, p.Big())
+
+		parsed, err := PathFromBig(index)
+		require.NoError(t, err)
+		require.Equal(t, p, parsed)
+		require.Equal(t, 1, p.Cmp(p.Parent()))
+		require.Equal(t, -1, p.Cmp(p.Left()))
+	}
+	require.False(t, p.Left().IsAncestor(p))
+	require.False(t, p.Sibling().IsAncestor(p.Left()))
+}
+
+func TestPath_Cmp(t *testing.T) {
+	require.Equal(t, 0, NewPath(9).Cmp(NewPath(9)))
+	require.Equal(t, -1, NewPath(8).Cmp(NewPath(9)))
+	require.Equal(t, 1, NewPath(16).Cmp(NewPath(15)))
+	require.Equal(t, -1, NewPath(1<<62).Cmp(NewPath(1<<62).Left()))
+}
+
+func TestHelperPaths(t *testing.T) {
+	cases := [][]uint64{
+		{8, 9, 14},
+		{9},
+		{2, 3},
+		{2, 4},
+		{1 << 50, 1<<50 | 77, 3},
+	}
+	for _, indices := range cases {
+		paths := make([]Path, len(indices))
+		for i, index := range indices {
+			paths[i] = NewPath(index)
+		}
+
+		expected := []Path{}
+		for _, index := range HelperIndices(indices) {
+			expected = append(expected, NewPath(index))
+		}
+		require.Equal(t, expected, HelperPaths(paths))
+	}
+}
+
+func BenchmarkPath(b *testing.B) {
+	b.Run("Uint64", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			index := uint64(1)
+			for depth := 0; depth < 40; depth++ {
+				index = Sibling(index<<1 | 1)
+			}
+			for !IsRight(index) && index > 1 {
+				index = Parent(index)
+			}
+		}
+	})
+	b.Run("Path", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			p := Path{}
+			for depth := 0; depth < 40; depth++ {
+				p = p.Right().Sibling()
+			}
+			for !p.IsRight() && !p.IsRoot() {
+				p = p.Parent()
+			}
+		}
+	})
+	b.Run("PathDeep", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			p := Path{}
+			for depth := 0; depth < 160; depth++ {
+				p = p.Right().Sibling()
+			}
+			for !p.IsRight() && !p.IsRoot() {
+				p = p.Parent()
+			}
+		}
+	})
+}
+
+func BenchmarkHelperIndices(b *testing.B) {
+	indices := []uint64{1<<40 | 12, 1<<40 | 13, 1<<40 | 1<<20, 1<<20 | 5}
+	paths := make([]Path, len(indices))
+	for i, index := range indices {
+		paths[i] = NewPath(index)
+	}
+
+	b.Run("Uint64", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			HelperIndices(indices)
+		}
+	})
+	b.Run("Path", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			HelperPaths(paths)
+		}
+	})
+}
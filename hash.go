@@ -1,6 +1,13 @@
 package ssz
 
-import "hash"
+import (
+	"fmt"
+	"hash"
+	"runtime"
+	"sync"
+
+	"github.com/minio/sha256-simd"
+)
 
 type HashFn func(dst []byte, input []byte) error
 
@@ -23,3 +30,213 @@ func NativeHashWrapper(hashFn hash.Hash) HashFn {
 		return nil
 	}
 }
+
+// sha256HashFn is the HashFn of the default TreeHash
+func sha256HashFn(dst []byte, input []byte) error {
+	for i := 0; i+64 <= len(input); i += 64 {
+		res := sha256.Sum256(input[i : i+64])
+		copy(dst[i/2:], res[:])
+	}
+	return nil
+}
+
+// DefaultTreeHash is the sha256 TreeHash used by the package level
+// functions to build and verify trees and proofs.
+var DefaultTreeHash = newSha256TreeHash()
+
+func newSha256TreeHash() *TreeHash {
+	t := NewTreeHash(sha256HashFn)
+	t.sum = sha256.Sum256
+	return t
+}
+
+// TreeHash is a merkle hash function together with the hashes of the
+// zero subtrees computed with it. Its methods build and verify the trees
+// and the proofs of chains that merkleize with a hash other than sha256.
+// The nodes of a tree are hashed with the TreeHash that built them.
+type TreeHash struct {
+	fn         HashFn
+	sum        func(data []byte) [32]byte
+	zeroHashes [65][32]byte
+	zeroLevels map[string]int
+
+	// minParallel is the number of branches of a level from which
+	// they are hashed across goroutines, zero if they are not
+	minParallel int
+}
+
+// TreeHashOption is an option of a TreeHash
+type TreeHashOption func(t *TreeHash)
+
+// WithParallelHashing hashes the levels of a tree with at least minBranches
+// branches across goroutines. The hash function has to be safe for concurrent use.
+func WithParallelHashing(minBranches int) TreeHashOption {
+	return func(t *TreeHash) {
+		if minBranches < 1 {
+			minBranches = 1
+		}
+		t.minParallel = minBranches
+	}
+}
+
+// NewTreeHash creates a TreeHash with the given hash function. It computes
+// the zero hashes of the function, so it should be created once and reused.
+func NewTreeHash(fn HashFn, opts ...TreeHashOption) *TreeHash {
+	t := &TreeHash{
+		fn:         fn,
+		zeroLevels: map[string]int{},
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	t.zeroLevels[string(t.zeroHashes[0][:])] = 0
+
+	tmp := make([]byte, 64)
+	for i := 0; i < 64; i++ {
+		copy(tmp[:32], t.zeroHashes[i][:])
+		copy(tmp[32:], t.zeroHashes[i][:])
+		if err := fn(tmp, tmp); err != nil {
+			panic(fmt.Sprintf("failed to compute the zero hashes: %v", err))
+		}
+		copy(t.zeroHashes[i+1][:], tmp[:32])
+		t.zeroLevels[string(t.zeroHashes[i+1][:])] = i + 1
+	}
+	return t
+}
+
+// Hash returns the hash of the left and right 32 bytes chunks. The chunks
+// that are shorter are padded with zeros.
+func (t *TreeHash) Hash(left, right []byte) []byte {
+	if t.sum != nil {
+		var buf [64]byte
+		copy(buf[:32], left)
+		copy(buf[32:], right)
+		res := t.sum(buf[:])
+		return res[:]
+	}
+	res, err := t.hashPair(left, right)
+	if err != nil {
+		panic(fmt.Sprintf("failed to hash the chunks: %v", err))
+	}
+	return res
+}
+
+// hashPair returns the hash of two chunks with the hash function
+func (t *TreeHash) hashPair(left, right []byte) ([]byte, error) {
+	buf := make([]byte, 64)
+	copy(buf[:32], left)
+	copy(buf[32:], right)
+	if err := t.fn(buf, buf); err != nil {
+		return nil, err
+	}
+	return buf[:32:32], nil
+}
+
+// hashBatchSize is the maximum number of branches hashed with one call
+// to the hash function, which bounds the size of the input buffer
+const hashBatchSize = 1024
+
+// hashBranches sets the value of the branches to the hash of their children,
+// which have to be hashed already.
+func (t *TreeHash) hashBranches(nodes []*Node) {
+	workers := 1
+	if t.minParallel != 0 && len(nodes) >= t.minParallel {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers == 1 {
+		t.hashBatches(nodes)
+		return
+	}
+
+	var wg sync.WaitGroup
+	size := (len(nodes) + workers - 1) / workers
+	for start := 0; start < len(nodes); start += size {
+		end := start + size
+		if end > len(nodes) {
+			end = len(nodes)
+		}
+		wg.Add(1)
+		go func(nodes []*Node) {
+			defer wg.Done()
+			t.hashBatches(nodes)
+		}(nodes[start:end])
+	}
+	wg.Wait()
+}
+
+func (t *TreeHash) hashBatches(nodes []*Node) {
+	buf := make([]byte, 0, 64*min(len(nodes), hashBatchSize))
+	batch := make([]*Node, 0, cap(buf)/64)
+
+	for len(nodes) != 0 {
+		buf, batch = buf[:0], batch[:0]
+		for len(nodes) != 0 && len(batch) != hashBatchSize {
+			n := nodes[0]
+			nodes = nodes[1:]
+
+			left, right := n.left.value, n.right.value
+			if len(left) == 32 && len(right) == 32 {
+				buf = append(append(buf, left...), right...)
+			} else {
+				// the leaves that are not padded to 32 bytes
+				indx := len(buf)
+				buf = append(append(buf, zeroBytes...), zeroBytes...)
+				copy(buf[indx:indx+32], left)
+				copy(buf[indx+32:], right)
+			}
+			batch = append(batch, n)
+		}
+		if len(batch) == 0 {
+			continue
+		}
+
+		// the hash functions hash the pairs in place
+		if err := t.fn(buf, buf); err != nil {
+			panic(fmt.Sprintf("failed to hash the chunks: %v", err))
+		}
+		hashes := make([]byte, 32*len(batch))
+		copy(hashes, buf[:len(hashes)])
+		for i, n := range batch {
+			n.value = hashes[32*i : 32*(i+1) : 32*(i+1)]
+		}
+	}
+}
+
+// ZeroHash returns the root of a subtree of the given depth with zero leaves
+func (t *TreeHash) ZeroHash(depth int) []byte {
+	return t.zeroHashes[depth][:]
+}
+
+// zeroLevel returns the depth of the zero subtree with the given root
+func (t *TreeHash) zeroLevel(h []byte) (int, bool) {
+	level, ok := t.zeroLevels[string(h)]
+	return level, ok
+}
+
+// zeroTables are the zero hashes of the hash functions of the Hashers, by
+// the hash of two zero chunks. The zero hashes are shared and each Hasher
+// keeps its own hash function, which does not have to be safe for concurrent use.
+var zeroTables sync.Map
+
+func init() {
+	zeroTables.Store(DefaultTreeHash.zeroHashes[1], DefaultTreeHash)
+}
+
+// hasherTreeHash returns a TreeHash with the hash function of a Hasher and
+// the zero hashes computed the first time a Hasher uses the same hash
+func hasherTreeHash(fn HashFn) *TreeHash {
+	buf := make([]byte, 64)
+	if err := fn(buf, buf); err != nil {
+		panic(fmt.Sprintf("failed to compute the zero hashes: %v", err))
+	}
+	var key [32]byte
+	copy(key[:], buf[:32])
+
+	if z, ok := zeroTables.Load(key); ok {
+		zero := z.(*TreeHash)
+		return &TreeHash{fn: fn, zeroHashes: zero.zeroHashes, zeroLevels: zero.zeroLevels}
+	}
+	t := NewTreeHash(fn)
+	zeroTables.LoadOrStore(key, &TreeHash{zeroHashes: t.zeroHashes, zeroLevels: t.zeroLevels})
+	return t
+}
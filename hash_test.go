@@ -0,0 +1,189 @@
+package ssz
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/minio/sha256-simd"
+	"github.com/stretchr/testify/require"
+)
+
+// prefixHashFn is a merkle hash other than sha256 for testing
+func prefixHashFn(dst []byte, input []byte) error {
+	for i := 0; i+64 <= len(input); i += 64 {
+		res := sha256.Sum256(append([]byte{0x1}, input[i:i+64]...))
+		copy(dst[i/2:], res[:])
+	}
+	return nil
+}
+
+func TestTreeHash_ZeroHashes(t *testing.T) {
+	th := NewTreeHash(prefixHashFn)
+
+	expected := make([]byte, 64)
+	require.NoError(t, prefixHashFn(expected, expected))
+	require.Equal(t, expected[:32], th.ZeroHash(1))
+	require.Equal(t, expected[:32], th.Hash(zeroBytes, zeroBytes))
+	require.NotEqual(t, DefaultTreeHash.ZeroHash(1), th.ZeroHash(1))
+
+	for i := 0; i < 64; i++ {
+		require.Equal(t, th.ZeroHash(i+1), th.Hash(th.ZeroHash(i), th.ZeroHash(i)))
+	}
+	require.Equal(t, zeroHashes[10][:], DefaultTreeHash.ZeroHash(10))
+}
+
+func TestTreeHash_Proofs(t *testing.T) {
+	th := NewTreeHash(prefixHashFn)
+	obj := &proverTestObj{a: 1, b: []uint64{1, 2, 3, 4, 5}}
+
+	tree, err := th.ProofTree(obj)
+	require.NoError(t, err)
+
+	hh := NewHasherWithTreeHash(th)
+	require.NoError(t, obj.HashTreeRootWith(hh))
+	root := tree.Hash()
+	require.Equal(t, hh.Hash(), root)
+
+	// the list and the bytes are padded with the zero hashes of the hash
+	hh = NewHasherWithHashFn(prefixHashFn)
+	require.NoError(t, obj.HashTreeRootWith(hh))
+	require.Equal(t, hh.Hash(), root)
+
+	defaultTree, err := ProofTree(obj)
+	require.NoError(t, err)
+	require.NotEqual(t, defaultTree.Hash(), root)
+
+	proof, err := tree.Prove(41)
+	require.NoError(t, err)
+
+	ok, err := th.VerifyProof(root, proof)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = VerifyProof(root, proof)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	proof2, err := NewProvingHasherWithTreeHash(th, 41).Prove(obj)
+	require.NoError(t, err)
+	require.Equal(t, proof, proof2)
+
+	multiproof, err := tree.ProveMulti([]int{40, 41, 13})
+	require.NoError(t, err)
+
+	ok, err = th.VerifyMultiproof(root, multiproof.Hashes, multiproof.Leaves, multiproof.Indices)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// the zero hashes of the hash are omitted and filled in
+	compressed := multiproof.CompressWith(th)
+	require.NotEmpty(t, compressed.ZeroLevels)
+	require.Less(t, len(multiproof.Compress().ZeroLevels), len(compressed.ZeroLevels))
+
+	ok, err = th.VerifyCompressedMultiproof(root, compressed)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, multiproof, compressed.DecompressWith(th))
+}
+
+func TestTreeHash_SetEmptySubtree(t *testing.T) {
+	th := NewTreeHash(prefixHashFn)
+
+	r, err := th.TreeFromNodesWithMixin([]*Node{LeafFromUint64(1)}, 1, 16)
+	require.NoError(t, err)
+
+	// the empty subtree is expanded with the zero hashes of the hash
+	require.NoError(t, r.SetLeaf(40, LeafFromUint64(9).value))
+
+	leaves := []*Node{LeafFromUint64(1)}
+	for i := 1; i < 8; i++ {
+		leaves = append(leaves, EmptyLeaf())
+	}
+	leaves = append(leaves, LeafFromUint64(9))
+
+	expected, err := th.TreeFromNodesWithMixin(leaves, 1, 16)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), r.Hash())
+}
+
+func TestTreeHash_Many(t *testing.T) {
+	// the trees are hashed with any number of TreeHash objects
+	obj := &proverTestObj{a: 1, b: []uint64{1, 2, 3, 4, 5}}
+	for i := 0; i < 300; i++ {
+		tree, err := NewTreeHash(prefixHashFn).ProofTree(obj)
+		require.NoError(t, err)
+
+		hh := NewHasherWithHashFn(prefixHashFn)
+		require.NoError(t, obj.HashTreeRootWith(hh))
+		require.Equal(t, hh.Hash(), tree.Hash())
+	}
+}
+
+func TestTreeHash_UnpaddedLeaves(t *testing.T) {
+	// the leaves that are not padded to 32 bytes are padded with zeros
+	// with the default hash function and with any other function
+	leaves := func() []*Node {
+		return []*Node{
+			NewNodeWithValue([]byte{0x1}),
+			NewNodeWithValue([]byte{0x2, 0x3}),
+			NewNodeWithValue(nil),
+		}
+	}
+	padded := []*Node{}
+	for _, l := range leaves() {
+		padded = append(padded, LeafFromBytes(append([]byte{}, l.value...)))
+	}
+
+	expected, err := DefaultTreeHash.TreeFromNodes(padded, 4)
+	require.NoError(t, err)
+
+	for _, th := range []*TreeHash{DefaultTreeHash, NewTreeHash(sha256HashFn)} {
+		require.Equal(t, expected.Hash(), th.Hash(th.Hash([]byte{0x1}, []byte{0x2, 0x3}), th.Hash(nil, nil)))
+
+		root, err := th.TreeFromNodes(leaves(), 4)
+		require.NoError(t, err)
+		require.Equal(t, expected.Hash(), root.Hash())
+	}
+}
+
+func TestNewHasherWithHashFn_ZeroHashes(t *testing.T) {
+	// the Hashers with the same hash share the zero hashes and keep their function
+	h1, h2 := NewHasherWithHashFn(prefixHashFn), NewHasherWithHashFn(prefixHashFn)
+	require.NotSame(t, h1.tree, h2.tree)
+	require.Equal(t, reflect.ValueOf(h1.tree.zeroLevels).Pointer(), reflect.ValueOf(h2.tree.zeroLevels).Pointer())
+	require.Equal(t, NewTreeHash(prefixHashFn).zeroHashes, h1.tree.zeroHashes)
+
+	h3 := NewHasherWithHash(sha256.New())
+	require.Equal(t, reflect.ValueOf(DefaultTreeHash.zeroLevels).Pointer(), reflect.ValueOf(h3.tree.zeroLevels).Pointer())
+	require.NotEqual(t, h1.tree.zeroHashes, h3.tree.zeroHashes)
+}
+
+func TestNewHasherWithHashFn_Concurrent(t *testing.T) {
+	obj := &proverTestObj{a: 1, b: []uint64{1, 2, 3, 4, 5}}
+	hh := NewHasher()
+	require.NoError(t, obj.HashTreeRootWith(hh))
+	expected, err := hh.HashRoot()
+	require.NoError(t, err)
+
+	// each Hasher uses its own hash.Hash, which is not safe for concurrent use
+	var wg sync.WaitGroup
+	roots := make([][32]byte, 8)
+	for i := range roots {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				hh := NewHasherWithHashFn(NativeHashWrapper(sha256.New()))
+				if err := obj.HashTreeRootWith(hh); err != nil {
+					return
+				}
+				roots[i], _ = hh.HashRoot()
+			}
+		}(i)
+	}
+	wg.Wait()
+	for _, root := range roots {
+		require.Equal(t, expected, root)
+	}
+}
@@ -1,6 +1,7 @@
 package ssz
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"hash"
@@ -10,7 +11,7 @@ import (
 	"github.com/minio/sha256-simd"
 )
 
-var _ HashWalker = (*Hasher)(nil)
+var _ ExtendedHashWalker = (*Hasher)(nil)
 
 var (
 	// ErrIncorrectByteSize means that the byte size is incorrect
@@ -18,6 +19,10 @@ var (
 
 	// ErrIncorrectListSize means that the size of the list is incorrect
 	ErrIncorrectListSize = fmt.Errorf("incorrect list size")
+
+	// ErrUnsupportedHashWalker means that the HashWalker is not an
+	// ExtendedHashWalker and cannot merkleize the type
+	ErrUnsupportedHashWalker = fmt.Errorf("the HashWalker does not merkleize the type")
 )
 
 var zeroHashes [65][32]byte
@@ -28,16 +33,9 @@ func init() {
 	falseBytes = make([]byte, 32)
 	trueBytes = make([]byte, 32)
 	trueBytes[0] = 1
-	zeroHashLevels = make(map[string]int)
-	zeroHashLevels[string(falseBytes)] = 0
 
-	tmp := [64]byte{}
-	for i := 0; i < 64; i++ {
-		copy(tmp[:32], zeroHashes[i][:])
-		copy(tmp[32:], zeroHashes[i][:])
-		zeroHashes[i+1] = sha256.Sum256(tmp[:])
-		zeroHashLevels[string(zeroHashes[i+1][:])] = i + 1
-	}
+	zeroHashes = DefaultTreeHash.zeroHashes
+	zeroHashLevels = DefaultTreeHash.zeroLevels
 }
 
 // HashWithDefaultHasher hashes a HashRoot object with a Hasher from
@@ -68,11 +66,39 @@ type Hasher struct {
 
 	// sha256 hash function
 	hash HashFn
+
+	// zero hashes of the hash function
+	tree *TreeHash
+
+	// workers is the number of goroutines that hash the big lists
+	workers int
+	// children hash the objects of the lists in the workers
+	children []*Hasher
+}
+
+// HasherOption is an option of a Hasher
+type HasherOption func(h *Hasher)
+
+// WithHashWorkers hashes the big lists across the given number of goroutines.
+// The hash function of the TreeHash of the Hasher has to be safe for concurrent
+// use, like the sha256 of the default TreeHash or gohashtree.
+func WithHashWorkers(workers int) HasherOption {
+	return func(h *Hasher) {
+		h.workers = workers
+	}
 }
 
 // NewHasher creates a new Hasher object with sha256 hash
-func NewHasher() *Hasher {
-	return NewHasherWithHash(sha256.New())
+func NewHasher(opts ...HasherOption) *Hasher {
+	h := &Hasher{
+		hash: NativeHashWrapper(sha256.New()),
+		tree: DefaultTreeHash,
+		tmp:  make([]byte, 32),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // NewHasherWithHash creates a new Hasher object with a custom hash.Hash function
@@ -80,12 +106,25 @@ func NewHasherWithHash(hh hash.Hash) *Hasher {
 	return NewHasherWithHashFn(NativeHashWrapper(hh))
 }
 
-// NewHasherWithHashFn creates a new Hasher object with a custom HashFn function
-func NewHasherWithHashFn(hh HashFn) *Hasher {
-	return &Hasher{
-		hash: hh,
+// NewHasherWithHashFn creates a new Hasher object with a custom HashFn function.
+// The zero hashes of the function are computed once and shared by the Hashers
+// with the same hash.
+func NewHasherWithHashFn(hh HashFn, opts ...HasherOption) *Hasher {
+	return NewHasherWithTreeHash(hasherTreeHash(hh), opts...)
+}
+
+// NewHasherWithTreeHash creates a new Hasher object with the hash function
+// and the zero hashes of a TreeHash
+func NewHasherWithTreeHash(t *TreeHash, opts ...HasherOption) *Hasher {
+	h := &Hasher{
+		hash: t.fn,
+		tree: t,
 		tmp:  make([]byte, 32),
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // Reset resets the Hasher obj
@@ -127,6 +166,16 @@ func (h *Hasher) PutUint8(i uint8) {
 	h.AppendBytes32([]byte{byte(i)})
 }
 
+// PutUint128 appends a uint128 in 32 bytes
+func (h *Hasher) PutUint128(i Uint128) {
+	h.AppendBytes32(MarshalValue(nil, i))
+}
+
+// PutUint256 appends a uint256 in 32 bytes
+func (h *Hasher) PutUint256(i Uint256) {
+	h.AppendBytes32(MarshalValue(nil, i))
+}
+
 func CalculateLimit(maxCapacity, numItems, size uint64) uint64 {
 	limit := (maxCapacity*size + 31) / 32
 	if limit != 0 {
@@ -161,6 +210,14 @@ func (h *Hasher) AppendUint64(i uint64) {
 	h.buf = MarshalValue(h.buf, i)
 }
 
+func (h *Hasher) AppendUint128(i Uint128) {
+	h.buf = MarshalValue(h.buf, i)
+}
+
+func (h *Hasher) AppendUint256(i Uint256) {
+	h.buf = MarshalValue(h.buf, i)
+}
+
 func (h *Hasher) Append(i []byte) {
 	h.buf = append(h.buf, i...)
 }
@@ -259,6 +316,11 @@ func (h *Hasher) PutBytes(b []byte) {
 	h.Merkleize(indx)
 }
 
+// PutZeroChunk appends a zero chunk
+func (h *Hasher) PutZeroChunk() {
+	h.buf = append(h.buf, zeroBytes...)
+}
+
 // Index marks the current buffer index
 func (h *Hasher) Index() int {
 	return len(h.buf)
@@ -304,6 +366,111 @@ func (h *Hasher) MerkleizeWithMixin(indx int, num, limit uint64) {
 	h.buf = append(h.buf[:indx], input[:32]...)
 }
 
+// MerkleizeProgressive is used to merkleize the last group of the hasher with the
+// progressive layout of EIP-7916. The chunks are split in subtrees of 1, 4, 16...
+// chunks, so the depth of the tree grows with the number of chunks instead
+// of depending on a fixed limit.
+func (h *Hasher) MerkleizeProgressive(indx int) {
+	h.FillUpTo32()
+	input := h.buf[indx:]
+
+	// merkleize the input
+	root := h.merkleizeProgressiveImpl(input)
+	h.buf = append(h.buf[:indx], root...)
+}
+
+// MerkleizeProgressiveWithMixin is used to merkleize the last group of the hasher
+// with the progressive layout of EIP-7916 and mix in the length of the list.
+func (h *Hasher) MerkleizeProgressiveWithMixin(indx int, num uint64) {
+	h.MerkleizeProgressive(indx)
+
+	// mixin with the size
+	output := h.tmp[:32]
+	for indx := range output {
+		output[indx] = 0
+	}
+	MarshalValue[uint64](output[:0], num)
+	h.buf = append(h.buf, output...)
+
+	// input is of the form [<input><size>] of 64 bytes
+	input := h.buf[indx:]
+	h.hash(input, input)
+	h.buf = h.buf[:indx+32]
+}
+
+func (h *Hasher) merkleizeProgressiveImpl(input []byte) []byte {
+	count := len(input) / 32
+
+	// split the chunks in subtrees of 1, 4, 16... chunks
+	type subtree struct {
+		start, end int
+		limit      uint64
+	}
+	subtrees := []subtree{}
+	for start, limit := 0, 1; start < count; start, limit = start+limit, limit*4 {
+		end := start + limit
+		if end > count {
+			end = count
+		}
+		subtrees = append(subtrees, subtree{start: start * 32, end: end * 32, limit: uint64(limit)})
+	}
+
+	// root is of the form [<rest><subtree>], where rest is the root of the
+	// following subtrees and it is a zero chunk after the last subtree.
+	// The subtrees are merkleized starting from the last one since merkleizeImpl
+	// can write past the end of its input.
+	root := make([]byte, 64)
+	for i := len(subtrees) - 1; i >= 0; i-- {
+		s := subtrees[i]
+		chunks := input[s.start:s.end]
+		chunks = h.merkleizeImpl(chunks[:0], chunks, s.limit)
+
+		copy(root[32:], chunks[:32])
+		h.hash(root, root)
+	}
+	return root[:32]
+}
+
+// MerkleizeWithSelector is used to mix in the selector of a union with
+// the hash tree root of the selected value, which is the last group of the hasher.
+// The None value of a union is represented by an empty group.
+func (h *Hasher) MerkleizeWithSelector(indx int, selector uint8) {
+	if selector > maxUnionSelector {
+		panic(fmt.Sprintf("BUG: union selector '%d' out of bounds", selector))
+	}
+	h.MerkleizeWithMixin(indx, uint64(selector), 1)
+}
+
+// MerkleizeOptional is used to mix in the presence bit of an optional value with
+// the hash tree root of the value. A value that is not present is an empty group.
+func (h *Hasher) MerkleizeOptional(indx int, present bool) {
+	var selector uint8
+	if present {
+		selector = 1
+	}
+	h.MerkleizeWithSelector(indx, selector)
+}
+
+// MerkleizeStable is used to merkleize the fields of a stable container (EIP-7495),
+// which are the last group of the hasher. The fields are padded up to limit and the
+// root is mixed in with the root of the active fields bitvector.
+func (h *Hasher) MerkleizeStable(indx int, activeFields []byte, limit uint64) {
+	h.FillUpTo32()
+	input := h.buf[indx:]
+
+	// merkleize the fields
+	input = h.merkleizeImpl(input[:0], input, limit)
+	h.buf = append(h.buf[:indx], input[:32]...)
+
+	// hash the active fields as a Bitvector[limit]
+	h.PutBytes(activeFields)
+
+	// input is of the form [<fields><active fields>] of 64 bytes
+	input = h.buf[indx:]
+	h.hash(input, input)
+	h.buf = append(h.buf[:indx], input[:32]...)
+}
+
 func (h *Hasher) Hash() []byte {
 	return h.buf[len(h.buf)-32:]
 }
@@ -380,24 +547,291 @@ func (h *Hasher) merkleizeImpl(dst []byte, input []byte, limit uint64) []byte {
 
 	depth := getDepth(limit)
 	if len(input) == 0 {
-		return append(dst, zeroHashes[depth][:]...)
+		return append(dst, h.tree.zeroHashes[depth][:]...)
 	}
 
-	for i := uint8(0); i < depth; i++ {
+	if h.workers > 1 && count >= parallelMinChunks && len(input)%32 == 0 {
+		return append(dst, h.merkleizeParallel(input, count, depth)...)
+	}
+	input = h.merkleizeLevels(h.hash, input, 0, depth)
+	return append(dst, input...)
+}
+
+// merkleizeLevels hashes the input in place from the level from of the tree
+// up to the level to and returns the root
+func (h *Hasher) merkleizeLevels(hash HashFn, input []byte, from, to uint8) []byte {
+	for i := from; i < to; i++ {
 		layerLen := len(input) / 32
 		oddNodeLength := layerLen%2 == 1
 
 		if oddNodeLength {
 			// is odd length
-			input = append(input, zeroHashes[i][:]...)
+			input = append(input, h.tree.zeroHashes[i][:]...)
 			layerLen++
 		}
 
 		outputLen := (layerLen / 2) * 32
 
-		h.hash(input, input)
+		h.hashLevel(hash, input, i)
 		input = input[:outputLen]
 	}
+	return input
+}
 
-	return append(dst, input...)
+// hashLevel hashes in place the pairs of chunks of a level of the tree. The
+// pairs of zero hashes of the level are the roots of zero subtrees, whose
+// parent is the zero hash of the next level, so only the runs of pairs
+// between them are hashed. The zero subtrees are only looked for when the
+// first or the last pair of the level is zero, like in the vectors padded
+// with zeros, so that the dense levels are hashed in a single call.
+func (h *Hasher) hashLevel(hash HashFn, input []byte, level uint8) {
+	zero := h.tree.zeroHashes[level][:]
+
+	numPairs := len(input) / 64
+	if numPairs == 0 {
+		return
+	}
+	if !isZeroPair(input[:64], zero) && !isZeroPair(input[len(input)-64:], zero) {
+		hash(input, input)
+		return
+	}
+
+	start := 0
+	for i := 0; i <= numPairs; i++ {
+		if i != numPairs {
+			if !isZeroPair(input[64*i:64*(i+1)], zero) {
+				continue
+			}
+		}
+		if start != i {
+			// the hashes of the pairs are moved next to the previous ones
+			hash(input[64*start:64*i], input[64*start:64*i])
+			if start != 0 {
+				copy(input[32*start:], input[64*start:64*start+32*(i-start)])
+			}
+		}
+		if i != numPairs {
+			copy(input[32*i:32*(i+1)], h.tree.zeroHashes[level+1][:])
+		}
+		start = i + 1
+	}
+}
+
+// isZeroPair returns whether the two chunks of the pair are the zero hash
+func isZeroPair(pair, zero []byte) bool {
+	return bytes.Equal(pair[:32], zero) && bytes.Equal(pair[32:], zero)
+}
+
+const (
+	// parallelMinChunks is the number of chunks from which a Hasher
+	// with workers merkleizes them concurrently
+	parallelMinChunks = 1 << 12
+
+	// parallelMinObjects is the number of objects of a list from which
+	// a Hasher with workers hashes them concurrently
+	parallelMinObjects = 1 << 10
+)
+
+// merkleizeParallel splits the chunks in subtrees of the same size that are
+// merkleized concurrently, and then merkleizes their roots up to the depth.
+func (h *Hasher) merkleizeParallel(input []byte, count uint64, depth uint8) []byte {
+	subDepth := getDepth((count + uint64(h.workers) - 1) / uint64(h.workers))
+	size := 32 << subDepth
+	numSubtrees := (len(input) + size - 1) / size
+
+	roots := make([]byte, numSubtrees*32)
+
+	var wg sync.WaitGroup
+	for i := 0; i < numSubtrees; i++ {
+		start, end := i*size, (i+1)*size
+		if end > len(input) {
+			end = len(input)
+		}
+		wg.Add(1)
+		// the capacity of the chunks is limited so that the odd
+		// layers of the last subtree do not write after the input
+		go func(i int, chunks []byte) {
+			defer wg.Done()
+			copy(roots[i*32:], h.merkleizeLevels(h.tree.fn, chunks, 0, subDepth))
+		}(i, input[start:end:end])
+	}
+	wg.Wait()
+
+	return h.merkleizeLevels(h.tree.fn, roots, subDepth, depth)
+}
+
+// AppendUint128 appends a uint128 with the HashWalker
+func AppendUint128(hh HashWalker, i Uint128) {
+	if ext, ok := hh.(ExtendedHashWalker); ok {
+		ext.AppendUint128(i)
+		return
+	}
+	hh.Append(MarshalValue(nil, i))
+}
+
+// AppendUint256 appends a uint256 with the HashWalker
+func AppendUint256(hh HashWalker, i Uint256) {
+	if ext, ok := hh.(ExtendedHashWalker); ok {
+		ext.AppendUint256(i)
+		return
+	}
+	hh.Append(MarshalValue(nil, i))
+}
+
+// PutUint128 appends a uint128 in 32 bytes with the HashWalker
+func PutUint128(hh HashWalker, i Uint128) {
+	if ext, ok := hh.(ExtendedHashWalker); ok {
+		ext.PutUint128(i)
+		return
+	}
+	hh.AppendBytes32(MarshalValue(nil, i))
+}
+
+// PutUint256 appends a uint256 in 32 bytes with the HashWalker
+func PutUint256(hh HashWalker, i Uint256) {
+	if ext, ok := hh.(ExtendedHashWalker); ok {
+		ext.PutUint256(i)
+		return
+	}
+	hh.AppendBytes32(MarshalValue(nil, i))
+}
+
+// PutZeroChunk appends a zero chunk with the HashWalker
+func PutZeroChunk(hh HashWalker) {
+	if ext, ok := hh.(ExtendedHashWalker); ok {
+		ext.PutZeroChunk()
+		return
+	}
+	hh.Append(zeroBytes)
+}
+
+// MerkleizeProgressive merkleizes the last group of the HashWalker
+// as a progressive list
+func MerkleizeProgressive(hh HashWalker, indx int) error {
+	ext, err := asExtended(hh)
+	if err != nil {
+		return err
+	}
+	ext.MerkleizeProgressive(indx)
+	return nil
+}
+
+// MerkleizeProgressiveWithMixin merkleizes the last group of the HashWalker
+// as a progressive list and mixes in its length
+func MerkleizeProgressiveWithMixin(hh HashWalker, indx int, num uint64) error {
+	ext, err := asExtended(hh)
+	if err != nil {
+		return err
+	}
+	ext.MerkleizeProgressiveWithMixin(indx, num)
+	return nil
+}
+
+// MerkleizeWithSelector mixes in the selector of a union with the root of
+// the last group of the HashWalker
+func MerkleizeWithSelector(hh HashWalker, indx int, selector uint8) error {
+	ext, err := asExtended(hh)
+	if err != nil {
+		return err
+	}
+	ext.MerkleizeWithSelector(indx, selector)
+	return nil
+}
+
+// MerkleizeOptional mixes in the presence bit of an optional value with
+// the root of the last group of the HashWalker
+func MerkleizeOptional(hh HashWalker, indx int, present bool) error {
+	ext, err := asExtended(hh)
+	if err != nil {
+		return err
+	}
+	ext.MerkleizeOptional(indx, present)
+	return nil
+}
+
+// MerkleizeStable merkleizes the last group of the HashWalker as the fields
+// of a stable container and mixes in the active fields
+func MerkleizeStable(hh HashWalker, indx int, activeFields []byte, limit uint64) error {
+	ext, err := asExtended(hh)
+	if err != nil {
+		return err
+	}
+	ext.MerkleizeStable(indx, activeFields, limit)
+	return nil
+}
+
+// PutObjects appends the roots of the objects of a list to the hasher.
+// A Hasher with workers hashes the big lists of objects concurrently, and
+// a ContextWalker stops with the error of its context once it is done.
+func PutObjects[T HashRootProof](hh HashWalker, objs []T) error {
+	h, ok := asHasher(hh)
+	if !ok || h.workers <= 1 || len(objs) < parallelMinObjects {
+		for _, obj := range objs {
+			if err := walkerErr(hh); err != nil {
+				return err
+			}
+			if err := obj.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	indx := len(h.buf)
+	h.buf = append(h.buf, make([]byte, 32*len(objs))...)
+	roots := h.buf[indx:]
+
+	for len(h.children) < h.workers {
+		h.children = append(h.children, NewHasherWithTreeHash(h.tree))
+	}
+
+	errs := make([]error, h.workers)
+	size := (len(objs) + h.workers - 1) / h.workers
+	done := walkerDone(hh)
+
+	var wg sync.WaitGroup
+	for w := 0; w*size < len(objs); w++ {
+		start, end := w*size, (w+1)*size
+		if end > len(objs) {
+			end = len(objs)
+		}
+		wg.Add(1)
+		go func(w, start, end int) {
+			defer wg.Done()
+
+			child := h.children[w]
+			for i := start; i < end; i++ {
+				select {
+				case <-done:
+					// the error of the context is returned below
+					return
+				default:
+				}
+				child.Reset()
+				if err := objs[i].HashTreeRootWith(child); err != nil {
+					errs[w] = err
+					return
+				}
+				root, err := child.HashRoot()
+				if err != nil {
+					errs[w] = err
+					return
+				}
+				copy(roots[i*32:], root[:])
+			}
+		}(w, start, end)
+	}
+	wg.Wait()
+
+	if err := walkerErr(hh); err != nil {
+		h.buf = h.buf[:indx]
+		return err
+	}
+	for _, err := range errs {
+		if err != nil {
+			h.buf = h.buf[:indx]
+			return err
+		}
+	}
+	return nil
 }
@@ -1,10 +1,13 @@
 package ssz
 
 import (
+	"errors"
 	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/prysmaticlabs/gohashtree"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDepth(t *testing.T) {
@@ -72,3 +75,176 @@ func TestHashGoHashTree(t *testing.T) {
 
 	fmt.Println(buf)
 }
+
+func TestHasher_Workers(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	hashers := map[string]*Hasher{
+		"default":    NewHasher(WithHashWorkers(4)),
+		"gohashtree": NewHasherWithHashFn(gohashtree.HashByteSlice, WithHashWorkers(3)),
+	}
+
+	// number of uint64 values around the number of chunks merkleized concurrently
+	for _, num := range []int{0, 1, 4 * parallelMinChunks, 4*parallelMinChunks + 1, 4*parallelMinChunks + 5, 5*parallelMinChunks - 3, 1 << 16} {
+		vals := make([]uint64, num)
+		for i := range vals {
+			vals[i] = r.Uint64()
+		}
+
+		expected := NewHasher()
+		expected.PutUint64Array(vals, 1<<20)
+
+		for name, hh := range hashers {
+			hh.Reset()
+			hh.PutUint64Array(vals, 1<<20)
+			require.Equal(t, expected.Hash(), hh.Hash(), "%s: %d values", name, num)
+		}
+	}
+}
+
+func TestHasher_WorkersObjects(t *testing.T) {
+	putObjects := func(hh HashWalker, objs []*proverTestObj) ([]byte, error) {
+		indx := hh.Index()
+		if err := PutObjects(hh, objs); err != nil {
+			return nil, err
+		}
+		hh.MerkleizeWithMixin(indx, uint64(len(objs)), 1<<20)
+		return append([]byte{}, hh.Hash()...), nil
+	}
+
+	for _, num := range []int{0, 10, parallelMinObjects, 3*parallelMinObjects + 7} {
+		objs := make([]*proverTestObj, num)
+		for i := range objs {
+			objs[i] = &proverTestObj{a: uint64(i), b: []uint64{uint64(i), 2}}
+		}
+
+		expected, err := putObjects(NewHasher(), objs)
+		require.NoError(t, err)
+
+		hh := NewHasher(WithHashWorkers(4))
+		hh.PutUint64(1)
+
+		indx := hh.Index()
+		found, err := putObjects(hh, objs)
+		require.NoError(t, err)
+		require.Equal(t, expected, found)
+		require.Equal(t, indx+32, hh.Index())
+
+		// the other walkers hash the objects one by one
+		w := &Wrapper{}
+		_, err = putObjects(w, objs)
+		require.NoError(t, err)
+		require.Equal(t, expected, w.Node().Hash())
+	}
+}
+
+type workersErrObj struct {
+	err error
+}
+
+func (w *workersErrObj) HashTreeRootWith(hh HashWalker) error {
+	if w.err != nil {
+		return w.err
+	}
+	hh.PutUint64(1)
+	return nil
+}
+
+func TestHasher_WorkersObjectsError(t *testing.T) {
+	errObj := errors.New("object error")
+
+	objs := make([]*workersErrObj, 2*parallelMinObjects)
+	for i := range objs {
+		objs[i] = &workersErrObj{}
+	}
+	objs[len(objs)-10].err = errObj
+
+	hh := NewHasher(WithHashWorkers(4))
+	hh.PutUint64(1)
+	require.ErrorIs(t, PutObjects(hh, objs), errObj)
+	require.Equal(t, 32, hh.Index())
+}
+
+func BenchmarkHasher_Workers(b *testing.B) {
+	vals := make([]uint64, 1<<20)
+	for i := range vals {
+		vals[i] = uint64(i)
+	}
+
+	for _, workers := range []int{1, 4} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			hh := NewHasher(WithHashWorkers(workers))
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				hh.PutUint64Array(vals, 1<<40)
+				hh.Reset()
+			}
+		})
+	}
+}
+
+func TestHasher_ZeroSubtrees(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	hashers := map[string]*Hasher{
+		"native":     NewHasher(),
+		"gohashtree": NewHasherWithHashFn(gohashtree.HashByteSlice),
+		"workers":    NewHasher(WithHashWorkers(4)),
+	}
+
+	for _, num := range []int{1, 7, 64, 1000, 1024, 4*parallelMinChunks + 3} {
+		chunks := sparseChunks(r, num)
+
+		leaves := []*Node{}
+		for _, c := range chunks {
+			leaves = append(leaves, LeafFromBytes(c))
+		}
+		limit := int(nextPowerOfTwo(uint64(num)))
+		root, err := TreeFromNodes(leaves, limit)
+		require.NoError(t, err)
+		expected := hashPairs(DefaultTreeHash, root)
+
+		for name, hh := range hashers {
+			hh.Reset()
+			indx := hh.Index()
+			for _, c := range chunks {
+				hh.Append(c)
+			}
+			hh.Merkleize(indx)
+			require.Equal(t, expected, hh.Hash(), "%s: %d chunks", name, num)
+		}
+	}
+}
+
+func BenchmarkHasher_ZeroSubtrees(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+
+	// a vector of roots like the RandaoMixes with mostly zero roots
+	cases := map[string][][]byte{
+		"dense":  make([][]byte, 1<<16),
+		"sparse": sparseChunks(r, 1<<16),
+	}
+	for i := range cases["dense"] {
+		cases["dense"][i] = make([]byte, 32)
+		r.Read(cases["dense"][i])
+	}
+
+	for name, chunks := range cases {
+		b.Run(name, func(b *testing.B) {
+			hh := NewHasherWithHashFn(gohashtree.HashByteSlice)
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				indx := hh.Index()
+				for _, c := range chunks {
+					hh.Append(c)
+				}
+				hh.Merkleize(indx)
+				hh.Reset()
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package ssz
 
+import "io"
+
 // Marshaler is the interface implemented by types that can marshal themselves into valid SZZ.
 type Marshaler interface {
 	MarshalSSZTo(dst []byte) ([]byte, error)
@@ -7,6 +9,11 @@ type Marshaler interface {
 	SizeSSZ() int
 }
 
+// WriterMarshaler is the interface implemented by types that can marshal themselves into an io.Writer.
+type WriterMarshaler interface {
+	MarshalSSZWriter(w io.Writer) error
+}
+
 type SSZSizer interface {
 	SizeSSZ() int
 }
@@ -17,6 +24,11 @@ type Unmarshaler interface {
 	UnmarshalSSZTail(buf []byte) ([]byte, error)
 }
 
+// ReaderUnmarshaler is the interface implemented by types that can unmarshal themselves from an io.Reader.
+type ReaderUnmarshaler interface {
+	UnmarshalSSZReader(r io.Reader, size int) error
+}
+
 type HashRoot interface {
 	GetTree() (*Node, error)
 	HashTreeRoot() ([32]byte, error)
@@ -50,6 +62,28 @@ type HashWalker interface {
 	MerkleizeWithMixin(indx int, num, limit uint64)
 }
 
+// ExtendedHashWalker is a HashWalker that also hashes the uint128 and uint256
+// values and merkleizes the progressive lists, the unions, the optional
+// values and the stable containers. The methods are not part of HashWalker
+// so that its other implementations still build. The generated code calls
+// them through the functions of the same name, which fall back to the
+// HashWalker methods for the values and return ErrUnsupportedHashWalker for
+// the types that the walker does not merkleize. A ContextWalker or a
+// ProfileWalker only merkleizes these types if the walker it wraps does.
+type ExtendedHashWalker interface {
+	HashWalker
+	AppendUint128(i Uint128)
+	AppendUint256(i Uint256)
+	PutUint128(i Uint128)
+	PutUint256(i Uint256)
+	PutZeroChunk()
+	MerkleizeProgressive(indx int)
+	MerkleizeProgressiveWithMixin(indx int, num uint64)
+	MerkleizeWithSelector(indx int, selector uint8)
+	MerkleizeOptional(indx int, present bool)
+	MerkleizeStable(indx int, activeFields []byte, limit uint64)
+}
+
 type PtrConstraint[T any] interface {
 	*T
 	Unmarshaler
@@ -70,6 +104,30 @@ func UnmarshalField[T any, PT PtrConstraint[T]](field *PT, buf []byte) error {
 	return (*field).UnmarshalSSZ(buf)
 }
 
+// UnmarshalOptionalField unmarshals an optional object. The field
+// is set to nil if the value is not present.
+func UnmarshalOptionalField[T any, PT PtrConstraint[T]](field *PT, buf []byte) error {
+	present, buf, err := UnmarshalOptionalPresence(buf)
+	if err != nil {
+		return err
+	}
+	if !present {
+		*field = nil
+		return nil
+	}
+	return UnmarshalField[T, PT](field, buf)
+}
+
+// SetOptional allocates the optional field if it is active
+// and sets it to nil otherwise.
+func SetOptional[T any](field **T, active bool) {
+	if !active {
+		*field = nil
+	} else if *field == nil {
+		*field = new(T)
+	}
+}
+
 // UnmarshalSliceWithIndexCallback handles slices with index-aware unmarshal logic
 func UnmarshalSliceWithIndexCallback[T any](
 	slice *[]T,
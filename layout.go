@@ -0,0 +1,232 @@
+package ssz
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/ferranbt/fastssz/gindex"
+)
+
+// ErrInvalidPath is returned when a field path cannot be resolved
+// to a generalized index.
+var ErrInvalidPath = fmt.Errorf("invalid field path")
+
+func ErrUnknownFieldFn(obj, field string) error {
+	return fmt.Errorf("%w: field '%s' not found in %s", ErrInvalidPath, field, obj)
+}
+
+// GindexFn resolves a field path to a generalized index relative
+// to the root of an object.
+type GindexFn func(path string) (int, error)
+
+// ConcatGindices returns the generalized index of a node found by following
+// each of the generalized indices starting from the root of the previous one.
+// It returns zero, which is not a generalized index, if the index does not
+// fit in an int. The deeper nodes are represented with gindex.Path.
+func ConcatGindices(indices ...int) int {
+	raw := make([]uint64, len(indices))
+	for i, index := range indices {
+		if index < 0 {
+			return 0
+		}
+		raw[i] = uint64(index)
+	}
+	res, ok := gindex.Concat(raw...)
+	if !ok || res > math.MaxInt {
+		return 0
+	}
+	return int(res)
+}
+
+// SplitFieldPath splits the first field name of a path such as
+// 'body.execution_payload.block_hash' or 'validators[12].effective_balance'
+// from the rest of the path.
+func SplitFieldPath(path string) (string, string, error) {
+	indx := strings.IndexAny(path, ".[")
+	if indx == -1 {
+		indx = len(path)
+	}
+	field, rest := path[:indx], path[indx:]
+	if field == "" {
+		return "", "", fmt.Errorf("%w: expected a field name in '%s'", ErrInvalidPath, path)
+	}
+	rest, err := trimPathSeparator(rest)
+	if err != nil {
+		return "", "", err
+	}
+	return field, rest, nil
+}
+
+// FieldGindex resolves the rest of a path inside of the field at the given
+// generalized index. fn resolves paths relative to the field and is nil if
+// the field is a leaf of the tree.
+func FieldGindex(gindex int, rest string, fn GindexFn) (int, error) {
+	if gindex == 0 {
+		return 0, fmt.Errorf("%w: generalized index overflows, use gindex.Path", ErrInvalidPath)
+	}
+	if rest == "" {
+		return gindex, nil
+	}
+	if fn == nil {
+		return 0, fmt.Errorf("%w: cannot resolve '%s' inside a basic value", ErrInvalidPath, rest)
+	}
+	sub, err := fn(rest)
+	if err != nil {
+		return 0, err
+	}
+	res := ConcatGindices(gindex, sub)
+	if res == 0 {
+		return 0, fmt.Errorf("%w: generalized index overflows, use gindex.Path", ErrInvalidPath)
+	}
+	return res, nil
+}
+
+// ListGindex resolves a path of the form '[i]...' relative to the root of a
+// list with the given limit. elemSize is the size of the elements of a list of
+// basic values, which are packed in chunks, and zero for composite elements.
+func ListGindex(path string, limit, elemSize uint64, fn GindexFn) (int, error) {
+	i, rest, err := splitPathIndex(path, limit)
+	if err != nil {
+		return 0, err
+	}
+	return FieldGindex(ListElemGindex(i, limit, elemSize), rest, fn)
+}
+
+// VectorGindex resolves a path of the form '[i]...' relative to the root of
+// a vector of the given size. elemSize has the same meaning as in ListGindex.
+func VectorGindex(path string, size, elemSize uint64, fn GindexFn) (int, error) {
+	i, rest, err := splitPathIndex(path, size)
+	if err != nil {
+		return 0, err
+	}
+	return FieldGindex(VectorElemGindex(i, size, elemSize), rest, fn)
+}
+
+// ProgressiveListGindex resolves a path of the form '[i]...' relative to the
+// root of a progressive list (EIP-7916).
+func ProgressiveListGindex(path string, elemSize uint64, fn GindexFn) (int, error) {
+	i, rest, err := splitPathIndex(path, ProgressiveListLimit)
+	if err != nil {
+		return 0, err
+	}
+	return FieldGindex(ProgressiveListElemGindex(i, elemSize), rest, fn)
+}
+
+// OptionalGindex resolves a path relative to the value of an optional,
+// which is mixed in with its presence bit.
+func OptionalGindex(path string, fn GindexFn) (int, error) {
+	return FieldGindex(2, path, fn)
+}
+
+// ListElemGindex returns the generalized index of the chunk that holds
+// the i-th element of a list relative to the root of the list, or zero
+// if it does not fit in an int.
+func ListElemGindex(i int, limit, elemSize uint64) int {
+	return ConcatGindices(2, VectorElemGindex(i, limit, elemSize))
+}
+
+// VectorElemGindex returns the generalized index of the chunk that holds
+// the i-th element of a vector relative to the root of the vector, or zero
+// if it does not fit in an int.
+func VectorElemGindex(i int, size, elemSize uint64) int {
+	chunk, numChunks := uint64(i), size
+	if elemSize != 0 {
+		chunk = uint64(i) * elemSize / 32
+		numChunks = (size*elemSize + 31) / 32
+	}
+	depth := getDepth(numChunks)
+	if depth > 62 {
+		return 0
+	}
+	return 1<<depth | int(chunk)
+}
+
+// ProgressiveListElemGindex returns the generalized index of the chunk that
+// holds the i-th element of a progressive list relative to the root of the
+// list, or zero if it does not fit in an int.
+func ProgressiveListElemGindex(i int, elemSize uint64) int {
+	chunk := uint64(i)
+	if elemSize != 0 {
+		chunk = uint64(i) * elemSize / 32
+	}
+
+	return ConcatGindices(2, progressiveChunkGindex(chunk))
+}
+
+// progressiveChunkGindex returns the generalized index of a chunk relative
+// to the root of a progressive merkle tree.
+func progressiveChunkGindex(chunk uint64) int {
+	// the k-th subtree holds 4^k chunks and it is the right child
+	// of the node found after k left turns from the root
+	k, start := 0, uint64(0)
+	for chunk >= start+1<<(2*k) {
+		start += 1 << (2 * k)
+		k++
+	}
+	if 3*k+1 > 62 {
+		return 0
+	}
+	subtree := 1<<(k+1) | 1
+	return ConcatGindices(subtree, 1<<(2*k)|int(chunk-start))
+}
+
+// splitPathIndex splits the '[i]' index at the beginning of a path
+func splitPathIndex(path string, size uint64) (int, string, error) {
+	end := strings.IndexByte(path, ']')
+	if !strings.HasPrefix(path, "[") || end == -1 {
+		return 0, "", fmt.Errorf("%w: expected an index in '%s'", ErrInvalidPath, path)
+	}
+	i, err := strconv.ParseUint(path[1:end], 10, 63)
+	if err != nil {
+		return 0, "", fmt.Errorf("%w: incorrect index in '%s'", ErrInvalidPath, path)
+	}
+	if i >= size {
+		return 0, "", fmt.Errorf("%w: index %d out of bounds for size %d", ErrInvalidPath, i, size)
+	}
+	rest, err := trimPathSeparator(path[end+1:])
+	if err != nil {
+		return 0, "", err
+	}
+	return int(i), rest, nil
+}
+
+func trimPathSeparator(rest string) (string, error) {
+	if strings.HasPrefix(rest, ".") {
+		if rest = rest[1:]; rest == "" || rest[0] == '.' || rest[0] == '[' {
+			return "", fmt.Errorf("%w: expected a field name after '.'", ErrInvalidPath)
+		}
+		return rest, nil
+	}
+	if rest != "" && rest[0] != '[' {
+		return "", fmt.Errorf("%w: unexpected '%s'", ErrInvalidPath, rest)
+	}
+	return rest, nil
+}
+
+// ProveField returns a proof of the field path of an object. fn resolves
+// the path to a generalized index relative to the root of the object.
+func ProveField(v HashRootProof, fn GindexFn, path string) (*Proof, error) {
+	gindex, err := fn(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewProvingHasher(gindex).Prove(v)
+}
+
+// ProveFields returns a multiproof of the field paths of an object.
+func ProveFields(v HashRootProof, fn GindexFn, paths ...string) (*Multiproof, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("%w: no field paths to prove", ErrInvalidPath)
+	}
+	indices := make([]int, len(paths))
+	for i, path := range paths {
+		gindex, err := fn(path)
+		if err != nil {
+			return nil, err
+		}
+		indices[i] = gindex
+	}
+	return NewProvingHasher(indices...).ProveMulti(v)
+}
@@ -0,0 +1,89 @@
+package ssz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcatGindices(t *testing.T) {
+	require.Equal(t, 1, ConcatGindices())
+	require.Equal(t, 5, ConcatGindices(1, 5))
+	require.Equal(t, 105, ConcatGindices(52, 3))
+	require.Equal(t, 412, ConcatGindices(25, 28))
+	require.Equal(t, 0b1_0_1_11, ConcatGindices(2, 3, 7))
+
+	// the indices that do not fit in an int are zero
+	require.Equal(t, 0, ConcatGindices(1<<40, 1<<40))
+	require.Equal(t, 0, ConcatGindices(2, 1<<62))
+	require.Equal(t, 0, ConcatGindices(2, 0))
+	require.Equal(t, 0, ListElemGindex(1, 1<<62, 0))
+	require.Equal(t, 0, VectorElemGindex(1, 1<<63, 0))
+	require.Equal(t, 0, ProgressiveListElemGindex(1<<62, 0))
+}
+
+func TestProgressiveListElemGindex(t *testing.T) {
+	leaves := []*Node{}
+	for i := 0; i < 30; i++ {
+		leaves = append(leaves, LeafFromUint64(uint64(i)))
+	}
+	tree, err := TreeFromNodesProgressive(leaves)
+	require.NoError(t, err)
+	tree = NewNodeWithLR(tree, LeafFromUint64(uint64(len(leaves))))
+
+	for i := range leaves {
+		node, err := tree.Get(ProgressiveListElemGindex(i, 0))
+		require.NoError(t, err)
+		require.Equal(t, leaves[i].value, node.value)
+	}
+	// four uint64 values in each chunk
+	node, err := tree.Get(ProgressiveListElemGindex(21, 8))
+	require.NoError(t, err)
+	require.Equal(t, leaves[5].value, node.value)
+}
+
+func TestSplitFieldPath(t *testing.T) {
+	cases := []struct {
+		path, field, rest string
+	}{
+		{"slot", "slot", ""},
+		{"body.execution_payload.block_hash", "body", "execution_payload.block_hash"},
+		{"validators[12].effective_balance", "validators", "[12].effective_balance"},
+	}
+	for _, c := range cases {
+		field, rest, err := SplitFieldPath(c.path)
+		require.NoError(t, err)
+		require.Equal(t, c.field, field)
+		require.Equal(t, c.rest, rest)
+	}
+
+	for _, path := range []string{"", ".slot", "[1]", "body.", "body..root"} {
+		_, _, err := SplitFieldPath(path)
+		require.ErrorIs(t, err, ErrInvalidPath, path)
+	}
+}
+
+func TestListGindex(t *testing.T) {
+	gindex, err := ListGindex("[3]", 16, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, ConcatGindices(2, 16+3), gindex)
+
+	// uint16 values are packed in chunks of 16 elements
+	gindex, err = ListGindex("[17]", 64, 2, nil)
+	require.NoError(t, err)
+	require.Equal(t, ConcatGindices(2, 4+1), gindex)
+
+	// the index of a list of 2^40 lists of 2^40 elements overflows
+	inner := func(path string) (int, error) {
+		return ListGindex(path, 1<<40, 0, nil)
+	}
+	_, err = ListGindex("[1][2]", 1<<40, 0, inner)
+	require.ErrorIs(t, err, ErrInvalidPath)
+	_, err = ListGindex("[1]", 1<<62, 0, nil)
+	require.ErrorIs(t, err, ErrInvalidPath)
+
+	_, err = ListGindex("[16]", 16, 0, nil)
+	require.ErrorIs(t, err, ErrInvalidPath)
+	_, err = ListGindex("[1].a", 16, 8, nil)
+	require.ErrorIs(t, err, ErrInvalidPath)
+}
@@ -0,0 +1,406 @@
+package ssz
+
+import (
+	"context"
+	"fmt"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+var _ ExtendedHashWalker = (*ProfileWalker)(nil)
+
+// ProfileWalker is a HashWalker that records the statistics of every group of
+// chunks merkleized while it walks an object with another HashWalker, like a
+// Hasher or a Wrapper. Like the ProvingHasher, a group is opened by Index and
+// closed by the Merkleize methods, which resolves the generalized index of
+// the group in the object. The containers with a HashCache walk all their
+// fields with a ProfileWalker.
+type ProfileWalker struct {
+	HashWalker
+
+	groups []profileGroup
+	// open is the stack of the groups that are not merkleized yet,
+	// -1 for the groups that are deeper than maxLevel
+	open []int
+
+	maxLevel int
+	fields   map[int]string
+	fieldFn  func(path string) (int, error)
+	paths    []string
+
+	labels  bool
+	baseCtx context.Context
+}
+
+type profileGroup struct {
+	provingGroup
+
+	stats  MerkleizeStats
+	opened time.Time
+
+	// label is the position of the group in the chunks of
+	// the groups that contain it and ctx its pprof labels
+	label string
+	ctx   context.Context
+}
+
+// MerkleizeStats are the statistics of a group of chunks merkleized by an object
+type MerkleizeStats struct {
+	// Gindex is the generalized index of the root of the group
+	// in the walked object or zero if it is not known
+	Gindex int `json:"gindex"`
+	// Path is the field path of the group if it is given to the ProfileWalker
+	Path string `json:"path,omitempty"`
+	// Level is the number of groups that contain the group
+	Level int `json:"level"`
+	// Kind is the Merkleize method that merkleized the group
+	Kind string `json:"kind"`
+	// Chunks is the number of chunks of the group
+	Chunks uint64 `json:"chunks"`
+	// Hashes is the number of hashes of pairs of chunks to merkleize
+	// the group, including the zero subtrees and the mixins
+	Hashes uint64 `json:"hashes"`
+	// Time is the time spent merkleizing the chunks of the group
+	Time time.Duration `json:"time"`
+	// Total is the time spent since the group is opened, which includes
+	// walking its values and the groups it contains
+	Total time.Duration `json:"total"`
+}
+
+// HashProfile is the report of a ProfileWalker, with the groups in the
+// order in which they are opened
+type HashProfile struct {
+	Groups []MerkleizeStats `json:"groups"`
+}
+
+// ProfileOption is an option of a ProfileWalker
+type ProfileOption func(p *ProfileWalker)
+
+// WithMaxLevel only records the groups contained in at most maxLevel groups.
+// The time of the deeper groups is part of the total of the recorded ones.
+func WithMaxLevel(maxLevel int) ProfileOption {
+	return func(p *ProfileWalker) {
+		p.maxLevel = maxLevel
+	}
+}
+
+// WithFieldPaths sets the field path of the groups whose generalized index
+// is returned by gindexFn for the path, like the Gindex functions generated
+// for the containers. The paths that do not resolve are skipped.
+func WithFieldPaths(gindexFn func(path string) (int, error), paths ...string) ProfileOption {
+	return func(p *ProfileWalker) {
+		p.fieldFn = gindexFn
+		p.paths = append(p.paths, paths...)
+	}
+}
+
+// WithPprofLabels sets the "ssz_chunk" pprof label of the goroutine to the
+// position of the open group, like "/3/12" for the element 12 of the field 3,
+// so that the CPU profiles show the cost of each group. The labels are added
+// to the ones of the context. WithMaxLevel limits the number of labels.
+func WithPprofLabels(ctx context.Context) ProfileOption {
+	return func(p *ProfileWalker) {
+		p.labels = true
+		p.baseCtx = ctx
+	}
+}
+
+// NewProfileWalker creates a ProfileWalker that walks with hh
+func NewProfileWalker(hh HashWalker, opts ...ProfileOption) *ProfileWalker {
+	p := &ProfileWalker{
+		HashWalker: hh,
+		maxLevel:   -1,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Reset clears the recorded groups and resets the walker if it can be reset
+func (p *ProfileWalker) Reset() {
+	p.groups = p.groups[:0]
+	p.open = p.open[:0]
+	if r, ok := p.HashWalker.(interface{ Reset() }); ok {
+		r.Reset()
+	}
+}
+
+// Report returns the statistics of the groups merkleized since the last Reset
+func (p *ProfileWalker) Report() *HashProfile {
+	if p.fields == nil && p.fieldFn != nil {
+		p.fields = map[int]string{}
+		for _, path := range p.paths {
+			if gindex, err := p.fieldFn(path); err == nil {
+				p.fields[gindex] = path
+			}
+		}
+	}
+
+	report := &HashProfile{Groups: make([]MerkleizeStats, 0, len(p.groups))}
+	for i := range p.groups {
+		g := &p.groups[i]
+		if g.parent == -1 {
+			g.gindex = 1
+		} else {
+			g.gindex = p.groups[g.parent].childGindex(g.pos)
+		}
+
+		stats := g.stats
+		stats.Gindex = g.gindex
+		if path, ok := p.fields[g.gindex]; ok && g.gindex != 0 {
+			stats.Path = path
+		}
+		report.Groups = append(report.Groups, stats)
+	}
+	return report
+}
+
+// Index marks the current index and opens a new group
+func (p *ProfileWalker) Index() int {
+	indx := p.HashWalker.Index()
+
+	level := len(p.open)
+	if p.maxLevel != -1 && level > p.maxLevel {
+		p.open = append(p.open, -1)
+		return indx
+	}
+
+	g := profileGroup{
+		provingGroup: provingGroup{start: indx, parent: -1},
+		opened:       time.Now(),
+	}
+	g.stats.Level = level
+	if level != 0 {
+		parent := &p.groups[p.open[level-1]]
+		g.parent = p.open[level-1]
+		g.pos = walkerChunks(p.HashWalker, parent.start, indx)
+		g.label = parent.label + "/" + strconv.FormatUint(g.pos, 10)
+	}
+	if p.labels {
+		ctx := p.baseCtx
+		if g.parent != -1 {
+			ctx = p.groups[g.parent].ctx
+		}
+		label := g.label
+		if label == "" {
+			label = "/"
+		}
+		g.ctx = pprof.WithLabels(ctx, pprof.Labels("ssz_chunk", label))
+		pprof.SetGoroutineLabels(g.ctx)
+	}
+
+	p.open = append(p.open, len(p.groups))
+	p.groups = append(p.groups, g)
+	return indx
+}
+
+// merkleize closes the last open group and records its stats while
+// the chunks since indx are merkleized by fn
+func (p *ProfileWalker) merkleize(indx int, kind string, fn func(g *provingGroup, chunks uint64) uint64) {
+	id := p.open[len(p.open)-1]
+	p.open = p.open[:len(p.open)-1]
+
+	chunks := walkerChunks(p.HashWalker, indx, p.HashWalker.Index())
+	if id == -1 {
+		fn(&provingGroup{}, chunks)
+		return
+	}
+
+	g := &p.groups[id]
+	start := time.Now()
+	g.stats.Hashes = fn(&g.provingGroup, chunks)
+	end := time.Now()
+
+	g.stats.Kind = kind
+	g.stats.Chunks = chunks
+	g.stats.Time = end.Sub(start)
+	g.stats.Total = end.Sub(g.opened)
+
+	if p.labels {
+		ctx := p.baseCtx
+		if g.parent != -1 {
+			ctx = p.groups[g.parent].ctx
+		}
+		pprof.SetGoroutineLabels(ctx)
+	}
+}
+
+func (p *ProfileWalker) Merkleize(indx int) {
+	p.merkleize(indx, "merkleize", func(g *provingGroup, chunks uint64) uint64 {
+		g.depth = getDepth(chunks)
+		p.HashWalker.Merkleize(indx)
+		return merkleizeHashes(chunks, g.depth)
+	})
+}
+
+func (p *ProfileWalker) MerkleizeWithMixin(indx int, num, limit uint64) {
+	p.merkleize(indx, "mixin", func(g *provingGroup, chunks uint64) uint64 {
+		g.mixin = true
+		if limit == 0 {
+			g.depth = getDepth(chunks)
+		} else {
+			g.depth = getDepth(limit)
+		}
+		p.HashWalker.MerkleizeWithMixin(indx, num, limit)
+		return merkleizeHashes(chunks, g.depth) + 1
+	})
+}
+
+func (p *ProfileWalker) MerkleizeProgressive(indx int) {
+	p.merkleize(indx, "progressive", func(g *provingGroup, chunks uint64) uint64 {
+		g.progressive = true
+		p.HashWalker.(ExtendedHashWalker).MerkleizeProgressive(indx)
+		return progressiveHashes(chunks)
+	})
+}
+
+func (p *ProfileWalker) MerkleizeProgressiveWithMixin(indx int, num uint64) {
+	p.merkleize(indx, "progressive_mixin", func(g *provingGroup, chunks uint64) uint64 {
+		g.progressive = true
+		g.mixin = true
+		p.HashWalker.(ExtendedHashWalker).MerkleizeProgressiveWithMixin(indx, num)
+		return progressiveHashes(chunks) + 1
+	})
+}
+
+func (p *ProfileWalker) MerkleizeWithSelector(indx int, selector uint8) {
+	p.merkleize(indx, "selector", func(g *provingGroup, chunks uint64) uint64 {
+		g.mixin = true
+		p.HashWalker.(ExtendedHashWalker).MerkleizeWithSelector(indx, selector)
+		return 1
+	})
+}
+
+func (p *ProfileWalker) MerkleizeOptional(indx int, present bool) {
+	p.merkleize(indx, "optional", func(g *provingGroup, chunks uint64) uint64 {
+		g.mixin = true
+		p.HashWalker.(ExtendedHashWalker).MerkleizeOptional(indx, present)
+		return 1
+	})
+}
+
+func (p *ProfileWalker) MerkleizeStable(indx int, activeFields []byte, limit uint64) {
+	p.merkleize(indx, "stable", func(g *provingGroup, chunks uint64) uint64 {
+		g.mixin = true
+		g.depth = getDepth(limit)
+		p.HashWalker.(ExtendedHashWalker).MerkleizeStable(indx, activeFields, limit)
+		return merkleizeHashes(chunks, g.depth) + 1
+	})
+}
+
+func (p *ProfileWalker) AppendUint128(i Uint128) {
+	AppendUint128(p.HashWalker, i)
+}
+
+func (p *ProfileWalker) AppendUint256(i Uint256) {
+	AppendUint256(p.HashWalker, i)
+}
+
+func (p *ProfileWalker) PutUint128(i Uint128) {
+	PutUint128(p.HashWalker, i)
+}
+
+func (p *ProfileWalker) PutUint256(i Uint256) {
+	PutUint256(p.HashWalker, i)
+}
+
+func (p *ProfileWalker) PutZeroChunk() {
+	PutZeroChunk(p.HashWalker)
+}
+
+// PutBytes appends bytes and merkleizes them in their own group
+// if they are longer than 32 bytes
+func (p *ProfileWalker) PutBytes(b []byte) {
+	if len(b) <= 32 {
+		p.HashWalker.PutBytes(b)
+		return
+	}
+	indx := p.Index()
+	p.AppendBytes32(b)
+	p.Merkleize(indx)
+}
+
+// PutBitlist appends a ssz bitlist in its own group
+func (p *ProfileWalker) PutBitlist(bb []byte, maxSize uint64) {
+	b, size := parseBitlist(nil, bb)
+
+	indx := p.Index()
+	p.AppendBytes32(b)
+	p.MerkleizeWithMixin(indx, size, (maxSize+255)/256)
+}
+
+// PutUint64Array appends an array of uint64 in its own group
+func (p *ProfileWalker) PutUint64Array(b []uint64, maxCapacity ...uint64) {
+	indx := p.Index()
+	for _, i := range b {
+		p.AppendUint64(i)
+	}
+	p.FillUpTo32()
+
+	if len(maxCapacity) == 0 {
+		p.Merkleize(indx)
+	} else {
+		numItems := uint64(len(b))
+		p.MerkleizeWithMixin(indx, numItems, CalculateLimit(maxCapacity[0], numItems, 8))
+	}
+}
+
+// walkerChunks returns the number of chunks walked by hh between the
+// indices start and end. The index of a Wrapper is the number of nodes,
+// and the index of the hashers is the size of their buffer.
+func walkerChunks(hh HashWalker, start, end int) uint64 {
+	if c, ok := hh.(*ContextWalker); ok {
+		hh = c.HashWalker
+	}
+	if w, ok := hh.(*Wrapper); ok {
+		return uint64(end - start + (len(w.buf)+31)/32)
+	}
+	return uint64(end-start+31) / 32
+}
+
+// merkleizeHashes returns the number of hashes of pairs of
+// chunks to merkleize the chunks in a tree of the given depth
+func merkleizeHashes(chunks uint64, depth uint8) uint64 {
+	var hashes uint64
+	for i := uint8(0); i < depth && chunks != 0; i++ {
+		chunks = (chunks + 1) / 2
+		hashes += chunks
+	}
+	return hashes
+}
+
+// progressiveHashes returns the number of hashes of pairs of chunks
+// to merkleize the chunks with the progressive layout of EIP-7916
+func progressiveHashes(chunks uint64) uint64 {
+	var hashes uint64
+	for size, depth := uint64(1), uint8(0); chunks != 0; size, depth = size*4, depth+2 {
+		n := chunks
+		if n > size {
+			n = size
+		}
+		// the subtree is the right child of a node of the spine
+		hashes += merkleizeHashes(n, depth) + 1
+		chunks -= n
+	}
+	return hashes
+}
+
+// String returns the groups of the profile as a table
+func (h *HashProfile) String() string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "GINDEX\tPATH\tKIND\tCHUNKS\tHASHES\tTIME\tTOTAL")
+	for _, g := range h.Groups {
+		gindex := "-"
+		if g.Gindex != 0 {
+			gindex = strconv.Itoa(g.Gindex)
+		}
+		fmt.Fprintf(w, "%s%s\t%s\t%s\t%d\t%d\t%s\t%s\n", strings.Repeat("  ", g.Level), gindex, g.Path, g.Kind, g.Chunks, g.Hashes, g.Time, g.Total)
+	}
+	w.Flush()
+	return sb.String()
+}
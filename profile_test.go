@@ -0,0 +1,111 @@
+package ssz
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileWalker(t *testing.T) {
+	obj := &proverTestObj{a: 1, b: []uint64{1, 2, 3, 4, 5}}
+
+	expected := NewHasher()
+	require.NoError(t, obj.HashTreeRootWith(expected))
+
+	tree, err := ProofTree(obj)
+	require.NoError(t, err)
+
+	// the root, the uint64 list and the bytes
+	groups := []MerkleizeStats{
+		{Gindex: 1, Level: 0, Kind: "merkleize", Chunks: 3, Hashes: 3},
+		{Gindex: 5, Level: 1, Kind: "mixin", Chunks: 2, Hashes: 3},
+		{Gindex: 6, Level: 1, Kind: "merkleize", Chunks: 2, Hashes: 1},
+	}
+
+	for name, hh := range map[string]HashWalker{"hasher": NewHasher(), "wrapper": &Wrapper{}} {
+		p := NewProfileWalker(hh)
+		require.NoError(t, obj.HashTreeRootWith(p))
+		require.Equal(t, expected.Hash(), p.Hash(), name)
+
+		report := p.Report()
+		require.Len(t, report.Groups, len(groups), name)
+		for i, g := range report.Groups {
+			require.Positive(t, g.Total)
+			g.Time, g.Total = 0, 0
+			require.Equal(t, groups[i], g, name)
+
+			// the groups are in the tree of the object
+			node, err := tree.Get(g.Gindex)
+			require.NoError(t, err)
+			require.NotNil(t, node.left)
+		}
+		require.Contains(t, report.String(), "mixin")
+	}
+}
+
+func TestProfileWalker_Options(t *testing.T) {
+	obj := &proverTestObj{a: 1, b: []uint64{1, 2, 3, 4, 5}}
+
+	paths := map[string]int{"b": 5, "c": 6}
+	gindexFn := func(path string) (int, error) {
+		gindex, ok := paths[path]
+		if !ok {
+			return 0, ErrUnknownFieldFn("obj", path)
+		}
+		return gindex, nil
+	}
+
+	ctx := context.Background()
+	p := NewProfileWalker(NewContextWalker(ctx, NewHasher()),
+		WithMaxLevel(0),
+		WithFieldPaths(gindexFn, "b", "unknown"),
+		WithPprofLabels(ctx),
+	)
+	require.NoError(t, obj.HashTreeRootWith(p))
+
+	// only the root is recorded
+	report := p.Report()
+	require.Len(t, report.Groups, 1)
+	require.Equal(t, uint64(3), report.Groups[0].Chunks)
+
+	p.Reset()
+	p.maxLevel = -1
+	require.NoError(t, obj.HashTreeRootWith(p))
+
+	report = p.Report()
+	require.Len(t, report.Groups, 3)
+	require.Equal(t, "", report.Groups[0].Path)
+	require.Equal(t, "b", report.Groups[1].Path)
+	require.Equal(t, "", report.Groups[2].Path)
+
+	data, err := json.Marshal(report)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"path":"b"`)
+}
+
+func TestProfileWalker_Hashes(t *testing.T) {
+	cases := []struct {
+		chunks uint64
+		depth  uint8
+		hashes uint64
+	}{
+		{0, 3, 0},
+		{1, 0, 0},
+		{1, 3, 3},
+		{2, 1, 1},
+		{3, 2, 3},
+		{5, 3, 6},
+		{8, 3, 7},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.hashes, merkleizeHashes(c.chunks, c.depth), "%d chunks", c.chunks)
+	}
+
+	// subtrees of 1, 4 and 16 chunks and a node of the spine for each
+	require.Equal(t, uint64(0), progressiveHashes(0))
+	require.Equal(t, uint64(1), progressiveHashes(1))
+	require.Equal(t, uint64(1+3+1), progressiveHashes(5))
+	require.Equal(t, uint64(1+3+1+4+1), progressiveHashes(7))
+}
@@ -7,27 +7,37 @@ import (
 	"math"
 	"sort"
 
-	"github.com/minio/sha256-simd"
+	"github.com/ferranbt/fastssz/gindex"
 )
 
 // VerifyProof verifies a single merkle branch. It's more
 // efficient than VerifyMultiproof for proving one leaf.
 func VerifyProof(root []byte, proof *Proof) (bool, error) {
-	if len(proof.Hashes) != getPathLength(proof.Index) {
+	return DefaultTreeHash.VerifyProof(root, proof)
+}
+
+// VerifyProof is the same as VerifyProof with the TreeHash.
+func (t *TreeHash) VerifyProof(root []byte, proof *Proof) (bool, error) {
+	path := proof.Path
+	if proof.Index != 0 || path.IsRoot() {
+		// the root is proven with the index 1, the zero value of a
+		// Proof without an index or a path is not a valid proof
+		var err error
+		if path, err = indexToPath(proof.Index); err != nil {
+			return false, err
+		}
+	}
+	pathLen := path.Depth()
+	if len(proof.Hashes) != pathLen {
 		return false, errors.New("invalid proof length")
 	}
 
 	node := proof.Leaf[:]
-	tmp := make([]byte, 64)
 	for i, h := range proof.Hashes {
-		if getPosAtLevel(proof.Index, i) {
-			copy(tmp[:32], h[:])
-			copy(tmp[32:], node[:])
-			node = hashFn(tmp)
+		if path.Step(pathLen - 1 - i) {
+			node = t.Hash(h, node)
 		} else {
-			copy(tmp[:32], node[:])
-			copy(tmp[32:], h[:])
-			node = hashFn(tmp)
+			node = t.Hash(node, h)
 		}
 	}
 
@@ -36,14 +46,12 @@ func VerifyProof(root []byte, proof *Proof) (bool, error) {
 
 // VerifyMultiproof verifies a proof for multiple leaves against the given root.
 //
-// The arguments provided to this function need to adhere to some ordering rules, otherwise
-// a successful verification is not guaranteed even if the client holds correct data:
-//
-// 1. `leaves` and `indices` have same order, i.e. `leaves[i]` is the leaf at `indices[i]`;
-//
-// 2. `proofs` are sorted in descending order according to their generalised indices.
+// `leaves[i]` is the leaf at `indices[i]` but the leaves can be given in any order,
+// the indices are sorted internally. The hashes in `proof` are matched against the
+// generalised indices required to prove the leaves (see `getRequiredIndices`), which
+// are sorted in descending order. This is the order returned by `Node.ProveMulti`.
 //
-// For a better understanding of "2.", consider the following the tree:
+// For example, consider the following the tree:
 //
 //	       .
 //	   .       .            * = intermediate hash (i.e. an element of `proof`)
@@ -54,53 +62,134 @@ func VerifyProof(root []byte, proof *Proof) (bool, error) {
 // Let's call such hashes "*5", "*6" and "*15" respectively.
 // Then, when calling this function `proof` should be ordered as [*15, *6, *5].
 func VerifyMultiproof(root []byte, proof [][]byte, leaves [][]byte, indices []int) (bool, error) {
-	if len(indices) == 0 {
+	return DefaultTreeHash.VerifyMultiproof(root, proof, leaves, indices)
+}
+
+// VerifyMultiproof is the same as VerifyMultiproof with the TreeHash.
+func (t *TreeHash) VerifyMultiproof(root []byte, proof [][]byte, leaves [][]byte, indices []int) (bool, error) {
+	paths, err := indicesToPaths(indices)
+	if err != nil {
+		return false, err
+	}
+	return t.VerifyMultiproofPaths(root, proof, leaves, paths)
+}
+
+// VerifyMultiproofPaths is the same as VerifyMultiproof for
+// general indices of any depth.
+func VerifyMultiproofPaths(root []byte, proof [][]byte, leaves [][]byte, paths []gindex.Path) (bool, error) {
+	return DefaultTreeHash.VerifyMultiproofPaths(root, proof, leaves, paths)
+}
+
+// VerifyMultiproofPaths is the same as VerifyMultiproofPaths with the TreeHash.
+func (t *TreeHash) VerifyMultiproofPaths(root []byte, proof [][]byte, leaves [][]byte, paths []gindex.Path) (bool, error) {
+	return t.verifyMultiproof(root, len(proof), func(i int) ([]byte, error) {
+		return proof[i], nil
+	}, leaves, paths)
+}
+
+// VerifyCompressedMultiproof verifies a compressed proof for multiple leaves
+// against the given root. The omitted zero hashes are taken from the zero
+// hashes table as they are required, without decompressing the proof.
+func VerifyCompressedMultiproof(root []byte, proof *CompressedMultiproof) (bool, error) {
+	return DefaultTreeHash.VerifyCompressedMultiproof(root, proof)
+}
+
+// VerifyCompressedMultiproof is the same as VerifyCompressedMultiproof with the TreeHash.
+func (t *TreeHash) VerifyCompressedMultiproof(root []byte, proof *CompressedMultiproof) (bool, error) {
+	paths := proof.Paths
+	if proof.Indices != nil {
+		var err error
+		if paths, err = indicesToPaths(proof.Indices); err != nil {
+			return false, err
+		}
+	}
+
+	// the hashes are requested in order, the i-th omitted hash
+	// is the zero hash at the i-th zero level
+	zc := 0
+	ok, err := t.verifyMultiproof(root, len(proof.Hashes), func(i int) ([]byte, error) {
+		if h := proof.Hashes[i]; h != nil {
+			return h, nil
+		}
+		if zc >= len(proof.ZeroLevels) {
+			return nil, errors.New("proof is missing zero levels for the omitted hashes")
+		}
+		level := proof.ZeroLevels[zc]
+		if level < 0 || level >= len(t.zeroHashes) {
+			return nil, fmt.Errorf("incorrect zero hash level %d", level)
+		}
+		zc++
+		return t.ZeroHash(level), nil
+	}, proof.Leaves, paths)
+	if err != nil {
+		return false, err
+	}
+	if zc != len(proof.ZeroLevels) {
+		return false, fmt.Errorf("proof has %d zero levels for %d omitted hashes", len(proof.ZeroLevels), zc)
+	}
+	return ok, nil
+}
+
+// verifyMultiproof verifies the leaves at the given paths against the root.
+// hash returns the i-th of the numHashes proof hashes and it is called in
+// order.
+func (t *TreeHash) verifyMultiproof(root []byte, numHashes int, hash func(i int) ([]byte, error), leaves [][]byte, paths []gindex.Path) (bool, error) {
+	if len(paths) == 0 {
 		return false, errors.New("indices length is zero")
 	}
 
-	if len(leaves) != len(indices) {
+	if len(leaves) != len(paths) {
 		return false, errors.New("number of leaves and indices mismatch")
 	}
 
-	reqIndices := getRequiredIndices(indices)
-	if len(reqIndices) != len(proof) {
-		return false, fmt.Errorf("number of proof hashes %d and required indices %d mismatch", len(proof), len(reqIndices))
+	reqPaths := gindex.HelperPaths(paths)
+	if len(reqPaths) != numHashes {
+		return false, fmt.Errorf("number of proof hashes %d and required indices %d mismatch", numHashes, len(reqPaths))
 	}
 
 	// userGenIndices contains all generalised indices between leaves and proof hashes
 	// i.e., the indices retrieved from the user of this function
-	userGenIndices := make([]int, len(indices)+len(reqIndices))
-	pos := 0
+	userGenIndices := make([]gindex.Path, 0, len(paths)+len(reqPaths))
 	// Create database of index -> value (hash) from inputs
-	db := make(map[int][]byte)
+	db := make(map[gindex.Path][]byte)
 	for i, leaf := range leaves {
-		db[indices[i]] = leaf
-		userGenIndices[pos] = indices[i]
-		pos++
+		if prev, ok := db[paths[i]]; ok {
+			// the same index can be proven more than once with the same leaf
+			if !bytes.Equal(prev, leaf) {
+				return false, fmt.Errorf("conflicting leaves for index %s", paths[i])
+			}
+			continue
+		}
+		db[paths[i]] = leaf
+		userGenIndices = append(userGenIndices, paths[i])
 	}
-	for i, h := range proof {
-		db[reqIndices[i]] = h
-		userGenIndices[pos] = reqIndices[i]
-		pos++
+	for i := 0; i < numHashes; i++ {
+		h, err := hash(i)
+		if err != nil {
+			return false, err
+		}
+		db[reqPaths[i]] = h
+		userGenIndices = append(userGenIndices, reqPaths[i])
 	}
 
 	// Make sure keys are sorted in reverse order since we start from the leaves
-	sort.Sort(sort.Reverse(sort.IntSlice(userGenIndices)))
+	sort.Slice(userGenIndices, func(i, j int) bool {
+		return userGenIndices[i].Cmp(userGenIndices[j]) > 0
+	})
 
 	// The depth of the tree up to the greatest index
-	cap := int(math.Log2(float64(userGenIndices[0])))
+	cap := userGenIndices[0].Depth()
 
 	// Allocate space for auxiliary keys created when computing intermediate hashes
 	// Auxiliary indices are useful to avoid using store all indices to traverse
 	// in a single array and sort upon an insertion, which would be inefficient.
-	auxGenIndices := make([]int, 0, cap)
+	auxGenIndices := make([]gindex.Path, 0, cap)
 
 	// To keep track the current position to inspect in both arrays
-	pos = 0
+	pos := 0
 	posAux := 0
 
-	tmp := make([]byte, 64)
-	var index int
+	var index gindex.Path
 
 	// Iter over the tree, computing hashes and storing them
 	// in the in-memory database, until the root is reached.
@@ -109,10 +198,10 @@ func VerifyMultiproof(root []byte, proof [][]byte, leaves [][]byte, indices []in
 	for posAux < len(auxGenIndices) || pos < len(userGenIndices) {
 		// We need to establish from which array we're going to take the next index
 		//
-		// 1. If we've no auxiliary indices yet, we're going to use the generalised ones
+		// 1. If we have no auxiliary indices left, we're going to use the generalised ones
 		// 2. If we have no more client indices, we're going to use the auxiliary ones
 		// 3. If we both, then we're going to compare them and take the biggest one
-		if len(auxGenIndices) == 0 || (pos < len(userGenIndices) && auxGenIndices[posAux] < userGenIndices[pos]) {
+		if posAux == len(auxGenIndices) || (pos < len(userGenIndices) && auxGenIndices[posAux].Cmp(userGenIndices[pos]) < 0) {
 			index = userGenIndices[pos]
 			pos++
 		} else {
@@ -121,26 +210,24 @@ func VerifyMultiproof(root []byte, proof [][]byte, leaves [][]byte, indices []in
 		}
 
 		// Root has been reached
-		if index == 1 {
+		if index.IsRoot() {
 			break
 		}
 
 		// If the parent is already computed, we don't need to calculate the intermediate hash
-		_, hasParent := db[getParent(index)]
+		parentIndex := index.Parent()
+		_, hasParent := db[parentIndex]
 		if hasParent {
 			continue
 		}
 
-		left, hasLeft := db[(index|1)^1]
-		right, hasRight := db[index|1]
+		left, hasLeft := db[parentIndex.Left()]
+		right, hasRight := db[parentIndex.Right()]
 		if !hasRight || !hasLeft {
-			return false, fmt.Errorf("proof is missing required nodes, either %d or %d", (index|1)^1, index|1)
+			return false, fmt.Errorf("proof is missing required nodes, either %s or %s", parentIndex.Left(), parentIndex.Right())
 		}
 
-		copy(tmp[:32], left[:])
-		copy(tmp[32:], right[:])
-		parentIndex := getParent(index)
-		db[parentIndex] = hashFn(tmp)
+		db[parentIndex] = t.Hash(left, right)
 
 		// An intermediate hash has been computed, as such we need to store its index
 		// to remember to examine it later
@@ -148,7 +235,7 @@ func VerifyMultiproof(root []byte, proof [][]byte, leaves [][]byte, indices []in
 
 	}
 
-	res, ok := db[1]
+	res, ok := db[gindex.Path{}]
 	if !ok {
 		return false, fmt.Errorf("root was not computed during proof verification")
 	}
@@ -156,6 +243,36 @@ func VerifyMultiproof(root []byte, proof [][]byte, leaves [][]byte, indices []in
 	return bytes.Equal(res, root), nil
 }
 
+// indicesToPaths returns the paths of the general indices.
+func indicesToPaths(indices []int) ([]gindex.Path, error) {
+	paths := make([]gindex.Path, len(indices))
+	for i, index := range indices {
+		path, err := indexToPath(index)
+		if err != nil {
+			return nil, err
+		}
+		paths[i] = path
+	}
+	return paths, nil
+}
+
+// indexToPath returns the path of a general index.
+func indexToPath(index int) (gindex.Path, error) {
+	if index < 1 {
+		return gindex.Path{}, fmt.Errorf("invalid generalized index %d", index)
+	}
+	return gindex.NewPath(uint64(index)), nil
+}
+
+// pathToIndex returns the general index of a path if it fits in an int.
+func pathToIndex(path gindex.Path) (int, bool) {
+	index, ok := path.Index()
+	if !ok || index > math.MaxInt {
+		return 0, false
+	}
+	return int(index), true
+}
+
 // Returns the position (i.e. false for left, true for right)
 // of an index at a given level.
 // Level 0 is the actual index's level, Level 1 is the position
@@ -166,58 +283,32 @@ func getPosAtLevel(index int, level int) bool {
 
 // Returns the length of the path to a node represented by its generalized index.
 func getPathLength(index int) int {
-	return int(math.Log2(float64(index)))
+	return gindex.Depth(uint64(index))
 }
 
 // Returns the generalized index for a node's sibling.
 func getSibling(index int) int {
-	return index ^ 1
+	return int(gindex.Sibling(uint64(index)))
 }
 
 // Returns the generalized index for a node's parent.
 func getParent(index int) int {
-	return index >> 1
+	return int(gindex.Parent(uint64(index)))
 }
 
 // Returns generalized indices for all nodes in the tree that are
 // required to prove the given leaf indices. The returned indices
 // are in a decreasing order.
 func getRequiredIndices(leafIndices []int) []int {
-	exists := struct{}{}
-	// Sibling hashes needed for verification
-	required := make(map[int]struct{})
-	// Set of hashes that will be computed
-	// on the path from leaf to root.
-	computed := make(map[int]struct{})
-	leaves := make(map[int]struct{})
-
-	for _, leaf := range leafIndices {
-		leaves[leaf] = exists
-		cur := leaf
-		for cur > 1 {
-			sibling := getSibling(cur)
-			parent := getParent(cur)
-			required[sibling] = exists
-			computed[parent] = exists
-			cur = parent
-		}
+	indices := make([]uint64, len(leafIndices))
+	for i, leaf := range leafIndices {
+		indices[i] = uint64(leaf)
 	}
+	required := gindex.HelperIndices(indices)
 
-	requiredList := make([]int, 0, len(required))
-	// Remove computed indices from required ones
-	for r := range required {
-		_, isComputed := computed[r]
-		_, isLeaf := leaves[r]
-		if !isComputed && !isLeaf {
-			requiredList = append(requiredList, r)
-		}
+	requiredList := make([]int, len(required))
+	for i, r := range required {
+		requiredList[i] = int(r)
 	}
-
-	sort.Sort(sort.Reverse(sort.IntSlice(requiredList)))
 	return requiredList
 }
-
-func hashFn(data []byte) []byte {
-	res := sha256.Sum256(data)
-	return res[:]
-}
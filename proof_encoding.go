@@ -0,0 +1,792 @@
+package ssz
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/ferranbt/fastssz/gindex"
+)
+
+// The proofs are encoded with the layout of the merkle proofs document
+// of the consensus specs, where the generalized indices are uint64:
+//
+//	class Proof(Container):
+//	    index: uint64
+//	    leaf: Bytes32
+//	    hashes: List[Bytes32, 64]       # from the leaf up to the root
+//
+//	class Multiproof(Container):
+//	    indices: List[uint64, ...]
+//	    leaves: List[Bytes32, ...]
+//	    hashes: List[Bytes32, ...]      # in decreasing order of their indices
+//
+//	class CompressedMultiproof(Container):
+//	    indices: List[uint64, ...]
+//	    leaves: List[Bytes32, ...]
+//	    hashes: List[Bytes32, ...]      # the hashes that are not omitted
+//	    zero_levels: List[uint8, ...]   # the level of every omitted zero hash
+//	    omitted: Bitlist[...]           # the positions of the omitted hashes
+//
+// The generalized indices deeper than 63 levels are only supported
+// with the JSON encoding, in which they are decimal strings.
+
+const (
+	// proofMaxHashes is the maximum number of hashes of a proof
+	// of an uint64 generalized index
+	proofMaxHashes = 64
+
+	// maxProofItems is the maximum number of items in the lists of
+	// a multiproof, which is bounded by the size of the offsets
+	maxProofItems = math.MaxUint32
+)
+
+// MarshalSSZ ssz marshals the Proof object
+func (p *Proof) MarshalSSZ() ([]byte, error) {
+	return MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the Proof object to a target array
+func (p *Proof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Index'
+	var index uint64
+	if index, err = proofIndex(p.Index, p.Path); err != nil {
+		return
+	}
+	dst = MarshalValue(dst, index)
+
+	// Field (1) 'Leaf'
+	if size := uint64(len(p.Leaf)); size != 32 {
+		err = ErrBytesLengthFn("Proof.Leaf", size, 32)
+		return
+	}
+	dst = append(dst, p.Leaf...)
+
+	// Offset (2) 'Hashes'
+	dst = WriteOffset(dst, 44)
+
+	// Field (2) 'Hashes'
+	if size := uint64(len(p.Hashes)); size > proofMaxHashes {
+		err = ErrListTooBigFn("Proof.Hashes", size, proofMaxHashes)
+		return
+	}
+	return marshalHashes(dst, "Proof.Hashes", p.Hashes)
+}
+
+// UnmarshalSSZ ssz unmarshals the Proof object
+func (p *Proof) UnmarshalSSZ(buf []byte) error {
+	return UnmarshalSSZ(p, buf)
+}
+
+// UnmarshalSSZTail unmarshals the Proof object and returns the remaining buffer
+func (p *Proof) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	if size < 44 {
+		return nil, ErrSize
+	}
+
+	tail := buf
+	var o2 uint64
+	marker := NewOffsetMarker(uint64(size), 44)
+
+	// Field (0) 'Index'
+	var index uint64
+	index, buf = UnmarshallValue[uint64](buf)
+	if p.Index, p.Path, err = unmarshalProofIndex(index); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Leaf'
+	p.Leaf, buf = UnmarshalBytes(nil, buf, 32)
+
+	// Offset (2) 'Hashes'
+	if o2, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Hashes'
+	if p.Hashes, err = unmarshalHashes(p.Hashes, tail[o2:], proofMaxHashes); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Proof object
+func (p *Proof) SizeSSZ() (size int) {
+	size = 44
+
+	// Field (2) 'Hashes'
+	size += len(p.Hashes) * 32
+
+	return
+}
+
+// MarshalSSZ ssz marshals the Multiproof object
+func (p *Multiproof) MarshalSSZ() ([]byte, error) {
+	return MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the Multiproof object to a target array
+func (p *Multiproof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	var indices []uint64
+	if indices, err = multiproofIndices(p.Indices, p.Paths); err != nil {
+		return
+	}
+	offset := 12
+
+	// Offset (0) 'Indices'
+	dst = WriteOffset(dst, offset)
+	offset += len(indices) * 8
+
+	// Offset (1) 'Leaves'
+	dst = WriteOffset(dst, offset)
+	offset += len(p.Leaves) * 32
+
+	// Offset (2) 'Hashes'
+	dst = WriteOffset(dst, offset)
+
+	// Field (0) 'Indices'
+	for ii := 0; ii < len(indices); ii++ {
+		dst = MarshalValue(dst, indices[ii])
+	}
+
+	// Field (1) 'Leaves'
+	if dst, err = marshalHashes(dst, "Multiproof.Leaves", p.Leaves); err != nil {
+		return
+	}
+
+	// Field (2) 'Hashes'
+	return marshalHashes(dst, "Multiproof.Hashes", p.Hashes)
+}
+
+// UnmarshalSSZ ssz unmarshals the Multiproof object
+func (p *Multiproof) UnmarshalSSZ(buf []byte) error {
+	return UnmarshalSSZ(p, buf)
+}
+
+// UnmarshalSSZTail unmarshals the Multiproof object and returns the remaining buffer
+func (p *Multiproof) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	if size < 12 {
+		return nil, ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2 uint64
+	marker := NewOffsetMarker(uint64(size), 12)
+
+	// Offset (0) 'Indices'
+	if o0, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (1) 'Leaves'
+	if o1, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (2) 'Hashes'
+	if o2, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (0) 'Indices'
+	if p.Indices, p.Paths, err = unmarshalProofIndices(tail[o0:o1]); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Leaves'
+	if p.Leaves, err = unmarshalHashes(p.Leaves, tail[o1:o2], maxProofItems); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Hashes'
+	if p.Hashes, err = unmarshalHashes(p.Hashes, tail[o2:], maxProofItems); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Multiproof object
+func (p *Multiproof) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (0) 'Indices'
+	if p.Indices != nil {
+		size += len(p.Indices) * 8
+	} else {
+		size += len(p.Paths) * 8
+	}
+
+	// Field (1) 'Leaves'
+	size += len(p.Leaves) * 32
+
+	// Field (2) 'Hashes'
+	size += len(p.Hashes) * 32
+
+	return
+}
+
+// MarshalSSZ ssz marshals the CompressedMultiproof object
+func (c *CompressedMultiproof) MarshalSSZ() ([]byte, error) {
+	return MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CompressedMultiproof object to a target array
+func (c *CompressedMultiproof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	var indices []uint64
+	if indices, err = multiproofIndices(c.Indices, c.Paths); err != nil {
+		return
+	}
+	hashes, omitted := c.omittedHashes()
+	if omitted.Count() != uint64(len(c.ZeroLevels)) {
+		err = fmt.Errorf("CompressedMultiproof.ZeroLevels: expected %d levels and %d found", omitted.Count(), len(c.ZeroLevels))
+		return
+	}
+	offset := 20
+
+	// Offset (0) 'Indices'
+	dst = WriteOffset(dst, offset)
+	offset += len(indices) * 8
+
+	// Offset (1) 'Leaves'
+	dst = WriteOffset(dst, offset)
+	offset += len(c.Leaves) * 32
+
+	// Offset (2) 'Hashes'
+	dst = WriteOffset(dst, offset)
+	offset += len(hashes) * 32
+
+	// Offset (3) 'ZeroLevels'
+	dst = WriteOffset(dst, offset)
+	offset += len(c.ZeroLevels)
+
+	// Offset (4) 'Omitted'
+	dst = WriteOffset(dst, offset)
+
+	// Field (0) 'Indices'
+	for ii := 0; ii < len(indices); ii++ {
+		dst = MarshalValue(dst, indices[ii])
+	}
+
+	// Field (1) 'Leaves'
+	if dst, err = marshalHashes(dst, "CompressedMultiproof.Leaves", c.Leaves); err != nil {
+		return
+	}
+
+	// Field (2) 'Hashes'
+	if dst, err = marshalHashes(dst, "CompressedMultiproof.Hashes", hashes); err != nil {
+		return
+	}
+
+	// Field (3) 'ZeroLevels'
+	for ii := 0; ii < len(c.ZeroLevels); ii++ {
+		if level := c.ZeroLevels[ii]; level < 0 || level >= len(zeroHashes) {
+			err = fmt.Errorf("CompressedMultiproof.ZeroLevels: incorrect zero hash level %d", level)
+			return
+		}
+		dst = MarshalValue(dst, uint8(c.ZeroLevels[ii]))
+	}
+
+	// Field (4) 'Omitted'
+	dst = append(dst, omitted...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CompressedMultiproof object
+func (c *CompressedMultiproof) UnmarshalSSZ(buf []byte) error {
+	return UnmarshalSSZ(c, buf)
+}
+
+// UnmarshalSSZTail unmarshals the CompressedMultiproof object and returns the remaining buffer
+func (c *CompressedMultiproof) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	if size < 20 {
+		return nil, ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2, o3, o4 uint64
+	marker := NewOffsetMarker(uint64(size), 20)
+
+	// Offset (0) 'Indices'
+	if o0, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (1) 'Leaves'
+	if o1, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (2) 'Hashes'
+	if o2, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (3) 'ZeroLevels'
+	if o3, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (4) 'Omitted'
+	if o4, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (0) 'Indices'
+	if c.Indices, c.Paths, err = unmarshalProofIndices(tail[o0:o1]); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Leaves'
+	if c.Leaves, err = unmarshalHashes(c.Leaves, tail[o1:o2], maxProofItems); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Hashes'
+	var hashes [][]byte
+	if hashes, err = unmarshalHashes(nil, tail[o2:o3], maxProofItems); err != nil {
+		return nil, err
+	}
+
+	// Field (3) 'ZeroLevels'
+	c.ZeroLevels = Extend(c.ZeroLevels, o4-o3)
+	for ii, level := range tail[o3:o4] {
+		if int(level) >= len(zeroHashes) {
+			return nil, fmt.Errorf("CompressedMultiproof.ZeroLevels: incorrect zero hash level %d", level)
+		}
+		c.ZeroLevels[ii] = int(level)
+	}
+
+	// Field (4) 'Omitted'
+	var omitted Bitlist
+	if omitted, err = UnmarshalBitList(nil, tail[o4:], maxProofItems); err != nil {
+		return nil, err
+	}
+	if omitted.Count() != uint64(len(c.ZeroLevels)) || omitted.Len()-omitted.Count() != uint64(len(hashes)) {
+		return nil, fmt.Errorf("CompressedMultiproof.Omitted: %d omitted hashes do not match %d zero levels and %d hashes", omitted.Count(), len(c.ZeroLevels), len(hashes))
+	}
+	c.Hashes = Extend(c.Hashes, omitted.Len())
+	for ii := uint64(0); ii < omitted.Len(); ii++ {
+		if omitted.BitAt(ii) {
+			c.Hashes[ii] = nil
+		} else {
+			c.Hashes[ii], hashes = hashes[0], hashes[1:]
+		}
+	}
+
+	return
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CompressedMultiproof object
+func (c *CompressedMultiproof) SizeSSZ() (size int) {
+	size = 20
+
+	// Field (0) 'Indices'
+	if c.Indices != nil {
+		size += len(c.Indices) * 8
+	} else {
+		size += len(c.Paths) * 8
+	}
+
+	// Field (1) 'Leaves'
+	size += len(c.Leaves) * 32
+
+	// Field (2) 'Hashes' and (4) 'Omitted'
+	for _, h := range c.Hashes {
+		if h != nil {
+			size += 32
+		}
+	}
+	size += len(c.Hashes)/8 + 1
+
+	// Field (3) 'ZeroLevels'
+	size += len(c.ZeroLevels)
+
+	return
+}
+
+// omittedHashes returns the hashes that are not omitted and the
+// bitlist with the positions of the omitted ones.
+func (c *CompressedMultiproof) omittedHashes() ([][]byte, Bitlist) {
+	hashes := make([][]byte, 0, len(c.Hashes))
+	omitted := NewBitlist(uint64(len(c.Hashes)))
+	for i, h := range c.Hashes {
+		if h == nil {
+			omitted.SetBitAt(uint64(i), true)
+		} else {
+			hashes = append(hashes, h)
+		}
+	}
+	return hashes, omitted
+}
+
+func marshalHashes(dst []byte, name string, hashes [][]byte) ([]byte, error) {
+	for ii := 0; ii < len(hashes); ii++ {
+		if size := uint64(len(hashes[ii])); size != 32 {
+			return nil, ErrBytesLengthFn(name+"[ii]", size, 32)
+		}
+		dst = append(dst, hashes[ii]...)
+	}
+	return dst, nil
+}
+
+func unmarshalHashes(hashes [][]byte, buf []byte, maxItems uint64) ([][]byte, error) {
+	if err := UnmarshalSliceWithIndexCallback(&hashes, buf, 32, maxItems, func(ii uint64, buf []byte) (err error) {
+		hashes[ii], _ = UnmarshalBytes(nil, buf, 32)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return hashes, nil
+}
+
+// proofIndex returns the uint64 generalized index of a proof
+func proofIndex(index int, path gindex.Path) (uint64, error) {
+	if index < 0 {
+		return 0, fmt.Errorf("invalid generalized index %d", index)
+	}
+	if index != 0 {
+		return uint64(index), nil
+	}
+	res, ok := path.Index()
+	if !ok {
+		return 0, fmt.Errorf("generalized index %s does not fit in an uint64", path)
+	}
+	return res, nil
+}
+
+// unmarshalProofIndex returns the generalized index of a proof as an
+// int if it fits or as a path otherwise
+func unmarshalProofIndex(index uint64) (int, gindex.Path, error) {
+	if index == 0 {
+		return 0, gindex.Path{}, fmt.Errorf("invalid generalized index %d", index)
+	}
+	if index > math.MaxInt {
+		return 0, gindex.NewPath(index), nil
+	}
+	return int(index), gindex.Path{}, nil
+}
+
+// multiproofIndices returns the uint64 generalized indices of a multiproof
+func multiproofIndices(indices []int, paths []gindex.Path) ([]uint64, error) {
+	if indices == nil {
+		res := make([]uint64, len(paths))
+		for i, path := range paths {
+			index, err := proofIndex(0, path)
+			if err != nil {
+				return nil, err
+			}
+			res[i] = index
+		}
+		return res, nil
+	}
+
+	res := make([]uint64, len(indices))
+	for i, index := range indices {
+		index, err := proofIndex(index, gindex.Path{})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = index
+	}
+	return res, nil
+}
+
+// unmarshalProofIndices returns the generalized indices of a multiproof
+// as ints if all of them fit or as paths otherwise
+func unmarshalProofIndices(buf []byte) ([]int, []gindex.Path, error) {
+	var raw []uint64
+	if err := UnmarshalSliceWithIndexCallback(&raw, buf, 8, maxProofItems, func(ii uint64, buf []byte) (err error) {
+		raw[ii], _ = UnmarshallValue[uint64](buf)
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	indices := make([]int, len(raw))
+	for i, index := range raw {
+		if index == 0 {
+			return nil, nil, fmt.Errorf("invalid generalized index %d", index)
+		}
+		if index > math.MaxInt {
+			paths := make([]gindex.Path, len(raw))
+			for i, index := range raw {
+				paths[i] = gindex.NewPath(index)
+			}
+			return nil, paths, nil
+		}
+		indices[i] = int(index)
+	}
+	return indices, nil, nil
+}
+
+// ---- JSON encoding ----
+
+type proofJSON struct {
+	Index  string   `json:"index"`
+	Leaf   string   `json:"leaf"`
+	Hashes []string `json:"hashes"`
+}
+
+type multiproofJSON struct {
+	Indices []string `json:"indices"`
+	Leaves  []string `json:"leaves"`
+	Hashes  []string `json:"hashes"`
+}
+
+type compressedMultiproofJSON struct {
+	Indices    []string  `json:"indices"`
+	Leaves     []string  `json:"leaves"`
+	Hashes     []*string `json:"hashes"`
+	ZeroLevels []int     `json:"zero_levels"`
+}
+
+// MarshalJSON implements the json.Marshaler interface. The generalized
+// index is a decimal string and the hashes are 0x prefixed hex strings.
+func (p *Proof) MarshalJSON() ([]byte, error) {
+	index, err := jsonIndex(p.Index, p.Path)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&proofJSON{
+		Index:  index,
+		Leaf:   encodeHash(p.Leaf),
+		Hashes: encodeHashes(p.Hashes),
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (p *Proof) UnmarshalJSON(data []byte) error {
+	var raw proofJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	index, path, err := decodeIndex(raw.Index)
+	if err != nil {
+		return err
+	}
+	leaf, err := decodeHash(raw.Leaf)
+	if err != nil {
+		return err
+	}
+	hashes, err := decodeHashes(raw.Hashes)
+	if err != nil {
+		return err
+	}
+	*p = Proof{Index: index, Path: path, Leaf: leaf, Hashes: hashes}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (p *Multiproof) MarshalJSON() ([]byte, error) {
+	indices, err := jsonIndices(p.Indices, p.Paths)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&multiproofJSON{
+		Indices: indices,
+		Leaves:  encodeHashes(p.Leaves),
+		Hashes:  encodeHashes(p.Hashes),
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (p *Multiproof) UnmarshalJSON(data []byte) error {
+	var raw multiproofJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	indices, paths, err := decodeIndices(raw.Indices)
+	if err != nil {
+		return err
+	}
+	leaves, err := decodeHashes(raw.Leaves)
+	if err != nil {
+		return err
+	}
+	hashes, err := decodeHashes(raw.Hashes)
+	if err != nil {
+		return err
+	}
+	*p = Multiproof{Indices: indices, Paths: paths, Leaves: leaves, Hashes: hashes}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. The omitted
+// hashes are null.
+func (c *CompressedMultiproof) MarshalJSON() ([]byte, error) {
+	indices, err := jsonIndices(c.Indices, c.Paths)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]*string, len(c.Hashes))
+	for i, h := range c.Hashes {
+		if h != nil {
+			str := encodeHash(h)
+			hashes[i] = &str
+		}
+	}
+	zeroLevels := c.ZeroLevels
+	if zeroLevels == nil {
+		zeroLevels = []int{}
+	}
+	return json.Marshal(&compressedMultiproofJSON{
+		Indices:    indices,
+		Leaves:     encodeHashes(c.Leaves),
+		Hashes:     hashes,
+		ZeroLevels: zeroLevels,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (c *CompressedMultiproof) UnmarshalJSON(data []byte) error {
+	var raw compressedMultiproofJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	indices, paths, err := decodeIndices(raw.Indices)
+	if err != nil {
+		return err
+	}
+	leaves, err := decodeHashes(raw.Leaves)
+	if err != nil {
+		return err
+	}
+	omitted := 0
+	hashes := make([][]byte, len(raw.Hashes))
+	for i, h := range raw.Hashes {
+		if h == nil {
+			omitted++
+			continue
+		}
+		if hashes[i], err = decodeHash(*h); err != nil {
+			return err
+		}
+	}
+	if omitted != len(raw.ZeroLevels) {
+		return fmt.Errorf("%d omitted hashes do not match %d zero levels", omitted, len(raw.ZeroLevels))
+	}
+	for _, level := range raw.ZeroLevels {
+		if level < 0 || level >= len(zeroHashes) {
+			return fmt.Errorf("incorrect zero hash level %d", level)
+		}
+	}
+	*c = CompressedMultiproof{Indices: indices, Paths: paths, Leaves: leaves, Hashes: hashes, ZeroLevels: raw.ZeroLevels}
+	return nil
+}
+
+func jsonIndex(index int, path gindex.Path) (string, error) {
+	if index < 0 {
+		return "", fmt.Errorf("invalid generalized index %d", index)
+	}
+	if index != 0 {
+		return fmt.Sprint(index), nil
+	}
+	return path.String(), nil
+}
+
+func jsonIndices(indices []int, paths []gindex.Path) ([]string, error) {
+	if indices == nil {
+		res := make([]string, len(paths))
+		for i, path := range paths {
+			res[i] = path.String()
+		}
+		return res, nil
+	}
+
+	res := make([]string, len(indices))
+	for i, index := range indices {
+		str, err := jsonIndex(index, gindex.Path{})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = str
+	}
+	return res, nil
+}
+
+// decodeIndex returns the generalized index as an int if it
+// fits or as a path otherwise
+func decodeIndex(str string) (int, gindex.Path, error) {
+	path, err := gindex.ParsePath(str)
+	if err != nil {
+		return 0, gindex.Path{}, err
+	}
+	if index, ok := pathToIndex(path); ok {
+		return index, gindex.Path{}, nil
+	}
+	return 0, path, nil
+}
+
+func decodeIndices(strs []string) ([]int, []gindex.Path, error) {
+	indices := make([]int, len(strs))
+	paths := make([]gindex.Path, len(strs))
+	deep := false
+	for i, str := range strs {
+		index, path, err := decodeIndex(str)
+		if err != nil {
+			return nil, nil, err
+		}
+		if index == 0 {
+			deep = true
+		} else {
+			path = gindex.NewPath(uint64(index))
+		}
+		indices[i], paths[i] = index, path
+	}
+	if deep {
+		return nil, paths, nil
+	}
+	return indices, nil, nil
+}
+
+func encodeHash(h []byte) string {
+	return "0x" + hex.EncodeToString(h)
+}
+
+func encodeHashes(hashes [][]byte) []string {
+	res := make([]string, len(hashes))
+	for i, h := range hashes {
+		res[i] = encodeHash(h)
+	}
+	return res
+}
+
+func decodeHash(str string) ([]byte, error) {
+	if !strings.HasPrefix(str, "0x") {
+		return nil, fmt.Errorf("hash '%s' does not have the 0x prefix", str)
+	}
+	h, err := hex.DecodeString(str[2:])
+	if err != nil {
+		return nil, err
+	}
+	if len(h) != 32 {
+		return nil, fmt.Errorf("hash '%s' is not 32 bytes", str)
+	}
+	return h, nil
+}
+
+func decodeHashes(strs []string) ([][]byte, error) {
+	res := make([][]byte, len(strs))
+	for i, str := range strs {
+		h, err := decodeHash(str)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = h
+	}
+	return res, nil
+}
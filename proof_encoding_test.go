@@ -0,0 +1,138 @@
+package ssz
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ferranbt/fastssz/gindex"
+	"github.com/stretchr/testify/require"
+)
+
+func testProofTree(t *testing.T) *Node {
+	leaves := make([]*Node, 5)
+	for i := range leaves {
+		leaves[i] = LeafFromUint64(uint64(i + 1))
+	}
+	r, err := TreeFromNodesWithMixin(leaves, len(leaves), 16)
+	require.NoError(t, err)
+	return r
+}
+
+func TestProof_Encoding(t *testing.T) {
+	r := testProofTree(t)
+	proof, err := r.Prove(33)
+	require.NoError(t, err)
+
+	data, err := proof.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, proof.SizeSSZ())
+
+	proof2 := new(Proof)
+	require.NoError(t, proof2.UnmarshalSSZ(data))
+	require.Equal(t, proof, proof2)
+
+	// the same layout as the spec container
+	require.Equal(t, LeafFromUint64(33).value[:8], data[:8])
+	require.Equal(t, proof.Leaf, data[8:40])
+
+	raw, err := json.Marshal(proof)
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `"index":"33"`)
+	require.Contains(t, string(raw), `"leaf":"0x0200000000000000000000000000000000000000000000000000000000000000"`)
+
+	proof2 = new(Proof)
+	require.NoError(t, json.Unmarshal(raw, proof2))
+	require.Equal(t, proof, proof2)
+
+	ok, err := VerifyProof(r.Hash(), proof2)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestProof_EncodingDeep(t *testing.T) {
+	path := gindex.ConcatPaths(gindex.NewPath(1<<40), gindex.NewPath(1<<40))
+	proof := &Proof{Path: path, Leaf: make([]byte, 32), Hashes: make([][]byte, 80)}
+	for i := range proof.Hashes {
+		proof.Hashes[i] = zeroHashes[i%64][:]
+	}
+
+	// the index does not fit in an uint64
+	_, err := proof.MarshalSSZ()
+	require.Error(t, err)
+
+	raw, err := json.Marshal(proof)
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `"index":"`+path.String()+`"`)
+
+	proof2 := new(Proof)
+	require.NoError(t, json.Unmarshal(raw, proof2))
+	require.Equal(t, proof, proof2)
+}
+
+func TestMultiproof_Encoding(t *testing.T) {
+	r := testProofTree(t)
+	multiproof, err := r.ProveMulti([]int{33, 36, 3})
+	require.NoError(t, err)
+
+	data, err := multiproof.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, multiproof.SizeSSZ())
+
+	multiproof2 := new(Multiproof)
+	require.NoError(t, multiproof2.UnmarshalSSZ(data))
+	require.Equal(t, multiproof, multiproof2)
+
+	raw, err := json.Marshal(multiproof)
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `"indices":["33","36","3"]`)
+
+	multiproof2 = new(Multiproof)
+	require.NoError(t, json.Unmarshal(raw, multiproof2))
+	require.Equal(t, multiproof, multiproof2)
+
+	ok, err := VerifyMultiproof(r.Hash(), multiproof2.Hashes, multiproof2.Leaves, multiproof2.Indices)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// truncated and incorrect encodings
+	require.Error(t, new(Multiproof).UnmarshalSSZ(data[:len(data)-1]))
+	require.Error(t, new(Multiproof).UnmarshalSSZ(data[:11]))
+	require.Error(t, json.Unmarshal([]byte(`{"indices":["0"],"leaves":[],"hashes":[]}`), new(Multiproof)))
+	require.Error(t, json.Unmarshal([]byte(`{"indices":["1"],"leaves":["0x00"],"hashes":[]}`), new(Multiproof)))
+}
+
+func TestCompressedMultiproof_Encoding(t *testing.T) {
+	r := testProofTree(t)
+	multiproof, err := r.ProveMulti([]int{33, 36})
+	require.NoError(t, err)
+
+	compressed := multiproof.Compress()
+	require.NotEmpty(t, compressed.ZeroLevels)
+
+	data, err := compressed.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, compressed.SizeSSZ())
+
+	// the omitted hashes are not encoded
+	uncompressed, err := multiproof.MarshalSSZ()
+	require.NoError(t, err)
+	require.Less(t, len(data), len(uncompressed))
+
+	compressed2 := new(CompressedMultiproof)
+	require.NoError(t, compressed2.UnmarshalSSZ(data))
+	require.Equal(t, compressed, compressed2)
+	require.Equal(t, multiproof, compressed2.Decompress())
+
+	raw, err := json.Marshal(compressed)
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `null`)
+
+	compressed2 = new(CompressedMultiproof)
+	require.NoError(t, json.Unmarshal(raw, compressed2))
+	require.Equal(t, compressed, compressed2)
+
+	// the zero levels must match the omitted hashes
+	compressed.ZeroLevels = compressed.ZeroLevels[1:]
+	_, err = compressed.MarshalSSZ()
+	require.Error(t, err)
+}
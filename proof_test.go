@@ -0,0 +1,148 @@
+package ssz
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/ferranbt/fastssz/gindex"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyMultiproof_AnyOrder(t *testing.T) {
+	r := testProofTree(t)
+	root := r.Hash()
+
+	multiproof, err := r.ProveMulti([]int{33, 36, 3})
+	require.NoError(t, err)
+
+	// the leaves are given in a different order than the proof
+	indices := []int{3, 33, 36}
+	leaves := [][]byte{multiproof.Leaves[2], multiproof.Leaves[0], multiproof.Leaves[1]}
+	ok, err := VerifyMultiproof(root, multiproof.Hashes, leaves, indices)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// the same leaf can be given more than once
+	ok, err = VerifyMultiproof(root, multiproof.Hashes, append(leaves, leaves[1]), append(indices, 33))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, err = VerifyMultiproof(root, multiproof.Hashes, append(leaves, leaves[0]), append(indices, 33))
+	require.Error(t, err)
+
+	// the leaves do not match their indices
+	leaves[1], leaves[2] = leaves[2], leaves[1]
+	ok, err = VerifyMultiproof(root, multiproof.Hashes, leaves, indices)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestVerifyCompressedMultiproof(t *testing.T) {
+	r := testProofTree(t)
+	root := r.Hash()
+
+	multiproof, err := r.ProveMulti([]int{33, 36})
+	require.NoError(t, err)
+
+	compressed := multiproof.Compress()
+	require.NotEmpty(t, compressed.ZeroLevels)
+
+	ok, err := VerifyCompressedMultiproof(root, compressed)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// the zero levels that are not used are rejected
+	levels := compressed.ZeroLevels
+	compressed.ZeroLevels = append(append([]int{}, levels...), 0)
+	_, err = VerifyCompressedMultiproof(root, compressed)
+	require.Error(t, err)
+	compressed.ZeroLevels = levels
+
+	// a different zero level changes the omitted hash
+	compressed.ZeroLevels[0]++
+	ok, err = VerifyCompressedMultiproof(root, compressed)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	compressed.ZeroLevels = compressed.ZeroLevels[:0]
+	_, err = VerifyCompressedMultiproof(root, compressed)
+	require.Error(t, err)
+
+	// deep generalized indices only found in the paths
+	deep, err := r.ProveMultiPaths([]gindex.Path{gindex.NewPath(33), gindex.NewPath(5)})
+	require.NoError(t, err)
+	compressed = deep.Compress()
+	compressed.Indices = nil
+
+	ok, err = VerifyCompressedMultiproof(root, compressed)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func BenchmarkVerifyMultiproof(b *testing.B) {
+	r, multiproof := benchmarkMultiproof(b)
+	root := r.Hash()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		decompressed := multiproof.Decompress()
+		if _, err := VerifyMultiproof(root, decompressed.Hashes, decompressed.Leaves, decompressed.Indices); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkVerifyCompressedMultiproof(b *testing.B) {
+	r, multiproof := benchmarkMultiproof(b)
+	root := r.Hash()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := VerifyCompressedMultiproof(root, multiproof); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkMultiproof(b *testing.B) (*Node, *CompressedMultiproof) {
+	leaves := make([]*Node, 100)
+	for i := range leaves {
+		leaves[i] = LeafFromUint64(uint64(i))
+	}
+	r, err := TreeFromNodesWithMixin(leaves, len(leaves), 1<<20)
+	if err != nil {
+		b.Fatal(err)
+	}
+	multiproof, err := r.ProveMulti([]int{1<<21 | 3, 1<<21 | 50, 3})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return r, multiproof.Compress()
+}
+
+func TestVerifyProof_ZeroIndex(t *testing.T) {
+	r := testProofTree(t)
+	root := r.Hash()
+
+	// a proof without an index or a path is rejected
+	_, err := VerifyProof(root, &Proof{Leaf: root})
+	require.Error(t, err)
+
+	// or decoded from a json without the index
+	var proof Proof
+	err = json.Unmarshal([]byte(`{"leaf":"0x`+hex.EncodeToString(root)+`","hashes":[]}`), &proof)
+	if err == nil {
+		_, err = VerifyProof(root, &proof)
+	}
+	require.Error(t, err)
+
+	// the root is proven with the index 1
+	ok, err := VerifyProof(root, &Proof{Index: 1, Leaf: root})
+	require.NoError(t, err)
+	require.True(t, ok)
+}
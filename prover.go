@@ -0,0 +1,469 @@
+package ssz
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// ProvingHasher is a HashWalker that hashes an object with the Hasher buffer
+// algorithm and only records the hashes required to prove a set of
+// generalized indices, without building the tree of the object.
+//
+// The object is walked twice. The first walk does not hash and resolves the
+// generalized index of each group merkleized by the object. The second walk
+// hashes the object and records the leaves and the helper hashes of the proof
+// while merkleizing the groups in the path of the proven indices.
+type ProvingHasher struct {
+	*Hasher
+
+	indices []int
+	// required is the list of indices to record, which are
+	// the proven indices and their helper indices
+	required []int
+	// paths is the set of indices from the root to the proven indices
+	paths  map[int]struct{}
+	hashes map[int][]byte
+
+	// groups is the layout of the groups of the object
+	groups []provingGroup
+	// open is the stack of the groups that are not merkleized yet
+	open   []int
+	next   int
+	layout bool
+}
+
+// provingGroup is a group of chunks merkleized by the object
+type provingGroup struct {
+	start  int
+	parent int
+	// pos is the position of the group in the chunks of its parent
+	pos uint64
+	// gindex is the generalized index of the root of the group or
+	// zero if it does not fit in an int
+	gindex int
+
+	depth       uint8
+	mixin       bool
+	progressive bool
+}
+
+// NewProvingHasher creates a new ProvingHasher for the given generalized indices
+func NewProvingHasher(indices ...int) *ProvingHasher {
+	return NewProvingHasherWithTreeHash(DefaultTreeHash, indices...)
+}
+
+// NewProvingHasherWithTreeHash creates a new ProvingHasher for the given
+// generalized indices that hashes with a TreeHash
+func NewProvingHasherWithTreeHash(t *TreeHash, indices ...int) *ProvingHasher {
+	p := &ProvingHasher{
+		Hasher:   NewHasherWithTreeHash(t),
+		indices:  indices,
+		required: append(getRequiredIndices(indices), indices...),
+		paths:    map[int]struct{}{},
+	}
+	for _, index := range indices {
+		for ; index >= 1; index >>= 1 {
+			p.paths[index] = struct{}{}
+		}
+	}
+	return p
+}
+
+// Prove returns the proof of the generalized index of the ProvingHasher
+func (p *ProvingHasher) Prove(v HashRootProof) (*Proof, error) {
+	if len(p.indices) != 1 {
+		return nil, fmt.Errorf("expected one generalized index but found %d", len(p.indices))
+	}
+	if err := p.walk(v); err != nil {
+		return nil, err
+	}
+
+	index := p.indices[0]
+	proof := &Proof{
+		Index:  index,
+		Leaf:   p.hashes[index],
+		Hashes: make([][]byte, 0, getPathLength(index)),
+	}
+	for ; index > 1; index >>= 1 {
+		proof.Hashes = append(proof.Hashes, p.hashes[getSibling(index)])
+	}
+	return proof, nil
+}
+
+// ProveMulti returns the multiproof of the generalized indices of the ProvingHasher
+func (p *ProvingHasher) ProveMulti(v HashRootProof) (*Multiproof, error) {
+	if err := p.walk(v); err != nil {
+		return nil, err
+	}
+
+	proof := &Multiproof{
+		Indices: p.indices,
+		Leaves:  make([][]byte, len(p.indices)),
+	}
+	for i, index := range p.indices {
+		proof.Leaves[i] = p.hashes[index]
+	}
+	helpers := p.required[:len(p.required)-len(p.indices)]
+	proof.Hashes = make([][]byte, len(helpers))
+	for i, index := range helpers {
+		proof.Hashes[i] = p.hashes[index]
+	}
+	return proof, nil
+}
+
+func (p *ProvingHasher) walk(v HashRootProof) error {
+	// resolve the layout of the groups without hashing
+	hash := p.hash
+	p.hash = func(dst []byte, input []byte) error { return nil }
+	p.reset(true)
+	p.groups = p.groups[:0]
+	err := v.HashTreeRootWith(p)
+	p.hash = hash
+	if err != nil {
+		return err
+	}
+	if len(p.groups) == 0 || len(p.open) != 0 {
+		return fmt.Errorf("object does not merkleize a single root")
+	}
+	p.resolveGroups()
+
+	// hash and record the proof
+	p.reset(false)
+	p.hashes = map[int][]byte{}
+	if err := v.HashTreeRootWith(p); err != nil {
+		return err
+	}
+	for _, index := range p.required {
+		if _, ok := p.hashes[index]; !ok {
+			return fmt.Errorf("generalized index %d not found in the object", index)
+		}
+	}
+	return nil
+}
+
+func (p *ProvingHasher) reset(layout bool) {
+	p.Hasher.Reset()
+	p.open = p.open[:0]
+	p.next = 0
+	p.layout = layout
+}
+
+// resolveGroups computes the generalized index of every group. The groups
+// are sorted by the order in which they are opened, so a parent is always
+// resolved before its children.
+func (p *ProvingHasher) resolveGroups() {
+	p.groups[0].gindex = 1
+	for i := 1; i < len(p.groups); i++ {
+		g := &p.groups[i]
+		if g.parent == -1 {
+			// second root, it cannot be proven
+			continue
+		}
+		g.gindex = p.groups[g.parent].childGindex(g.pos)
+	}
+}
+
+// childGindex returns the generalized index of the group at the position pos
+// in the chunks of the group, or zero if it does not fit in an int
+func (g *provingGroup) childGindex(pos uint64) int {
+	if g.gindex == 0 {
+		return 0
+	}
+
+	base := g.gindex
+	if g.mixin {
+		base = ConcatGindices(base, 2)
+	}
+	local := 1<<g.depth | int(pos)
+	if g.progressive {
+		local = progressiveChunkGindex(pos)
+	}
+	if bits.Len(uint(base))+bits.Len(uint(local)) > 64 {
+		// the group is too deep to be proven with an int generalized index
+		return 0
+	}
+	return ConcatGindices(base, local)
+}
+
+// Index marks the current buffer index and opens a new group
+func (p *ProvingHasher) Index() int {
+	indx := p.Hasher.Index()
+	if p.layout {
+		g := provingGroup{start: indx, parent: -1}
+		if len(p.open) != 0 {
+			parent := p.open[len(p.open)-1]
+			g.parent = parent
+			g.pos = uint64(indx-p.groups[parent].start) / 32
+		}
+		p.groups = append(p.groups, g)
+	}
+	p.open = append(p.open, p.next)
+	p.next++
+	return indx
+}
+
+// closeGroup returns the last open group and whether it has to be recorded
+func (p *ProvingHasher) closeGroup() (*provingGroup, bool) {
+	id := p.open[len(p.open)-1]
+	p.open = p.open[:len(p.open)-1]
+
+	g := &p.groups[id]
+	if p.layout || g.gindex == 0 {
+		return g, false
+	}
+	_, ok := p.paths[g.gindex]
+	return g, ok
+}
+
+func (p *ProvingHasher) Merkleize(indx int) {
+	g, record := p.closeGroup()
+	if p.layout {
+		g.depth = getDepth(uint64((len(p.buf) - indx + 31) / 32))
+	}
+	if !record {
+		p.Hasher.Merkleize(indx)
+		return
+	}
+	p.FillUpTo32()
+	root := p.merkleizeTree(p.buf[indx:], g.depth, g.gindex)
+	p.buf = append(p.buf[:indx], root...)
+}
+
+func (p *ProvingHasher) MerkleizeWithMixin(indx int, num, limit uint64) {
+	g, record := p.closeGroup()
+	if p.layout {
+		g.mixin = true
+		if limit == 0 {
+			limit = uint64((len(p.buf) - indx + 31) / 32)
+		}
+		g.depth = getDepth(limit)
+	}
+	if !record {
+		p.Hasher.MerkleizeWithMixin(indx, num, limit)
+		return
+	}
+	p.FillUpTo32()
+	root := p.merkleizeTree(p.buf[indx:], g.depth, 2*g.gindex)
+	p.buf = append(p.buf[:indx], root...)
+	p.mixinRecord(indx, num, g.gindex)
+}
+
+func (p *ProvingHasher) MerkleizeProgressive(indx int) {
+	g, record := p.closeGroup()
+	if p.layout {
+		g.progressive = true
+	}
+	if !record {
+		p.Hasher.MerkleizeProgressive(indx)
+		return
+	}
+	p.FillUpTo32()
+	root := p.merkleizeProgressive(p.buf[indx:], g.gindex)
+	p.buf = append(p.buf[:indx], root...)
+}
+
+func (p *ProvingHasher) MerkleizeProgressiveWithMixin(indx int, num uint64) {
+	g, record := p.closeGroup()
+	if p.layout {
+		g.progressive = true
+		g.mixin = true
+	}
+	if !record {
+		p.Hasher.MerkleizeProgressiveWithMixin(indx, num)
+		return
+	}
+	p.FillUpTo32()
+	root := p.merkleizeProgressive(p.buf[indx:], 2*g.gindex)
+	p.buf = append(p.buf[:indx], root...)
+	p.mixinRecord(indx, num, g.gindex)
+}
+
+func (p *ProvingHasher) MerkleizeWithSelector(indx int, selector uint8) {
+	if selector > maxUnionSelector {
+		panic(fmt.Sprintf("BUG: union selector '%d' out of bounds", selector))
+	}
+	p.MerkleizeWithMixin(indx, uint64(selector), 1)
+}
+
+func (p *ProvingHasher) MerkleizeOptional(indx int, present bool) {
+	var selector uint8
+	if present {
+		selector = 1
+	}
+	p.MerkleizeWithSelector(indx, selector)
+}
+
+func (p *ProvingHasher) MerkleizeStable(indx int, activeFields []byte, limit uint64) {
+	g, record := p.closeGroup()
+	if p.layout {
+		g.mixin = true
+		g.depth = getDepth(limit)
+	}
+	if !record {
+		p.Hasher.MerkleizeStable(indx, activeFields, limit)
+		return
+	}
+	p.FillUpTo32()
+	root := p.merkleizeTree(p.buf[indx:], g.depth, 2*g.gindex)
+	p.buf = append(p.buf[:indx], root...)
+
+	// the active fields bitvector is the right child of the root
+	p.Hasher.PutBytes(activeFields)
+	p.record(2*g.gindex+1, p.buf[indx+32:])
+	p.hashRecord(indx, g.gindex)
+}
+
+// PutBytes appends bytes and merkleizes them in their own group
+// if they are longer than 32 bytes
+func (p *ProvingHasher) PutBytes(b []byte) {
+	if len(b) <= 32 {
+		p.AppendBytes32(b)
+		return
+	}
+	indx := p.Index()
+	p.AppendBytes32(b)
+	p.Merkleize(indx)
+}
+
+// PutBitlist appends a ssz bitlist
+func (p *ProvingHasher) PutBitlist(bb []byte, maxSize uint64) {
+	var size uint64
+	p.tmp, size = parseBitlist(p.tmp[:0], bb)
+
+	indx := p.Index()
+	p.AppendBytes32(p.tmp)
+	p.MerkleizeWithMixin(indx, size, (maxSize+255)/256)
+}
+
+// PutUint64Array appends an array of uint64
+func (p *ProvingHasher) PutUint64Array(b []uint64, maxCapacity ...uint64) {
+	indx := p.Index()
+	for _, i := range b {
+		p.AppendUint64(i)
+	}
+	p.FillUpTo32()
+
+	if len(maxCapacity) == 0 {
+		p.Merkleize(indx)
+	} else {
+		numItems := uint64(len(b))
+		p.MerkleizeWithMixin(indx, numItems, CalculateLimit(maxCapacity[0], numItems, 8))
+	}
+}
+
+// merkleizeTree merkleizes the chunks of the input in a tree of the given depth
+// whose root has the gindex generalized index and records the required hashes.
+// The input is overwritten with the intermediate hashes as in merkleizeImpl.
+func (p *ProvingHasher) merkleizeTree(input []byte, depth uint8, gindex int) []byte {
+	type node struct {
+		index, level, pos int
+	}
+	nodes := []node{}
+	for _, index := range p.required {
+		if k, pos, ok := subtreePos(gindex, index); ok && k <= int(depth) {
+			nodes = append(nodes, node{index: index, level: int(depth) - k, pos: pos})
+		}
+	}
+	capture := func(level int, layer []byte) {
+		for _, n := range nodes {
+			if n.level != level {
+				continue
+			}
+			if n.pos*32 < len(layer) {
+				p.record(n.index, layer[n.pos*32:])
+			} else {
+				p.record(n.index, p.tree.zeroHashes[level][:])
+			}
+		}
+	}
+
+	if len(input) == 0 {
+		for level := 0; level <= int(depth); level++ {
+			capture(level, nil)
+		}
+		return p.tree.zeroHashes[depth][:]
+	}
+	for i := uint8(0); ; i++ {
+		capture(int(i), input)
+		if i == depth {
+			break
+		}
+		layerLen := len(input) / 32
+		if layerLen%2 == 1 {
+			input = append(input, p.tree.zeroHashes[i][:]...)
+			layerLen++
+		}
+		p.hash(input, input)
+		input = input[:(layerLen/2)*32]
+	}
+	return input[:32]
+}
+
+// merkleizeProgressive merkleizes the chunks of the input with the progressive
+// layout of EIP-7916 and records the required hashes.
+func (p *ProvingHasher) merkleizeProgressive(input []byte, gindex int) []byte {
+	count := len(input) / 32
+
+	type subtree struct {
+		start, end int
+		depth      uint8
+	}
+	subtrees := []subtree{}
+	for start, limit := 0, 1; start < count; start, limit = start+limit, limit*4 {
+		end := min(start+limit, count)
+		subtrees = append(subtrees, subtree{start: start * 32, end: end * 32, depth: getDepth(uint64(limit))})
+	}
+
+	// the subtrees are merkleized starting from the last one
+	// since merkleizeTree can write past the end of its input
+	root := make([]byte, 64)
+	p.record(gindex<<len(subtrees), root)
+	for i := len(subtrees) - 1; i >= 0; i-- {
+		s := subtrees[i]
+		chunks := input[s.start:s.end]
+		copy(root[32:], p.merkleizeTree(chunks, s.depth, gindex<<(i+1)|1))
+
+		p.hash(root, root)
+		p.record(gindex<<i, root)
+	}
+	return root[:32]
+}
+
+// mixinRecord mixes in the num with the root at indx and records the hashes
+func (p *ProvingHasher) mixinRecord(indx int, num uint64, gindex int) {
+	p.record(2*gindex, p.buf[indx:])
+	p.buf = MarshalValue[uint64](p.buf, num)
+	p.FillUpTo32()
+	p.record(2*gindex+1, p.buf[indx+32:])
+	p.hashRecord(indx, gindex)
+}
+
+// hashRecord hashes the two chunks at indx and records the result
+func (p *ProvingHasher) hashRecord(indx int, gindex int) {
+	input := p.buf[indx:]
+	p.hash(input, input)
+	p.buf = p.buf[:indx+32]
+	p.record(gindex, p.buf[indx:])
+}
+
+func (p *ProvingHasher) record(index int, hash []byte) {
+	if _, ok := p.hashes[index]; ok {
+		return
+	}
+	for _, i := range p.required {
+		if i == index {
+			p.hashes[index] = append([]byte{}, hash[:32]...)
+			return
+		}
+	}
+}
+
+// subtreePos returns the depth and the position of index in the subtree
+// whose root is the root generalized index.
+func subtreePos(root, index int) (int, int, bool) {
+	k := bits.Len(uint(index)) - bits.Len(uint(root))
+	if k < 0 || index>>k != root {
+		return 0, 0, false
+	}
+	return k, index - root<<k, true
+}
@@ -0,0 +1,48 @@
+package ssz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type proverTestObj struct {
+	a uint64
+	b []uint64
+}
+
+func (p *proverTestObj) HashTreeRootWith(hh HashWalker) error {
+	indx := hh.Index()
+	hh.PutUint64(p.a)
+	hh.PutUint64Array(p.b, 16)
+	hh.PutBytes(make([]byte, 48))
+	hh.Merkleize(indx)
+	return nil
+}
+
+func TestProvingHasher(t *testing.T) {
+	obj := &proverTestObj{a: 1, b: []uint64{1, 2, 3, 4, 5}}
+
+	tree, err := ProofTree(obj)
+	require.NoError(t, err)
+
+	// uint64 list elements, length of the list and chunk of the bytes
+	for _, gindex := range []int{4, 5, 11, 40, 41, 12, 13} {
+		expected, err := tree.Prove(gindex)
+		require.NoError(t, err)
+
+		proof, err := NewProvingHasher(gindex).Prove(obj)
+		require.NoError(t, err)
+		require.Equal(t, expected, proof)
+	}
+
+	multiproof, err := NewProvingHasher(40, 13).ProveMulti(obj)
+	require.NoError(t, err)
+
+	ok, err := VerifyMultiproof(tree.Hash(), multiproof.Hashes, multiproof.Leaves, multiproof.Indices)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, err = NewProvingHasher(40, 13).Prove(obj)
+	require.Error(t, err)
+}
@@ -0,0 +1,920 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrUnsupportedType is returned by the reflection codec for the types that it cannot encode
+var ErrUnsupportedType = fmt.Errorf("type not supported by the reflection codec")
+
+// Marshal encodes v in SSZ format with reflection. The layout of the type is read from
+// the same ssz-size, ssz-max and ssz:"bitlist" tags that sszgen uses and the encoding
+// is the same as the one of the generated MarshalSSZ function.
+func Marshal(v any) ([]byte, error) {
+	val, typ, err := reflectValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return typ.marshal(make([]byte, 0, typ.size(val)), val, typ.name())
+}
+
+// Unmarshal decodes the SSZ encoding in buf into v with reflection. v must be a pointer.
+func Unmarshal(buf []byte, v any) error {
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return fmt.Errorf("unmarshal requires a non nil pointer")
+	}
+	typ, err := typeOf(val.Type().Elem())
+	if err != nil {
+		return err
+	}
+	return typ.unmarshal(buf, val.Elem())
+}
+
+// HashTreeRoot computes the hash tree root of v with reflection
+func HashTreeRoot(v any) ([32]byte, error) {
+	val, typ, err := reflectValue(v)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	hh := DefaultHasherPool.Get()
+	if err := typ.hashTreeRootWith(hh, val, typ.name()); err != nil {
+		DefaultHasherPool.Put(hh)
+		return [32]byte{}, err
+	}
+	root, err := hh.HashRoot()
+	DefaultHasherPool.Put(hh)
+	return root, err
+}
+
+// Prove returns the proof of the generalized index of the tree of v with reflection
+func Prove(v any, gindex int) (*Proof, error) {
+	val, typ, err := reflectValue(v)
+	if err != nil {
+		return nil, err
+	}
+	w := &Wrapper{}
+	if err := typ.hashTreeRootWith(w, val, typ.name()); err != nil {
+		return nil, err
+	}
+	return w.Node().Prove(gindex)
+}
+
+func reflectValue(v any) (reflect.Value, *sszType, error) {
+	val := reflect.ValueOf(v)
+	if !val.IsValid() {
+		return val, nil, fmt.Errorf("cannot encode a nil value")
+	}
+	typ, err := typeOf(val.Type())
+	if err != nil {
+		return val, nil, err
+	}
+	return val, typ, nil
+}
+
+type sszKind int
+
+const (
+	kindBool sszKind = iota
+	kindUint
+	kindUintWords
+	kindTime
+	kindBytes
+	kindByteList
+	kindBitlist
+	kindBitvector
+	kindVector
+	kindList
+	kindContainer
+)
+
+// sszType is the layout of a type for the reflection codec
+type sszType struct {
+	kind sszKind
+	typ  reflect.Type
+
+	// length is the size of uints and fixed bytes, the number of
+	// elements of vectors and the number of bits of bitvectors
+	length uint64
+
+	// limit is the max number of elements of lists and bitlists
+	limit uint64
+
+	elem   *sszType
+	fields []*sszField
+
+	// fixed is true if the encoding of the type has a fixed size
+	fixed bool
+
+	// fixedSize is the size of the type in the fixed part of a container
+	fixedSize int
+}
+
+type sszField struct {
+	name  string
+	index []int
+	typ   *sszType
+}
+
+// typeCache caches the layout of the types by reflect.Type
+var typeCache sync.Map
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	uint128Type   = reflect.TypeOf(Uint128{})
+	uint256Type   = reflect.TypeOf(Uint256{})
+	bitvectorType = reflect.TypeOf(Bitvector{})
+)
+
+func typeOf(t reflect.Type) (*sszType, error) {
+	if typ, ok := typeCache.Load(t); ok {
+		return typ.(*sszType), nil
+	}
+	typ, err := newTypeParser().parse(t, &sszTags{}, 0)
+	if err != nil {
+		return nil, err
+	}
+	typ2, _ := typeCache.LoadOrStore(t, typ)
+	return typ2.(*sszType), nil
+}
+
+// sszTags are the ssz tags of a struct field. Each position of the
+// ssz-size and ssz-max tags is a dimension of the field.
+type sszTags struct {
+	sizes   []string
+	maxes   []string
+	bitlist bool
+}
+
+func parseTags(tag reflect.StructTag) (*sszTags, error) {
+	tags := &sszTags{}
+	if size, ok := tag.Lookup("ssz-size"); ok {
+		tags.sizes = strings.Split(size, ",")
+	}
+	if max, ok := tag.Lookup("ssz-max"); ok {
+		tags.maxes = strings.Split(max, ",")
+	}
+	if ssz, ok := tag.Lookup("ssz"); ok {
+		for _, p := range strings.Split(ssz, ",") {
+			if p == "bitlist" {
+				tags.bitlist = true
+			} else if p != "" {
+				return nil, fmt.Errorf("%w: ssz tag '%s'", ErrUnsupportedType, p)
+			}
+		}
+	}
+	return tags, nil
+}
+
+func (s *sszTags) size(dim int) (uint64, bool, error) {
+	return parseTagSize(s.sizes, dim)
+}
+
+func (s *sszTags) max(dim int) (uint64, bool, error) {
+	return parseTagSize(s.maxes, dim)
+}
+
+func parseTagSize(values []string, dim int) (uint64, bool, error) {
+	if dim >= len(values) {
+		return 0, false, nil
+	}
+	value := values[dim]
+	if value == "?" || value == "" {
+		return 0, false, nil
+	}
+	if strings.HasPrefix(value, "var(") {
+		return 0, false, fmt.Errorf("%w: variable size %s", ErrUnsupportedType, value)
+	}
+	num, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to parse ssz tag '%s': %v", value, err)
+	}
+	return num, true, nil
+}
+
+type typeParser struct {
+	// visiting are the structs being parsed to detect recursive types
+	visiting map[reflect.Type]struct{}
+}
+
+func newTypeParser() *typeParser {
+	return &typeParser{visiting: map[reflect.Type]struct{}{}}
+}
+
+func (p *typeParser) parse(t reflect.Type, tags *sszTags, dim int) (*sszType, error) {
+	size, hasSize, err := tags.size(dim)
+	if err != nil {
+		return nil, err
+	}
+	max, hasMax, err := tags.max(dim)
+	if err != nil {
+		return nil, err
+	}
+
+	switch t {
+	case timeType:
+		return &sszType{kind: kindTime, typ: t, length: 8, fixed: true, fixedSize: 8}, nil
+	case uint128Type, uint256Type:
+		return &sszType{kind: kindUintWords, typ: t, length: uint64(t.Size()), fixed: true, fixedSize: int(t.Size())}, nil
+	case bitvectorType:
+		if !hasSize {
+			return nil, fmt.Errorf("bitvector requires a ssz-size tag")
+		}
+		return &sszType{kind: kindBitvector, typ: t, length: size, fixed: true, fixedSize: int((size + 7) / 8)}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &sszType{kind: kindBool, typ: t, length: 1, fixed: true, fixedSize: 1}, nil
+
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &sszType{kind: kindUint, typ: t, length: uint64(t.Size()), fixed: true, fixedSize: int(t.Size())}, nil
+
+	case reflect.Ptr:
+		if t.Elem().Kind() != reflect.Struct {
+			return nil, fmt.Errorf("%w: pointer to %s", ErrUnsupportedType, t.Elem())
+		}
+		return p.parse(t.Elem(), tags, dim)
+
+	case reflect.Struct:
+		return p.parseContainer(t)
+
+	case reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &sszType{kind: kindBytes, typ: t, length: uint64(t.Len()), fixed: true, fixedSize: t.Len()}, nil
+		}
+		return p.parseVector(t, uint64(t.Len()), tags, dim)
+
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			switch {
+			case tags.bitlist || t.Name() == "Bitlist":
+				// ssz.Bitlist and go-bitfield Bitlist
+				if !hasMax {
+					return nil, fmt.Errorf("bitlist requires a ssz-max tag")
+				}
+				return &sszType{kind: kindBitlist, typ: t, limit: max, fixedSize: bytesPerLengthOffset}, nil
+			case hasSize:
+				return &sszType{kind: kindBytes, typ: t, length: size, fixed: true, fixedSize: int(size)}, nil
+			case hasMax:
+				return &sszType{kind: kindByteList, typ: t, limit: max, fixedSize: bytesPerLengthOffset}, nil
+			}
+			return nil, fmt.Errorf("bytes require a ssz-size or ssz-max tag")
+		}
+		if hasSize {
+			return p.parseVector(t, size, tags, dim)
+		}
+		if hasMax {
+			elem, err := p.parse(t.Elem(), tags, dim+1)
+			if err != nil {
+				return nil, err
+			}
+			return &sszType{kind: kindList, typ: t, limit: max, elem: elem, fixedSize: bytesPerLengthOffset}, nil
+		}
+		return nil, fmt.Errorf("slice requires a ssz-size or ssz-max tag")
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, t)
+}
+
+func (p *typeParser) parseVector(t reflect.Type, length uint64, tags *sszTags, dim int) (*sszType, error) {
+	elem, err := p.parse(t.Elem(), tags, dim+1)
+	if err != nil {
+		return nil, err
+	}
+	typ := &sszType{kind: kindVector, typ: t, length: length, elem: elem, fixed: elem.fixed}
+	if elem.fixed {
+		typ.fixedSize = int(length) * elem.fixedSize
+	} else {
+		typ.fixedSize = int(length) * bytesPerLengthOffset
+	}
+	return typ, nil
+}
+
+func (p *typeParser) parseContainer(t reflect.Type) (*sszType, error) {
+	if typ, ok := typeCache.Load(t); ok {
+		return typ.(*sszType), nil
+	}
+	if _, ok := p.visiting[t]; ok {
+		return nil, fmt.Errorf("%w: recursive type %s", ErrUnsupportedType, t)
+	}
+	p.visiting[t] = struct{}{}
+	defer delete(p.visiting, t)
+
+	typ := &sszType{kind: kindContainer, typ: t, fixed: true}
+	if err := p.parseFields(typ, t, nil); err != nil {
+		return nil, err
+	}
+	if len(typ.fields) == 0 {
+		return nil, fmt.Errorf("container %s has no fields", t)
+	}
+	for _, f := range typ.fields {
+		if !f.typ.fixed {
+			typ.fixed = false
+		}
+	}
+	if typ.fixed {
+		for _, f := range typ.fields {
+			typ.fixedSize += f.typ.fixedSize
+		}
+	} else {
+		typ.fixedSize = bytesPerLengthOffset
+	}
+
+	typ2, _ := typeCache.LoadOrStore(t, typ)
+	return typ2.(*sszType), nil
+}
+
+// parseFields parses the fields of the struct in order. The fields of
+// embedded structs are part of the container like in sszgen.
+func (p *typeParser) parseFields(typ *sszType, t reflect.Type, index []int) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fieldIndex := append(append([]int{}, index...), i)
+
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			if err := p.parseFields(typ, f.Type, fieldIndex); err != nil {
+				return err
+			}
+			continue
+		}
+		if f.Name == "_" {
+			return fmt.Errorf("%w: stable containers", ErrUnsupportedType)
+		}
+		if !f.IsExported() || strings.HasPrefix(f.Name, "XXX_") || f.Tag.Get("ssz") == "-" {
+			continue
+		}
+
+		tags, err := parseTags(f.Tag)
+		if err != nil {
+			return err
+		}
+		elem, err := p.parse(f.Type, tags, 0)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", t.Name(), f.Name, err)
+		}
+		typ.fields = append(typ.fields, &sszField{name: f.Name, index: fieldIndex, typ: elem})
+	}
+	return nil
+}
+
+func (t *sszType) name() string {
+	if t.typ.Kind() == reflect.Ptr {
+		return t.typ.Elem().Name()
+	}
+	return t.typ.Name()
+}
+
+// field returns the value of the field of a struct. Nil pointers
+// to structs are encoded as the zero value of the struct.
+func (f *sszField) value(v reflect.Value) reflect.Value {
+	return deref(v.FieldByIndex(f.index))
+}
+
+func deref(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Zero(v.Type().Elem())
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// ---- size ----
+
+func (t *sszType) size(v reflect.Value) int {
+	if t.fixed {
+		if t.kind == kindContainer {
+			return t.fixedSizeForContainer()
+		}
+		return t.fixedSize
+	}
+	v = deref(v)
+
+	switch t.kind {
+	case kindByteList, kindBitlist:
+		return v.Len()
+
+	case kindVector, kindList:
+		size := 0
+		for i := 0; i < v.Len(); i++ {
+			if t.elem.fixed {
+				size += t.elem.size(v.Index(i))
+			} else {
+				size += bytesPerLengthOffset + t.elem.size(v.Index(i))
+			}
+		}
+		return size
+
+	case kindContainer:
+		size := 0
+		for _, f := range t.fields {
+			if f.typ.fixed {
+				size += f.typ.size(f.value(v))
+			} else {
+				size += bytesPerLengthOffset + f.typ.size(f.value(v))
+			}
+		}
+		return size
+	}
+	panic(fmt.Sprintf("BUG: size of fixed kind %d", t.kind))
+}
+
+func (t *sszType) fixedSizeForContainer() int {
+	size := 0
+	for _, f := range t.fields {
+		size += f.typ.fixedSize
+	}
+	return size
+}
+
+// ---- marshal ----
+
+func (t *sszType) marshal(dst []byte, v reflect.Value, name string) ([]byte, error) {
+	v = deref(v)
+
+	switch t.kind {
+	case kindBool:
+		return MarshalValue(dst, v.Bool()), nil
+
+	case kindUint:
+		return marshalUint(dst, v.Uint(), t.length), nil
+
+	case kindUintWords:
+		for i := 0; i < v.Len(); i++ {
+			dst = binary.LittleEndian.AppendUint64(dst, v.Index(i).Uint())
+		}
+		return dst, nil
+
+	case kindTime:
+		return MarshalTime(dst, v.Interface().(time.Time)), nil
+
+	case kindBytes:
+		if size := uint64(v.Len()); size != t.length {
+			return nil, ErrBytesLengthFn(name, size, t.length)
+		}
+		return append(dst, bytesOf(v)...), nil
+
+	case kindByteList:
+		if size := uint64(v.Len()); size > t.limit {
+			return nil, ErrBytesLengthFn(name, size, t.limit)
+		}
+		return append(dst, v.Bytes()...), nil
+
+	case kindBitlist:
+		if err := ValidateBitlist(v.Bytes(), t.limit); err != nil {
+			return nil, err
+		}
+		return append(dst, v.Bytes()...), nil
+
+	case kindBitvector:
+		if err := ValidateBitvector(v.Bytes(), t.length); err != nil {
+			return nil, err
+		}
+		return append(dst, v.Bytes()...), nil
+
+	case kindVector:
+		if size := uint64(v.Len()); size != t.length {
+			return nil, ErrVectorLengthFn(name, size, t.length)
+		}
+		return t.marshalElems(dst, v, name)
+
+	case kindList:
+		if size := uint64(v.Len()); size > t.limit {
+			return nil, ErrListTooBigFn(name, size, t.limit)
+		}
+		return t.marshalElems(dst, v, name)
+
+	case kindContainer:
+		return t.marshalContainer(dst, v)
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, t.typ)
+}
+
+func marshalUint(dst []byte, i uint64, size uint64) []byte {
+	switch size {
+	case 1:
+		return append(dst, uint8(i))
+	case 2:
+		return binary.LittleEndian.AppendUint16(dst, uint16(i))
+	case 4:
+		return binary.LittleEndian.AppendUint32(dst, uint32(i))
+	default:
+		return binary.LittleEndian.AppendUint64(dst, i)
+	}
+}
+
+// bytesOf returns the content of a byte slice or a byte array
+func bytesOf(v reflect.Value) []byte {
+	if v.Kind() == reflect.Slice {
+		return v.Bytes()
+	}
+	if v.CanAddr() {
+		return v.Slice(0, v.Len()).Bytes()
+	}
+	buf := make([]byte, v.Len())
+	reflect.Copy(reflect.ValueOf(buf), v)
+	return buf
+}
+
+func (t *sszType) marshalElems(dst []byte, v reflect.Value, name string) ([]byte, error) {
+	var err error
+	if t.elem.fixed {
+		for i := 0; i < v.Len(); i++ {
+			if dst, err = t.elem.marshal(dst, v.Index(i), name); err != nil {
+				return nil, err
+			}
+		}
+		return dst, nil
+	}
+
+	offset := bytesPerLengthOffset * v.Len()
+	for i := 0; i < v.Len(); i++ {
+		dst = WriteOffset(dst, offset)
+		offset += t.elem.size(v.Index(i))
+	}
+	for i := 0; i < v.Len(); i++ {
+		if dst, err = t.elem.marshal(dst, v.Index(i), name); err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
+
+func (t *sszType) marshalContainer(dst []byte, v reflect.Value) ([]byte, error) {
+	var err error
+
+	// fixed part and offsets
+	offset := t.fixedSizeForContainer()
+	for _, f := range t.fields {
+		if f.typ.fixed {
+			if dst, err = f.typ.marshal(dst, f.value(v), t.name()+"."+f.name); err != nil {
+				return nil, err
+			}
+		} else {
+			dst = WriteOffset(dst, offset)
+			offset += f.typ.size(f.value(v))
+		}
+	}
+
+	// dynamic parts
+	for _, f := range t.fields {
+		if !f.typ.fixed {
+			if dst, err = f.typ.marshal(dst, f.value(v), t.name()+"."+f.name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return dst, nil
+}
+
+// ---- unmarshal ----
+
+// unmarshal decodes buf into v. Pointers to structs are allocated if they are nil.
+func (t *sszType) unmarshal(buf []byte, v reflect.Value) error {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	if t.fixed && len(buf) != t.size(v) {
+		return ErrSize
+	}
+
+	switch t.kind {
+	case kindBool:
+		if err := IsValidBool(buf); err != nil {
+			return err
+		}
+		v.SetBool(buf[0] == 1)
+
+	case kindUint:
+		switch t.length {
+		case 1:
+			v.SetUint(uint64(buf[0]))
+		case 2:
+			v.SetUint(uint64(binary.LittleEndian.Uint16(buf)))
+		case 4:
+			v.SetUint(uint64(binary.LittleEndian.Uint32(buf)))
+		default:
+			v.SetUint(binary.LittleEndian.Uint64(buf))
+		}
+
+	case kindUintWords:
+		for i := 0; i < v.Len(); i++ {
+			v.Index(i).SetUint(binary.LittleEndian.Uint64(buf[8*i:]))
+		}
+
+	case kindTime:
+		val, _ := UnmarshalTime(buf)
+		v.Set(reflect.ValueOf(val))
+
+	case kindBytes:
+		if v.Kind() == reflect.Array {
+			reflect.Copy(v, reflect.ValueOf(buf))
+		} else {
+			v.SetBytes(append([]byte{}, buf...))
+		}
+
+	case kindByteList:
+		if uint64(len(buf)) > t.limit {
+			return ErrBytesLength
+		}
+		v.SetBytes(append([]byte{}, buf...))
+
+	case kindBitlist:
+		if err := ValidateBitlist(buf, t.limit); err != nil {
+			return err
+		}
+		v.SetBytes(append([]byte{}, buf...))
+
+	case kindBitvector:
+		if err := ValidateBitvector(buf, t.length); err != nil {
+			return err
+		}
+		v.SetBytes(append([]byte{}, buf...))
+
+	case kindVector:
+		if t.elem.fixed {
+			return t.unmarshalFixedElems(buf, v, t.length)
+		}
+		return t.unmarshalDynamicElems(buf, v, t.length, true)
+
+	case kindList:
+		if t.elem.fixed {
+			num, err := DivideInt2(uint64(len(buf)), uint64(t.elem.fixedSize), t.limit)
+			if err != nil {
+				return err
+			}
+			return t.unmarshalFixedElems(buf, v, num)
+		}
+		return t.unmarshalDynamicElems(buf, v, t.limit, false)
+
+	case kindContainer:
+		return t.unmarshalContainer(buf, v)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedType, t.typ)
+	}
+	return nil
+}
+
+// extendSlice sets the length of a slice to num
+func extendSlice(v reflect.Value, num uint64) {
+	if v.Kind() != reflect.Slice {
+		return
+	}
+	if uint64(v.Cap()) < num {
+		v.Set(reflect.MakeSlice(v.Type(), int(num), int(num)))
+	} else {
+		v.SetLen(int(num))
+	}
+}
+
+func (t *sszType) unmarshalFixedElems(buf []byte, v reflect.Value, num uint64) error {
+	if uint64(len(buf)) != num*uint64(t.elem.fixedSize) {
+		return ErrSize
+	}
+	extendSlice(v, num)
+
+	size := t.elem.fixedSize
+	for i := 0; i < int(num); i++ {
+		if err := t.elem.unmarshal(buf[i*size:(i+1)*size], v.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *sszType) unmarshalDynamicElems(buf []byte, v reflect.Value, num uint64, isVector bool) error {
+	length, err := DecodeDynamicLength(buf, num)
+	if err != nil {
+		return err
+	}
+	if isVector && length != num {
+		return ErrVectorLength
+	}
+	extendSlice(v, length)
+
+	return UnmarshalDynamic(buf, length, func(indx uint64, b []byte) error {
+		return t.elem.unmarshal(b, v.Index(int(indx)))
+	})
+}
+
+func (t *sszType) unmarshalContainer(buf []byte, v reflect.Value) error {
+	size := len(buf)
+	fixedSize := t.fixedSizeForContainer()
+	if size < fixedSize {
+		return ErrSize
+	}
+	marker := NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	tail := buf
+	offsets := []uint64{}
+	for _, f := range t.fields {
+		if f.typ.fixed {
+			if err := f.typ.unmarshal(buf[:f.typ.fixedSize], v.FieldByIndex(f.index)); err != nil {
+				return err
+			}
+			buf = buf[f.typ.fixedSize:]
+		} else {
+			var offset uint64
+			var err error
+			if offset, buf, err = marker.ReadOffset(buf); err != nil {
+				return err
+			}
+			offsets = append(offsets, offset)
+		}
+	}
+	if len(offsets) == 0 {
+		return nil
+	}
+
+	offsets = append(offsets, uint64(size))
+	c := 0
+	for _, f := range t.fields {
+		if !f.typ.fixed {
+			if err := f.typ.unmarshal(tail[offsets[c]:offsets[c+1]], v.FieldByIndex(f.index)); err != nil {
+				return err
+			}
+			c++
+		}
+	}
+	return nil
+}
+
+// ---- hash tree root ----
+
+// hashTreeRootWith hashes the value with the same calls to the HashWalker as the
+// generated HashTreeRootWith function so that the root and the tree are the same.
+func (t *sszType) hashTreeRootWith(hh HashWalker, v reflect.Value, name string) error {
+	v = deref(v)
+
+	switch t.kind {
+	case kindBool:
+		hh.PutBool(v.Bool())
+
+	case kindUint:
+		switch t.length {
+		case 1:
+			hh.PutUint8(uint8(v.Uint()))
+		case 2:
+			hh.PutUint16(uint16(v.Uint()))
+		case 4:
+			hh.PutUint32(uint32(v.Uint()))
+		default:
+			hh.PutUint64(v.Uint())
+		}
+
+	case kindUintWords:
+		if t.length == 16 {
+			PutUint128(hh, Uint128{v.Index(0).Uint(), v.Index(1).Uint()})
+		} else {
+			PutUint256(hh, Uint256{v.Index(0).Uint(), v.Index(1).Uint(), v.Index(2).Uint(), v.Index(3).Uint()})
+		}
+
+	case kindTime:
+		hh.PutUint64(uint64(v.Interface().(time.Time).Unix()))
+
+	case kindBytes:
+		if size := uint64(v.Len()); size != t.length {
+			return ErrBytesLengthFn(name, size, t.length)
+		}
+		hh.PutBytes(bytesOf(v))
+
+	case kindByteList:
+		return t.hashByteList(hh, v.Bytes(), false)
+
+	case kindBitlist:
+		if v.Len() == 0 {
+			return ErrEmptyBitlist
+		}
+		hh.PutBitlist(v.Bytes(), t.limit)
+
+	case kindBitvector:
+		if err := ValidateBitvector(v.Bytes(), t.length); err != nil {
+			return err
+		}
+		hh.PutBytes(v.Bytes())
+
+	case kindVector:
+		if size := uint64(v.Len()); size != t.length {
+			return ErrVectorLengthFn(name, size, t.length)
+		}
+		return t.hashElems(hh, v, name)
+
+	case kindList:
+		if size := uint64(v.Len()); size > t.limit {
+			return ErrListTooBigFn(name, size, t.limit)
+		}
+		return t.hashElems(hh, v, name)
+
+	case kindContainer:
+		indx := hh.Index()
+		for _, f := range t.fields {
+			if err := f.typ.hashTreeRootWith(hh, f.value(v), t.name()+"."+f.name); err != nil {
+				return err
+			}
+		}
+		hh.Merkleize(indx)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedType, t.typ)
+	}
+	return nil
+}
+
+func (t *sszType) hashByteList(hh HashWalker, b []byte, appendBytes bool) error {
+	elemIndx := hh.Index()
+	byteLen := uint64(len(b))
+	if byteLen > t.limit {
+		return ErrIncorrectListSize
+	}
+	if appendBytes {
+		hh.AppendBytes32(b)
+	} else {
+		hh.Append(b)
+	}
+	hh.MerkleizeWithMixin(elemIndx, byteLen, (t.limit+31)/32)
+	return nil
+}
+
+func (t *sszType) hashElems(hh HashWalker, v reflect.Value, name string) error {
+	subIndx := hh.Index()
+	num := uint64(v.Len())
+
+	switch t.elem.kind {
+	case kindUint, kindBool:
+		// basic values are packed in chunks
+		for i := 0; i < v.Len(); i++ {
+			var elem uint64
+			if t.elem.kind == kindBool {
+				if v.Index(i).Bool() {
+					elem = 1
+				}
+			} else {
+				elem = v.Index(i).Uint()
+			}
+			switch t.elem.length {
+			case 1:
+				hh.AppendUint8(uint8(elem))
+			case 2:
+				hh.AppendUint16(uint16(elem))
+			case 4:
+				hh.AppendUint32(uint32(elem))
+			default:
+				hh.AppendUint64(elem)
+			}
+		}
+		if t.kind == kindList {
+			hh.FillUpTo32()
+			hh.MerkleizeWithMixin(subIndx, num, CalculateLimit(t.limit, num, t.elem.length))
+		} else {
+			hh.Merkleize(subIndx)
+		}
+		return nil
+
+	case kindBytes:
+		// each element of 32 bytes is a chunk
+		for i := 0; i < v.Len(); i++ {
+			elem := bytesOf(v.Index(i))
+			if uint64(len(elem)) != t.elem.length {
+				return ErrBytesLength
+			}
+			if t.elem.length == 32 {
+				hh.Append(elem)
+			} else {
+				hh.PutBytes(elem)
+			}
+		}
+
+	case kindByteList:
+		for i := 0; i < v.Len(); i++ {
+			if err := t.elem.hashByteList(hh, v.Index(i).Bytes(), true); err != nil {
+				return err
+			}
+		}
+
+	default:
+		for i := 0; i < v.Len(); i++ {
+			if err := t.elem.hashTreeRootWith(hh, v.Index(i), name); err != nil {
+				return err
+			}
+		}
+	}
+
+	if t.kind == kindList {
+		hh.MerkleizeWithMixin(subIndx, num, t.limit)
+	} else {
+		hh.Merkleize(subIndx)
+	}
+	return nil
+}
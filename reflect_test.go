@@ -0,0 +1,118 @@
+package ssz
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type reflectElem struct {
+	A uint16
+	B []byte `ssz-max:"4"`
+}
+
+type reflectContainer struct {
+	A     uint64
+	Root  [32]byte
+	Flags []bool         `ssz-size:"3"`
+	Elems []*reflectElem `ssz-max:"2"`
+	Bits  Bitlist        `ssz-max:"8"`
+	skip  uint64
+}
+
+func TestReflect_Encoding(t *testing.T) {
+	obj := &reflectContainer{
+		A:     1,
+		Root:  [32]byte{0x2},
+		Flags: []bool{true, false, true},
+		Elems: []*reflectElem{{A: 3, B: []byte{0x4}}},
+		Bits:  NewBitlist(2),
+	}
+
+	buf, err := Marshal(obj)
+	require.NoError(t, err)
+
+	expected := []byte{0x1, 0, 0, 0, 0, 0, 0, 0}
+	expected = append(expected, obj.Root[:]...)
+	expected = append(expected, 0x1, 0x0, 0x1)
+	expected = append(expected, 51, 0, 0, 0, 62, 0, 0, 0)
+	// Elems: one offset and the element with its own offset
+	expected = append(expected, 4, 0, 0, 0, 0x3, 0x0, 6, 0, 0, 0, 0x4)
+	// Bits
+	expected = append(expected, 0x4)
+	require.Equal(t, expected, buf)
+
+	obj2 := &reflectContainer{}
+	require.NoError(t, Unmarshal(buf, obj2))
+	require.Equal(t, obj, obj2)
+
+	// the root is computed with the hasher and with the proof tree
+	root, err := HashTreeRoot(obj)
+	require.NoError(t, err)
+
+	proof, err := Prove(obj, 1)
+	require.NoError(t, err)
+	require.Equal(t, root[:], proof.Leaf)
+
+	// the proof of the field 'A' verifies with the root
+	proof, err = Prove(obj, 8)
+	require.NoError(t, err)
+
+	ok, err := VerifyProof(root[:], proof)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestReflect_BasicTypes(t *testing.T) {
+	buf, err := Marshal(uint32(5))
+	require.NoError(t, err)
+	require.Equal(t, []byte{5, 0, 0, 0}, buf)
+
+	var num uint32
+	require.NoError(t, Unmarshal(buf, &num))
+	require.Equal(t, uint32(5), num)
+
+	root, err := HashTreeRoot([32]byte{0x1})
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{0x1}, root)
+}
+
+func TestReflect_Limits(t *testing.T) {
+	_, err := Marshal(&reflectContainer{Flags: make([]bool, 3), Elems: make([]*reflectElem, 3), Bits: NewBitlist(1)})
+	require.ErrorContains(t, err, ErrListTooBig.Error())
+
+	_, err = Marshal(&reflectContainer{Flags: make([]bool, 2), Bits: NewBitlist(1)})
+	require.ErrorContains(t, err, ErrBytesLength.Error())
+
+	// the size of the fixed part is validated
+	require.ErrorIs(t, Unmarshal([]byte{0x1}, &reflectContainer{}), ErrSize)
+}
+
+func TestReflect_Unsupported(t *testing.T) {
+	_, err := Marshal(&struct {
+		A []uint64
+	}{})
+	require.Error(t, err)
+
+	_, err = Marshal(&struct {
+		A []uint64 `ssz-max:"var(max)"`
+	}{})
+	require.ErrorIs(t, err, ErrUnsupportedType)
+
+	_, err = Marshal(&struct {
+		A *uint64 `ssz:"optional"`
+	}{})
+	require.ErrorIs(t, err, ErrUnsupportedType)
+
+	require.Error(t, Unmarshal(nil, reflectContainer{}))
+}
+
+func TestReflect_Cache(t *testing.T) {
+	typ, err := typeOf(reflect.TypeOf(&reflectContainer{}))
+	require.NoError(t, err)
+
+	typ2, err := typeOf(reflect.TypeOf(reflectContainer{}))
+	require.NoError(t, err)
+	require.Same(t, typ, typ2)
+}
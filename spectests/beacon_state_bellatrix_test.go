@@ -2,10 +2,14 @@ package spectests
 
 import (
 	"encoding/hex"
+	"math"
+	"math/rand"
 	"os"
+	"runtime"
 	"testing"
 
 	ssz "github.com/ferranbt/fastssz"
+	"github.com/prysmaticlabs/gohashtree"
 	"github.com/stretchr/testify/require"
 )
 
@@ -134,3 +138,202 @@ func TestBeaconStateTree_HashTreeRoot(t *testing.T) {
 	// taken from https://goerli.beaconcha.in/slot/4744352 - stateRoot field
 	require.Equal(t, "c4a9c5ebf637c089db599574b568bb679b385c1984f08410707db08e03d7ae52", hex.EncodeToString(hash))
 }
+
+func benchmarkBeaconStateTree(b *testing.B, th *ssz.TreeHash) {
+	data, err := os.ReadFile(TestFileName)
+	require.NoError(b, err)
+
+	sszState := BeaconStateBellatrix{}
+	require.NoError(b, sszState.UnmarshalSSZ(data))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		tree, err := th.ProofTree(&sszState)
+		if err != nil {
+			b.Fatal(err)
+		}
+		tree.Hash()
+	}
+}
+
+func BenchmarkBeaconStateTree_Hash(b *testing.B) {
+	benchmarkBeaconStateTree(b, ssz.DefaultTreeHash)
+}
+
+func BenchmarkBeaconStateTree_HashGohashtree(b *testing.B) {
+	benchmarkBeaconStateTree(b, ssz.NewTreeHash(gohashtree.HashByteSlice, ssz.WithParallelHashing(4096)))
+}
+
+func BenchmarkBeaconState_HashTreeRoot(b *testing.B) {
+	data, err := os.ReadFile(TestFileName)
+	require.NoError(b, err)
+
+	sszState := BeaconStateBellatrix{}
+	require.NoError(b, sszState.UnmarshalSSZ(data))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	hh := ssz.NewHasherWithHashFn(gohashtree.HashByteSlice)
+	for i := 0; i < b.N; i++ {
+		if err := sszState.HashTreeRootWith(hh); err != nil {
+			b.Fatal(err)
+		}
+		hh.Reset()
+	}
+}
+
+func BenchmarkBeaconState_HashTreeRootWorkers(b *testing.B) {
+	data, err := os.ReadFile(TestFileName)
+	require.NoError(b, err)
+
+	sszState := BeaconStateBellatrix{}
+	require.NoError(b, sszState.UnmarshalSSZ(data))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	hh := ssz.NewHasherWithHashFn(gohashtree.HashByteSlice, ssz.WithHashWorkers(runtime.GOMAXPROCS(0)))
+	for i := 0; i < b.N; i++ {
+		if err := sszState.HashTreeRootWith(hh); err != nil {
+			b.Fatal(err)
+		}
+		hh.Reset()
+	}
+}
+
+func BenchmarkBeaconState_HashTreeRootSparse(b *testing.B) {
+	data, err := os.ReadFile(TestFileName)
+	require.NoError(b, err)
+
+	sszState := BeaconStateBellatrix{}
+	require.NoError(b, sszState.UnmarshalSSZ(data))
+
+	// like a devnet state, only the first epochs have randao mixes and slashings
+	for i := 1024; i < len(sszState.RandaoMixes); i++ {
+		sszState.RandaoMixes[i] = make([]byte, 32)
+	}
+	for i := range sszState.Slashings {
+		sszState.Slashings[i] = 0
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	hh := ssz.NewHasherWithHashFn(gohashtree.HashByteSlice)
+	for i := 0; i < b.N; i++ {
+		if err := sszState.HashTreeRootWith(hh); err != nil {
+			b.Fatal(err)
+		}
+		hh.Reset()
+	}
+}
+
+// newBeaconStateBellatrix returns a mainnet state with numValidators random
+// validators. If sparse, like a devnet state, only the first epochs have
+// randao mixes and block roots and there are no slashings.
+func newBeaconStateBellatrix(r *rand.Rand, numValidators int, sparse bool) *BeaconStateBellatrix {
+	randBytes := func(n int) []byte {
+		buf := make([]byte, n)
+		r.Read(buf)
+		return buf
+	}
+	roots := func(n uint64, filled int) [][]byte {
+		res := make([][]byte, n)
+		for i := range res {
+			if i < filled {
+				res[i] = randBytes(32)
+			} else {
+				res[i] = make([]byte, 32)
+			}
+		}
+		return res
+	}
+	syncCommittee := func() *SyncCommittee {
+		c := &SyncCommittee{}
+		for i := uint64(0); i < syncCommitteePubKeys; i++ {
+			c.PubKeys = append(c.PubKeys, randBytes(48))
+		}
+		r.Read(c.AggregatePubKey[:])
+		return c
+	}
+
+	filled := int(randaoMixes)
+	if sparse {
+		filled = 1024
+	}
+	state := &BeaconStateBellatrix{
+		GenesisValidatorsRoot: randBytes(32),
+		Slot:                  uint64(filled) * 32,
+		Fork:                  &Fork{PreviousVersion: randBytes(4), CurrentVersion: randBytes(4)},
+		LatestBlockHeader: &BeaconBlockHeader{
+			ParentRoot: randBytes(32),
+			StateRoot:  randBytes(32),
+			BodyRoot:   randBytes(32),
+		},
+		BlockRoots:                  roots(rootsSize, filled),
+		StateRoots:                  roots(rootsSize, filled),
+		Eth1Data:                    &Eth1Data{DepositRoot: randBytes(32), BlockHash: randBytes(32)},
+		RandaoMixes:                 roots(randaoMixes, filled),
+		Slashings:                   make([]uint64, slashings),
+		JustificationBits:           []byte{0x0f},
+		PreviousJustifiedCheckpoint: &Checkpoint{Root: randBytes(32)},
+		CurrentJustifiedCheckpoint:  &Checkpoint{Root: randBytes(32)},
+		FinalizedCheckpoint:         &Checkpoint{Root: randBytes(32)},
+		CurrentSyncCommittee:        syncCommittee(),
+		NextSyncCommittee:           syncCommittee(),
+		LatestExecutionPayloadHeader: &ExecutionPayloadHeader{
+			ParentHash:       randBytes(32),
+			FeeRecipient:     randBytes(20),
+			StateRoot:        randBytes(32),
+			ReceiptsRoot:     randBytes(32),
+			LogsBloom:        randBytes(256),
+			PrevRandao:       randBytes(32),
+			BaseFeePerGas:    randBytes(32),
+			BlockHash:        randBytes(32),
+			TransactionsRoot: randBytes(32),
+		},
+	}
+	if !sparse {
+		for i := range state.Slashings {
+			state.Slashings[i] = r.Uint64()
+		}
+	}
+	for i := 0; i < numValidators; i++ {
+		state.Validators = append(state.Validators, &Validator{
+			Pubkey:                randBytes(48),
+			WithdrawalCredentials: randBytes(32),
+			EffectiveBalance:      32000000000,
+			ExitEpoch:             math.MaxUint64,
+			WithdrawableEpoch:     math.MaxUint64,
+		})
+		state.Balances = append(state.Balances, 32000000000+r.Uint64()%1000000)
+		state.InactivityScores = append(state.InactivityScores, 0)
+	}
+	state.PreviousEpochParticipation = randBytes(numValidators)
+	state.CurrentEpochParticipation = randBytes(numValidators)
+	return state
+}
+
+func BenchmarkBeaconState_HashTreeRootSynthetic(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, name := range []string{"dense", "sparse"} {
+		state := newBeaconStateBellatrix(r, 1<<14, name == "sparse")
+
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			hh := ssz.NewHasherWithHashFn(gohashtree.HashByteSlice)
+			for i := 0; i < b.N; i++ {
+				if err := state.HashTreeRootWith(hh); err != nil {
+					b.Fatal(err)
+				}
+				hh.Reset()
+			}
+		})
+	}
+}
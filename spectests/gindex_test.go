@@ -0,0 +1,60 @@
+package spectests
+
+import (
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGindex_Spec(t *testing.T) {
+	cases := []struct {
+		fn     func(string) (int, error)
+		path   string
+		gindex int
+	}{
+		// FINALIZED_ROOT_GINDEX of the altair light client
+		{BeaconStateAltairGindex, "finalized_checkpoint.root", 105},
+		// CURRENT_SYNC_COMMITTEE_GINDEX and NEXT_SYNC_COMMITTEE_GINDEX
+		{BeaconStateAltairGindex, "current_sync_committee", 54},
+		{BeaconStateAltairGindex, "next_sync_committee", 55},
+		// EXECUTION_PAYLOAD_GINDEX of the capella light client
+		{BeaconBlockBodyCapellaGindex, "execution_payload", 25},
+		{BeaconBlockBodyCapellaGindex, "execution_payload.block_hash", 25<<4 | 12},
+		{BeaconStateBellatrixGindex, "block_roots", 37},
+		{BeaconStateBellatrixGindex, "block_roots[3]", 303104 + 3},
+		// list of containers with the length mixed in
+		{BeaconStateGindex, "validators[12].effective_balance", ssz.ConcatGindices(43, 2, 1<<40+12, 8+2)},
+		// list of uint64 packed in chunks of 4 elements
+		{BeaconStateGindex, "balances[9]", ssz.ConcatGindices(44, 2, 1<<38+2)},
+	}
+
+	for _, c := range cases {
+		gindex, err := c.fn(c.path)
+		require.NoError(t, err, c.path)
+		require.Equal(t, c.gindex, gindex, c.path)
+	}
+
+	require.Equal(t, 303104+3, BeaconStateBellatrixGindexBlockRootsElem(3))
+	require.Equal(t, ssz.ConcatGindices(44, 2, 1<<38+2), BeaconStateGindexBalancesElem(9))
+}
+
+func TestGindex_InvalidPath(t *testing.T) {
+	paths := []string{
+		"",
+		"unknown",
+		"slot.epoch",
+		"finalized_checkpoint.",
+		"finalized_checkpoint..root",
+		"finalized_checkpoint[0]",
+		"block_roots[8192]",
+		"block_roots[a]",
+		"block_roots[0",
+		"block_roots[0]root",
+		"validators[0].unknown",
+	}
+	for _, path := range paths {
+		_, err := BeaconStateAltairGindex(path)
+		require.ErrorIs(t, err, ssz.ErrInvalidPath, path)
+	}
+}
@@ -1,5 +1,7 @@
 package spectests
 
+import ssz "github.com/ferranbt/fastssz"
+
 type AggregateAndProof struct {
 	Index          uint64       `json:"aggregator_index"`
 	Aggregate      *Attestation `json:"aggregate"`
@@ -324,7 +326,7 @@ type ExecutionPayloadTransactions struct {
 
 // Capella types
 
-type Uint256 [32]byte
+type Uint256 ssz.Uint256
 
 type ExecutionPayloadCapella struct {
 	ParentHash    [32]byte      `ssz-size:"32" json:"parent_hash"`
@@ -338,7 +340,7 @@ type ExecutionPayloadCapella struct {
 	GasUsed       uint64        `json:"gas_used"`
 	Timestamp     uint64        `json:"timestamp"`
 	ExtraData     []byte        `ssz-max:"32" json:"extra_data"`
-	BaseFeePerGas Uint256       `ssz-size:"32" json:"base_fee_per_gas"`
+	BaseFeePerGas Uint256       `json:"base_fee_per_gas"`
 	BlockHash     [32]byte      `ssz-size:"32" json:"block_hash"`
 	Transactions  [][]byte      `ssz-max:"1048576,1073741824" ssz-size:"?,?" json:"transactions"`
 	Withdrawals   []*Withdrawal `json:"withdrawals" ssz-max:"var(withdrawals)"`
@@ -356,7 +358,7 @@ type ExecutionPayloadHeaderCapella struct {
 	GasUsed          uint64    `json:"gas_used"`
 	Timestamp        uint64    `json:"timestamp"`
 	ExtraData        []byte    `json:"extra_data" ssz-max:"32"`
-	BaseFeePerGas    Uint256   `json:"base_fee_per_gas" ssz-size:"32"`
+	BaseFeePerGas    Uint256   `json:"base_fee_per_gas"`
 	BlockHash        [32]byte  `json:"block_hash" ssz-size:"32"`
 	TransactionsRoot [32]byte  `json:"transactions_root" ssz-size:"32"`
 	WithdrawalRoot   [32]byte  `json:"withdrawals_root" ssz-size:"32"`
@@ -457,7 +459,7 @@ type ExecutionPayloadDeneb struct {
 	GasUsed       uint64        `json:"gas_used"`
 	Timestamp     uint64        `json:"timestamp"`
 	ExtraData     []byte        `ssz-max:"32" json:"extra_data"`
-	BaseFeePerGas Uint256       `ssz-size:"32" json:"base_fee_per_gas"`
+	BaseFeePerGas Uint256       `json:"base_fee_per_gas"`
 	BlockHash     [32]byte      `ssz-size:"32" json:"block_hash"`
 	Transactions  [][]byte      `ssz-max:"1048576,1073741824" ssz-size:"?,?" json:"transactions"`
 	Withdrawals   []*Withdrawal `json:"withdrawals" ssz-max:"var(withdrawals)"`
@@ -477,7 +479,7 @@ type ExecutionPayloadHeaderDeneb struct {
 	GasUsed          uint64    `json:"gas_used"`
 	Timestamp        uint64    `json:"timestamp"`
 	ExtraData        []byte    `json:"extra_data" ssz-max:"32"`
-	BaseFeePerGas    Uint256   `json:"base_fee_per_gas" ssz-size:"32"`
+	BaseFeePerGas    Uint256   `json:"base_fee_per_gas"`
 	BlockHash        [32]byte  `json:"block_hash" ssz-size:"32"`
 	TransactionsRoot [32]byte  `json:"transactions_root" ssz-size:"32"`
 	WithdrawalRoot   [32]byte  `json:"withdrawals_root" ssz-size:"32"`
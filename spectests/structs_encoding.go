@@ -1,9 +1,12 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 164da2c88ae2703d1c65d5bed7aadac4ac2014119bb5b67b0539cd04f0a16543
+// Hash: c481465b71b194c91a4c5ecdf5a9166d1310c353393b96947f84109d3af7af42
 // Version: 2.0.0
 package spectests
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -34,6 +37,38 @@ func (a *AggregateAndProof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the AggregateAndProof object to a writer
+func (a *AggregateAndProof) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := a.fixedSize()
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalValue(dst, a.Index)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (1) 'Aggregate'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'SelectionProof'
+	dst = append(dst, a.SelectionProof[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Aggregate'
+	if dst, err = ww.WriteObject(dst, a.Aggregate); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the AggregateAndProof object
 func (a *AggregateAndProof) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(a, buf)
@@ -70,6 +105,41 @@ func (a *AggregateAndProof) UnmarshalSSZTail(buf []byte) (rest []byte, err error
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the AggregateAndProof object from a reader with the size of the encoding
+func (a *AggregateAndProof) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := a.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o1 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Index'
+		a.Index, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (1) 'Aggregate'
+		if o1, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (2) 'SelectionProof'
+		buf = ssz.UnmarshalFixedBytes(a.SelectionProof[:], buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (1) 'Aggregate'
+	if err = ssz.ReadField(rr, &a.Aggregate, size-int(o1)); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the AggregateAndProof object
 func (a *AggregateAndProof) fixedSize() int {
 	return int(108)
@@ -93,6 +163,11 @@ func (a *AggregateAndProof) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(a)
 }
 
+// HashTreeRootCtx ssz hashes the AggregateAndProof object and stops with the error of the context once it is done
+func (a *AggregateAndProof) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, a)
+}
+
 // HashTreeRootWith ssz hashes the AggregateAndProof object with a hasher
 func (a *AggregateAndProof) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -117,6 +192,45 @@ func (a *AggregateAndProof) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(a)
 }
 
+// GetTreeCtx ssz hashes the AggregateAndProof object and stops with the error of the context once it is done
+func (a *AggregateAndProof) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, a)
+}
+
+// Generalized indices of the AggregateAndProof fields
+const (
+	AggregateAndProofGindexIndex          = 4
+	AggregateAndProofGindexAggregate      = 5
+	AggregateAndProofGindexSelectionProof = 6
+)
+
+// AggregateAndProofGindex returns the generalized index of a field path in the AggregateAndProof object
+func AggregateAndProofGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "aggregator_index":
+		return ssz.FieldGindex(AggregateAndProofGindexIndex, rest, nil)
+	case "aggregate":
+		return ssz.FieldGindex(AggregateAndProofGindexAggregate, rest, AttestationGindex)
+	case "selection_proof":
+		return ssz.FieldGindex(AggregateAndProofGindexSelectionProof, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("AggregateAndProof", field)
+}
+
+// ProveField returns a proof of the field path in the AggregateAndProof object
+func (a *AggregateAndProof) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(a, AggregateAndProofGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the AggregateAndProof object
+func (a *AggregateAndProof) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(a, AggregateAndProofGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the Checkpoint object
 func (c *Checkpoint) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -139,6 +253,33 @@ func (c *Checkpoint) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Checkpoint object to a writer
+func (c *Checkpoint) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalValue(dst, c.Epoch)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Root'
+	if size := uint64(len(c.Root)); size != 32 {
+		err = ssz.ErrBytesLengthFn("Checkpoint.Root", size, 32)
+		return
+	}
+	dst = append(dst, c.Root...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Checkpoint object
 func (c *Checkpoint) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -161,6 +302,29 @@ func (c *Checkpoint) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Checkpoint object from a reader with the size of the encoding
+func (c *Checkpoint) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Epoch'
+		c.Epoch, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (1) 'Root'
+		c.Root, buf = ssz.UnmarshalBytes(c.Root, buf, 32)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Checkpoint object
 func (c *Checkpoint) fixedSize() int {
 	return int(40)
@@ -177,6 +341,11 @@ func (c *Checkpoint) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the Checkpoint object and stops with the error of the context once it is done
+func (c *Checkpoint) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the Checkpoint object with a hasher
 func (c *Checkpoint) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -200,6 +369,42 @@ func (c *Checkpoint) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+// GetTreeCtx ssz hashes the Checkpoint object and stops with the error of the context once it is done
+func (c *Checkpoint) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the Checkpoint fields
+const (
+	CheckpointGindexEpoch = 2
+	CheckpointGindexRoot  = 3
+)
+
+// CheckpointGindex returns the generalized index of a field path in the Checkpoint object
+func CheckpointGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "epoch":
+		return ssz.FieldGindex(CheckpointGindexEpoch, rest, nil)
+	case "root":
+		return ssz.FieldGindex(CheckpointGindexRoot, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Checkpoint", field)
+}
+
+// ProveField returns a proof of the field path in the Checkpoint object
+func (c *Checkpoint) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, CheckpointGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Checkpoint object
+func (c *Checkpoint) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, CheckpointGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the AttestationData object
 func (a *AttestationData) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(a)
@@ -237,6 +442,51 @@ func (a *AttestationData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the AttestationData object to a writer
+func (a *AttestationData) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, uint64(a.Slot))
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Index'
+	dst = ssz.MarshalValue(dst, a.Index)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'BeaconBlockHash'
+	dst = append(dst, a.BeaconBlockHash[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'Source'
+	if a.Source == nil {
+		a.Source = new(Checkpoint)
+	}
+	if dst, err = ww.WriteObject(dst, a.Source); err != nil {
+		return
+	}
+
+	// Field (4) 'Target'
+	if a.Target == nil {
+		a.Target = new(Checkpoint)
+	}
+	if dst, err = ww.WriteObject(dst, a.Target); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the AttestationData object
 func (a *AttestationData) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(a, buf)
@@ -276,6 +526,46 @@ func (a *AttestationData) UnmarshalSSZTail(buf []byte) (rest []byte, err error)
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the AttestationData object from a reader with the size of the encoding
+func (a *AttestationData) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := a.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Slot'
+		{
+			var val uint64
+			val, buf = ssz.UnmarshallValue[uint64](buf)
+			a.Slot = Slot(val)
+		}
+
+		// Field (1) 'Index'
+		a.Index, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (2) 'BeaconBlockHash'
+		buf = ssz.UnmarshalFixedBytes(a.BeaconBlockHash[:], buf)
+
+		// Field (3) 'Source'
+		if buf, err = ssz.UnmarshalFieldTail(&a.Source, buf); err != nil {
+			return
+		}
+
+		// Field (4) 'Target'
+		if buf, err = ssz.UnmarshalFieldTail(&a.Target, buf); err != nil {
+			return
+		}
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the AttestationData object
 func (a *AttestationData) fixedSize() int {
 	return int(128)
@@ -292,6 +582,11 @@ func (a *AttestationData) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(a)
 }
 
+// HashTreeRootCtx ssz hashes the AttestationData object and stops with the error of the context once it is done
+func (a *AttestationData) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, a)
+}
+
 // HashTreeRootWith ssz hashes the AttestationData object with a hasher
 func (a *AttestationData) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -330,6 +625,51 @@ func (a *AttestationData) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(a)
 }
 
+// GetTreeCtx ssz hashes the AttestationData object and stops with the error of the context once it is done
+func (a *AttestationData) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, a)
+}
+
+// Generalized indices of the AttestationData fields
+const (
+	AttestationDataGindexSlot            = 8
+	AttestationDataGindexIndex           = 9
+	AttestationDataGindexBeaconBlockHash = 10
+	AttestationDataGindexSource          = 11
+	AttestationDataGindexTarget          = 12
+)
+
+// AttestationDataGindex returns the generalized index of a field path in the AttestationData object
+func AttestationDataGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "slot":
+		return ssz.FieldGindex(AttestationDataGindexSlot, rest, nil)
+	case "index":
+		return ssz.FieldGindex(AttestationDataGindexIndex, rest, nil)
+	case "beacon_block_root":
+		return ssz.FieldGindex(AttestationDataGindexBeaconBlockHash, rest, nil)
+	case "source":
+		return ssz.FieldGindex(AttestationDataGindexSource, rest, CheckpointGindex)
+	case "target":
+		return ssz.FieldGindex(AttestationDataGindexTarget, rest, CheckpointGindex)
+	}
+	return 0, ssz.ErrUnknownFieldFn("AttestationData", field)
+}
+
+// ProveField returns a proof of the field path in the AttestationData object
+func (a *AttestationData) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(a, AttestationDataGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the AttestationData object
+func (a *AttestationData) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(a, AttestationDataGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the Attestation object
 func (a *Attestation) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(a)
@@ -364,6 +704,45 @@ func (a *Attestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Attestation object to a writer
+func (a *Attestation) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := a.fixedSize()
+
+	// Offset (0) 'AggregationBits'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if a.Data == nil {
+		a.Data = new(AttestationData)
+	}
+	if dst, err = ww.WriteObject(dst, a.Data); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	dst = append(dst, a.Signature[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (0) 'AggregationBits'
+	if size := ssz.BitlistLen(a.AggregationBits); size > 2048 {
+		err = ssz.ErrBytesLengthFn("Attestation.AggregationBits", size, 2048)
+		return
+	}
+	dst = append(dst, a.AggregationBits...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Attestation object
 func (a *Attestation) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(a, buf)
@@ -402,6 +781,51 @@ func (a *Attestation) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the Attestation object from a reader with the size of the encoding
+func (a *Attestation) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := a.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'AggregationBits'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (1) 'Data'
+		if buf, err = ssz.UnmarshalFieldTail(&a.Data, buf); err != nil {
+			return
+		}
+
+		// Field (2) 'Signature'
+		buf = ssz.UnmarshalFixedBytes(a.Signature[:], buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'AggregationBits'
+	if uint64(size-int(o0)) > 257 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o0), func(buf []byte) (_ []byte, err error) {
+		if a.AggregationBits, err = ssz.UnmarshalBitList(a.AggregationBits, buf, 2048); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Attestation object
 func (a *Attestation) fixedSize() int {
 	return int(228)
@@ -422,6 +846,11 @@ func (a *Attestation) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(a)
 }
 
+// HashTreeRootCtx ssz hashes the Attestation object and stops with the error of the context once it is done
+func (a *Attestation) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, a)
+}
+
 // HashTreeRootWith ssz hashes the Attestation object with a hasher
 func (a *Attestation) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -453,6 +882,45 @@ func (a *Attestation) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(a)
 }
 
+// GetTreeCtx ssz hashes the Attestation object and stops with the error of the context once it is done
+func (a *Attestation) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, a)
+}
+
+// Generalized indices of the Attestation fields
+const (
+	AttestationGindexAggregationBits = 4
+	AttestationGindexData            = 5
+	AttestationGindexSignature       = 6
+)
+
+// AttestationGindex returns the generalized index of a field path in the Attestation object
+func AttestationGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "aggregation_bits":
+		return ssz.FieldGindex(AttestationGindexAggregationBits, rest, nil)
+	case "data":
+		return ssz.FieldGindex(AttestationGindexData, rest, AttestationDataGindex)
+	case "signature":
+		return ssz.FieldGindex(AttestationGindexSignature, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Attestation", field)
+}
+
+// ProveField returns a proof of the field path in the Attestation object
+func (a *Attestation) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(a, AttestationGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Attestation object
+func (a *Attestation) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(a, AttestationGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the DepositData object
 func (d *DepositData) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
@@ -481,20 +949,59 @@ func (d *DepositData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the DepositData object
-func (d *DepositData) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(d, buf)
-}
+// MarshalSSZWriter ssz marshals the DepositData object to a writer
+func (d *DepositData) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
 
-// UnmarshalSSZTail unmarshals the DepositData object and returns the remaining bufferº
-func (d *DepositData) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
-	fixedSize := d.fixedSize()
-	if size < fixedSize {
-		return nil, ssz.ErrSize
+	// Field (0) 'Pubkey'
+	dst = append(dst, d.Pubkey[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
 	}
 
-	// Field (0) 'Pubkey'
+	// Field (1) 'WithdrawalCredentials'
+	dst = append(dst, d.WithdrawalCredentials[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalValue(dst, d.Amount)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'Signature'
+	if size := uint64(len(d.Signature)); size != 96 {
+		err = ssz.ErrBytesLengthFn("DepositData.Signature", size, 96)
+		return
+	}
+	dst = append(dst, d.Signature...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the DepositData object
+func (d *DepositData) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(d, buf)
+}
+
+// UnmarshalSSZTail unmarshals the DepositData object and returns the remaining bufferº
+func (d *DepositData) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := d.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'Pubkey'
 	buf = ssz.UnmarshalFixedBytes(d.Pubkey[:], buf)
 
 	// Field (1) 'WithdrawalCredentials'
@@ -509,6 +1016,35 @@ func (d *DepositData) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the DepositData object from a reader with the size of the encoding
+func (d *DepositData) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := d.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Pubkey'
+		buf = ssz.UnmarshalFixedBytes(d.Pubkey[:], buf)
+
+		// Field (1) 'WithdrawalCredentials'
+		buf = ssz.UnmarshalFixedBytes(d.WithdrawalCredentials[:], buf)
+
+		// Field (2) 'Amount'
+		d.Amount, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (3) 'Signature'
+		d.Signature, buf = ssz.UnmarshalBytes(d.Signature, buf, 96)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the DepositData object
 func (d *DepositData) fixedSize() int {
 	return int(184)
@@ -525,6 +1061,11 @@ func (d *DepositData) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(d)
 }
 
+// HashTreeRootCtx ssz hashes the DepositData object and stops with the error of the context once it is done
+func (d *DepositData) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, d)
+}
+
 // HashTreeRootWith ssz hashes the DepositData object with a hasher
 func (d *DepositData) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -554,6 +1095,48 @@ func (d *DepositData) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(d)
 }
 
+// GetTreeCtx ssz hashes the DepositData object and stops with the error of the context once it is done
+func (d *DepositData) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, d)
+}
+
+// Generalized indices of the DepositData fields
+const (
+	DepositDataGindexPubkey                = 4
+	DepositDataGindexWithdrawalCredentials = 5
+	DepositDataGindexAmount                = 6
+	DepositDataGindexSignature             = 7
+)
+
+// DepositDataGindex returns the generalized index of a field path in the DepositData object
+func DepositDataGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "pubkey":
+		return ssz.FieldGindex(DepositDataGindexPubkey, rest, nil)
+	case "withdrawal_credentials":
+		return ssz.FieldGindex(DepositDataGindexWithdrawalCredentials, rest, nil)
+	case "amount":
+		return ssz.FieldGindex(DepositDataGindexAmount, rest, nil)
+	case "signature":
+		return ssz.FieldGindex(DepositDataGindexSignature, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("DepositData", field)
+}
+
+// ProveField returns a proof of the field path in the DepositData object
+func (d *DepositData) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(d, DepositDataGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the DepositData object
+func (d *DepositData) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(d, DepositDataGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the Deposit object
 func (d *Deposit) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
@@ -587,6 +1170,41 @@ func (d *Deposit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Deposit object to a writer
+func (d *Deposit) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Proof'
+	if size := uint64(len(d.Proof)); size != 33 {
+		err = ssz.ErrVectorLengthFn("Deposit.Proof", size, 33)
+		return
+	}
+	for ii := 0; ii < len(d.Proof); ii++ {
+		if size := uint64(len(d.Proof[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("Deposit.Proof[ii]", size, 32)
+			return
+		}
+		dst = append(dst, d.Proof[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'Data'
+	if d.Data == nil {
+		d.Data = new(DepositData)
+	}
+	if dst, err = ww.WriteObject(dst, d.Data); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Deposit object
 func (d *Deposit) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(d, buf)
@@ -614,6 +1232,34 @@ func (d *Deposit) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Deposit object from a reader with the size of the encoding
+func (d *Deposit) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := d.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Proof'
+		d.Proof = make([][]byte, 33)
+		for ii := uint64(0); ii < 33; ii++ {
+			d.Proof[ii], buf = ssz.UnmarshalBytes(d.Proof[ii], buf, 32)
+		}
+
+		// Field (1) 'Data'
+		if buf, err = ssz.UnmarshalFieldTail(&d.Data, buf); err != nil {
+			return
+		}
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Deposit object
 func (d *Deposit) fixedSize() int {
 	return int(1240)
@@ -630,6 +1276,11 @@ func (d *Deposit) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(d)
 }
 
+// HashTreeRootCtx ssz hashes the Deposit object and stops with the error of the context once it is done
+func (d *Deposit) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, d)
+}
+
 // HashTreeRootWith ssz hashes the Deposit object with a hasher
 func (d *Deposit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -668,6 +1319,50 @@ func (d *Deposit) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(d)
 }
 
+// GetTreeCtx ssz hashes the Deposit object and stops with the error of the context once it is done
+func (d *Deposit) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, d)
+}
+
+// Generalized indices of the Deposit fields
+const (
+	DepositGindexProof = 2
+	DepositGindexData  = 3
+)
+
+// DepositGindexProofElem returns the generalized index of the chunk of the i-th element of the Proof field,
+// or zero if it does not fit in an int
+func DepositGindexProofElem(i int) int {
+	return ssz.ConcatGindices(DepositGindexProof, ssz.VectorElemGindex(i, 33, 0))
+}
+
+// DepositGindex returns the generalized index of a field path in the Deposit object
+func DepositGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "proof":
+		return ssz.FieldGindex(DepositGindexProof, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, 33, 0, nil)
+		})
+	case "data":
+		return ssz.FieldGindex(DepositGindexData, rest, DepositDataGindex)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Deposit", field)
+}
+
+// ProveField returns a proof of the field path in the Deposit object
+func (d *Deposit) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(d, DepositGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Deposit object
+func (d *Deposit) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(d, DepositGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the DepositMessage object
 func (d *DepositMessage) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
@@ -697,6 +1392,43 @@ func (d *DepositMessage) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the DepositMessage object to a writer
+func (d *DepositMessage) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Pubkey'
+	if size := uint64(len(d.Pubkey)); size != 48 {
+		err = ssz.ErrBytesLengthFn("DepositMessage.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, d.Pubkey...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'WithdrawalCredentials'
+	if size := uint64(len(d.WithdrawalCredentials)); size != 32 {
+		err = ssz.ErrBytesLengthFn("DepositMessage.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, d.WithdrawalCredentials...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalValue(dst, d.Amount)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the DepositMessage object
 func (d *DepositMessage) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(d, buf)
@@ -722,6 +1454,32 @@ func (d *DepositMessage) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the DepositMessage object from a reader with the size of the encoding
+func (d *DepositMessage) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := d.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Pubkey'
+		d.Pubkey, buf = ssz.UnmarshalBytes(d.Pubkey, buf, 48)
+
+		// Field (1) 'WithdrawalCredentials'
+		d.WithdrawalCredentials, buf = ssz.UnmarshalBytes(d.WithdrawalCredentials, buf, 32)
+
+		// Field (2) 'Amount'
+		d.Amount, buf = ssz.UnmarshallValue[uint64](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the DepositMessage object
 func (d *DepositMessage) fixedSize() int {
 	return int(88)
@@ -738,6 +1496,11 @@ func (d *DepositMessage) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(d)
 }
 
+// HashTreeRootCtx ssz hashes the DepositMessage object and stops with the error of the context once it is done
+func (d *DepositMessage) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, d)
+}
+
 // HashTreeRootWith ssz hashes the DepositMessage object with a hasher
 func (d *DepositMessage) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -768,6 +1531,45 @@ func (d *DepositMessage) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(d)
 }
 
+// GetTreeCtx ssz hashes the DepositMessage object and stops with the error of the context once it is done
+func (d *DepositMessage) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, d)
+}
+
+// Generalized indices of the DepositMessage fields
+const (
+	DepositMessageGindexPubkey                = 4
+	DepositMessageGindexWithdrawalCredentials = 5
+	DepositMessageGindexAmount                = 6
+)
+
+// DepositMessageGindex returns the generalized index of a field path in the DepositMessage object
+func DepositMessageGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "pubkey":
+		return ssz.FieldGindex(DepositMessageGindexPubkey, rest, nil)
+	case "withdrawal_credentials":
+		return ssz.FieldGindex(DepositMessageGindexWithdrawalCredentials, rest, nil)
+	case "amount":
+		return ssz.FieldGindex(DepositMessageGindexAmount, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("DepositMessage", field)
+}
+
+// ProveField returns a proof of the field path in the DepositMessage object
+func (d *DepositMessage) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(d, DepositMessageGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the DepositMessage object
+func (d *DepositMessage) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(d, DepositMessageGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the IndexedAttestation object
 func (i *IndexedAttestation) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(i)
@@ -808,6 +1610,51 @@ func (i *IndexedAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the IndexedAttestation object to a writer
+func (i *IndexedAttestation) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := i.fixedSize()
+
+	// Offset (0) 'AttestationIndices'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if i.Data == nil {
+		i.Data = new(AttestationData)
+	}
+	if dst, err = ww.WriteObject(dst, i.Data); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	if size := uint64(len(i.Signature)); size != 96 {
+		err = ssz.ErrBytesLengthFn("IndexedAttestation.Signature", size, 96)
+		return
+	}
+	dst = append(dst, i.Signature...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (0) 'AttestationIndices'
+	if size := uint64(len(i.AttestationIndices)); size > 2048 {
+		err = ssz.ErrListTooBigFn("IndexedAttestation.AttestationIndices", size, 2048)
+		return
+	}
+	for ii := 0; ii < len(i.AttestationIndices); ii++ {
+		dst = ssz.MarshalValue(dst, i.AttestationIndices[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the IndexedAttestation object
 func (i *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(i, buf)
@@ -849,6 +1696,54 @@ func (i *IndexedAttestation) UnmarshalSSZTail(buf []byte) (rest []byte, err erro
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the IndexedAttestation object from a reader with the size of the encoding
+func (i *IndexedAttestation) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := i.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'AttestationIndices'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (1) 'Data'
+		if buf, err = ssz.UnmarshalFieldTail(&i.Data, buf); err != nil {
+			return
+		}
+
+		// Field (2) 'Signature'
+		i.Signature, buf = ssz.UnmarshalBytes(i.Signature, buf, 96)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'AttestationIndices'
+	if uint64(size-int(o0)) > 16384 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o0), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&i.AttestationIndices, buf, 8, 2048, func(ii uint64, buf []byte) (err error) {
+			i.AttestationIndices[ii], buf = ssz.UnmarshallValue[uint64](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the IndexedAttestation object
 func (i *IndexedAttestation) fixedSize() int {
 	return int(228)
@@ -869,6 +1764,11 @@ func (i *IndexedAttestation) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
 }
 
+// HashTreeRootCtx ssz hashes the IndexedAttestation object and stops with the error of the context once it is done
+func (i *IndexedAttestation) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, i)
+}
+
 // HashTreeRootWith ssz hashes the IndexedAttestation object with a hasher
 func (i *IndexedAttestation) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -912,6 +1812,53 @@ func (i *IndexedAttestation) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
 
+// GetTreeCtx ssz hashes the IndexedAttestation object and stops with the error of the context once it is done
+func (i *IndexedAttestation) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, i)
+}
+
+// Generalized indices of the IndexedAttestation fields
+const (
+	IndexedAttestationGindexAttestationIndices = 4
+	IndexedAttestationGindexData               = 5
+	IndexedAttestationGindexSignature          = 6
+)
+
+// IndexedAttestationGindexAttestationIndicesElem returns the generalized index of the chunk of the i-th element of the AttestationIndices field,
+// or zero if it does not fit in an int
+func IndexedAttestationGindexAttestationIndicesElem(i int) int {
+	return ssz.ConcatGindices(IndexedAttestationGindexAttestationIndices, ssz.ListElemGindex(i, 2048, 8))
+}
+
+// IndexedAttestationGindex returns the generalized index of a field path in the IndexedAttestation object
+func IndexedAttestationGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "attesting_indices":
+		return ssz.FieldGindex(IndexedAttestationGindexAttestationIndices, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 2048, 8, nil)
+		})
+	case "data":
+		return ssz.FieldGindex(IndexedAttestationGindexData, rest, AttestationDataGindex)
+	case "signature":
+		return ssz.FieldGindex(IndexedAttestationGindexSignature, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("IndexedAttestation", field)
+}
+
+// ProveField returns a proof of the field path in the IndexedAttestation object
+func (i *IndexedAttestation) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(i, IndexedAttestationGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the IndexedAttestation object
+func (i *IndexedAttestation) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(i, IndexedAttestationGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the PendingAttestation object
 func (p *PendingAttestation) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(p)
@@ -949,8 +1896,53 @@ func (p *PendingAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the PendingAttestation object
-func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
+// MarshalSSZWriter ssz marshals the PendingAttestation object to a writer
+func (p *PendingAttestation) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := p.fixedSize()
+
+	// Offset (0) 'AggregationBits'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if p.Data == nil {
+		p.Data = new(AttestationData)
+	}
+	if dst, err = ww.WriteObject(dst, p.Data); err != nil {
+		return
+	}
+
+	// Field (2) 'InclusionDelay'
+	dst = ssz.MarshalValue(dst, p.InclusionDelay)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'ProposerIndex'
+	dst = ssz.MarshalValue(dst, p.ProposerIndex)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (0) 'AggregationBits'
+	if size := ssz.BitlistLen(p.AggregationBits); size > 2048 {
+		err = ssz.ErrBytesLengthFn("PendingAttestation.AggregationBits", size, 2048)
+		return
+	}
+	dst = append(dst, p.AggregationBits...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the PendingAttestation object
+func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(p, buf)
 }
 
@@ -990,6 +1982,54 @@ func (p *PendingAttestation) UnmarshalSSZTail(buf []byte) (rest []byte, err erro
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the PendingAttestation object from a reader with the size of the encoding
+func (p *PendingAttestation) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := p.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'AggregationBits'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (1) 'Data'
+		if buf, err = ssz.UnmarshalFieldTail(&p.Data, buf); err != nil {
+			return
+		}
+
+		// Field (2) 'InclusionDelay'
+		p.InclusionDelay, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (3) 'ProposerIndex'
+		p.ProposerIndex, buf = ssz.UnmarshallValue[uint64](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'AggregationBits'
+	if uint64(size-int(o0)) > 257 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o0), func(buf []byte) (_ []byte, err error) {
+		if p.AggregationBits, err = ssz.UnmarshalBitList(p.AggregationBits, buf, 2048); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the PendingAttestation object
 func (p *PendingAttestation) fixedSize() int {
 	return int(148)
@@ -1010,6 +2050,11 @@ func (p *PendingAttestation) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(p)
 }
 
+// HashTreeRootCtx ssz hashes the PendingAttestation object and stops with the error of the context once it is done
+func (p *PendingAttestation) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, p)
+}
+
 // HashTreeRootWith ssz hashes the PendingAttestation object with a hasher
 func (p *PendingAttestation) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -1044,6 +2089,48 @@ func (p *PendingAttestation) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(p)
 }
 
+// GetTreeCtx ssz hashes the PendingAttestation object and stops with the error of the context once it is done
+func (p *PendingAttestation) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, p)
+}
+
+// Generalized indices of the PendingAttestation fields
+const (
+	PendingAttestationGindexAggregationBits = 4
+	PendingAttestationGindexData            = 5
+	PendingAttestationGindexInclusionDelay  = 6
+	PendingAttestationGindexProposerIndex   = 7
+)
+
+// PendingAttestationGindex returns the generalized index of a field path in the PendingAttestation object
+func PendingAttestationGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "aggregation_bits":
+		return ssz.FieldGindex(PendingAttestationGindexAggregationBits, rest, nil)
+	case "data":
+		return ssz.FieldGindex(PendingAttestationGindexData, rest, AttestationDataGindex)
+	case "inclusion_delay":
+		return ssz.FieldGindex(PendingAttestationGindexInclusionDelay, rest, nil)
+	case "proposer_index":
+		return ssz.FieldGindex(PendingAttestationGindexProposerIndex, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("PendingAttestation", field)
+}
+
+// ProveField returns a proof of the field path in the PendingAttestation object
+func (p *PendingAttestation) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(p, PendingAttestationGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the PendingAttestation object
+func (p *PendingAttestation) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(p, PendingAttestationGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the Fork object
 func (f *Fork) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(f)
@@ -1073,6 +2160,43 @@ func (f *Fork) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Fork object to a writer
+func (f *Fork) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'PreviousVersion'
+	if size := uint64(len(f.PreviousVersion)); size != 4 {
+		err = ssz.ErrBytesLengthFn("Fork.PreviousVersion", size, 4)
+		return
+	}
+	dst = append(dst, f.PreviousVersion...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'CurrentVersion'
+	if size := uint64(len(f.CurrentVersion)); size != 4 {
+		err = ssz.ErrBytesLengthFn("Fork.CurrentVersion", size, 4)
+		return
+	}
+	dst = append(dst, f.CurrentVersion...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Epoch'
+	dst = ssz.MarshalValue(dst, f.Epoch)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Fork object
 func (f *Fork) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(f, buf)
@@ -1098,6 +2222,32 @@ func (f *Fork) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Fork object from a reader with the size of the encoding
+func (f *Fork) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := f.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'PreviousVersion'
+		f.PreviousVersion, buf = ssz.UnmarshalBytes(f.PreviousVersion, buf, 4)
+
+		// Field (1) 'CurrentVersion'
+		f.CurrentVersion, buf = ssz.UnmarshalBytes(f.CurrentVersion, buf, 4)
+
+		// Field (2) 'Epoch'
+		f.Epoch, buf = ssz.UnmarshallValue[uint64](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Fork object
 func (f *Fork) fixedSize() int {
 	return int(16)
@@ -1114,6 +2264,11 @@ func (f *Fork) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(f)
 }
 
+// HashTreeRootCtx ssz hashes the Fork object and stops with the error of the context once it is done
+func (f *Fork) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, f)
+}
+
 // HashTreeRootWith ssz hashes the Fork object with a hasher
 func (f *Fork) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -1144,6 +2299,45 @@ func (f *Fork) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(f)
 }
 
+// GetTreeCtx ssz hashes the Fork object and stops with the error of the context once it is done
+func (f *Fork) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, f)
+}
+
+// Generalized indices of the Fork fields
+const (
+	ForkGindexPreviousVersion = 4
+	ForkGindexCurrentVersion  = 5
+	ForkGindexEpoch           = 6
+)
+
+// ForkGindex returns the generalized index of a field path in the Fork object
+func ForkGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "previous_version":
+		return ssz.FieldGindex(ForkGindexPreviousVersion, rest, nil)
+	case "current_version":
+		return ssz.FieldGindex(ForkGindexCurrentVersion, rest, nil)
+	case "epoch":
+		return ssz.FieldGindex(ForkGindexEpoch, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Fork", field)
+}
+
+// ProveField returns a proof of the field path in the Fork object
+func (f *Fork) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(f, ForkGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Fork object
+func (f *Fork) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(f, ForkGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the Validator object
 func (v *Validator) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(v)
@@ -1188,6 +2382,73 @@ func (v *Validator) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Validator object to a writer
+func (v *Validator) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Pubkey'
+	if size := uint64(len(v.Pubkey)); size != 48 {
+		err = ssz.ErrBytesLengthFn("Validator.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, v.Pubkey...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'WithdrawalCredentials'
+	if size := uint64(len(v.WithdrawalCredentials)); size != 32 {
+		err = ssz.ErrBytesLengthFn("Validator.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, v.WithdrawalCredentials...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'EffectiveBalance'
+	dst = ssz.MarshalValue(dst, v.EffectiveBalance)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'Slashed'
+	dst = ssz.MarshalValue(dst, v.Slashed)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	dst = ssz.MarshalValue(dst, v.ActivationEligibilityEpoch)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'ActivationEpoch'
+	dst = ssz.MarshalValue(dst, v.ActivationEpoch)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (6) 'ExitEpoch'
+	dst = ssz.MarshalValue(dst, v.ExitEpoch)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (7) 'WithdrawableEpoch'
+	dst = ssz.MarshalValue(dst, v.WithdrawableEpoch)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Validator object
 func (v *Validator) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(v, buf)
@@ -1231,6 +2492,50 @@ func (v *Validator) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Validator object from a reader with the size of the encoding
+func (v *Validator) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := v.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Pubkey'
+		v.Pubkey, buf = ssz.UnmarshalBytes(v.Pubkey, buf, 48)
+
+		// Field (1) 'WithdrawalCredentials'
+		v.WithdrawalCredentials, buf = ssz.UnmarshalBytes(v.WithdrawalCredentials, buf, 32)
+
+		// Field (2) 'EffectiveBalance'
+		v.EffectiveBalance, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (3) 'Slashed'
+		if err = ssz.IsValidBool(buf); err != nil {
+			return
+		}
+		v.Slashed, buf = ssz.UnmarshallValue[bool](buf)
+
+		// Field (4) 'ActivationEligibilityEpoch'
+		v.ActivationEligibilityEpoch, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (5) 'ActivationEpoch'
+		v.ActivationEpoch, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (6) 'ExitEpoch'
+		v.ExitEpoch, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (7) 'WithdrawableEpoch'
+		v.WithdrawableEpoch, buf = ssz.UnmarshallValue[uint64](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Validator object
 func (v *Validator) fixedSize() int {
 	return int(121)
@@ -1247,6 +2552,11 @@ func (v *Validator) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(v)
 }
 
+// HashTreeRootCtx ssz hashes the Validator object and stops with the error of the context once it is done
+func (v *Validator) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, v)
+}
+
 // HashTreeRootWith ssz hashes the Validator object with a hasher
 func (v *Validator) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -1292,6 +2602,60 @@ func (v *Validator) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(v)
 }
 
+// GetTreeCtx ssz hashes the Validator object and stops with the error of the context once it is done
+func (v *Validator) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, v)
+}
+
+// Generalized indices of the Validator fields
+const (
+	ValidatorGindexPubkey                     = 8
+	ValidatorGindexWithdrawalCredentials      = 9
+	ValidatorGindexEffectiveBalance           = 10
+	ValidatorGindexSlashed                    = 11
+	ValidatorGindexActivationEligibilityEpoch = 12
+	ValidatorGindexActivationEpoch            = 13
+	ValidatorGindexExitEpoch                  = 14
+	ValidatorGindexWithdrawableEpoch          = 15
+)
+
+// ValidatorGindex returns the generalized index of a field path in the Validator object
+func ValidatorGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "pubkey":
+		return ssz.FieldGindex(ValidatorGindexPubkey, rest, nil)
+	case "withdrawal_credentials":
+		return ssz.FieldGindex(ValidatorGindexWithdrawalCredentials, rest, nil)
+	case "effective_balance":
+		return ssz.FieldGindex(ValidatorGindexEffectiveBalance, rest, nil)
+	case "slashed":
+		return ssz.FieldGindex(ValidatorGindexSlashed, rest, nil)
+	case "activation_eligibility_epoch":
+		return ssz.FieldGindex(ValidatorGindexActivationEligibilityEpoch, rest, nil)
+	case "activation_epoch":
+		return ssz.FieldGindex(ValidatorGindexActivationEpoch, rest, nil)
+	case "exit_epoch":
+		return ssz.FieldGindex(ValidatorGindexExitEpoch, rest, nil)
+	case "withdrawable_epoch":
+		return ssz.FieldGindex(ValidatorGindexWithdrawableEpoch, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Validator", field)
+}
+
+// ProveField returns a proof of the field path in the Validator object
+func (v *Validator) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(v, ValidatorGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Validator object
+func (v *Validator) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(v, ValidatorGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the VoluntaryExit object
 func (v *VoluntaryExit) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(v)
@@ -1310,6 +2674,29 @@ func (v *VoluntaryExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the VoluntaryExit object to a writer
+func (v *VoluntaryExit) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalValue(dst, v.Epoch)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'ValidatorIndex'
+	dst = ssz.MarshalValue(dst, v.ValidatorIndex)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the VoluntaryExit object
 func (v *VoluntaryExit) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(v, buf)
@@ -1332,6 +2719,29 @@ func (v *VoluntaryExit) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the VoluntaryExit object from a reader with the size of the encoding
+func (v *VoluntaryExit) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := v.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Epoch'
+		v.Epoch, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (1) 'ValidatorIndex'
+		v.ValidatorIndex, buf = ssz.UnmarshallValue[uint64](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the VoluntaryExit object
 func (v *VoluntaryExit) fixedSize() int {
 	return int(16)
@@ -1348,6 +2758,11 @@ func (v *VoluntaryExit) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(v)
 }
 
+// HashTreeRootCtx ssz hashes the VoluntaryExit object and stops with the error of the context once it is done
+func (v *VoluntaryExit) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, v)
+}
+
 // HashTreeRootWith ssz hashes the VoluntaryExit object with a hasher
 func (v *VoluntaryExit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -1367,14 +2782,50 @@ func (v *VoluntaryExit) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(v)
 }
 
-// MarshalSSZ ssz marshals the SignedVoluntaryExit object
-func (s *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// GetTreeCtx ssz hashes the VoluntaryExit object and stops with the error of the context once it is done
+func (v *VoluntaryExit) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, v)
 }
 
-// MarshalSSZTo ssz marshals the SignedVoluntaryExit object to a target array
-func (s *SignedVoluntaryExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// Generalized indices of the VoluntaryExit fields
+const (
+	VoluntaryExitGindexEpoch          = 2
+	VoluntaryExitGindexValidatorIndex = 3
+)
+
+// VoluntaryExitGindex returns the generalized index of a field path in the VoluntaryExit object
+func VoluntaryExitGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "epoch":
+		return ssz.FieldGindex(VoluntaryExitGindexEpoch, rest, nil)
+	case "validator_index":
+		return ssz.FieldGindex(VoluntaryExitGindexValidatorIndex, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("VoluntaryExit", field)
+}
+
+// ProveField returns a proof of the field path in the VoluntaryExit object
+func (v *VoluntaryExit) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(v, VoluntaryExitGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the VoluntaryExit object
+func (v *VoluntaryExit) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(v, VoluntaryExitGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedVoluntaryExit object to a target array
+func (s *SignedVoluntaryExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
 	// Field (0) 'Exit'
 	if s.Exit == nil {
@@ -1390,6 +2841,31 @@ func (s *SignedVoluntaryExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the SignedVoluntaryExit object to a writer
+func (s *SignedVoluntaryExit) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Exit'
+	if s.Exit == nil {
+		s.Exit = new(VoluntaryExit)
+	}
+	if dst, err = ww.WriteObject(dst, s.Exit); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	dst = append(dst, s.Signature[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the SignedVoluntaryExit object
 func (s *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(s, buf)
@@ -1414,6 +2890,31 @@ func (s *SignedVoluntaryExit) UnmarshalSSZTail(buf []byte) (rest []byte, err err
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the SignedVoluntaryExit object from a reader with the size of the encoding
+func (s *SignedVoluntaryExit) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := s.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Exit'
+		if buf, err = ssz.UnmarshalFieldTail(&s.Exit, buf); err != nil {
+			return
+		}
+
+		// Field (1) 'Signature'
+		buf = ssz.UnmarshalFixedBytes(s.Signature[:], buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the SignedVoluntaryExit object
 func (s *SignedVoluntaryExit) fixedSize() int {
 	return int(112)
@@ -1430,6 +2931,11 @@ func (s *SignedVoluntaryExit) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
 }
 
+// HashTreeRootCtx ssz hashes the SignedVoluntaryExit object and stops with the error of the context once it is done
+func (s *SignedVoluntaryExit) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, s)
+}
+
 // HashTreeRootWith ssz hashes the SignedVoluntaryExit object with a hasher
 func (s *SignedVoluntaryExit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -1454,6 +2960,42 @@ func (s *SignedVoluntaryExit) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
+// GetTreeCtx ssz hashes the SignedVoluntaryExit object and stops with the error of the context once it is done
+func (s *SignedVoluntaryExit) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, s)
+}
+
+// Generalized indices of the SignedVoluntaryExit fields
+const (
+	SignedVoluntaryExitGindexExit      = 2
+	SignedVoluntaryExitGindexSignature = 3
+)
+
+// SignedVoluntaryExitGindex returns the generalized index of a field path in the SignedVoluntaryExit object
+func SignedVoluntaryExitGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "message":
+		return ssz.FieldGindex(SignedVoluntaryExitGindexExit, rest, VoluntaryExitGindex)
+	case "signature":
+		return ssz.FieldGindex(SignedVoluntaryExitGindexSignature, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("SignedVoluntaryExit", field)
+}
+
+// ProveField returns a proof of the field path in the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(s, SignedVoluntaryExitGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(s, SignedVoluntaryExitGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the Eth1Block object
 func (e *Eth1Block) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
@@ -1479,6 +3021,39 @@ func (e *Eth1Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Eth1Block object to a writer
+func (e *Eth1Block) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Timestamp'
+	dst = ssz.MarshalValue(dst, e.Timestamp)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'DepositRoot'
+	if size := uint64(len(e.DepositRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("Eth1Block.DepositRoot", size, 32)
+		return
+	}
+	dst = append(dst, e.DepositRoot...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'DepositCount'
+	dst = ssz.MarshalValue(dst, e.DepositCount)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Eth1Block object
 func (e *Eth1Block) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(e, buf)
@@ -1504,6 +3079,32 @@ func (e *Eth1Block) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Eth1Block object from a reader with the size of the encoding
+func (e *Eth1Block) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := e.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Timestamp'
+		e.Timestamp, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (1) 'DepositRoot'
+		e.DepositRoot, buf = ssz.UnmarshalBytes(e.DepositRoot, buf, 32)
+
+		// Field (2) 'DepositCount'
+		e.DepositCount, buf = ssz.UnmarshallValue[uint64](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Eth1Block object
 func (e *Eth1Block) fixedSize() int {
 	return int(48)
@@ -1520,6 +3121,11 @@ func (e *Eth1Block) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(e)
 }
 
+// HashTreeRootCtx ssz hashes the Eth1Block object and stops with the error of the context once it is done
+func (e *Eth1Block) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, e)
+}
+
 // HashTreeRootWith ssz hashes the Eth1Block object with a hasher
 func (e *Eth1Block) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -1546,6 +3152,45 @@ func (e *Eth1Block) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
 
+// GetTreeCtx ssz hashes the Eth1Block object and stops with the error of the context once it is done
+func (e *Eth1Block) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, e)
+}
+
+// Generalized indices of the Eth1Block fields
+const (
+	Eth1BlockGindexTimestamp    = 4
+	Eth1BlockGindexDepositRoot  = 5
+	Eth1BlockGindexDepositCount = 6
+)
+
+// Eth1BlockGindex returns the generalized index of a field path in the Eth1Block object
+func Eth1BlockGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "timestamp":
+		return ssz.FieldGindex(Eth1BlockGindexTimestamp, rest, nil)
+	case "deposit_root":
+		return ssz.FieldGindex(Eth1BlockGindexDepositRoot, rest, nil)
+	case "deposit_count":
+		return ssz.FieldGindex(Eth1BlockGindexDepositCount, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Eth1Block", field)
+}
+
+// ProveField returns a proof of the field path in the Eth1Block object
+func (e *Eth1Block) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(e, Eth1BlockGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Eth1Block object
+func (e *Eth1Block) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(e, Eth1BlockGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the Eth1Data object
 func (e *Eth1Data) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
@@ -1575,6 +3220,43 @@ func (e *Eth1Data) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Eth1Data object to a writer
+func (e *Eth1Data) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'DepositRoot'
+	if size := uint64(len(e.DepositRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("Eth1Data.DepositRoot", size, 32)
+		return
+	}
+	dst = append(dst, e.DepositRoot...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'DepositCount'
+	dst = ssz.MarshalValue(dst, e.DepositCount)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'BlockHash'
+	if size := uint64(len(e.BlockHash)); size != 32 {
+		err = ssz.ErrBytesLengthFn("Eth1Data.BlockHash", size, 32)
+		return
+	}
+	dst = append(dst, e.BlockHash...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Eth1Data object
 func (e *Eth1Data) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(e, buf)
@@ -1600,6 +3282,32 @@ func (e *Eth1Data) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Eth1Data object from a reader with the size of the encoding
+func (e *Eth1Data) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := e.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'DepositRoot'
+		e.DepositRoot, buf = ssz.UnmarshalBytes(e.DepositRoot, buf, 32)
+
+		// Field (1) 'DepositCount'
+		e.DepositCount, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (2) 'BlockHash'
+		e.BlockHash, buf = ssz.UnmarshalBytes(e.BlockHash, buf, 32)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Eth1Data object
 func (e *Eth1Data) fixedSize() int {
 	return int(72)
@@ -1616,6 +3324,11 @@ func (e *Eth1Data) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(e)
 }
 
+// HashTreeRootCtx ssz hashes the Eth1Data object and stops with the error of the context once it is done
+func (e *Eth1Data) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, e)
+}
+
 // HashTreeRootWith ssz hashes the Eth1Data object with a hasher
 func (e *Eth1Data) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -1646,6 +3359,45 @@ func (e *Eth1Data) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
 
+// GetTreeCtx ssz hashes the Eth1Data object and stops with the error of the context once it is done
+func (e *Eth1Data) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, e)
+}
+
+// Generalized indices of the Eth1Data fields
+const (
+	Eth1DataGindexDepositRoot  = 4
+	Eth1DataGindexDepositCount = 5
+	Eth1DataGindexBlockHash    = 6
+)
+
+// Eth1DataGindex returns the generalized index of a field path in the Eth1Data object
+func Eth1DataGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "deposit_root":
+		return ssz.FieldGindex(Eth1DataGindexDepositRoot, rest, nil)
+	case "deposit_count":
+		return ssz.FieldGindex(Eth1DataGindexDepositCount, rest, nil)
+	case "block_hash":
+		return ssz.FieldGindex(Eth1DataGindexBlockHash, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Eth1Data", field)
+}
+
+// ProveField returns a proof of the field path in the Eth1Data object
+func (e *Eth1Data) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(e, Eth1DataGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Eth1Data object
+func (e *Eth1Data) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(e, Eth1DataGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the SigningRoot object
 func (s *SigningRoot) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
@@ -1672,6 +3424,37 @@ func (s *SigningRoot) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the SigningRoot object to a writer
+func (s *SigningRoot) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'ObjectRoot'
+	if size := uint64(len(s.ObjectRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("SigningRoot.ObjectRoot", size, 32)
+		return
+	}
+	dst = append(dst, s.ObjectRoot...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Domain'
+	if size := uint64(len(s.Domain)); size != 8 {
+		err = ssz.ErrBytesLengthFn("SigningRoot.Domain", size, 8)
+		return
+	}
+	dst = append(dst, s.Domain...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the SigningRoot object
 func (s *SigningRoot) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(s, buf)
@@ -1694,6 +3477,29 @@ func (s *SigningRoot) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the SigningRoot object from a reader with the size of the encoding
+func (s *SigningRoot) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := s.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'ObjectRoot'
+		s.ObjectRoot, buf = ssz.UnmarshalBytes(s.ObjectRoot, buf, 32)
+
+		// Field (1) 'Domain'
+		s.Domain, buf = ssz.UnmarshalBytes(s.Domain, buf, 8)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the SigningRoot object
 func (s *SigningRoot) fixedSize() int {
 	return int(40)
@@ -1710,6 +3516,11 @@ func (s *SigningRoot) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
 }
 
+// HashTreeRootCtx ssz hashes the SigningRoot object and stops with the error of the context once it is done
+func (s *SigningRoot) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, s)
+}
+
 // HashTreeRootWith ssz hashes the SigningRoot object with a hasher
 func (s *SigningRoot) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -1737,6 +3548,42 @@ func (s *SigningRoot) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
+// GetTreeCtx ssz hashes the SigningRoot object and stops with the error of the context once it is done
+func (s *SigningRoot) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, s)
+}
+
+// Generalized indices of the SigningRoot fields
+const (
+	SigningRootGindexObjectRoot = 2
+	SigningRootGindexDomain     = 3
+)
+
+// SigningRootGindex returns the generalized index of a field path in the SigningRoot object
+func SigningRootGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "object_root":
+		return ssz.FieldGindex(SigningRootGindexObjectRoot, rest, nil)
+	case "domain":
+		return ssz.FieldGindex(SigningRootGindexDomain, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("SigningRoot", field)
+}
+
+// ProveField returns a proof of the field path in the SigningRoot object
+func (s *SigningRoot) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(s, SigningRootGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the SigningRoot object
+func (s *SigningRoot) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(s, SigningRootGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the HistoricalBatch object
 func (h *HistoricalBatch) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(h)
@@ -1767,6 +3614,41 @@ func (h *HistoricalBatch) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the HistoricalBatch object to a writer
+func (h *HistoricalBatch) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'BlockRoots'
+	if size := uint64(len(h.BlockRoots)); size != historicalRoots {
+		err = ssz.ErrVectorLengthFn("HistoricalBatch.BlockRoots", size, historicalRoots)
+		return
+	}
+	for ii := 0; ii < len(h.BlockRoots); ii++ {
+		dst = append(dst, h.BlockRoots[ii][:]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'StateRoots'
+	if size := uint64(len(h.StateRoots)); size != historicalRoots {
+		err = ssz.ErrVectorLengthFn("HistoricalBatch.StateRoots", size, historicalRoots)
+		return
+	}
+	for ii := 0; ii < len(h.StateRoots); ii++ {
+		dst = append(dst, h.StateRoots[ii][:]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the HistoricalBatch object
 func (h *HistoricalBatch) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(h, buf)
@@ -1795,6 +3677,35 @@ func (h *HistoricalBatch) UnmarshalSSZTail(buf []byte) (rest []byte, err error)
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the HistoricalBatch object from a reader with the size of the encoding
+func (h *HistoricalBatch) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := h.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'BlockRoots'
+		h.BlockRoots = make([][32]byte, historicalRoots)
+		for ii := uint64(0); ii < historicalRoots; ii++ {
+			buf = ssz.UnmarshalFixedBytes(h.BlockRoots[ii][:], buf)
+		}
+
+		// Field (1) 'StateRoots'
+		h.StateRoots = make([][32]byte, historicalRoots)
+		for ii := uint64(0); ii < historicalRoots; ii++ {
+			buf = ssz.UnmarshalFixedBytes(h.StateRoots[ii][:], buf)
+		}
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the HistoricalBatch object
 func (h *HistoricalBatch) fixedSize() int {
 	return int(((historicalRoots * 32) + (historicalRoots * 32)))
@@ -1811,6 +3722,11 @@ func (h *HistoricalBatch) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(h)
 }
 
+// HashTreeRootCtx ssz hashes the HistoricalBatch object and stops with the error of the context once it is done
+func (h *HistoricalBatch) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, h)
+}
+
 // HashTreeRootWith ssz hashes the HistoricalBatch object with a hasher
 func (h *HistoricalBatch) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -1850,6 +3766,58 @@ func (h *HistoricalBatch) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(h)
 }
 
+// GetTreeCtx ssz hashes the HistoricalBatch object and stops with the error of the context once it is done
+func (h *HistoricalBatch) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, h)
+}
+
+// Generalized indices of the HistoricalBatch fields
+const (
+	HistoricalBatchGindexBlockRoots = 2
+	HistoricalBatchGindexStateRoots = 3
+)
+
+// HistoricalBatchGindexBlockRootsElem returns the generalized index of the chunk of the i-th element of the BlockRoots field,
+// or zero if it does not fit in an int
+func HistoricalBatchGindexBlockRootsElem(i int) int {
+	return ssz.ConcatGindices(HistoricalBatchGindexBlockRoots, ssz.VectorElemGindex(i, historicalRoots, 0))
+}
+
+// HistoricalBatchGindexStateRootsElem returns the generalized index of the chunk of the i-th element of the StateRoots field,
+// or zero if it does not fit in an int
+func HistoricalBatchGindexStateRootsElem(i int) int {
+	return ssz.ConcatGindices(HistoricalBatchGindexStateRoots, ssz.VectorElemGindex(i, historicalRoots, 0))
+}
+
+// HistoricalBatchGindex returns the generalized index of a field path in the HistoricalBatch object
+func HistoricalBatchGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "block_roots":
+		return ssz.FieldGindex(HistoricalBatchGindexBlockRoots, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, historicalRoots, 0, nil)
+		})
+	case "state_roots":
+		return ssz.FieldGindex(HistoricalBatchGindexStateRoots, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, historicalRoots, 0, nil)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("HistoricalBatch", field)
+}
+
+// ProveField returns a proof of the field path in the HistoricalBatch object
+func (h *HistoricalBatch) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(h, HistoricalBatchGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the HistoricalBatch object
+func (h *HistoricalBatch) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(h, HistoricalBatchGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the ProposerSlashing object
 func (p *ProposerSlashing) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(p)
@@ -1878,6 +3846,33 @@ func (p *ProposerSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the ProposerSlashing object to a writer
+func (p *ProposerSlashing) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Header1'
+	if p.Header1 == nil {
+		p.Header1 = new(SignedBeaconBlockHeader)
+	}
+	if dst, err = ww.WriteObject(dst, p.Header1); err != nil {
+		return
+	}
+
+	// Field (1) 'Header2'
+	if p.Header2 == nil {
+		p.Header2 = new(SignedBeaconBlockHeader)
+	}
+	if dst, err = ww.WriteObject(dst, p.Header2); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the ProposerSlashing object
 func (p *ProposerSlashing) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(p, buf)
@@ -1904,6 +3899,33 @@ func (p *ProposerSlashing) UnmarshalSSZTail(buf []byte) (rest []byte, err error)
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the ProposerSlashing object from a reader with the size of the encoding
+func (p *ProposerSlashing) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := p.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Header1'
+		if buf, err = ssz.UnmarshalFieldTail(&p.Header1, buf); err != nil {
+			return
+		}
+
+		// Field (1) 'Header2'
+		if buf, err = ssz.UnmarshalFieldTail(&p.Header2, buf); err != nil {
+			return
+		}
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the ProposerSlashing object
 func (p *ProposerSlashing) fixedSize() int {
 	return int(416)
@@ -1920,6 +3942,11 @@ func (p *ProposerSlashing) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(p)
 }
 
+// HashTreeRootCtx ssz hashes the ProposerSlashing object and stops with the error of the context once it is done
+func (p *ProposerSlashing) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, p)
+}
+
 // HashTreeRootWith ssz hashes the ProposerSlashing object with a hasher
 func (p *ProposerSlashing) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -1949,6 +3976,42 @@ func (p *ProposerSlashing) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(p)
 }
 
+// GetTreeCtx ssz hashes the ProposerSlashing object and stops with the error of the context once it is done
+func (p *ProposerSlashing) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, p)
+}
+
+// Generalized indices of the ProposerSlashing fields
+const (
+	ProposerSlashingGindexHeader1 = 2
+	ProposerSlashingGindexHeader2 = 3
+)
+
+// ProposerSlashingGindex returns the generalized index of a field path in the ProposerSlashing object
+func ProposerSlashingGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "signed_header_1":
+		return ssz.FieldGindex(ProposerSlashingGindexHeader1, rest, SignedBeaconBlockHeaderGindex)
+	case "signed_header_2":
+		return ssz.FieldGindex(ProposerSlashingGindexHeader2, rest, SignedBeaconBlockHeaderGindex)
+	}
+	return 0, ssz.ErrUnknownFieldFn("ProposerSlashing", field)
+}
+
+// ProveField returns a proof of the field path in the ProposerSlashing object
+func (p *ProposerSlashing) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(p, ProposerSlashingGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the ProposerSlashing object
+func (p *ProposerSlashing) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(p, ProposerSlashingGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the AttesterSlashing object
 func (a *AttesterSlashing) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(a)
@@ -1982,6 +4045,38 @@ func (a *AttesterSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the AttesterSlashing object to a writer
+func (a *AttesterSlashing) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := a.fixedSize()
+
+	// Offset (0) 'Attestation1'
+	dst = ssz.WriteOffset(dst, offset)
+	if a.Attestation1 == nil {
+		a.Attestation1 = new(IndexedAttestation)
+	}
+	offset += a.Attestation1.SizeSSZ()
+
+	// Offset (1) 'Attestation2'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Attestation1'
+	if dst, err = ww.WriteObject(dst, a.Attestation1); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if dst, err = ww.WriteObject(dst, a.Attestation2); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the AttesterSlashing object
 func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(a, buf)
@@ -2022,6 +4117,44 @@ func (a *AttesterSlashing) UnmarshalSSZTail(buf []byte) (rest []byte, err error)
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the AttesterSlashing object from a reader with the size of the encoding
+func (a *AttesterSlashing) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := a.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0, o1 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'Attestation1'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (1) 'Attestation2'
+		if o1, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'Attestation1'
+	if err = ssz.ReadField(rr, &a.Attestation1, int(o1-o0)); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if err = ssz.ReadField(rr, &a.Attestation2, size-int(o1)); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the AttesterSlashing object
 func (a *AttesterSlashing) fixedSize() int {
 	return int(8)
@@ -2051,6 +4184,11 @@ func (a *AttesterSlashing) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(a)
 }
 
+// HashTreeRootCtx ssz hashes the AttesterSlashing object and stops with the error of the context once it is done
+func (a *AttesterSlashing) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, a)
+}
+
 // HashTreeRootWith ssz hashes the AttesterSlashing object with a hasher
 func (a *AttesterSlashing) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -2074,6 +4212,42 @@ func (a *AttesterSlashing) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(a)
 }
 
+// GetTreeCtx ssz hashes the AttesterSlashing object and stops with the error of the context once it is done
+func (a *AttesterSlashing) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, a)
+}
+
+// Generalized indices of the AttesterSlashing fields
+const (
+	AttesterSlashingGindexAttestation1 = 2
+	AttesterSlashingGindexAttestation2 = 3
+)
+
+// AttesterSlashingGindex returns the generalized index of a field path in the AttesterSlashing object
+func AttesterSlashingGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "attestation_1":
+		return ssz.FieldGindex(AttesterSlashingGindexAttestation1, rest, IndexedAttestationGindex)
+	case "attestation_2":
+		return ssz.FieldGindex(AttesterSlashingGindexAttestation2, rest, IndexedAttestationGindex)
+	}
+	return 0, ssz.ErrUnknownFieldFn("AttesterSlashing", field)
+}
+
+// ProveField returns a proof of the field path in the AttesterSlashing object
+func (a *AttesterSlashing) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(a, AttesterSlashingGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the AttesterSlashing object
+func (a *AttesterSlashing) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(a, AttesterSlashingGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the BeaconBlock object
 func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
@@ -2115,6 +4289,58 @@ func (b *BeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the BeaconBlock object to a writer
+func (b *BeaconBlock) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := b.fixedSize()
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, b.Slot)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalValue(dst, b.ProposerIndex)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'ParentRoot'
+	if size := uint64(len(b.ParentRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlock.ParentRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.ParentRoot...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'StateRoot'
+	if size := uint64(len(b.StateRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlock.StateRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.StateRoot...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (4) 'Body'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (4) 'Body'
+	if dst, err = ww.WriteObject(dst, b.Body); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the BeaconBlock object
 func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(b, buf)
@@ -2157,6 +4383,47 @@ func (b *BeaconBlock) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the BeaconBlock object from a reader with the size of the encoding
+func (b *BeaconBlock) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o4 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Slot'
+		b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (1) 'ProposerIndex'
+		b.ProposerIndex, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (2) 'ParentRoot'
+		b.ParentRoot, buf = ssz.UnmarshalBytes(b.ParentRoot, buf, 32)
+
+		// Field (3) 'StateRoot'
+		b.StateRoot, buf = ssz.UnmarshalBytes(b.StateRoot, buf, 32)
+
+		// Offset (4) 'Body'
+		if o4, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (4) 'Body'
+	if err = ssz.ReadField(rr, &b.Body, size-int(o4)); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the BeaconBlock object
 func (b *BeaconBlock) fixedSize() int {
 	return int(84)
@@ -2180,6 +4447,11 @@ func (b *BeaconBlock) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
+// HashTreeRootCtx ssz hashes the BeaconBlock object and stops with the error of the context once it is done
+func (b *BeaconBlock) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
+}
+
 // HashTreeRootWith ssz hashes the BeaconBlock object with a hasher
 func (b *BeaconBlock) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -2218,6 +4490,51 @@ func (b *BeaconBlock) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
+// GetTreeCtx ssz hashes the BeaconBlock object and stops with the error of the context once it is done
+func (b *BeaconBlock) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
+}
+
+// Generalized indices of the BeaconBlock fields
+const (
+	BeaconBlockGindexSlot          = 8
+	BeaconBlockGindexProposerIndex = 9
+	BeaconBlockGindexParentRoot    = 10
+	BeaconBlockGindexStateRoot     = 11
+	BeaconBlockGindexBody          = 12
+)
+
+// BeaconBlockGindex returns the generalized index of a field path in the BeaconBlock object
+func BeaconBlockGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "slot":
+		return ssz.FieldGindex(BeaconBlockGindexSlot, rest, nil)
+	case "proposer_index":
+		return ssz.FieldGindex(BeaconBlockGindexProposerIndex, rest, nil)
+	case "parent_root":
+		return ssz.FieldGindex(BeaconBlockGindexParentRoot, rest, nil)
+	case "state_root":
+		return ssz.FieldGindex(BeaconBlockGindexStateRoot, rest, nil)
+	case "body":
+		return ssz.FieldGindex(BeaconBlockGindexBody, rest, BeaconBlockBodyPhase0Gindex)
+	}
+	return 0, ssz.ErrUnknownFieldFn("BeaconBlock", field)
+}
+
+// ProveField returns a proof of the field path in the BeaconBlock object
+func (b *BeaconBlock) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BeaconBlockGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the BeaconBlock object
+func (b *BeaconBlock) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BeaconBlockGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the SignedBeaconBlock object
 func (s *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
@@ -2246,6 +4563,36 @@ func (s *SignedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the SignedBeaconBlock object to a writer
+func (s *SignedBeaconBlock) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := s.fixedSize()
+
+	// Offset (0) 'Block'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Signature'
+	if size := uint64(len(s.Signature)); size != 96 {
+		err = ssz.ErrBytesLengthFn("SignedBeaconBlock.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (0) 'Block'
+	if dst, err = ww.WriteObject(dst, s.Block); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the SignedBeaconBlock object
 func (s *SignedBeaconBlock) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(s, buf)
@@ -2279,6 +4626,38 @@ func (s *SignedBeaconBlock) UnmarshalSSZTail(buf []byte) (rest []byte, err error
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the SignedBeaconBlock object from a reader with the size of the encoding
+func (s *SignedBeaconBlock) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := s.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'Block'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (1) 'Signature'
+		s.Signature, buf = ssz.UnmarshalBytes(s.Signature, buf, 96)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'Block'
+	if err = ssz.ReadField(rr, &s.Block, size-int(o0)); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the SignedBeaconBlock object
 func (s *SignedBeaconBlock) fixedSize() int {
 	return int(100)
@@ -2302,6 +4681,11 @@ func (s *SignedBeaconBlock) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
 }
 
+// HashTreeRootCtx ssz hashes the SignedBeaconBlock object and stops with the error of the context once it is done
+func (s *SignedBeaconBlock) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, s)
+}
+
 // HashTreeRootWith ssz hashes the SignedBeaconBlock object with a hasher
 func (s *SignedBeaconBlock) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -2327,13 +4711,49 @@ func (s *SignedBeaconBlock) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
-// MarshalSSZ ssz marshals the Transfer object
-func (t *Transfer) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(t)
+// GetTreeCtx ssz hashes the SignedBeaconBlock object and stops with the error of the context once it is done
+func (s *SignedBeaconBlock) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, s)
 }
 
-// MarshalSSZTo ssz marshals the Transfer object to a target array
-func (t *Transfer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// Generalized indices of the SignedBeaconBlock fields
+const (
+	SignedBeaconBlockGindexBlock     = 2
+	SignedBeaconBlockGindexSignature = 3
+)
+
+// SignedBeaconBlockGindex returns the generalized index of a field path in the SignedBeaconBlock object
+func SignedBeaconBlockGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "message":
+		return ssz.FieldGindex(SignedBeaconBlockGindexBlock, rest, BeaconBlockGindex)
+	case "signature":
+		return ssz.FieldGindex(SignedBeaconBlockGindexSignature, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("SignedBeaconBlock", field)
+}
+
+// ProveField returns a proof of the field path in the SignedBeaconBlock object
+func (s *SignedBeaconBlock) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(s, SignedBeaconBlockGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the SignedBeaconBlock object
+func (s *SignedBeaconBlock) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(s, SignedBeaconBlockGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the Transfer object
+func (t *Transfer) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(t)
+}
+
+// MarshalSSZTo ssz marshals the Transfer object to a target array
+func (t *Transfer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 
 	// Field (0) 'Sender'
@@ -2368,6 +4788,67 @@ func (t *Transfer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Transfer object to a writer
+func (t *Transfer) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Sender'
+	dst = ssz.MarshalValue(dst, t.Sender)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Recipient'
+	dst = ssz.MarshalValue(dst, t.Recipient)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalValue(dst, t.Amount)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'Fee'
+	dst = ssz.MarshalValue(dst, t.Fee)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'Slot'
+	dst = ssz.MarshalValue(dst, t.Slot)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'Pubkey'
+	if size := uint64(len(t.Pubkey)); size != 48 {
+		err = ssz.ErrBytesLengthFn("Transfer.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, t.Pubkey...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (6) 'Signature'
+	if size := uint64(len(t.Signature)); size != 96 {
+		err = ssz.ErrBytesLengthFn("Transfer.Signature", size, 96)
+		return
+	}
+	dst = append(dst, t.Signature...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Transfer object
 func (t *Transfer) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(t, buf)
@@ -2405,6 +4886,44 @@ func (t *Transfer) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Transfer object from a reader with the size of the encoding
+func (t *Transfer) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := t.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Sender'
+		t.Sender, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (1) 'Recipient'
+		t.Recipient, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (2) 'Amount'
+		t.Amount, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (3) 'Fee'
+		t.Fee, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (4) 'Slot'
+		t.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (5) 'Pubkey'
+		t.Pubkey, buf = ssz.UnmarshalBytes(t.Pubkey, buf, 48)
+
+		// Field (6) 'Signature'
+		t.Signature, buf = ssz.UnmarshalBytes(t.Signature, buf, 96)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Transfer object
 func (t *Transfer) fixedSize() int {
 	return int(184)
@@ -2421,6 +4940,11 @@ func (t *Transfer) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(t)
 }
 
+// HashTreeRootCtx ssz hashes the Transfer object and stops with the error of the context once it is done
+func (t *Transfer) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, t)
+}
+
 // HashTreeRootWith ssz hashes the Transfer object with a hasher
 func (t *Transfer) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -2463,6 +4987,57 @@ func (t *Transfer) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(t)
 }
 
+// GetTreeCtx ssz hashes the Transfer object and stops with the error of the context once it is done
+func (t *Transfer) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, t)
+}
+
+// Generalized indices of the Transfer fields
+const (
+	TransferGindexSender    = 8
+	TransferGindexRecipient = 9
+	TransferGindexAmount    = 10
+	TransferGindexFee       = 11
+	TransferGindexSlot      = 12
+	TransferGindexPubkey    = 13
+	TransferGindexSignature = 14
+)
+
+// TransferGindex returns the generalized index of a field path in the Transfer object
+func TransferGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "sender":
+		return ssz.FieldGindex(TransferGindexSender, rest, nil)
+	case "recipient":
+		return ssz.FieldGindex(TransferGindexRecipient, rest, nil)
+	case "amount":
+		return ssz.FieldGindex(TransferGindexAmount, rest, nil)
+	case "fee":
+		return ssz.FieldGindex(TransferGindexFee, rest, nil)
+	case "slot":
+		return ssz.FieldGindex(TransferGindexSlot, rest, nil)
+	case "pubkey":
+		return ssz.FieldGindex(TransferGindexPubkey, rest, nil)
+	case "signature":
+		return ssz.FieldGindex(TransferGindexSignature, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Transfer", field)
+}
+
+// ProveField returns a proof of the field path in the Transfer object
+func (t *Transfer) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(t, TransferGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Transfer object
+func (t *Transfer) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(t, TransferGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the BeaconState object
 func (b *BeaconState) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
@@ -2701,815 +5276,1065 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconState object
-func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(b, buf)
-}
-
-// UnmarshalSSZTail unmarshals the BeaconState object and returns the remaining bufferº
-func (b *BeaconState) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
-	fixedSize := b.fixedSize()
-	if size < fixedSize {
-		return nil, ssz.ErrSize
-	}
-
-	tail := buf
-	var o7, o9, o11, o12, o15, o16 uint64
-	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+// MarshalSSZWriter ssz marshals the BeaconState object to a writer
+func (b *BeaconState) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := b.fixedSize()
 
 	// Field (0) 'GenesisTime'
-	b.GenesisTime, buf = ssz.UnmarshallValue[uint64](buf)
+	dst = ssz.MarshalValue(dst, b.GenesisTime)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (1) 'GenesisValidatorsRoot'
-	b.GenesisValidatorsRoot, buf = ssz.UnmarshalBytes(b.GenesisValidatorsRoot, buf, 32)
+	if size := uint64(len(b.GenesisValidatorsRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconState.GenesisValidatorsRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.GenesisValidatorsRoot...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (2) 'Slot'
-	b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+	dst = ssz.MarshalValue(dst, b.Slot)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (3) 'Fork'
-	if buf, err = ssz.UnmarshalFieldTail(&b.Fork, buf); err != nil {
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if dst, err = ww.WriteObject(dst, b.Fork); err != nil {
 		return
 	}
 
 	// Field (4) 'LatestBlockHeader'
-	if buf, err = ssz.UnmarshalFieldTail(&b.LatestBlockHeader, buf); err != nil {
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if dst, err = ww.WriteObject(dst, b.LatestBlockHeader); err != nil {
 		return
 	}
 
 	// Field (5) 'BlockRoots'
-	b.BlockRoots = make([][]byte, rootsSize)
-	for ii := uint64(0); ii < rootsSize; ii++ {
-		b.BlockRoots[ii], buf = ssz.UnmarshalBytes(b.BlockRoots[ii], buf, 32)
+	if size := uint64(len(b.BlockRoots)); size != rootsSize {
+		err = ssz.ErrVectorLengthFn("BeaconState.BlockRoots", size, rootsSize)
+		return
+	}
+	for ii := 0; ii < len(b.BlockRoots); ii++ {
+		if size := uint64(len(b.BlockRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconState.BlockRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.BlockRoots[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (6) 'StateRoots'
-	b.StateRoots = make([][]byte, rootsSize)
-	for ii := uint64(0); ii < rootsSize; ii++ {
-		b.StateRoots[ii], buf = ssz.UnmarshalBytes(b.StateRoots[ii], buf, 32)
+	if size := uint64(len(b.StateRoots)); size != rootsSize {
+		err = ssz.ErrVectorLengthFn("BeaconState.StateRoots", size, rootsSize)
+		return
+	}
+	for ii := 0; ii < len(b.StateRoots); ii++ {
+		if size := uint64(len(b.StateRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconState.StateRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.StateRoots[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Offset (7) 'HistoricalRoots'
-	if o7, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.HistoricalRoots) * 32
 
 	// Field (8) 'Eth1Data'
-	if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = ww.WriteObject(dst, b.Eth1Data); err != nil {
 		return
 	}
 
 	// Offset (9) 'Eth1DataVotes'
-	if o9, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Eth1DataVotes) * 72
 
 	// Field (10) 'Eth1DepositIndex'
-	b.Eth1DepositIndex, buf = ssz.UnmarshallValue[uint64](buf)
+	dst = ssz.MarshalValue(dst, b.Eth1DepositIndex)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Offset (11) 'Validators'
-	if o11, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Validators) * 121
 
 	// Offset (12) 'Balances'
-	if o12, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Balances) * 8
 
 	// Field (13) 'RandaoMixes'
-	b.RandaoMixes = make([][]byte, randaoMixes)
-	for ii := uint64(0); ii < randaoMixes; ii++ {
-		b.RandaoMixes[ii], buf = ssz.UnmarshalBytes(b.RandaoMixes[ii], buf, 32)
+	if size := uint64(len(b.RandaoMixes)); size != randaoMixes {
+		err = ssz.ErrVectorLengthFn("BeaconState.RandaoMixes", size, randaoMixes)
+		return
+	}
+	for ii := 0; ii < len(b.RandaoMixes); ii++ {
+		if size := uint64(len(b.RandaoMixes[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconState.RandaoMixes[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.RandaoMixes[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (14) 'Slashings'
-	b.Slashings = ssz.Extend(b.Slashings, slashings)
-	for ii := uint64(0); ii < slashings; ii++ {
-		b.Slashings[ii], buf = ssz.UnmarshallValue[uint64](buf)
+	if size := uint64(len(b.Slashings)); size != slashings {
+		err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, slashings)
+		return
+	}
+	for ii := 0; ii < len(b.Slashings); ii++ {
+		dst = ssz.MarshalValue(dst, b.Slashings[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Offset (15) 'PreviousEpochAttestations'
-	if o15, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+		offset += 4
+		offset += b.PreviousEpochAttestations[ii].SizeSSZ()
 	}
 
 	// Offset (16) 'CurrentEpochAttestations'
-	if o16, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
 
 	// Field (17) 'JustificationBits'
-	b.JustificationBits, buf = ssz.UnmarshalBytes(b.JustificationBits, buf, 1)
+	if size := uint64(len(b.JustificationBits)); size != 1 {
+		err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
+		return
+	}
+	dst = append(dst, b.JustificationBits...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (18) 'PreviousJustifiedCheckpoint'
-	if buf, err = ssz.UnmarshalFieldTail(&b.PreviousJustifiedCheckpoint, buf); err != nil {
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = ww.WriteObject(dst, b.PreviousJustifiedCheckpoint); err != nil {
 		return
 	}
 
 	// Field (19) 'CurrentJustifiedCheckpoint'
-	if buf, err = ssz.UnmarshalFieldTail(&b.CurrentJustifiedCheckpoint, buf); err != nil {
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = ww.WriteObject(dst, b.CurrentJustifiedCheckpoint); err != nil {
 		return
 	}
 
 	// Field (20) 'FinalizedCheckpoint'
-	if buf, err = ssz.UnmarshalFieldTail(&b.FinalizedCheckpoint, buf); err != nil {
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = ww.WriteObject(dst, b.FinalizedCheckpoint); err != nil {
 		return
 	}
 
 	// Field (7) 'HistoricalRoots'
-	if err = ssz.UnmarshalSliceWithIndexCallback(&b.HistoricalRoots, tail[o7:o9], 32, 16777216, func(ii uint64, buf []byte) (err error) {
-		b.HistoricalRoots[ii], buf = ssz.UnmarshalBytes(b.HistoricalRoots[ii], buf, 32)
-		return nil
-	}); err != nil {
-		return nil, err
+	if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
+		err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+		return
 	}
-
+	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+		if size := uint64(len(b.HistoricalRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconState.HistoricalRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.HistoricalRoots[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
 	// Field (9) 'Eth1DataVotes'
-	if err = ssz.UnmarshalSliceSSZ(&b.Eth1DataVotes, tail[o9:o11], eth1DataVotes); err != nil {
-		return nil, err
+	if size := uint64(len(b.Eth1DataVotes)); size > eth1DataVotes {
+		err = ssz.ErrListTooBigFn("BeaconState.Eth1DataVotes", size, eth1DataVotes)
+		return
+	}
+	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+		if dst, err = ww.WriteObject(dst, b.Eth1DataVotes[ii]); err != nil {
+			return
+		}
 	}
 
 	// Field (11) 'Validators'
-	if err = ssz.UnmarshalSliceSSZ(&b.Validators, tail[o11:o12], 1099511627776); err != nil {
-		return nil, err
+	if size := uint64(len(b.Validators)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconState.Validators", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Validators); ii++ {
+		if dst, err = ww.WriteObject(dst, b.Validators[ii]); err != nil {
+			return
+		}
 	}
 
 	// Field (12) 'Balances'
-	if err = ssz.UnmarshalSliceWithIndexCallback(&b.Balances, tail[o12:o15], 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
-		b.Balances[ii], buf = ssz.UnmarshallValue[uint64](buf)
-		return nil
-	}); err != nil {
-		return nil, err
+	if size := uint64(len(b.Balances)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Balances); ii++ {
+		dst = ssz.MarshalValue(dst, b.Balances[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (15) 'PreviousEpochAttestations'
-	if err = ssz.UnmarshalDynamicSliceSSZ(&b.PreviousEpochAttestations, tail[o15:o16], epochAttestations); err != nil {
-		return nil, err
+	if size := uint64(len(b.PreviousEpochAttestations)); size > epochAttestations {
+		err = ssz.ErrListTooBigFn("BeaconState.PreviousEpochAttestations", size, epochAttestations)
+		return
 	}
-
-	// Field (16) 'CurrentEpochAttestations'
-	if err = ssz.UnmarshalDynamicSliceSSZ(&b.CurrentEpochAttestations, tail[o16:], epochAttestations); err != nil {
-		return nil, err
+	{
+		offset = 4 * len(b.PreviousEpochAttestations)
+		for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.PreviousEpochAttestations[ii].SizeSSZ()
+		}
 	}
-
-	return
-}
-
-// fixedSize returns the fixed size of the BeaconState object
-func (b *BeaconState) fixedSize() int {
-	return int((401 + (rootsSize * 32) + (rootsSize * 32) + (randaoMixes * 32) + (slashings * 8)))
-}
-
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
-func (b *BeaconState) SizeSSZ() (size int) {
-	size = b.fixedSize()
-
-	// Field (7) 'HistoricalRoots'
-	size += len(b.HistoricalRoots) * 32
-
-	// Field (9) 'Eth1DataVotes'
-	size += len(b.Eth1DataVotes) * 72
-
-	// Field (11) 'Validators'
-	size += len(b.Validators) * 121
-
-	// Field (12) 'Balances'
-	size += len(b.Balances) * 8
-
-	// Field (15) 'PreviousEpochAttestations'
 	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
-		size += 4
-		size += b.PreviousEpochAttestations[ii].SizeSSZ()
+		if dst, err = ww.WriteObject(dst, b.PreviousEpochAttestations[ii]); err != nil {
+			return
+		}
 	}
 
 	// Field (16) 'CurrentEpochAttestations'
+	if size := uint64(len(b.CurrentEpochAttestations)); size > epochAttestations {
+		err = ssz.ErrListTooBigFn("BeaconState.CurrentEpochAttestations", size, epochAttestations)
+		return
+	}
+	{
+		offset = 4 * len(b.CurrentEpochAttestations)
+		for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.CurrentEpochAttestations[ii].SizeSSZ()
+		}
+	}
 	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
-		size += 4
-		size += b.CurrentEpochAttestations[ii].SizeSSZ()
+		if dst, err = ww.WriteObject(dst, b.CurrentEpochAttestations[ii]); err != nil {
+			return
+		}
 	}
 
-	return
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
 }
 
-// HashTreeRoot ssz hashes the BeaconState object
-func (b *BeaconState) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// UnmarshalSSZ ssz unmarshals the BeaconState object
+func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(b, buf)
 }
 
-// HashTreeRootWith ssz hashes the BeaconState object with a hasher
-func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// UnmarshalSSZTail unmarshals the BeaconState object and returns the remaining bufferº
+func (b *BeaconState) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o7, o9, o11, o12, o15, o16 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
 
 	// Field (0) 'GenesisTime'
-	hh.PutUint64(b.GenesisTime)
+	b.GenesisTime, buf = ssz.UnmarshallValue[uint64](buf)
 
 	// Field (1) 'GenesisValidatorsRoot'
-	if size := uint64(len(b.GenesisValidatorsRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconState.GenesisValidatorsRoot", size, 32)
-		return
-	}
-	hh.PutBytes(b.GenesisValidatorsRoot)
+	b.GenesisValidatorsRoot, buf = ssz.UnmarshalBytes(b.GenesisValidatorsRoot, buf, 32)
 
 	// Field (2) 'Slot'
-	hh.PutUint64(b.Slot)
+	b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
 
 	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if err = b.Fork.HashTreeRootWith(hh); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&b.Fork, buf); err != nil {
 		return
 	}
 
 	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&b.LatestBlockHeader, buf); err != nil {
 		return
 	}
 
 	// Field (5) 'BlockRoots'
-	{
-		if size := uint64(len(b.BlockRoots)); size != rootsSize {
-			err = ssz.ErrVectorLengthFn("BeaconState.BlockRoots", size, rootsSize)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.BlockRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
+	b.BlockRoots = make([][]byte, rootsSize)
+	for ii := uint64(0); ii < rootsSize; ii++ {
+		b.BlockRoots[ii], buf = ssz.UnmarshalBytes(b.BlockRoots[ii], buf, 32)
 	}
 
 	// Field (6) 'StateRoots'
-	{
-		if size := uint64(len(b.StateRoots)); size != rootsSize {
-			err = ssz.ErrVectorLengthFn("BeaconState.StateRoots", size, rootsSize)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.StateRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
+	b.StateRoots = make([][]byte, rootsSize)
+	for ii := uint64(0); ii < rootsSize; ii++ {
+		b.StateRoots[ii], buf = ssz.UnmarshalBytes(b.StateRoots[ii], buf, 32)
 	}
 
-	// Field (7) 'HistoricalRoots'
-	{
-		if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
-			err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.HistoricalRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		numItems := uint64(len(b.HistoricalRoots))
-		hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
+	// Offset (7) 'HistoricalRoots'
+	if o7, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
 	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
 		return
 	}
 
-	// Field (9) 'Eth1DataVotes'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Eth1DataVotes))
-		if num > eth1DataVotes {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Eth1DataVotes {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, eth1DataVotes)
+	// Offset (9) 'Eth1DataVotes'
+	if o9, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
 	// Field (10) 'Eth1DepositIndex'
-	hh.PutUint64(b.Eth1DepositIndex)
+	b.Eth1DepositIndex, buf = ssz.UnmarshallValue[uint64](buf)
 
-	// Field (11) 'Validators'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Validators))
-		if num > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Validators {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+	// Offset (11) 'Validators'
+	if o11, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
-	// Field (12) 'Balances'
-	{
-		if size := uint64(len(b.Balances)); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Balances {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.Balances))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	// Offset (12) 'Balances'
+	if o12, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
 	// Field (13) 'RandaoMixes'
-	{
-		if size := uint64(len(b.RandaoMixes)); size != randaoMixes {
-			err = ssz.ErrVectorLengthFn("BeaconState.RandaoMixes", size, randaoMixes)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.RandaoMixes {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
+	b.RandaoMixes = make([][]byte, randaoMixes)
+	for ii := uint64(0); ii < randaoMixes; ii++ {
+		b.RandaoMixes[ii], buf = ssz.UnmarshalBytes(b.RandaoMixes[ii], buf, 32)
 	}
 
 	// Field (14) 'Slashings'
-	{
-		if size := uint64(len(b.Slashings)); size != slashings {
-			err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, slashings)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Slashings {
-			hh.AppendUint64(i)
-		}
-		hh.Merkleize(subIndx)
+	b.Slashings = ssz.Extend(b.Slashings, slashings)
+	for ii := uint64(0); ii < slashings; ii++ {
+		b.Slashings[ii], buf = ssz.UnmarshallValue[uint64](buf)
 	}
 
-	// Field (15) 'PreviousEpochAttestations'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.PreviousEpochAttestations))
-		if num > epochAttestations {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.PreviousEpochAttestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, epochAttestations)
+	// Offset (15) 'PreviousEpochAttestations'
+	if o15, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
-	// Field (16) 'CurrentEpochAttestations'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.CurrentEpochAttestations))
-		if num > epochAttestations {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.CurrentEpochAttestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, epochAttestations)
+	// Offset (16) 'CurrentEpochAttestations'
+	if o16, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
 	// Field (17) 'JustificationBits'
-	if size := uint64(len(b.JustificationBits)); size != 1 {
-		err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
-		return
-	}
-	hh.PutBytes(b.JustificationBits)
+	b.JustificationBits, buf = ssz.UnmarshalBytes(b.JustificationBits, buf, 1)
 
 	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&b.PreviousJustifiedCheckpoint, buf); err != nil {
 		return
 	}
 
 	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&b.CurrentJustifiedCheckpoint, buf); err != nil {
 		return
 	}
 
 	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&b.FinalizedCheckpoint, buf); err != nil {
 		return
 	}
 
-	hh.Merkleize(indx)
-	return
-}
+	// Field (7) 'HistoricalRoots'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.HistoricalRoots, tail[o7:o9], 32, 16777216, func(ii uint64, buf []byte) (err error) {
+		b.HistoricalRoots[ii], buf = ssz.UnmarshalBytes(b.HistoricalRoots[ii], buf, 32)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
 
-// GetTree ssz hashes the BeaconState object
-func (b *BeaconState) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
-}
+	// Field (9) 'Eth1DataVotes'
+	if err = ssz.UnmarshalSliceSSZ(&b.Eth1DataVotes, tail[o9:o11], eth1DataVotes); err != nil {
+		return nil, err
+	}
 
-// MarshalSSZ ssz marshals the BeaconBlockBodyPhase0 object
-func (b *BeaconBlockBodyPhase0) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
+	// Field (11) 'Validators'
+	if err = ssz.UnmarshalSliceSSZ(&b.Validators, tail[o11:o12], 1099511627776); err != nil {
+		return nil, err
+	}
 
-// MarshalSSZTo ssz marshals the BeaconBlockBodyPhase0 object to a target array
-func (b *BeaconBlockBodyPhase0) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := b.fixedSize()
+	// Field (12) 'Balances'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.Balances, tail[o12:o15], 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+		b.Balances[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
 
-	// Field (0) 'RandaoReveal'
-	if size := uint64(len(b.RandaoReveal)); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyPhase0.RandaoReveal", size, 96)
-		return
+	// Field (15) 'PreviousEpochAttestations'
+	if err = ssz.UnmarshalDynamicSliceSSZ(&b.PreviousEpochAttestations, tail[o15:o16], epochAttestations); err != nil {
+		return nil, err
 	}
-	dst = append(dst, b.RandaoReveal...)
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
+	// Field (16) 'CurrentEpochAttestations'
+	if err = ssz.UnmarshalDynamicSliceSSZ(&b.CurrentEpochAttestations, tail[o16:], epochAttestations); err != nil {
+		return nil, err
 	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the BeaconState object from a reader with the size of the encoding
+func (b *BeaconState) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
 	}
 
-	// Field (2) 'Graffiti'
-	dst = append(dst, b.Graffiti[:]...)
+	var o7, o9, o11, o12, o15, o16 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
 
-	// Offset (3) 'ProposerSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ProposerSlashings) * 416
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'GenesisTime'
+		b.GenesisTime, buf = ssz.UnmarshallValue[uint64](buf)
 
-	// Offset (4) 'AttesterSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		offset += 4
-		offset += b.AttesterSlashings[ii].SizeSSZ()
-	}
+		// Field (1) 'GenesisValidatorsRoot'
+		b.GenesisValidatorsRoot, buf = ssz.UnmarshalBytes(b.GenesisValidatorsRoot, buf, 32)
 
-	// Offset (5) 'Attestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		offset += 4
-		offset += b.Attestations[ii].SizeSSZ()
-	}
+		// Field (2) 'Slot'
+		b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
 
-	// Offset (6) 'Deposits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Deposits) * 1240
+		// Field (3) 'Fork'
+		if buf, err = ssz.UnmarshalFieldTail(&b.Fork, buf); err != nil {
+			return
+		}
 
-	// Offset (7) 'VoluntaryExits'
-	dst = ssz.WriteOffset(dst, offset)
+		// Field (4) 'LatestBlockHeader'
+		if buf, err = ssz.UnmarshalFieldTail(&b.LatestBlockHeader, buf); err != nil {
+			return
+		}
 
-	// Field (3) 'ProposerSlashings'
-	if size := uint64(len(b.ProposerSlashings)); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.ProposerSlashings", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
-		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+		// Field (5) 'BlockRoots'
+		b.BlockRoots = make([][]byte, rootsSize)
+		for ii := uint64(0); ii < rootsSize; ii++ {
+			b.BlockRoots[ii], buf = ssz.UnmarshalBytes(b.BlockRoots[ii], buf, 32)
+		}
+
+		// Field (6) 'StateRoots'
+		b.StateRoots = make([][]byte, rootsSize)
+		for ii := uint64(0); ii < rootsSize; ii++ {
+			b.StateRoots[ii], buf = ssz.UnmarshalBytes(b.StateRoots[ii], buf, 32)
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		if o7, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (8) 'Eth1Data'
+		if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
 			return
 		}
-	}
 
-	// Field (4) 'AttesterSlashings'
-	if size := uint64(len(b.AttesterSlashings)); size > 2 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.AttesterSlashings", size, 2)
-		return
-	}
-	{
-		offset = 4 * len(b.AttesterSlashings)
-		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.AttesterSlashings[ii].SizeSSZ()
+		// Offset (9) 'Eth1DataVotes'
+		if o9, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
 		}
-	}
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+		// Field (10) 'Eth1DepositIndex'
+		b.Eth1DepositIndex, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (11) 'Validators'
+		if o11, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (12) 'Balances'
+		if o12, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (13) 'RandaoMixes'
+		b.RandaoMixes = make([][]byte, randaoMixes)
+		for ii := uint64(0); ii < randaoMixes; ii++ {
+			b.RandaoMixes[ii], buf = ssz.UnmarshalBytes(b.RandaoMixes[ii], buf, 32)
+		}
+
+		// Field (14) 'Slashings'
+		b.Slashings = ssz.Extend(b.Slashings, slashings)
+		for ii := uint64(0); ii < slashings; ii++ {
+			b.Slashings[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		}
+
+		// Offset (15) 'PreviousEpochAttestations'
+		if o15, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (16) 'CurrentEpochAttestations'
+		if o16, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (17) 'JustificationBits'
+		b.JustificationBits, buf = ssz.UnmarshalBytes(b.JustificationBits, buf, 1)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if buf, err = ssz.UnmarshalFieldTail(&b.PreviousJustifiedCheckpoint, buf); err != nil {
 			return
 		}
-	}
 
-	// Field (5) 'Attestations'
-	if size := uint64(len(b.Attestations)); size > 128 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.Attestations", size, 128)
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if buf, err = ssz.UnmarshalFieldTail(&b.CurrentJustifiedCheckpoint, buf); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if buf, err = ssz.UnmarshalFieldTail(&b.FinalizedCheckpoint, buf); err != nil {
+			return
+		}
+
+		return buf, nil
+	}); err != nil {
 		return
 	}
-	{
-		offset = 4 * len(b.Attestations)
-		for ii := 0; ii < len(b.Attestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.Attestations[ii].SizeSSZ()
+
+	// Field (7) 'HistoricalRoots'
+	if o9-o7 > 536870912 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o9-o7), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&b.HistoricalRoots, buf, 32, 16777216, func(ii uint64, buf []byte) (err error) {
+			b.HistoricalRoots[ii], buf = ssz.UnmarshalBytes(b.HistoricalRoots[ii], buf, 32)
+			return nil
+		}); err != nil {
+			return nil, err
 		}
+		return buf, nil
+	}); err != nil {
+		return
 	}
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+
+	// Field (9) 'Eth1DataVotes'
+	if err = ssz.ReadSliceSSZ(rr, &b.Eth1DataVotes, int(o11-o9), eth1DataVotes); err != nil {
+		return
 	}
 
-	// Field (6) 'Deposits'
-	if size := uint64(len(b.Deposits)); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.Deposits", size, 16)
+	// Field (11) 'Validators'
+	if err = ssz.ReadSliceSSZ(rr, &b.Validators, int(o12-o11), 1099511627776); err != nil {
 		return
 	}
-	for ii := 0; ii < len(b.Deposits); ii++ {
-		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
-			return
+
+	// Field (12) 'Balances'
+	if o15-o12 > 8796093022208 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o15-o12), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&b.Balances, buf, 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+			b.Balances[ii], buf = ssz.UnmarshallValue[uint64](buf)
+			return nil
+		}); err != nil {
+			return nil, err
 		}
+		return buf, nil
+	}); err != nil {
+		return
 	}
 
-	// Field (7) 'VoluntaryExits'
-	if size := uint64(len(b.VoluntaryExits)); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.VoluntaryExits", size, 16)
+	// Field (15) 'PreviousEpochAttestations'
+	if err = ssz.ReadDynamicSliceSSZ(rr, &b.PreviousEpochAttestations, int(o16-o15), epochAttestations); err != nil {
 		return
 	}
-	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
-		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+
+	// Field (16) 'CurrentEpochAttestations'
+	if err = ssz.ReadDynamicSliceSSZ(rr, &b.CurrentEpochAttestations, size-int(o16), epochAttestations); err != nil {
+		return
 	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyPhase0 object
-func (b *BeaconBlockBodyPhase0) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(b, buf)
+// fixedSize returns the fixed size of the BeaconState object
+func (b *BeaconState) fixedSize() int {
+	return int((401 + (rootsSize * 32) + (rootsSize * 32) + (randaoMixes * 32) + (slashings * 8)))
 }
 
-// UnmarshalSSZTail unmarshals the BeaconBlockBodyPhase0 object and returns the remaining bufferº
-func (b *BeaconBlockBodyPhase0) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
-	fixedSize := b.fixedSize()
-	if size < fixedSize {
-		return nil, ssz.ErrSize
-	}
-
-	tail := buf
-	var o3, o4, o5, o6, o7 uint64
-	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
-
-	// Field (0) 'RandaoReveal'
-	b.RandaoReveal, buf = ssz.UnmarshalBytes(b.RandaoReveal, buf, 96)
-
-	// Field (1) 'Eth1Data'
-	if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
-		return
-	}
-
-	// Field (2) 'Graffiti'
-	buf = ssz.UnmarshalFixedBytes(b.Graffiti[:], buf)
-
-	// Offset (3) 'ProposerSlashings'
-	if o3, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
-
-	// Offset (4) 'AttesterSlashings'
-	if o4, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
-
-	// Offset (5) 'Attestations'
-	if o5, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
-
-	// Offset (6) 'Deposits'
-	if o6, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
-
-	// Offset (7) 'VoluntaryExits'
-	if o7, _, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
-
-	// Field (3) 'ProposerSlashings'
-	if err = ssz.UnmarshalSliceSSZ(&b.ProposerSlashings, tail[o3:o4], 16); err != nil {
-		return nil, err
-	}
-
-	// Field (4) 'AttesterSlashings'
-	if err = ssz.UnmarshalDynamicSliceSSZ(&b.AttesterSlashings, tail[o4:o5], 2); err != nil {
-		return nil, err
-	}
-
-	// Field (5) 'Attestations'
-	if err = ssz.UnmarshalDynamicSliceSSZ(&b.Attestations, tail[o5:o6], 128); err != nil {
-		return nil, err
-	}
-
-	// Field (6) 'Deposits'
-	if err = ssz.UnmarshalSliceSSZ(&b.Deposits, tail[o6:o7], 16); err != nil {
-		return nil, err
-	}
-
-	// Field (7) 'VoluntaryExits'
-	if err = ssz.UnmarshalSliceSSZ(&b.VoluntaryExits, tail[o7:], 16); err != nil {
-		return nil, err
-	}
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
+func (b *BeaconState) SizeSSZ() (size int) {
+	size = b.fixedSize()
 
-	return
-}
+	// Field (7) 'HistoricalRoots'
+	size += len(b.HistoricalRoots) * 32
 
-// fixedSize returns the fixed size of the BeaconBlockBodyPhase0 object
-func (b *BeaconBlockBodyPhase0) fixedSize() int {
-	return int(220)
-}
+	// Field (9) 'Eth1DataVotes'
+	size += len(b.Eth1DataVotes) * 72
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyPhase0 object
-func (b *BeaconBlockBodyPhase0) SizeSSZ() (size int) {
-	size = b.fixedSize()
+	// Field (11) 'Validators'
+	size += len(b.Validators) * 121
 
-	// Field (3) 'ProposerSlashings'
-	size += len(b.ProposerSlashings) * 416
+	// Field (12) 'Balances'
+	size += len(b.Balances) * 8
 
-	// Field (4) 'AttesterSlashings'
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+	// Field (15) 'PreviousEpochAttestations'
+	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
 		size += 4
-		size += b.AttesterSlashings[ii].SizeSSZ()
+		size += b.PreviousEpochAttestations[ii].SizeSSZ()
 	}
 
-	// Field (5) 'Attestations'
-	for ii := 0; ii < len(b.Attestations); ii++ {
+	// Field (16) 'CurrentEpochAttestations'
+	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
 		size += 4
-		size += b.Attestations[ii].SizeSSZ()
+		size += b.CurrentEpochAttestations[ii].SizeSSZ()
 	}
 
-	// Field (6) 'Deposits'
-	size += len(b.Deposits) * 1240
-
-	// Field (7) 'VoluntaryExits'
-	size += len(b.VoluntaryExits) * 112
-
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockBodyPhase0 object
-func (b *BeaconBlockBodyPhase0) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BeaconState object
+func (b *BeaconState) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockBodyPhase0 object with a hasher
-func (b *BeaconBlockBodyPhase0) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// HashTreeRootCtx ssz hashes the BeaconState object and stops with the error of the context once it is done
+func (b *BeaconState) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconState object with a hasher
+func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'RandaoReveal'
-	if size := uint64(len(b.RandaoReveal)); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyPhase0.RandaoReveal", size, 96)
+	// Field (0) 'GenesisTime'
+	hh.PutUint64(b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := uint64(len(b.GenesisValidatorsRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconState.GenesisValidatorsRoot", size, 32)
 		return
 	}
-	hh.PutBytes(b.RandaoReveal)
+	hh.PutBytes(b.GenesisValidatorsRoot)
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
+	// Field (2) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
 	}
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+	if err = b.Fork.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (2) 'Graffiti'
-	hh.PutBytes(b.Graffiti[:])
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
 
-	// Field (3) 'ProposerSlashings'
+	// Field (5) 'BlockRoots'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.ProposerSlashings))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
+		if size := uint64(len(b.BlockRoots)); size != rootsSize {
+			err = ssz.ErrVectorLengthFn("BeaconState.BlockRoots", size, rootsSize)
 			return
 		}
-		for _, elem := range b.ProposerSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+		subIndx := hh.Index()
+		for _, i := range b.BlockRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
 				return
 			}
+			hh.Append(i)
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+		hh.Merkleize(subIndx)
 	}
 
-	// Field (4) 'AttesterSlashings'
+	// Field (6) 'StateRoots'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.AttesterSlashings))
-		if num > 2 {
-			err = ssz.ErrIncorrectListSize
+		if size := uint64(len(b.StateRoots)); size != rootsSize {
+			err = ssz.ErrVectorLengthFn("BeaconState.StateRoots", size, rootsSize)
 			return
 		}
-		for _, elem := range b.AttesterSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+		subIndx := hh.Index()
+		for _, i := range b.StateRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
 				return
 			}
+			hh.Append(i)
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 2)
+		hh.Merkleize(subIndx)
 	}
 
-	// Field (5) 'Attestations'
+	// Field (7) 'HistoricalRoots'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Attestations))
-		if num > 128 {
-			err = ssz.ErrIncorrectListSize
+		if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
+			err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
 			return
 		}
-		for _, elem := range b.Attestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+		subIndx := hh.Index()
+		for _, i := range b.HistoricalRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
 				return
 			}
+			hh.Append(i)
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 128)
+		numItems := uint64(len(b.HistoricalRoots))
+		hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
 	}
 
-	// Field (6) 'Deposits'
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (9) 'Eth1DataVotes'
 	{
 		subIndx := hh.Index()
-		num := uint64(len(b.Deposits))
-		if num > 16 {
+		num := uint64(len(b.Eth1DataVotes))
+		if num > eth1DataVotes {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.Deposits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.Eth1DataVotes); err != nil {
+			return
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+		hh.MerkleizeWithMixin(subIndx, num, eth1DataVotes)
 	}
 
-	// Field (7) 'VoluntaryExits'
+	// Field (10) 'Eth1DepositIndex'
+	hh.PutUint64(b.Eth1DepositIndex)
+
+	// Field (11) 'Validators'
 	{
 		subIndx := hh.Index()
-		num := uint64(len(b.VoluntaryExits))
-		if num > 16 {
+		num := uint64(len(b.Validators))
+		if num > 1099511627776 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.VoluntaryExits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.Validators); err != nil {
+			return
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
 	}
 
-	hh.Merkleize(indx)
-	return
-}
-
-// GetTree ssz hashes the BeaconBlockBodyPhase0 object
-func (b *BeaconBlockBodyPhase0) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
-}
-
-// MarshalSSZ ssz marshals the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
+	// Field (12) 'Balances'
+	{
+		if size := uint64(len(b.Balances)); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Balances {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.Balances))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
 
-// MarshalSSZTo ssz marshals the BeaconBlockBodyAltair object to a target array
-func (b *BeaconBlockBodyAltair) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	// Field (13) 'RandaoMixes'
+	{
+		if size := uint64(len(b.RandaoMixes)); size != randaoMixes {
+			err = ssz.ErrVectorLengthFn("BeaconState.RandaoMixes", size, randaoMixes)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.RandaoMixes {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (14) 'Slashings'
+	{
+		if size := uint64(len(b.Slashings)); size != slashings {
+			err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, slashings)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Slashings {
+			hh.AppendUint64(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (15) 'PreviousEpochAttestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.PreviousEpochAttestations))
+		if num > epochAttestations {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = ssz.PutObjects(hh, b.PreviousEpochAttestations); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, epochAttestations)
+	}
+
+	// Field (16) 'CurrentEpochAttestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.CurrentEpochAttestations))
+		if num > epochAttestations {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = ssz.PutObjects(hh, b.CurrentEpochAttestations); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, epochAttestations)
+	}
+
+	// Field (17) 'JustificationBits'
+	if size := uint64(len(b.JustificationBits)); size != 1 {
+		err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
+		return
+	}
+	hh.PutBytes(b.JustificationBits)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BeaconState object
+func (b *BeaconState) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// GetTreeCtx ssz hashes the BeaconState object and stops with the error of the context once it is done
+func (b *BeaconState) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
+}
+
+// Generalized indices of the BeaconState fields
+const (
+	BeaconStateGindexGenesisTime                 = 32
+	BeaconStateGindexGenesisValidatorsRoot       = 33
+	BeaconStateGindexSlot                        = 34
+	BeaconStateGindexFork                        = 35
+	BeaconStateGindexLatestBlockHeader           = 36
+	BeaconStateGindexBlockRoots                  = 37
+	BeaconStateGindexStateRoots                  = 38
+	BeaconStateGindexHistoricalRoots             = 39
+	BeaconStateGindexEth1Data                    = 40
+	BeaconStateGindexEth1DataVotes               = 41
+	BeaconStateGindexEth1DepositIndex            = 42
+	BeaconStateGindexValidators                  = 43
+	BeaconStateGindexBalances                    = 44
+	BeaconStateGindexRandaoMixes                 = 45
+	BeaconStateGindexSlashings                   = 46
+	BeaconStateGindexPreviousEpochAttestations   = 47
+	BeaconStateGindexCurrentEpochAttestations    = 48
+	BeaconStateGindexJustificationBits           = 49
+	BeaconStateGindexPreviousJustifiedCheckpoint = 50
+	BeaconStateGindexCurrentJustifiedCheckpoint  = 51
+	BeaconStateGindexFinalizedCheckpoint         = 52
+)
+
+// BeaconStateGindexBlockRootsElem returns the generalized index of the chunk of the i-th element of the BlockRoots field,
+// or zero if it does not fit in an int
+func BeaconStateGindexBlockRootsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateGindexBlockRoots, ssz.VectorElemGindex(i, rootsSize, 0))
+}
+
+// BeaconStateGindexStateRootsElem returns the generalized index of the chunk of the i-th element of the StateRoots field,
+// or zero if it does not fit in an int
+func BeaconStateGindexStateRootsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateGindexStateRoots, ssz.VectorElemGindex(i, rootsSize, 0))
+}
+
+// BeaconStateGindexHistoricalRootsElem returns the generalized index of the chunk of the i-th element of the HistoricalRoots field,
+// or zero if it does not fit in an int
+func BeaconStateGindexHistoricalRootsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateGindexHistoricalRoots, ssz.ListElemGindex(i, 16777216, 0))
+}
+
+// BeaconStateGindexEth1DataVotesElem returns the generalized index of the chunk of the i-th element of the Eth1DataVotes field,
+// or zero if it does not fit in an int
+func BeaconStateGindexEth1DataVotesElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateGindexEth1DataVotes, ssz.ListElemGindex(i, eth1DataVotes, 0))
+}
+
+// BeaconStateGindexValidatorsElem returns the generalized index of the chunk of the i-th element of the Validators field,
+// or zero if it does not fit in an int
+func BeaconStateGindexValidatorsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateGindexValidators, ssz.ListElemGindex(i, 1099511627776, 0))
+}
+
+// BeaconStateGindexBalancesElem returns the generalized index of the chunk of the i-th element of the Balances field,
+// or zero if it does not fit in an int
+func BeaconStateGindexBalancesElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateGindexBalances, ssz.ListElemGindex(i, 1099511627776, 8))
+}
+
+// BeaconStateGindexRandaoMixesElem returns the generalized index of the chunk of the i-th element of the RandaoMixes field,
+// or zero if it does not fit in an int
+func BeaconStateGindexRandaoMixesElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateGindexRandaoMixes, ssz.VectorElemGindex(i, randaoMixes, 0))
+}
+
+// BeaconStateGindexSlashingsElem returns the generalized index of the chunk of the i-th element of the Slashings field,
+// or zero if it does not fit in an int
+func BeaconStateGindexSlashingsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateGindexSlashings, ssz.VectorElemGindex(i, slashings, 8))
+}
+
+// BeaconStateGindexPreviousEpochAttestationsElem returns the generalized index of the chunk of the i-th element of the PreviousEpochAttestations field,
+// or zero if it does not fit in an int
+func BeaconStateGindexPreviousEpochAttestationsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateGindexPreviousEpochAttestations, ssz.ListElemGindex(i, epochAttestations, 0))
+}
+
+// BeaconStateGindexCurrentEpochAttestationsElem returns the generalized index of the chunk of the i-th element of the CurrentEpochAttestations field,
+// or zero if it does not fit in an int
+func BeaconStateGindexCurrentEpochAttestationsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateGindexCurrentEpochAttestations, ssz.ListElemGindex(i, epochAttestations, 0))
+}
+
+// BeaconStateGindex returns the generalized index of a field path in the BeaconState object
+func BeaconStateGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "genesis_time":
+		return ssz.FieldGindex(BeaconStateGindexGenesisTime, rest, nil)
+	case "genesis_validators_root":
+		return ssz.FieldGindex(BeaconStateGindexGenesisValidatorsRoot, rest, nil)
+	case "slot":
+		return ssz.FieldGindex(BeaconStateGindexSlot, rest, nil)
+	case "fork":
+		return ssz.FieldGindex(BeaconStateGindexFork, rest, ForkGindex)
+	case "latest_block_header":
+		return ssz.FieldGindex(BeaconStateGindexLatestBlockHeader, rest, BeaconBlockHeaderGindex)
+	case "block_roots":
+		return ssz.FieldGindex(BeaconStateGindexBlockRoots, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, rootsSize, 0, nil)
+		})
+	case "state_roots":
+		return ssz.FieldGindex(BeaconStateGindexStateRoots, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, rootsSize, 0, nil)
+		})
+	case "historical_roots":
+		return ssz.FieldGindex(BeaconStateGindexHistoricalRoots, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16777216, 0, nil)
+		})
+	case "eth1_data":
+		return ssz.FieldGindex(BeaconStateGindexEth1Data, rest, Eth1DataGindex)
+	case "eth1_data_votes":
+		return ssz.FieldGindex(BeaconStateGindexEth1DataVotes, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, eth1DataVotes, 0, Eth1DataGindex)
+		})
+	case "eth1_deposit_index":
+		return ssz.FieldGindex(BeaconStateGindexEth1DepositIndex, rest, nil)
+	case "validators":
+		return ssz.FieldGindex(BeaconStateGindexValidators, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1099511627776, 0, ValidatorGindex)
+		})
+	case "balances":
+		return ssz.FieldGindex(BeaconStateGindexBalances, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1099511627776, 8, nil)
+		})
+	case "randao_mixes":
+		return ssz.FieldGindex(BeaconStateGindexRandaoMixes, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, randaoMixes, 0, nil)
+		})
+	case "slashings":
+		return ssz.FieldGindex(BeaconStateGindexSlashings, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, slashings, 8, nil)
+		})
+	case "previous_epoch_attestations":
+		return ssz.FieldGindex(BeaconStateGindexPreviousEpochAttestations, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, epochAttestations, 0, PendingAttestationGindex)
+		})
+	case "current_epoch_attestations":
+		return ssz.FieldGindex(BeaconStateGindexCurrentEpochAttestations, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, epochAttestations, 0, PendingAttestationGindex)
+		})
+	case "justification_bits":
+		return ssz.FieldGindex(BeaconStateGindexJustificationBits, rest, nil)
+	case "previous_justified_checkpoint":
+		return ssz.FieldGindex(BeaconStateGindexPreviousJustifiedCheckpoint, rest, CheckpointGindex)
+	case "current_justified_checkpoint":
+		return ssz.FieldGindex(BeaconStateGindexCurrentJustifiedCheckpoint, rest, CheckpointGindex)
+	case "finalized_checkpoint":
+		return ssz.FieldGindex(BeaconStateGindexFinalizedCheckpoint, rest, CheckpointGindex)
+	}
+	return 0, ssz.ErrUnknownFieldFn("BeaconState", field)
+}
+
+// ProveField returns a proof of the field path in the BeaconState object
+func (b *BeaconState) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BeaconStateGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the BeaconState object
+func (b *BeaconState) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BeaconStateGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBodyPhase0 object
+func (b *BeaconBlockBodyPhase0) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockBodyPhase0 object to a target array
+func (b *BeaconBlockBodyPhase0) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := b.fixedSize()
 
 	// Field (0) 'RandaoReveal'
 	if size := uint64(len(b.RandaoReveal)); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyAltair.RandaoReveal", size, 96)
+		err = ssz.ErrBytesLengthFn("BeaconBlockBodyPhase0.RandaoReveal", size, 96)
 		return
 	}
 	dst = append(dst, b.RandaoReveal...)
@@ -3550,17 +6375,9 @@ func (b *BeaconBlockBodyAltair) MarshalSSZTo(buf []byte) (dst []byte, err error)
 	// Offset (7) 'VoluntaryExits'
 	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregate)
-	}
-	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
-		return
-	}
-
 	// Field (3) 'ProposerSlashings'
 	if size := uint64(len(b.ProposerSlashings)); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.ProposerSlashings", size, 16)
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.ProposerSlashings", size, 16)
 		return
 	}
 	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
@@ -3571,7 +6388,7 @@ func (b *BeaconBlockBodyAltair) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Field (4) 'AttesterSlashings'
 	if size := uint64(len(b.AttesterSlashings)); size > 2 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.AttesterSlashings", size, 2)
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.AttesterSlashings", size, 2)
 		return
 	}
 	{
@@ -3589,7 +6406,7 @@ func (b *BeaconBlockBodyAltair) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Field (5) 'Attestations'
 	if size := uint64(len(b.Attestations)); size > 128 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.Attestations", size, 128)
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.Attestations", size, 128)
 		return
 	}
 	{
@@ -3607,7 +6424,7 @@ func (b *BeaconBlockBodyAltair) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Field (6) 'Deposits'
 	if size := uint64(len(b.Deposits)); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.Deposits", size, 16)
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.Deposits", size, 16)
 		return
 	}
 	for ii := 0; ii < len(b.Deposits); ii++ {
@@ -3618,7 +6435,7 @@ func (b *BeaconBlockBodyAltair) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Field (7) 'VoluntaryExits'
 	if size := uint64(len(b.VoluntaryExits)); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.VoluntaryExits", size, 16)
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.VoluntaryExits", size, 16)
 		return
 	}
 	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
@@ -3630,13 +6447,143 @@ func (b *BeaconBlockBodyAltair) MarshalSSZTo(buf []byte) (dst []byte, err error)
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) UnmarshalSSZ(buf []byte) error {
+// MarshalSSZWriter ssz marshals the BeaconBlockBodyPhase0 object to a writer
+func (b *BeaconBlockBodyPhase0) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := b.fixedSize()
+
+	// Field (0) 'RandaoReveal'
+	if size := uint64(len(b.RandaoReveal)); size != 96 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockBodyPhase0.RandaoReveal", size, 96)
+		return
+	}
+	dst = append(dst, b.RandaoReveal...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = ww.WriteObject(dst, b.Eth1Data); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	dst = append(dst, b.Graffiti[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1240
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (3) 'ProposerSlashings'
+	if size := uint64(len(b.ProposerSlashings)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = ww.WriteObject(dst, b.ProposerSlashings[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := uint64(len(b.AttesterSlashings)); size > 2 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.AttesterSlashings", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = ww.WriteObject(dst, b.AttesterSlashings[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := uint64(len(b.Attestations)); size > 128 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.Attestations", size, 128)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = ww.WriteObject(dst, b.Attestations[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := uint64(len(b.Deposits)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = ww.WriteObject(dst, b.Deposits[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := uint64(len(b.VoluntaryExits)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = ww.WriteObject(dst, b.VoluntaryExits[ii]); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyPhase0 object
+func (b *BeaconBlockBodyPhase0) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(b, buf)
 }
 
-// UnmarshalSSZTail unmarshals the BeaconBlockBodyAltair object and returns the remaining bufferº
-func (b *BeaconBlockBodyAltair) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+// UnmarshalSSZTail unmarshals the BeaconBlockBodyPhase0 object and returns the remaining bufferº
+func (b *BeaconBlockBodyPhase0) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	size := len(buf)
 	fixedSize := b.fixedSize()
 	if size < fixedSize {
@@ -3679,15 +6626,10 @@ func (b *BeaconBlockBodyAltair) UnmarshalSSZTail(buf []byte) (rest []byte, err e
 	}
 
 	// Offset (7) 'VoluntaryExits'
-	if o7, buf, err = marker.ReadOffset(buf); err != nil {
+	if o7, _, err = marker.ReadOffset(buf); err != nil {
 		return nil, err
 	}
 
-	// Field (8) 'SyncAggregate'
-	if buf, err = ssz.UnmarshalFieldTail(&b.SyncAggregate, buf); err != nil {
-		return
-	}
-
 	// Field (3) 'ProposerSlashings'
 	if err = ssz.UnmarshalSliceSSZ(&b.ProposerSlashings, tail[o3:o4], 16); err != nil {
 		return nil, err
@@ -3716,13 +6658,89 @@ func (b *BeaconBlockBodyAltair) UnmarshalSSZTail(buf []byte) (rest []byte, err e
 	return
 }
 
-// fixedSize returns the fixed size of the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) fixedSize() int {
-	return int((220 + (96 + syncCommitteeBits)))
+// UnmarshalSSZReader ssz unmarshals the BeaconBlockBodyPhase0 object from a reader with the size of the encoding
+func (b *BeaconBlockBodyPhase0) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o3, o4, o5, o6, o7 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'RandaoReveal'
+		b.RandaoReveal, buf = ssz.UnmarshalBytes(b.RandaoReveal, buf, 96)
+
+		// Field (1) 'Eth1Data'
+		if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		buf = ssz.UnmarshalFixedBytes(b.Graffiti[:], buf)
+
+		// Offset (3) 'ProposerSlashings'
+		if o3, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (4) 'AttesterSlashings'
+		if o4, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (5) 'Attestations'
+		if o5, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (6) 'Deposits'
+		if o6, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (7) 'VoluntaryExits'
+		if o7, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (3) 'ProposerSlashings'
+	if err = ssz.ReadSliceSSZ(rr, &b.ProposerSlashings, int(o4-o3), 16); err != nil {
+		return
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if err = ssz.ReadDynamicSliceSSZ(rr, &b.AttesterSlashings, int(o5-o4), 2); err != nil {
+		return
+	}
+
+	// Field (5) 'Attestations'
+	if err = ssz.ReadDynamicSliceSSZ(rr, &b.Attestations, int(o6-o5), 128); err != nil {
+		return
+	}
+
+	// Field (6) 'Deposits'
+	if err = ssz.ReadSliceSSZ(rr, &b.Deposits, int(o7-o6), 16); err != nil {
+		return
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if err = ssz.ReadSliceSSZ(rr, &b.VoluntaryExits, size-int(o7), 16); err != nil {
+		return
+	}
+
+	return
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) SizeSSZ() (size int) {
+// fixedSize returns the fixed size of the BeaconBlockBodyPhase0 object
+func (b *BeaconBlockBodyPhase0) fixedSize() int {
+	return int(220)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyPhase0 object
+func (b *BeaconBlockBodyPhase0) SizeSSZ() (size int) {
 	size = b.fixedSize()
 
 	// Field (3) 'ProposerSlashings'
@@ -3749,18 +6767,23 @@ func (b *BeaconBlockBodyAltair) SizeSSZ() (size int) {
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BeaconBlockBodyPhase0 object
+func (b *BeaconBlockBodyPhase0) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockBodyAltair object with a hasher
-func (b *BeaconBlockBodyAltair) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// HashTreeRootCtx ssz hashes the BeaconBlockBodyPhase0 object and stops with the error of the context once it is done
+func (b *BeaconBlockBodyPhase0) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockBodyPhase0 object with a hasher
+func (b *BeaconBlockBodyPhase0) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'RandaoReveal'
 	if size := uint64(len(b.RandaoReveal)); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyAltair.RandaoReveal", size, 96)
+		err = ssz.ErrBytesLengthFn("BeaconBlockBodyPhase0.RandaoReveal", size, 96)
 		return
 	}
 	hh.PutBytes(b.RandaoReveal)
@@ -3784,10 +6807,8 @@ func (b *BeaconBlockBodyAltair) HashTreeRootWith(hh ssz.HashWalker) (err error)
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.ProposerSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.ProposerSlashings); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
@@ -3800,10 +6821,8 @@ func (b *BeaconBlockBodyAltair) HashTreeRootWith(hh ssz.HashWalker) (err error)
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.AttesterSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.AttesterSlashings); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 2)
 	}
@@ -3816,10 +6835,8 @@ func (b *BeaconBlockBodyAltair) HashTreeRootWith(hh ssz.HashWalker) (err error)
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.Attestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.Attestations); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 128)
 	}
@@ -3832,10 +6849,8 @@ func (b *BeaconBlockBodyAltair) HashTreeRootWith(hh ssz.HashWalker) (err error)
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.Deposits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.Deposits); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
@@ -3848,44 +6863,128 @@ func (b *BeaconBlockBodyAltair) HashTreeRootWith(hh ssz.HashWalker) (err error)
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.VoluntaryExits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.VoluntaryExits); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
 
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregate)
-	}
-	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
-		return
-	}
-
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) GetTree() (*ssz.Node, error) {
+// GetTree ssz hashes the BeaconBlockBodyPhase0 object
+func (b *BeaconBlockBodyPhase0) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) MarshalSSZ() ([]byte, error) {
+// GetTreeCtx ssz hashes the BeaconBlockBodyPhase0 object and stops with the error of the context once it is done
+func (b *BeaconBlockBodyPhase0) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
+}
+
+// Generalized indices of the BeaconBlockBodyPhase0 fields
+const (
+	BeaconBlockBodyPhase0GindexRandaoReveal      = 8
+	BeaconBlockBodyPhase0GindexEth1Data          = 9
+	BeaconBlockBodyPhase0GindexGraffiti          = 10
+	BeaconBlockBodyPhase0GindexProposerSlashings = 11
+	BeaconBlockBodyPhase0GindexAttesterSlashings = 12
+	BeaconBlockBodyPhase0GindexAttestations      = 13
+	BeaconBlockBodyPhase0GindexDeposits          = 14
+	BeaconBlockBodyPhase0GindexVoluntaryExits    = 15
+)
+
+// BeaconBlockBodyPhase0GindexProposerSlashingsElem returns the generalized index of the chunk of the i-th element of the ProposerSlashings field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyPhase0GindexProposerSlashingsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyPhase0GindexProposerSlashings, ssz.ListElemGindex(i, 16, 0))
+}
+
+// BeaconBlockBodyPhase0GindexAttesterSlashingsElem returns the generalized index of the chunk of the i-th element of the AttesterSlashings field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyPhase0GindexAttesterSlashingsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyPhase0GindexAttesterSlashings, ssz.ListElemGindex(i, 2, 0))
+}
+
+// BeaconBlockBodyPhase0GindexAttestationsElem returns the generalized index of the chunk of the i-th element of the Attestations field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyPhase0GindexAttestationsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyPhase0GindexAttestations, ssz.ListElemGindex(i, 128, 0))
+}
+
+// BeaconBlockBodyPhase0GindexDepositsElem returns the generalized index of the chunk of the i-th element of the Deposits field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyPhase0GindexDepositsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyPhase0GindexDeposits, ssz.ListElemGindex(i, 16, 0))
+}
+
+// BeaconBlockBodyPhase0GindexVoluntaryExitsElem returns the generalized index of the chunk of the i-th element of the VoluntaryExits field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyPhase0GindexVoluntaryExitsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyPhase0GindexVoluntaryExits, ssz.ListElemGindex(i, 16, 0))
+}
+
+// BeaconBlockBodyPhase0Gindex returns the generalized index of a field path in the BeaconBlockBodyPhase0 object
+func BeaconBlockBodyPhase0Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "randao_reveal":
+		return ssz.FieldGindex(BeaconBlockBodyPhase0GindexRandaoReveal, rest, nil)
+	case "eth1_data":
+		return ssz.FieldGindex(BeaconBlockBodyPhase0GindexEth1Data, rest, Eth1DataGindex)
+	case "graffiti":
+		return ssz.FieldGindex(BeaconBlockBodyPhase0GindexGraffiti, rest, nil)
+	case "proposer_slashings":
+		return ssz.FieldGindex(BeaconBlockBodyPhase0GindexProposerSlashings, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, ProposerSlashingGindex)
+		})
+	case "attester_slashings":
+		return ssz.FieldGindex(BeaconBlockBodyPhase0GindexAttesterSlashings, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 2, 0, AttesterSlashingGindex)
+		})
+	case "attestations":
+		return ssz.FieldGindex(BeaconBlockBodyPhase0GindexAttestations, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 128, 0, AttestationGindex)
+		})
+	case "deposits":
+		return ssz.FieldGindex(BeaconBlockBodyPhase0GindexDeposits, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, DepositGindex)
+		})
+	case "voluntary_exits":
+		return ssz.FieldGindex(BeaconBlockBodyPhase0GindexVoluntaryExits, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, SignedVoluntaryExitGindex)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("BeaconBlockBodyPhase0", field)
+}
+
+// ProveField returns a proof of the field path in the BeaconBlockBodyPhase0 object
+func (b *BeaconBlockBodyPhase0) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BeaconBlockBodyPhase0Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the BeaconBlockBodyPhase0 object
+func (b *BeaconBlockBodyPhase0) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BeaconBlockBodyPhase0Gindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockBodyBellatrix object to a target array
-func (b *BeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BeaconBlockBodyAltair object to a target array
+func (b *BeaconBlockBodyAltair) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := b.fixedSize()
 
 	// Field (0) 'RandaoReveal'
 	if size := uint64(len(b.RandaoReveal)); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyBellatrix.RandaoReveal", size, 96)
+		err = ssz.ErrBytesLengthFn("BeaconBlockBodyAltair.RandaoReveal", size, 96)
 		return
 	}
 	dst = append(dst, b.RandaoReveal...)
@@ -3925,7 +7024,6 @@ func (b *BeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err err
 
 	// Offset (7) 'VoluntaryExits'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.VoluntaryExits) * 112
 
 	// Field (8) 'SyncAggregate'
 	if b.SyncAggregate == nil {
@@ -3935,12 +7033,9 @@ func (b *BeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err err
 		return
 	}
 
-	// Offset (9) 'ExecutionPayload'
-	dst = ssz.WriteOffset(dst, offset)
-
 	// Field (3) 'ProposerSlashings'
 	if size := uint64(len(b.ProposerSlashings)); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.ProposerSlashings", size, 16)
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.ProposerSlashings", size, 16)
 		return
 	}
 	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
@@ -3951,7 +7046,7 @@ func (b *BeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err err
 
 	// Field (4) 'AttesterSlashings'
 	if size := uint64(len(b.AttesterSlashings)); size > 2 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.AttesterSlashings", size, 2)
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.AttesterSlashings", size, 2)
 		return
 	}
 	{
@@ -3969,7 +7064,7 @@ func (b *BeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err err
 
 	// Field (5) 'Attestations'
 	if size := uint64(len(b.Attestations)); size > 128 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.Attestations", size, 128)
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.Attestations", size, 128)
 		return
 	}
 	{
@@ -3987,7 +7082,7 @@ func (b *BeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err err
 
 	// Field (6) 'Deposits'
 	if size := uint64(len(b.Deposits)); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.Deposits", size, 16)
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.Deposits", size, 16)
 		return
 	}
 	for ii := 0; ii < len(b.Deposits); ii++ {
@@ -3998,7 +7093,7 @@ func (b *BeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err err
 
 	// Field (7) 'VoluntaryExits'
 	if size := uint64(len(b.VoluntaryExits)); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.VoluntaryExits", size, 16)
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.VoluntaryExits", size, 16)
 		return
 	}
 	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
@@ -4007,117 +7102,321 @@ func (b *BeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err err
 		}
 	}
 
-	// Field (9) 'ExecutionPayload'
-	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
-		return
-	}
-
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(b, buf)
-}
-
-// UnmarshalSSZTail unmarshals the BeaconBlockBodyBellatrix object and returns the remaining bufferº
-func (b *BeaconBlockBodyBellatrix) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
-	fixedSize := b.fixedSize()
-	if size < fixedSize {
-		return nil, ssz.ErrSize
-	}
-
-	tail := buf
-	var o3, o4, o5, o6, o7, o9 uint64
-	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+// MarshalSSZWriter ssz marshals the BeaconBlockBodyAltair object to a writer
+func (b *BeaconBlockBodyAltair) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := b.fixedSize()
 
 	// Field (0) 'RandaoReveal'
-	b.RandaoReveal, buf = ssz.UnmarshalBytes(b.RandaoReveal, buf, 96)
+	if size := uint64(len(b.RandaoReveal)); size != 96 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockBodyAltair.RandaoReveal", size, 96)
+		return
+	}
+	dst = append(dst, b.RandaoReveal...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (1) 'Eth1Data'
-	if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = ww.WriteObject(dst, b.Eth1Data); err != nil {
 		return
 	}
 
 	// Field (2) 'Graffiti'
-	buf = ssz.UnmarshalFixedBytes(b.Graffiti[:], buf)
+	dst = append(dst, b.Graffiti[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Offset (3) 'ProposerSlashings'
-	if o3, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
 
 	// Offset (4) 'AttesterSlashings'
-	if o4, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
 	}
 
 	// Offset (5) 'Attestations'
-	if o5, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
 	}
 
 	// Offset (6) 'Deposits'
-	if o6, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1240
 
 	// Offset (7) 'VoluntaryExits'
-	if o7, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
 
 	// Field (8) 'SyncAggregate'
-	if buf, err = ssz.UnmarshalFieldTail(&b.SyncAggregate, buf); err != nil {
-		return
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
 	}
-
-	// Offset (9) 'ExecutionPayload'
-	if o9, _, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
+	if dst, err = ww.WriteObject(dst, b.SyncAggregate); err != nil {
+		return
 	}
 
 	// Field (3) 'ProposerSlashings'
-	if err = ssz.UnmarshalSliceSSZ(&b.ProposerSlashings, tail[o3:o4], 16); err != nil {
-		return nil, err
+	if size := uint64(len(b.ProposerSlashings)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = ww.WriteObject(dst, b.ProposerSlashings[ii]); err != nil {
+			return
+		}
 	}
 
 	// Field (4) 'AttesterSlashings'
-	if err = ssz.UnmarshalDynamicSliceSSZ(&b.AttesterSlashings, tail[o4:o5], 2); err != nil {
-		return nil, err
+	if size := uint64(len(b.AttesterSlashings)); size > 2 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.AttesterSlashings", size, 2)
+		return
 	}
-
-	// Field (5) 'Attestations'
-	if err = ssz.UnmarshalDynamicSliceSSZ(&b.Attestations, tail[o5:o6], 128); err != nil {
-		return nil, err
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
 	}
-
-	// Field (6) 'Deposits'
-	if err = ssz.UnmarshalSliceSSZ(&b.Deposits, tail[o6:o7], 16); err != nil {
-		return nil, err
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = ww.WriteObject(dst, b.AttesterSlashings[ii]); err != nil {
+			return
+		}
 	}
 
-	// Field (7) 'VoluntaryExits'
-	if err = ssz.UnmarshalSliceSSZ(&b.VoluntaryExits, tail[o7:o9], 16); err != nil {
-		return nil, err
+	// Field (5) 'Attestations'
+	if size := uint64(len(b.Attestations)); size > 128 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.Attestations", size, 128)
+		return
 	}
-
-	// Field (9) 'ExecutionPayload'
-	if err = ssz.UnmarshalField(&b.ExecutionPayload, tail[o9:]); err != nil {
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = ww.WriteObject(dst, b.Attestations[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := uint64(len(b.Deposits)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = ww.WriteObject(dst, b.Deposits[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := uint64(len(b.VoluntaryExits)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = ww.WriteObject(dst, b.VoluntaryExits[ii]); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(b, buf)
+}
+
+// UnmarshalSSZTail unmarshals the BeaconBlockBodyAltair object and returns the remaining bufferº
+func (b *BeaconBlockBodyAltair) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'RandaoReveal'
+	b.RandaoReveal, buf = ssz.UnmarshalBytes(b.RandaoReveal, buf, 96)
+
+	// Field (1) 'Eth1Data'
+	if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	buf = ssz.UnmarshalFixedBytes(b.Graffiti[:], buf)
+
+	// Offset (3) 'ProposerSlashings'
+	if o3, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (5) 'Attestations'
+	if o5, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (6) 'Deposits'
+	if o6, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (8) 'SyncAggregate'
+	if buf, err = ssz.UnmarshalFieldTail(&b.SyncAggregate, buf); err != nil {
 		return
 	}
 
+	// Field (3) 'ProposerSlashings'
+	if err = ssz.UnmarshalSliceSSZ(&b.ProposerSlashings, tail[o3:o4], 16); err != nil {
+		return nil, err
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if err = ssz.UnmarshalDynamicSliceSSZ(&b.AttesterSlashings, tail[o4:o5], 2); err != nil {
+		return nil, err
+	}
+
+	// Field (5) 'Attestations'
+	if err = ssz.UnmarshalDynamicSliceSSZ(&b.Attestations, tail[o5:o6], 128); err != nil {
+		return nil, err
+	}
+
+	// Field (6) 'Deposits'
+	if err = ssz.UnmarshalSliceSSZ(&b.Deposits, tail[o6:o7], 16); err != nil {
+		return nil, err
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if err = ssz.UnmarshalSliceSSZ(&b.VoluntaryExits, tail[o7:], 16); err != nil {
+		return nil, err
+	}
+
 	return
 }
 
-// fixedSize returns the fixed size of the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) fixedSize() int {
-	return int((224 + (96 + syncCommitteeBits)))
+// UnmarshalSSZReader ssz unmarshals the BeaconBlockBodyAltair object from a reader with the size of the encoding
+func (b *BeaconBlockBodyAltair) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o3, o4, o5, o6, o7 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'RandaoReveal'
+		b.RandaoReveal, buf = ssz.UnmarshalBytes(b.RandaoReveal, buf, 96)
+
+		// Field (1) 'Eth1Data'
+		if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		buf = ssz.UnmarshalFixedBytes(b.Graffiti[:], buf)
+
+		// Offset (3) 'ProposerSlashings'
+		if o3, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (4) 'AttesterSlashings'
+		if o4, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (5) 'Attestations'
+		if o5, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (6) 'Deposits'
+		if o6, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (7) 'VoluntaryExits'
+		if o7, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (8) 'SyncAggregate'
+		if buf, err = ssz.UnmarshalFieldTail(&b.SyncAggregate, buf); err != nil {
+			return
+		}
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (3) 'ProposerSlashings'
+	if err = ssz.ReadSliceSSZ(rr, &b.ProposerSlashings, int(o4-o3), 16); err != nil {
+		return
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if err = ssz.ReadDynamicSliceSSZ(rr, &b.AttesterSlashings, int(o5-o4), 2); err != nil {
+		return
+	}
+
+	// Field (5) 'Attestations'
+	if err = ssz.ReadDynamicSliceSSZ(rr, &b.Attestations, int(o6-o5), 128); err != nil {
+		return
+	}
+
+	// Field (6) 'Deposits'
+	if err = ssz.ReadSliceSSZ(rr, &b.Deposits, int(o7-o6), 16); err != nil {
+		return
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if err = ssz.ReadSliceSSZ(rr, &b.VoluntaryExits, size-int(o7), 16); err != nil {
+		return
+	}
+
+	return
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) SizeSSZ() (size int) {
+// fixedSize returns the fixed size of the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) fixedSize() int {
+	return int((220 + (96 + syncCommitteeBits)))
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) SizeSSZ() (size int) {
 	size = b.fixedSize()
 
 	// Field (3) 'ProposerSlashings'
@@ -4141,27 +7440,26 @@ func (b *BeaconBlockBodyBellatrix) SizeSSZ() (size int) {
 	// Field (7) 'VoluntaryExits'
 	size += len(b.VoluntaryExits) * 112
 
-	// Field (9) 'ExecutionPayload'
-	if b.ExecutionPayload == nil {
-		b.ExecutionPayload = new(ExecutionPayload)
-	}
-	size += b.ExecutionPayload.SizeSSZ()
-
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockBodyBellatrix object with a hasher
-func (b *BeaconBlockBodyBellatrix) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// HashTreeRootCtx ssz hashes the BeaconBlockBodyAltair object and stops with the error of the context once it is done
+func (b *BeaconBlockBodyAltair) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockBodyAltair object with a hasher
+func (b *BeaconBlockBodyAltair) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'RandaoReveal'
 	if size := uint64(len(b.RandaoReveal)); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyBellatrix.RandaoReveal", size, 96)
+		err = ssz.ErrBytesLengthFn("BeaconBlockBodyAltair.RandaoReveal", size, 96)
 		return
 	}
 	hh.PutBytes(b.RandaoReveal)
@@ -4185,10 +7483,8 @@ func (b *BeaconBlockBodyBellatrix) HashTreeRootWith(hh ssz.HashWalker) (err erro
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.ProposerSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.ProposerSlashings); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
@@ -4201,10 +7497,8 @@ func (b *BeaconBlockBodyBellatrix) HashTreeRootWith(hh ssz.HashWalker) (err erro
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.AttesterSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.AttesterSlashings); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 2)
 	}
@@ -4217,10 +7511,8 @@ func (b *BeaconBlockBodyBellatrix) HashTreeRootWith(hh ssz.HashWalker) (err erro
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.Attestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.Attestations); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 128)
 	}
@@ -4233,10 +7525,8 @@ func (b *BeaconBlockBodyBellatrix) HashTreeRootWith(hh ssz.HashWalker) (err erro
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.Deposits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.Deposits); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
@@ -4249,10 +7539,8 @@ func (b *BeaconBlockBodyBellatrix) HashTreeRootWith(hh ssz.HashWalker) (err erro
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.VoluntaryExits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.VoluntaryExits); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
@@ -4265,269 +7553,408 @@ func (b *BeaconBlockBodyBellatrix) HashTreeRootWith(hh ssz.HashWalker) (err erro
 		return
 	}
 
-	// Field (9) 'ExecutionPayload'
-	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
-		return
-	}
-
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) GetTree() (*ssz.Node, error) {
+// GetTree ssz hashes the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
-// MarshalSSZ ssz marshals the BeaconStateAltair object
-func (b *BeaconStateAltair) MarshalSSZ() ([]byte, error) {
+// GetTreeCtx ssz hashes the BeaconBlockBodyAltair object and stops with the error of the context once it is done
+func (b *BeaconBlockBodyAltair) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
+}
+
+// Generalized indices of the BeaconBlockBodyAltair fields
+const (
+	BeaconBlockBodyAltairGindexRandaoReveal      = 16
+	BeaconBlockBodyAltairGindexEth1Data          = 17
+	BeaconBlockBodyAltairGindexGraffiti          = 18
+	BeaconBlockBodyAltairGindexProposerSlashings = 19
+	BeaconBlockBodyAltairGindexAttesterSlashings = 20
+	BeaconBlockBodyAltairGindexAttestations      = 21
+	BeaconBlockBodyAltairGindexDeposits          = 22
+	BeaconBlockBodyAltairGindexVoluntaryExits    = 23
+	BeaconBlockBodyAltairGindexSyncAggregate     = 24
+)
+
+// BeaconBlockBodyAltairGindexProposerSlashingsElem returns the generalized index of the chunk of the i-th element of the ProposerSlashings field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyAltairGindexProposerSlashingsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyAltairGindexProposerSlashings, ssz.ListElemGindex(i, 16, 0))
+}
+
+// BeaconBlockBodyAltairGindexAttesterSlashingsElem returns the generalized index of the chunk of the i-th element of the AttesterSlashings field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyAltairGindexAttesterSlashingsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyAltairGindexAttesterSlashings, ssz.ListElemGindex(i, 2, 0))
+}
+
+// BeaconBlockBodyAltairGindexAttestationsElem returns the generalized index of the chunk of the i-th element of the Attestations field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyAltairGindexAttestationsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyAltairGindexAttestations, ssz.ListElemGindex(i, 128, 0))
+}
+
+// BeaconBlockBodyAltairGindexDepositsElem returns the generalized index of the chunk of the i-th element of the Deposits field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyAltairGindexDepositsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyAltairGindexDeposits, ssz.ListElemGindex(i, 16, 0))
+}
+
+// BeaconBlockBodyAltairGindexVoluntaryExitsElem returns the generalized index of the chunk of the i-th element of the VoluntaryExits field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyAltairGindexVoluntaryExitsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyAltairGindexVoluntaryExits, ssz.ListElemGindex(i, 16, 0))
+}
+
+// BeaconBlockBodyAltairGindex returns the generalized index of a field path in the BeaconBlockBodyAltair object
+func BeaconBlockBodyAltairGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "randao_reveal":
+		return ssz.FieldGindex(BeaconBlockBodyAltairGindexRandaoReveal, rest, nil)
+	case "eth1_data":
+		return ssz.FieldGindex(BeaconBlockBodyAltairGindexEth1Data, rest, Eth1DataGindex)
+	case "graffiti":
+		return ssz.FieldGindex(BeaconBlockBodyAltairGindexGraffiti, rest, nil)
+	case "proposer_slashings":
+		return ssz.FieldGindex(BeaconBlockBodyAltairGindexProposerSlashings, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, ProposerSlashingGindex)
+		})
+	case "attester_slashings":
+		return ssz.FieldGindex(BeaconBlockBodyAltairGindexAttesterSlashings, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 2, 0, AttesterSlashingGindex)
+		})
+	case "attestations":
+		return ssz.FieldGindex(BeaconBlockBodyAltairGindexAttestations, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 128, 0, AttestationGindex)
+		})
+	case "deposits":
+		return ssz.FieldGindex(BeaconBlockBodyAltairGindexDeposits, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, DepositGindex)
+		})
+	case "voluntary_exits":
+		return ssz.FieldGindex(BeaconBlockBodyAltairGindexVoluntaryExits, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, SignedVoluntaryExitGindex)
+		})
+	case "sync_aggregate":
+		return ssz.FieldGindex(BeaconBlockBodyAltairGindexSyncAggregate, rest, SyncAggregateGindex)
+	}
+	return 0, ssz.ErrUnknownFieldFn("BeaconBlockBodyAltair", field)
+}
+
+// ProveField returns a proof of the field path in the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BeaconBlockBodyAltairGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BeaconBlockBodyAltairGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBodyBellatrix object
+func (b *BeaconBlockBodyBellatrix) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BeaconStateAltair object to a target array
-func (b *BeaconStateAltair) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BeaconBlockBodyBellatrix object to a target array
+func (b *BeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := b.fixedSize()
 
-	// Field (0) 'GenesisTime'
-	dst = ssz.MarshalValue(dst, b.GenesisTime)
-
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := uint64(len(b.GenesisValidatorsRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconStateAltair.GenesisValidatorsRoot", size, 32)
+	// Field (0) 'RandaoReveal'
+	if size := uint64(len(b.RandaoReveal)); size != 96 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockBodyBellatrix.RandaoReveal", size, 96)
 		return
 	}
-	dst = append(dst, b.GenesisValidatorsRoot...)
-
-	// Field (2) 'Slot'
-	dst = ssz.MarshalValue(dst, b.Slot)
+	dst = append(dst, b.RandaoReveal...)
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
 	}
-	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+	// Field (2) 'Graffiti'
+	dst = append(dst, b.Graffiti[:]...)
+
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1240
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
+	}
+	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
-	// Field (5) 'BlockRoots'
-	if size := uint64(len(b.BlockRoots)); size != rootsSize {
-		err = ssz.ErrVectorLengthFn("BeaconStateAltair.BlockRoots", size, rootsSize)
+	// Offset (9) 'ExecutionPayload'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (3) 'ProposerSlashings'
+	if size := uint64(len(b.ProposerSlashings)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.ProposerSlashings", size, 16)
 		return
 	}
-	for ii := uint64(0); ii < rootsSize; ii++ {
-		if size := uint64(len(b.BlockRoots[ii])); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateAltair.BlockRoots[ii]", size, 32)
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
 			return
 		}
-		dst = append(dst, b.BlockRoots[ii]...)
 	}
 
-	// Field (6) 'StateRoots'
-	if size := uint64(len(b.StateRoots)); size != rootsSize {
-		err = ssz.ErrVectorLengthFn("BeaconStateAltair.StateRoots", size, rootsSize)
+	// Field (4) 'AttesterSlashings'
+	if size := uint64(len(b.AttesterSlashings)); size > 2 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.AttesterSlashings", size, 2)
 		return
 	}
-	for ii := uint64(0); ii < rootsSize; ii++ {
-		if size := uint64(len(b.StateRoots[ii])); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateAltair.StateRoots[ii]", size, 32)
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
 			return
 		}
-		dst = append(dst, b.StateRoots[ii]...)
 	}
 
-	// Offset (7) 'HistoricalRoots'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.HistoricalRoots) * 32
-
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+	// Field (5) 'Attestations'
+	if size := uint64(len(b.Attestations)); size > 128 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.Attestations", size, 128)
 		return
 	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
-	// Offset (9) 'Eth1DataVotes'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Eth1DataVotes) * 72
-
-	// Field (10) 'Eth1DepositIndex'
-	dst = ssz.MarshalValue(dst, b.Eth1DepositIndex)
-
-	// Offset (11) 'Validators'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Validators) * 121
-
-	// Offset (12) 'Balances'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Balances) * 8
-
-	// Field (13) 'RandaoMixes'
-	if size := uint64(len(b.RandaoMixes)); size != randaoMixes {
-		err = ssz.ErrVectorLengthFn("BeaconStateAltair.RandaoMixes", size, randaoMixes)
+	// Field (6) 'Deposits'
+	if size := uint64(len(b.Deposits)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.Deposits", size, 16)
 		return
 	}
-	for ii := uint64(0); ii < randaoMixes; ii++ {
-		if size := uint64(len(b.RandaoMixes[ii])); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateAltair.RandaoMixes[ii]", size, 32)
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
 			return
 		}
-		dst = append(dst, b.RandaoMixes[ii]...)
 	}
 
-	// Field (14) 'Slashings'
-	if size := uint64(len(b.Slashings)); size != slashings {
-		err = ssz.ErrVectorLengthFn("BeaconStateAltair.Slashings", size, slashings)
+	// Field (7) 'VoluntaryExits'
+	if size := uint64(len(b.VoluntaryExits)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.VoluntaryExits", size, 16)
 		return
 	}
-	for ii := uint64(0); ii < slashings; ii++ {
-		dst = ssz.MarshalValue(dst, b.Slashings[ii])
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
 	}
 
-	// Offset (15) 'PreviousEpochParticipation'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.PreviousEpochParticipation)
-
-	// Offset (16) 'CurrentEpochParticipation'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.CurrentEpochParticipation)
-
-	// Field (17) 'JustificationBits'
-	if size := uint64(len(b.JustificationBits)); size != 1 {
-		err = ssz.ErrBytesLengthFn("BeaconStateAltair.JustificationBits", size, 1)
+	// Field (9) 'ExecutionPayload'
+	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
 		return
 	}
-	dst = append(dst, b.JustificationBits...)
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	return
+}
+
+// MarshalSSZWriter ssz marshals the BeaconBlockBodyBellatrix object to a writer
+func (b *BeaconBlockBodyBellatrix) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := b.fixedSize()
+
+	// Field (0) 'RandaoReveal'
+	if size := uint64(len(b.RandaoReveal)); size != 96 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockBodyBellatrix.RandaoReveal", size, 96)
+		return
 	}
-	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+	dst = append(dst, b.RandaoReveal...)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
 	}
-	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, b.Eth1Data); err != nil {
 		return
 	}
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+	// Field (2) 'Graffiti'
+	dst = append(dst, b.Graffiti[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
 
-	// Offset (21) 'InactivityScores'
+	// Offset (3) 'ProposerSlashings'
 	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
 
-	// Field (22) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
 	}
-	if dst, err = b.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
-		return
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
 	}
 
-	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1240
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
 	}
-	if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, b.SyncAggregate); err != nil {
 		return
 	}
 
-	// Field (7) 'HistoricalRoots'
-	if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
-		err = ssz.ErrListTooBigFn("BeaconStateAltair.HistoricalRoots", size, 16777216)
+	// Offset (9) 'ExecutionPayload'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (3) 'ProposerSlashings'
+	if size := uint64(len(b.ProposerSlashings)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.ProposerSlashings", size, 16)
 		return
 	}
-	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
-		if size := uint64(len(b.HistoricalRoots[ii])); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateAltair.HistoricalRoots[ii]", size, 32)
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = ww.WriteObject(dst, b.ProposerSlashings[ii]); err != nil {
 			return
 		}
-		dst = append(dst, b.HistoricalRoots[ii]...)
 	}
 
-	// Field (9) 'Eth1DataVotes'
-	if size := uint64(len(b.Eth1DataVotes)); size > eth1DataVotes {
-		err = ssz.ErrListTooBigFn("BeaconStateAltair.Eth1DataVotes", size, eth1DataVotes)
+	// Field (4) 'AttesterSlashings'
+	if size := uint64(len(b.AttesterSlashings)); size > 2 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.AttesterSlashings", size, 2)
 		return
 	}
-	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
-		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = ww.WriteObject(dst, b.AttesterSlashings[ii]); err != nil {
 			return
 		}
 	}
 
-	// Field (11) 'Validators'
-	if size := uint64(len(b.Validators)); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateAltair.Validators", size, 1099511627776)
+	// Field (5) 'Attestations'
+	if size := uint64(len(b.Attestations)); size > 128 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.Attestations", size, 128)
 		return
 	}
-	for ii := 0; ii < len(b.Validators); ii++ {
-		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = ww.WriteObject(dst, b.Attestations[ii]); err != nil {
 			return
 		}
 	}
 
-	// Field (12) 'Balances'
-	if size := uint64(len(b.Balances)); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateAltair.Balances", size, 1099511627776)
+	// Field (6) 'Deposits'
+	if size := uint64(len(b.Deposits)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.Deposits", size, 16)
 		return
 	}
-	for ii := 0; ii < len(b.Balances); ii++ {
-		dst = ssz.MarshalValue(dst, b.Balances[ii])
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = ww.WriteObject(dst, b.Deposits[ii]); err != nil {
+			return
+		}
 	}
 
-	// Field (15) 'PreviousEpochParticipation'
-	if size := uint64(len(b.PreviousEpochParticipation)); size > 1099511627776 {
-		err = ssz.ErrBytesLengthFn("BeaconStateAltair.PreviousEpochParticipation", size, 1099511627776)
+	// Field (7) 'VoluntaryExits'
+	if size := uint64(len(b.VoluntaryExits)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.VoluntaryExits", size, 16)
 		return
 	}
-	dst = append(dst, b.PreviousEpochParticipation...)
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = ww.WriteObject(dst, b.VoluntaryExits[ii]); err != nil {
+			return
+		}
+	}
 
-	// Field (16) 'CurrentEpochParticipation'
-	if size := uint64(len(b.CurrentEpochParticipation)); size > 1099511627776 {
-		err = ssz.ErrBytesLengthFn("BeaconStateAltair.CurrentEpochParticipation", size, 1099511627776)
+	// Field (9) 'ExecutionPayload'
+	if dst, err = ww.WriteObject(dst, b.ExecutionPayload); err != nil {
 		return
 	}
-	dst = append(dst, b.CurrentEpochParticipation...)
 
-	// Field (21) 'InactivityScores'
-	if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateAltair.InactivityScores", size, 1099511627776)
+	if _, err = ww.Commit(dst); err != nil {
 		return
 	}
-	for ii := 0; ii < len(b.InactivityScores); ii++ {
-		dst = ssz.MarshalValue(dst, b.InactivityScores[ii])
-	}
-
-	return
+	return ww.Done()
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconStateAltair object
-func (b *BeaconStateAltair) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyBellatrix object
+func (b *BeaconBlockBodyBellatrix) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(b, buf)
 }
 
-// UnmarshalSSZTail unmarshals the BeaconStateAltair object and returns the remaining bufferº
-func (b *BeaconStateAltair) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+// UnmarshalSSZTail unmarshals the BeaconBlockBodyBellatrix object and returns the remaining bufferº
+func (b *BeaconBlockBodyBellatrix) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	size := len(buf)
 	fixedSize := b.fixedSize()
 	if size < fixedSize {
@@ -4535,464 +7962,329 @@ func (b *BeaconStateAltair) UnmarshalSSZTail(buf []byte) (rest []byte, err error
 	}
 
 	tail := buf
-	var o7, o9, o11, o12, o15, o16, o21 uint64
+	var o3, o4, o5, o6, o7, o9 uint64
 	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
 
-	// Field (0) 'GenesisTime'
-	b.GenesisTime, buf = ssz.UnmarshallValue[uint64](buf)
+	// Field (0) 'RandaoReveal'
+	b.RandaoReveal, buf = ssz.UnmarshalBytes(b.RandaoReveal, buf, 96)
 
-	// Field (1) 'GenesisValidatorsRoot'
-	b.GenesisValidatorsRoot, buf = ssz.UnmarshalBytes(b.GenesisValidatorsRoot, buf, 32)
+	// Field (1) 'Eth1Data'
+	if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
+		return
+	}
 
-	// Field (2) 'Slot'
-	b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+	// Field (2) 'Graffiti'
+	buf = ssz.UnmarshalFixedBytes(b.Graffiti[:], buf)
 
-	// Field (3) 'Fork'
-	if buf, err = ssz.UnmarshalFieldTail(&b.Fork, buf); err != nil {
-		return
+	// Offset (3) 'ProposerSlashings'
+	if o3, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
-	// Field (4) 'LatestBlockHeader'
-	if buf, err = ssz.UnmarshalFieldTail(&b.LatestBlockHeader, buf); err != nil {
-		return
+	// Offset (4) 'AttesterSlashings'
+	if o4, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
-	// Field (5) 'BlockRoots'
-	b.BlockRoots = make([][]byte, rootsSize)
-	for ii := uint64(0); ii < rootsSize; ii++ {
-		b.BlockRoots[ii], buf = ssz.UnmarshalBytes(b.BlockRoots[ii], buf, 32)
+	// Offset (5) 'Attestations'
+	if o5, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
-	// Field (6) 'StateRoots'
-	b.StateRoots = make([][]byte, rootsSize)
-	for ii := uint64(0); ii < rootsSize; ii++ {
-		b.StateRoots[ii], buf = ssz.UnmarshalBytes(b.StateRoots[ii], buf, 32)
+	// Offset (6) 'Deposits'
+	if o6, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
-	// Offset (7) 'HistoricalRoots'
+	// Offset (7) 'VoluntaryExits'
 	if o7, buf, err = marker.ReadOffset(buf); err != nil {
 		return nil, err
 	}
 
-	// Field (8) 'Eth1Data'
-	if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
+	// Field (8) 'SyncAggregate'
+	if buf, err = ssz.UnmarshalFieldTail(&b.SyncAggregate, buf); err != nil {
 		return
 	}
 
-	// Offset (9) 'Eth1DataVotes'
-	if o9, buf, err = marker.ReadOffset(buf); err != nil {
+	// Offset (9) 'ExecutionPayload'
+	if o9, _, err = marker.ReadOffset(buf); err != nil {
 		return nil, err
 	}
 
-	// Field (10) 'Eth1DepositIndex'
-	b.Eth1DepositIndex, buf = ssz.UnmarshallValue[uint64](buf)
-
-	// Offset (11) 'Validators'
-	if o11, buf, err = marker.ReadOffset(buf); err != nil {
+	// Field (3) 'ProposerSlashings'
+	if err = ssz.UnmarshalSliceSSZ(&b.ProposerSlashings, tail[o3:o4], 16); err != nil {
 		return nil, err
 	}
 
-	// Offset (12) 'Balances'
-	if o12, buf, err = marker.ReadOffset(buf); err != nil {
+	// Field (4) 'AttesterSlashings'
+	if err = ssz.UnmarshalDynamicSliceSSZ(&b.AttesterSlashings, tail[o4:o5], 2); err != nil {
 		return nil, err
 	}
 
-	// Field (13) 'RandaoMixes'
-	b.RandaoMixes = make([][]byte, randaoMixes)
-	for ii := uint64(0); ii < randaoMixes; ii++ {
-		b.RandaoMixes[ii], buf = ssz.UnmarshalBytes(b.RandaoMixes[ii], buf, 32)
-	}
-
-	// Field (14) 'Slashings'
-	b.Slashings = ssz.Extend(b.Slashings, slashings)
-	for ii := uint64(0); ii < slashings; ii++ {
-		b.Slashings[ii], buf = ssz.UnmarshallValue[uint64](buf)
+	// Field (5) 'Attestations'
+	if err = ssz.UnmarshalDynamicSliceSSZ(&b.Attestations, tail[o5:o6], 128); err != nil {
+		return nil, err
 	}
 
-	// Offset (15) 'PreviousEpochParticipation'
-	if o15, buf, err = marker.ReadOffset(buf); err != nil {
+	// Field (6) 'Deposits'
+	if err = ssz.UnmarshalSliceSSZ(&b.Deposits, tail[o6:o7], 16); err != nil {
 		return nil, err
 	}
 
-	// Offset (16) 'CurrentEpochParticipation'
-	if o16, buf, err = marker.ReadOffset(buf); err != nil {
+	// Field (7) 'VoluntaryExits'
+	if err = ssz.UnmarshalSliceSSZ(&b.VoluntaryExits, tail[o7:o9], 16); err != nil {
 		return nil, err
 	}
 
-	// Field (17) 'JustificationBits'
-	b.JustificationBits, buf = ssz.UnmarshalBytes(b.JustificationBits, buf, 1)
-
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if buf, err = ssz.UnmarshalFieldTail(&b.PreviousJustifiedCheckpoint, buf); err != nil {
+	// Field (9) 'ExecutionPayload'
+	if err = ssz.UnmarshalField(&b.ExecutionPayload, tail[o9:]); err != nil {
 		return
 	}
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if buf, err = ssz.UnmarshalFieldTail(&b.CurrentJustifiedCheckpoint, buf); err != nil {
-		return
-	}
+	return
+}
 
-	// Field (20) 'FinalizedCheckpoint'
-	if buf, err = ssz.UnmarshalFieldTail(&b.FinalizedCheckpoint, buf); err != nil {
-		return
+// UnmarshalSSZReader ssz unmarshals the BeaconBlockBodyBellatrix object from a reader with the size of the encoding
+func (b *BeaconBlockBodyBellatrix) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
 	}
 
-	// Offset (21) 'InactivityScores'
-	if o21, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	var o3, o4, o5, o6, o7, o9 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
 
-	// Field (22) 'CurrentSyncCommittee'
-	if buf, err = ssz.UnmarshalFieldTail(&b.CurrentSyncCommittee, buf); err != nil {
-		return
-	}
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'RandaoReveal'
+		b.RandaoReveal, buf = ssz.UnmarshalBytes(b.RandaoReveal, buf, 96)
 
-	// Field (23) 'NextSyncCommittee'
-	if buf, err = ssz.UnmarshalFieldTail(&b.NextSyncCommittee, buf); err != nil {
-		return
-	}
+		// Field (1) 'Eth1Data'
+		if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
+			return
+		}
 
-	// Field (7) 'HistoricalRoots'
-	if err = ssz.UnmarshalSliceWithIndexCallback(&b.HistoricalRoots, tail[o7:o9], 32, 16777216, func(ii uint64, buf []byte) (err error) {
-		b.HistoricalRoots[ii], buf = ssz.UnmarshalBytes(b.HistoricalRoots[ii], buf, 32)
-		return nil
+		// Field (2) 'Graffiti'
+		buf = ssz.UnmarshalFixedBytes(b.Graffiti[:], buf)
+
+		// Offset (3) 'ProposerSlashings'
+		if o3, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (4) 'AttesterSlashings'
+		if o4, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (5) 'Attestations'
+		if o5, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (6) 'Deposits'
+		if o6, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (7) 'VoluntaryExits'
+		if o7, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (8) 'SyncAggregate'
+		if buf, err = ssz.UnmarshalFieldTail(&b.SyncAggregate, buf); err != nil {
+			return
+		}
+
+		// Offset (9) 'ExecutionPayload'
+		if o9, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
 	}); err != nil {
-		return nil, err
+		return
 	}
 
-	// Field (9) 'Eth1DataVotes'
-	if err = ssz.UnmarshalSliceSSZ(&b.Eth1DataVotes, tail[o9:o11], eth1DataVotes); err != nil {
-		return nil, err
+	// Field (3) 'ProposerSlashings'
+	if err = ssz.ReadSliceSSZ(rr, &b.ProposerSlashings, int(o4-o3), 16); err != nil {
+		return
 	}
 
-	// Field (11) 'Validators'
-	if err = ssz.UnmarshalSliceSSZ(&b.Validators, tail[o11:o12], 1099511627776); err != nil {
-		return nil, err
+	// Field (4) 'AttesterSlashings'
+	if err = ssz.ReadDynamicSliceSSZ(rr, &b.AttesterSlashings, int(o5-o4), 2); err != nil {
+		return
 	}
 
-	// Field (12) 'Balances'
-	if err = ssz.UnmarshalSliceWithIndexCallback(&b.Balances, tail[o12:o15], 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
-		b.Balances[ii], buf = ssz.UnmarshallValue[uint64](buf)
-		return nil
-	}); err != nil {
-		return nil, err
+	// Field (5) 'Attestations'
+	if err = ssz.ReadDynamicSliceSSZ(rr, &b.Attestations, int(o6-o5), 128); err != nil {
+		return
 	}
 
-	// Field (15) 'PreviousEpochParticipation'
-	if b.PreviousEpochParticipation, err = ssz.UnmarshalDynamicBytes(b.PreviousEpochParticipation, tail[o15:o16], 1099511627776); err != nil {
+	// Field (6) 'Deposits'
+	if err = ssz.ReadSliceSSZ(rr, &b.Deposits, int(o7-o6), 16); err != nil {
 		return
 	}
 
-	// Field (16) 'CurrentEpochParticipation'
-	if b.CurrentEpochParticipation, err = ssz.UnmarshalDynamicBytes(b.CurrentEpochParticipation, tail[o16:o21], 1099511627776); err != nil {
+	// Field (7) 'VoluntaryExits'
+	if err = ssz.ReadSliceSSZ(rr, &b.VoluntaryExits, int(o9-o7), 16); err != nil {
 		return
 	}
 
-	// Field (21) 'InactivityScores'
-	if err = ssz.UnmarshalSliceWithIndexCallback(&b.InactivityScores, tail[o21:], 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
-		b.InactivityScores[ii], buf = ssz.UnmarshallValue[uint64](buf)
-		return nil
-	}); err != nil {
-		return nil, err
+	// Field (9) 'ExecutionPayload'
+	if err = ssz.ReadField(rr, &b.ExecutionPayload, size-int(o9)); err != nil {
+		return
 	}
 
 	return
 }
 
-// fixedSize returns the fixed size of the BeaconStateAltair object
-func (b *BeaconStateAltair) fixedSize() int {
-	return int((405 + (rootsSize * 32) + (rootsSize * 32) + (randaoMixes * 32) + (slashings * 8) + (48 + (syncCommitteePubKeys * 48)) + (48 + (syncCommitteePubKeys * 48))))
+// fixedSize returns the fixed size of the BeaconBlockBodyBellatrix object
+func (b *BeaconBlockBodyBellatrix) fixedSize() int {
+	return int((224 + (96 + syncCommitteeBits)))
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateAltair object
-func (b *BeaconStateAltair) SizeSSZ() (size int) {
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyBellatrix object
+func (b *BeaconBlockBodyBellatrix) SizeSSZ() (size int) {
 	size = b.fixedSize()
 
-	// Field (7) 'HistoricalRoots'
-	size += len(b.HistoricalRoots) * 32
-
-	// Field (9) 'Eth1DataVotes'
-	size += len(b.Eth1DataVotes) * 72
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 416
 
-	// Field (11) 'Validators'
-	size += len(b.Validators) * 121
+	// Field (4) 'AttesterSlashings'
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		size += 4
+		size += b.AttesterSlashings[ii].SizeSSZ()
+	}
 
-	// Field (12) 'Balances'
-	size += len(b.Balances) * 8
+	// Field (5) 'Attestations'
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		size += 4
+		size += b.Attestations[ii].SizeSSZ()
+	}
 
-	// Field (15) 'PreviousEpochParticipation'
-	size += len(b.PreviousEpochParticipation)
+	// Field (6) 'Deposits'
+	size += len(b.Deposits) * 1240
 
-	// Field (16) 'CurrentEpochParticipation'
-	size += len(b.CurrentEpochParticipation)
+	// Field (7) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
 
-	// Field (21) 'InactivityScores'
-	size += len(b.InactivityScores) * 8
+	// Field (9) 'ExecutionPayload'
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(ExecutionPayload)
+	}
+	size += b.ExecutionPayload.SizeSSZ()
 
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconStateAltair object
-func (b *BeaconStateAltair) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// HashTreeRoot ssz hashes the BeaconBlockBodyBellatrix object
+func (b *BeaconBlockBodyBellatrix) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BeaconStateAltair object with a hasher
-func (b *BeaconStateAltair) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// HashTreeRootCtx ssz hashes the BeaconBlockBodyBellatrix object and stops with the error of the context once it is done
+func (b *BeaconBlockBodyBellatrix) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
+}
 
-	// Field (0) 'GenesisTime'
-	hh.PutUint64(b.GenesisTime)
+// HashTreeRootWith ssz hashes the BeaconBlockBodyBellatrix object with a hasher
+func (b *BeaconBlockBodyBellatrix) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := uint64(len(b.GenesisValidatorsRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconStateAltair.GenesisValidatorsRoot", size, 32)
+	// Field (0) 'RandaoReveal'
+	if size := uint64(len(b.RandaoReveal)); size != 96 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockBodyBellatrix.RandaoReveal", size, 96)
 		return
 	}
-	hh.PutBytes(b.GenesisValidatorsRoot)
-
-	// Field (2) 'Slot'
-	hh.PutUint64(b.Slot)
+	hh.PutBytes(b.RandaoReveal)
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
 	}
-	if err = b.Fork.HashTreeRootWith(hh); err != nil {
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
-		return
-	}
+	// Field (2) 'Graffiti'
+	hh.PutBytes(b.Graffiti[:])
 
-	// Field (5) 'BlockRoots'
+	// Field (3) 'ProposerSlashings'
 	{
-		if size := uint64(len(b.BlockRoots)); size != rootsSize {
-			err = ssz.ErrVectorLengthFn("BeaconStateAltair.BlockRoots", size, rootsSize)
-			return
-		}
 		subIndx := hh.Index()
-		for _, i := range b.BlockRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
-	}
-
-	// Field (6) 'StateRoots'
-	{
-		if size := uint64(len(b.StateRoots)); size != rootsSize {
-			err = ssz.ErrVectorLengthFn("BeaconStateAltair.StateRoots", size, rootsSize)
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
 			return
 		}
-		subIndx := hh.Index()
-		for _, i := range b.StateRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
-	}
-
-	// Field (7) 'HistoricalRoots'
-	{
-		if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
-			err = ssz.ErrListTooBigFn("BeaconStateAltair.HistoricalRoots", size, 16777216)
+		if err = ssz.PutObjects(hh, b.ProposerSlashings); err != nil {
 			return
 		}
-		subIndx := hh.Index()
-		for _, i := range b.HistoricalRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		numItems := uint64(len(b.HistoricalRoots))
-		hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
-	}
-
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
-		return
+		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
 
-	// Field (9) 'Eth1DataVotes'
+	// Field (4) 'AttesterSlashings'
 	{
 		subIndx := hh.Index()
-		num := uint64(len(b.Eth1DataVotes))
-		if num > eth1DataVotes {
+		num := uint64(len(b.AttesterSlashings))
+		if num > 2 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.Eth1DataVotes {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.AttesterSlashings); err != nil {
+			return
 		}
-		hh.MerkleizeWithMixin(subIndx, num, eth1DataVotes)
+		hh.MerkleizeWithMixin(subIndx, num, 2)
 	}
 
-	// Field (10) 'Eth1DepositIndex'
-	hh.PutUint64(b.Eth1DepositIndex)
-
-	// Field (11) 'Validators'
+	// Field (5) 'Attestations'
 	{
 		subIndx := hh.Index()
-		num := uint64(len(b.Validators))
-		if num > 1099511627776 {
+		num := uint64(len(b.Attestations))
+		if num > 128 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.Validators {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
-	}
-
-	// Field (12) 'Balances'
-	{
-		if size := uint64(len(b.Balances)); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconStateAltair.Balances", size, 1099511627776)
+		if err = ssz.PutObjects(hh, b.Attestations); err != nil {
 			return
 		}
-		subIndx := hh.Index()
-		for _, i := range b.Balances {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.Balances))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		hh.MerkleizeWithMixin(subIndx, num, 128)
 	}
 
-	// Field (13) 'RandaoMixes'
+	// Field (6) 'Deposits'
 	{
-		if size := uint64(len(b.RandaoMixes)); size != randaoMixes {
-			err = ssz.ErrVectorLengthFn("BeaconStateAltair.RandaoMixes", size, randaoMixes)
-			return
-		}
 		subIndx := hh.Index()
-		for _, i := range b.RandaoMixes {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
-	}
-
-	// Field (14) 'Slashings'
-	{
-		if size := uint64(len(b.Slashings)); size != slashings {
-			err = ssz.ErrVectorLengthFn("BeaconStateAltair.Slashings", size, slashings)
+		num := uint64(len(b.Deposits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
 			return
 		}
-		subIndx := hh.Index()
-		for _, i := range b.Slashings {
-			hh.AppendUint64(i)
-		}
-		hh.Merkleize(subIndx)
-	}
-
-	// Field (15) 'PreviousEpochParticipation'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(b.PreviousEpochParticipation))
-		if byteLen > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
+		if err = ssz.PutObjects(hh, b.Deposits); err != nil {
 			return
 		}
-		hh.Append(b.PreviousEpochParticipation)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
 
-	// Field (16) 'CurrentEpochParticipation'
+	// Field (7) 'VoluntaryExits'
 	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(b.CurrentEpochParticipation))
-		if byteLen > 1099511627776 {
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		hh.Append(b.CurrentEpochParticipation)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
-	}
-
-	// Field (17) 'JustificationBits'
-	if size := uint64(len(b.JustificationBits)); size != 1 {
-		err = ssz.ErrBytesLengthFn("BeaconStateAltair.JustificationBits", size, 1)
-		return
-	}
-	hh.PutBytes(b.JustificationBits)
-
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
-	}
-
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
-	}
-
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
-	}
-
-	// Field (21) 'InactivityScores'
-	{
-		if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconStateAltair.InactivityScores", size, 1099511627776)
+		if err = ssz.PutObjects(hh, b.VoluntaryExits); err != nil {
 			return
 		}
-		subIndx := hh.Index()
-		for _, i := range b.InactivityScores {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.InactivityScores))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
 
-	// Field (22) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
 	}
-	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+	// Field (9) 'ExecutionPayload'
+	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
@@ -5000,27 +8292,127 @@ func (b *BeaconStateAltair) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
-// GetTree ssz hashes the BeaconStateAltair object
-func (b *BeaconStateAltair) GetTree() (*ssz.Node, error) {
+// GetTree ssz hashes the BeaconBlockBodyBellatrix object
+func (b *BeaconBlockBodyBellatrix) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
-// MarshalSSZ ssz marshals the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// GetTreeCtx ssz hashes the BeaconBlockBodyBellatrix object and stops with the error of the context once it is done
+func (b *BeaconBlockBodyBellatrix) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
 }
 
-// MarshalSSZTo ssz marshals the BeaconStateBellatrix object to a target array
-func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := b.fixedSize()
+// Generalized indices of the BeaconBlockBodyBellatrix fields
+const (
+	BeaconBlockBodyBellatrixGindexRandaoReveal      = 16
+	BeaconBlockBodyBellatrixGindexEth1Data          = 17
+	BeaconBlockBodyBellatrixGindexGraffiti          = 18
+	BeaconBlockBodyBellatrixGindexProposerSlashings = 19
+	BeaconBlockBodyBellatrixGindexAttesterSlashings = 20
+	BeaconBlockBodyBellatrixGindexAttestations      = 21
+	BeaconBlockBodyBellatrixGindexDeposits          = 22
+	BeaconBlockBodyBellatrixGindexVoluntaryExits    = 23
+	BeaconBlockBodyBellatrixGindexSyncAggregate     = 24
+	BeaconBlockBodyBellatrixGindexExecutionPayload  = 25
+)
+
+// BeaconBlockBodyBellatrixGindexProposerSlashingsElem returns the generalized index of the chunk of the i-th element of the ProposerSlashings field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyBellatrixGindexProposerSlashingsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyBellatrixGindexProposerSlashings, ssz.ListElemGindex(i, 16, 0))
+}
+
+// BeaconBlockBodyBellatrixGindexAttesterSlashingsElem returns the generalized index of the chunk of the i-th element of the AttesterSlashings field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyBellatrixGindexAttesterSlashingsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyBellatrixGindexAttesterSlashings, ssz.ListElemGindex(i, 2, 0))
+}
+
+// BeaconBlockBodyBellatrixGindexAttestationsElem returns the generalized index of the chunk of the i-th element of the Attestations field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyBellatrixGindexAttestationsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyBellatrixGindexAttestations, ssz.ListElemGindex(i, 128, 0))
+}
+
+// BeaconBlockBodyBellatrixGindexDepositsElem returns the generalized index of the chunk of the i-th element of the Deposits field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyBellatrixGindexDepositsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyBellatrixGindexDeposits, ssz.ListElemGindex(i, 16, 0))
+}
+
+// BeaconBlockBodyBellatrixGindexVoluntaryExitsElem returns the generalized index of the chunk of the i-th element of the VoluntaryExits field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyBellatrixGindexVoluntaryExitsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyBellatrixGindexVoluntaryExits, ssz.ListElemGindex(i, 16, 0))
+}
+
+// BeaconBlockBodyBellatrixGindex returns the generalized index of a field path in the BeaconBlockBodyBellatrix object
+func BeaconBlockBodyBellatrixGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "randao_reveal":
+		return ssz.FieldGindex(BeaconBlockBodyBellatrixGindexRandaoReveal, rest, nil)
+	case "eth1_data":
+		return ssz.FieldGindex(BeaconBlockBodyBellatrixGindexEth1Data, rest, Eth1DataGindex)
+	case "graffiti":
+		return ssz.FieldGindex(BeaconBlockBodyBellatrixGindexGraffiti, rest, nil)
+	case "proposer_slashings":
+		return ssz.FieldGindex(BeaconBlockBodyBellatrixGindexProposerSlashings, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, ProposerSlashingGindex)
+		})
+	case "attester_slashings":
+		return ssz.FieldGindex(BeaconBlockBodyBellatrixGindexAttesterSlashings, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 2, 0, AttesterSlashingGindex)
+		})
+	case "attestations":
+		return ssz.FieldGindex(BeaconBlockBodyBellatrixGindexAttestations, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 128, 0, AttestationGindex)
+		})
+	case "deposits":
+		return ssz.FieldGindex(BeaconBlockBodyBellatrixGindexDeposits, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, DepositGindex)
+		})
+	case "voluntary_exits":
+		return ssz.FieldGindex(BeaconBlockBodyBellatrixGindexVoluntaryExits, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, SignedVoluntaryExitGindex)
+		})
+	case "sync_aggregate":
+		return ssz.FieldGindex(BeaconBlockBodyBellatrixGindexSyncAggregate, rest, SyncAggregateGindex)
+	case "execution_payload":
+		return ssz.FieldGindex(BeaconBlockBodyBellatrixGindexExecutionPayload, rest, ExecutionPayloadGindex)
+	}
+	return 0, ssz.ErrUnknownFieldFn("BeaconBlockBodyBellatrix", field)
+}
+
+// ProveField returns a proof of the field path in the BeaconBlockBodyBellatrix object
+func (b *BeaconBlockBodyBellatrix) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BeaconBlockBodyBellatrixGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the BeaconBlockBodyBellatrix object
+func (b *BeaconBlockBodyBellatrix) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BeaconBlockBodyBellatrixGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the BeaconStateAltair object
+func (b *BeaconStateAltair) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconStateAltair object to a target array
+func (b *BeaconStateAltair) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := b.fixedSize()
 
 	// Field (0) 'GenesisTime'
 	dst = ssz.MarshalValue(dst, b.GenesisTime)
 
 	// Field (1) 'GenesisValidatorsRoot'
 	if size := uint64(len(b.GenesisValidatorsRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.GenesisValidatorsRoot", size, 32)
+		err = ssz.ErrBytesLengthFn("BeaconStateAltair.GenesisValidatorsRoot", size, 32)
 		return
 	}
 	dst = append(dst, b.GenesisValidatorsRoot...)
@@ -5046,12 +8438,12 @@ func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Field (5) 'BlockRoots'
 	if size := uint64(len(b.BlockRoots)); size != rootsSize {
-		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.BlockRoots", size, rootsSize)
+		err = ssz.ErrVectorLengthFn("BeaconStateAltair.BlockRoots", size, rootsSize)
 		return
 	}
 	for ii := uint64(0); ii < rootsSize; ii++ {
 		if size := uint64(len(b.BlockRoots[ii])); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.BlockRoots[ii]", size, 32)
+			err = ssz.ErrBytesLengthFn("BeaconStateAltair.BlockRoots[ii]", size, 32)
 			return
 		}
 		dst = append(dst, b.BlockRoots[ii]...)
@@ -5059,12 +8451,12 @@ func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Field (6) 'StateRoots'
 	if size := uint64(len(b.StateRoots)); size != rootsSize {
-		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.StateRoots", size, rootsSize)
+		err = ssz.ErrVectorLengthFn("BeaconStateAltair.StateRoots", size, rootsSize)
 		return
 	}
 	for ii := uint64(0); ii < rootsSize; ii++ {
 		if size := uint64(len(b.StateRoots[ii])); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.StateRoots[ii]", size, 32)
+			err = ssz.ErrBytesLengthFn("BeaconStateAltair.StateRoots[ii]", size, 32)
 			return
 		}
 		dst = append(dst, b.StateRoots[ii]...)
@@ -5099,12 +8491,12 @@ func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Field (13) 'RandaoMixes'
 	if size := uint64(len(b.RandaoMixes)); size != randaoMixes {
-		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.RandaoMixes", size, randaoMixes)
+		err = ssz.ErrVectorLengthFn("BeaconStateAltair.RandaoMixes", size, randaoMixes)
 		return
 	}
 	for ii := uint64(0); ii < randaoMixes; ii++ {
 		if size := uint64(len(b.RandaoMixes[ii])); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.RandaoMixes[ii]", size, 32)
+			err = ssz.ErrBytesLengthFn("BeaconStateAltair.RandaoMixes[ii]", size, 32)
 			return
 		}
 		dst = append(dst, b.RandaoMixes[ii]...)
@@ -5112,7 +8504,7 @@ func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Field (14) 'Slashings'
 	if size := uint64(len(b.Slashings)); size != slashings {
-		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.Slashings", size, slashings)
+		err = ssz.ErrVectorLengthFn("BeaconStateAltair.Slashings", size, slashings)
 		return
 	}
 	for ii := uint64(0); ii < slashings; ii++ {
@@ -5129,7 +8521,7 @@ func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Field (17) 'JustificationBits'
 	if size := uint64(len(b.JustificationBits)); size != 1 {
-		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.JustificationBits", size, 1)
+		err = ssz.ErrBytesLengthFn("BeaconStateAltair.JustificationBits", size, 1)
 		return
 	}
 	dst = append(dst, b.JustificationBits...)
@@ -5160,7 +8552,6 @@ func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Offset (21) 'InactivityScores'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.InactivityScores) * 8
 
 	// Field (22) 'CurrentSyncCommittee'
 	if b.CurrentSyncCommittee == nil {
@@ -5178,17 +8569,14 @@ func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error)
 		return
 	}
 
-	// Offset (24) 'LatestExecutionPayloadHeader'
-	dst = ssz.WriteOffset(dst, offset)
-
 	// Field (7) 'HistoricalRoots'
 	if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
-		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.HistoricalRoots", size, 16777216)
+		err = ssz.ErrListTooBigFn("BeaconStateAltair.HistoricalRoots", size, 16777216)
 		return
 	}
 	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
 		if size := uint64(len(b.HistoricalRoots[ii])); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.HistoricalRoots[ii]", size, 32)
+			err = ssz.ErrBytesLengthFn("BeaconStateAltair.HistoricalRoots[ii]", size, 32)
 			return
 		}
 		dst = append(dst, b.HistoricalRoots[ii]...)
@@ -5196,7 +8584,7 @@ func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Field (9) 'Eth1DataVotes'
 	if size := uint64(len(b.Eth1DataVotes)); size > eth1DataVotes {
-		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Eth1DataVotes", size, eth1DataVotes)
+		err = ssz.ErrListTooBigFn("BeaconStateAltair.Eth1DataVotes", size, eth1DataVotes)
 		return
 	}
 	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
@@ -5207,7 +8595,7 @@ func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Field (11) 'Validators'
 	if size := uint64(len(b.Validators)); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Validators", size, 1099511627776)
+		err = ssz.ErrListTooBigFn("BeaconStateAltair.Validators", size, 1099511627776)
 		return
 	}
 	for ii := 0; ii < len(b.Validators); ii++ {
@@ -5218,7 +8606,7 @@ func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Field (12) 'Balances'
 	if size := uint64(len(b.Balances)); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Balances", size, 1099511627776)
+		err = ssz.ErrListTooBigFn("BeaconStateAltair.Balances", size, 1099511627776)
 		return
 	}
 	for ii := 0; ii < len(b.Balances); ii++ {
@@ -5227,1164 +8615,5406 @@ func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Field (15) 'PreviousEpochParticipation'
 	if size := uint64(len(b.PreviousEpochParticipation)); size > 1099511627776 {
-		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.PreviousEpochParticipation", size, 1099511627776)
+		err = ssz.ErrBytesLengthFn("BeaconStateAltair.PreviousEpochParticipation", size, 1099511627776)
 		return
 	}
 	dst = append(dst, b.PreviousEpochParticipation...)
 
 	// Field (16) 'CurrentEpochParticipation'
 	if size := uint64(len(b.CurrentEpochParticipation)); size > 1099511627776 {
-		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.CurrentEpochParticipation", size, 1099511627776)
+		err = ssz.ErrBytesLengthFn("BeaconStateAltair.CurrentEpochParticipation", size, 1099511627776)
 		return
 	}
 	dst = append(dst, b.CurrentEpochParticipation...)
 
 	// Field (21) 'InactivityScores'
 	if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.InactivityScores", size, 1099511627776)
+		err = ssz.ErrListTooBigFn("BeaconStateAltair.InactivityScores", size, 1099511627776)
 		return
 	}
 	for ii := 0; ii < len(b.InactivityScores); ii++ {
 		dst = ssz.MarshalValue(dst, b.InactivityScores[ii])
 	}
 
-	// Field (24) 'LatestExecutionPayloadHeader'
-	if dst, err = b.LatestExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
-		return
-	}
-
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(b, buf)
-}
-
-// UnmarshalSSZTail unmarshals the BeaconStateBellatrix object and returns the remaining bufferº
-func (b *BeaconStateBellatrix) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
-	fixedSize := b.fixedSize()
-	if size < fixedSize {
-		return nil, ssz.ErrSize
-	}
-
-	tail := buf
-	var o7, o9, o11, o12, o15, o16, o21, o24 uint64
-	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+// MarshalSSZWriter ssz marshals the BeaconStateAltair object to a writer
+func (b *BeaconStateAltair) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := b.fixedSize()
 
 	// Field (0) 'GenesisTime'
-	b.GenesisTime, buf = ssz.UnmarshallValue[uint64](buf)
+	dst = ssz.MarshalValue(dst, b.GenesisTime)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (1) 'GenesisValidatorsRoot'
-	b.GenesisValidatorsRoot, buf = ssz.UnmarshalBytes(b.GenesisValidatorsRoot, buf, 32)
+	if size := uint64(len(b.GenesisValidatorsRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconStateAltair.GenesisValidatorsRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.GenesisValidatorsRoot...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (2) 'Slot'
-	b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+	dst = ssz.MarshalValue(dst, b.Slot)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (3) 'Fork'
-	if buf, err = ssz.UnmarshalFieldTail(&b.Fork, buf); err != nil {
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if dst, err = ww.WriteObject(dst, b.Fork); err != nil {
 		return
 	}
 
 	// Field (4) 'LatestBlockHeader'
-	if buf, err = ssz.UnmarshalFieldTail(&b.LatestBlockHeader, buf); err != nil {
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if dst, err = ww.WriteObject(dst, b.LatestBlockHeader); err != nil {
 		return
 	}
 
 	// Field (5) 'BlockRoots'
-	b.BlockRoots = make([][]byte, rootsSize)
-	for ii := uint64(0); ii < rootsSize; ii++ {
-		b.BlockRoots[ii], buf = ssz.UnmarshalBytes(b.BlockRoots[ii], buf, 32)
+	if size := uint64(len(b.BlockRoots)); size != rootsSize {
+		err = ssz.ErrVectorLengthFn("BeaconStateAltair.BlockRoots", size, rootsSize)
+		return
+	}
+	for ii := 0; ii < len(b.BlockRoots); ii++ {
+		if size := uint64(len(b.BlockRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateAltair.BlockRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.BlockRoots[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (6) 'StateRoots'
-	b.StateRoots = make([][]byte, rootsSize)
-	for ii := uint64(0); ii < rootsSize; ii++ {
-		b.StateRoots[ii], buf = ssz.UnmarshalBytes(b.StateRoots[ii], buf, 32)
+	if size := uint64(len(b.StateRoots)); size != rootsSize {
+		err = ssz.ErrVectorLengthFn("BeaconStateAltair.StateRoots", size, rootsSize)
+		return
+	}
+	for ii := 0; ii < len(b.StateRoots); ii++ {
+		if size := uint64(len(b.StateRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateAltair.StateRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.StateRoots[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Offset (7) 'HistoricalRoots'
-	if o7, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.HistoricalRoots) * 32
 
 	// Field (8) 'Eth1Data'
-	if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = ww.WriteObject(dst, b.Eth1Data); err != nil {
 		return
 	}
 
 	// Offset (9) 'Eth1DataVotes'
-	if o9, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Eth1DataVotes) * 72
 
 	// Field (10) 'Eth1DepositIndex'
-	b.Eth1DepositIndex, buf = ssz.UnmarshallValue[uint64](buf)
+	dst = ssz.MarshalValue(dst, b.Eth1DepositIndex)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Offset (11) 'Validators'
-	if o11, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Validators) * 121
 
 	// Offset (12) 'Balances'
-	if o12, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Balances) * 8
 
 	// Field (13) 'RandaoMixes'
-	b.RandaoMixes = make([][]byte, randaoMixes)
-	for ii := uint64(0); ii < randaoMixes; ii++ {
-		b.RandaoMixes[ii], buf = ssz.UnmarshalBytes(b.RandaoMixes[ii], buf, 32)
+	if size := uint64(len(b.RandaoMixes)); size != randaoMixes {
+		err = ssz.ErrVectorLengthFn("BeaconStateAltair.RandaoMixes", size, randaoMixes)
+		return
+	}
+	for ii := 0; ii < len(b.RandaoMixes); ii++ {
+		if size := uint64(len(b.RandaoMixes[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateAltair.RandaoMixes[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.RandaoMixes[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (14) 'Slashings'
-	b.Slashings = ssz.Extend(b.Slashings, slashings)
-	for ii := uint64(0); ii < slashings; ii++ {
-		b.Slashings[ii], buf = ssz.UnmarshallValue[uint64](buf)
+	if size := uint64(len(b.Slashings)); size != slashings {
+		err = ssz.ErrVectorLengthFn("BeaconStateAltair.Slashings", size, slashings)
+		return
+	}
+	for ii := 0; ii < len(b.Slashings); ii++ {
+		dst = ssz.MarshalValue(dst, b.Slashings[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Offset (15) 'PreviousEpochParticipation'
-	if o15, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.PreviousEpochParticipation)
 
 	// Offset (16) 'CurrentEpochParticipation'
-	if o16, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.CurrentEpochParticipation)
 
 	// Field (17) 'JustificationBits'
-	b.JustificationBits, buf = ssz.UnmarshalBytes(b.JustificationBits, buf, 1)
+	if size := uint64(len(b.JustificationBits)); size != 1 {
+		err = ssz.ErrBytesLengthFn("BeaconStateAltair.JustificationBits", size, 1)
+		return
+	}
+	dst = append(dst, b.JustificationBits...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (18) 'PreviousJustifiedCheckpoint'
-	if buf, err = ssz.UnmarshalFieldTail(&b.PreviousJustifiedCheckpoint, buf); err != nil {
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = ww.WriteObject(dst, b.PreviousJustifiedCheckpoint); err != nil {
 		return
 	}
 
 	// Field (19) 'CurrentJustifiedCheckpoint'
-	if buf, err = ssz.UnmarshalFieldTail(&b.CurrentJustifiedCheckpoint, buf); err != nil {
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = ww.WriteObject(dst, b.CurrentJustifiedCheckpoint); err != nil {
 		return
 	}
 
 	// Field (20) 'FinalizedCheckpoint'
-	if buf, err = ssz.UnmarshalFieldTail(&b.FinalizedCheckpoint, buf); err != nil {
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = ww.WriteObject(dst, b.FinalizedCheckpoint); err != nil {
 		return
 	}
 
 	// Offset (21) 'InactivityScores'
-	if o21, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+	dst = ssz.WriteOffset(dst, offset)
 
 	// Field (22) 'CurrentSyncCommittee'
-	if buf, err = ssz.UnmarshalFieldTail(&b.CurrentSyncCommittee, buf); err != nil {
+	if b.CurrentSyncCommittee == nil {
+		b.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = ww.WriteObject(dst, b.CurrentSyncCommittee); err != nil {
 		return
 	}
 
 	// Field (23) 'NextSyncCommittee'
-	if buf, err = ssz.UnmarshalFieldTail(&b.NextSyncCommittee, buf); err != nil {
-		return
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommittee)
 	}
-
-	// Offset (24) 'LatestExecutionPayloadHeader'
-	if o24, _, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
+	if dst, err = ww.WriteObject(dst, b.NextSyncCommittee); err != nil {
+		return
 	}
 
 	// Field (7) 'HistoricalRoots'
-	if err = ssz.UnmarshalSliceWithIndexCallback(&b.HistoricalRoots, tail[o7:o9], 32, 16777216, func(ii uint64, buf []byte) (err error) {
-		b.HistoricalRoots[ii], buf = ssz.UnmarshalBytes(b.HistoricalRoots[ii], buf, 32)
-		return nil
-	}); err != nil {
-		return nil, err
+	if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
+		err = ssz.ErrListTooBigFn("BeaconStateAltair.HistoricalRoots", size, 16777216)
+		return
+	}
+	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+		if size := uint64(len(b.HistoricalRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateAltair.HistoricalRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.HistoricalRoots[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (9) 'Eth1DataVotes'
-	if err = ssz.UnmarshalSliceSSZ(&b.Eth1DataVotes, tail[o9:o11], eth1DataVotes); err != nil {
-		return nil, err
+	if size := uint64(len(b.Eth1DataVotes)); size > eth1DataVotes {
+		err = ssz.ErrListTooBigFn("BeaconStateAltair.Eth1DataVotes", size, eth1DataVotes)
+		return
+	}
+	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+		if dst, err = ww.WriteObject(dst, b.Eth1DataVotes[ii]); err != nil {
+			return
+		}
 	}
 
 	// Field (11) 'Validators'
-	if err = ssz.UnmarshalSliceSSZ(&b.Validators, tail[o11:o12], 1099511627776); err != nil {
-		return nil, err
+	if size := uint64(len(b.Validators)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconStateAltair.Validators", size, 1099511627776)
+		return
 	}
-
-	// Field (12) 'Balances'
-	if err = ssz.UnmarshalSliceWithIndexCallback(&b.Balances, tail[o12:o15], 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
-		b.Balances[ii], buf = ssz.UnmarshallValue[uint64](buf)
-		return nil
-	}); err != nil {
-		return nil, err
+	for ii := 0; ii < len(b.Validators); ii++ {
+		if dst, err = ww.WriteObject(dst, b.Validators[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (12) 'Balances'
+	if size := uint64(len(b.Balances)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconStateAltair.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Balances); ii++ {
+		dst = ssz.MarshalValue(dst, b.Balances[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (15) 'PreviousEpochParticipation'
-	if b.PreviousEpochParticipation, err = ssz.UnmarshalDynamicBytes(b.PreviousEpochParticipation, tail[o15:o16], 1099511627776); err != nil {
+	if size := uint64(len(b.PreviousEpochParticipation)); size > 1099511627776 {
+		err = ssz.ErrBytesLengthFn("BeaconStateAltair.PreviousEpochParticipation", size, 1099511627776)
+		return
+	}
+	dst = append(dst, b.PreviousEpochParticipation...)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
 
 	// Field (16) 'CurrentEpochParticipation'
-	if b.CurrentEpochParticipation, err = ssz.UnmarshalDynamicBytes(b.CurrentEpochParticipation, tail[o16:o21], 1099511627776); err != nil {
+	if size := uint64(len(b.CurrentEpochParticipation)); size > 1099511627776 {
+		err = ssz.ErrBytesLengthFn("BeaconStateAltair.CurrentEpochParticipation", size, 1099511627776)
+		return
+	}
+	dst = append(dst, b.CurrentEpochParticipation...)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
 
 	// Field (21) 'InactivityScores'
-	if err = ssz.UnmarshalSliceWithIndexCallback(&b.InactivityScores, tail[o21:o24], 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
-		b.InactivityScores[ii], buf = ssz.UnmarshallValue[uint64](buf)
-		return nil
-	}); err != nil {
-		return nil, err
+	if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconStateAltair.InactivityScores", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.InactivityScores); ii++ {
+		dst = ssz.MarshalValue(dst, b.InactivityScores[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
-	// Field (24) 'LatestExecutionPayloadHeader'
-	if err = ssz.UnmarshalField(&b.LatestExecutionPayloadHeader, tail[o24:]); err != nil {
+	if _, err = ww.Commit(dst); err != nil {
 		return
 	}
-
-	return
+	return ww.Done()
 }
 
-// fixedSize returns the fixed size of the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) fixedSize() int {
-	return int((409 + (rootsSize * 32) + (rootsSize * 32) + (randaoMixes * 32) + (slashings * 8) + (48 + (syncCommitteePubKeys * 48)) + (48 + (syncCommitteePubKeys * 48))))
+// UnmarshalSSZ ssz unmarshals the BeaconStateAltair object
+func (b *BeaconStateAltair) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(b, buf)
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) SizeSSZ() (size int) {
-	size = b.fixedSize()
-
-	// Field (7) 'HistoricalRoots'
-	size += len(b.HistoricalRoots) * 32
-
-	// Field (9) 'Eth1DataVotes'
-	size += len(b.Eth1DataVotes) * 72
-
-	// Field (11) 'Validators'
-	size += len(b.Validators) * 121
-
-	// Field (12) 'Balances'
-	size += len(b.Balances) * 8
-
-	// Field (15) 'PreviousEpochParticipation'
-	size += len(b.PreviousEpochParticipation)
-
-	// Field (16) 'CurrentEpochParticipation'
-	size += len(b.CurrentEpochParticipation)
-
-	// Field (21) 'InactivityScores'
-	size += len(b.InactivityScores) * 8
-
-	// Field (24) 'LatestExecutionPayloadHeader'
-	if b.LatestExecutionPayloadHeader == nil {
-		b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeader)
+// UnmarshalSSZTail unmarshals the BeaconStateAltair object and returns the remaining bufferº
+func (b *BeaconStateAltair) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
 	}
-	size += b.LatestExecutionPayloadHeader.SizeSSZ()
-
-	return
-}
-
-// HashTreeRoot ssz hashes the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
-}
 
-// HashTreeRootWith ssz hashes the BeaconStateBellatrix object with a hasher
-func (b *BeaconStateBellatrix) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+	tail := buf
+	var o7, o9, o11, o12, o15, o16, o21 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
 
 	// Field (0) 'GenesisTime'
-	hh.PutUint64(b.GenesisTime)
+	b.GenesisTime, buf = ssz.UnmarshallValue[uint64](buf)
 
 	// Field (1) 'GenesisValidatorsRoot'
-	if size := uint64(len(b.GenesisValidatorsRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.GenesisValidatorsRoot", size, 32)
-		return
-	}
-	hh.PutBytes(b.GenesisValidatorsRoot)
+	b.GenesisValidatorsRoot, buf = ssz.UnmarshalBytes(b.GenesisValidatorsRoot, buf, 32)
 
 	// Field (2) 'Slot'
-	hh.PutUint64(b.Slot)
+	b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
 
 	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if err = b.Fork.HashTreeRootWith(hh); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&b.Fork, buf); err != nil {
 		return
 	}
 
 	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&b.LatestBlockHeader, buf); err != nil {
 		return
 	}
 
 	// Field (5) 'BlockRoots'
-	{
-		if size := uint64(len(b.BlockRoots)); size != rootsSize {
-			err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.BlockRoots", size, rootsSize)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.BlockRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
+	b.BlockRoots = make([][]byte, rootsSize)
+	for ii := uint64(0); ii < rootsSize; ii++ {
+		b.BlockRoots[ii], buf = ssz.UnmarshalBytes(b.BlockRoots[ii], buf, 32)
 	}
 
 	// Field (6) 'StateRoots'
-	{
-		if size := uint64(len(b.StateRoots)); size != rootsSize {
-			err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.StateRoots", size, rootsSize)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.StateRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
+	b.StateRoots = make([][]byte, rootsSize)
+	for ii := uint64(0); ii < rootsSize; ii++ {
+		b.StateRoots[ii], buf = ssz.UnmarshalBytes(b.StateRoots[ii], buf, 32)
 	}
 
-	// Field (7) 'HistoricalRoots'
-	{
-		if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
-			err = ssz.ErrListTooBigFn("BeaconStateBellatrix.HistoricalRoots", size, 16777216)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.HistoricalRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		numItems := uint64(len(b.HistoricalRoots))
-		hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
+	// Offset (7) 'HistoricalRoots'
+	if o7, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
 	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
 		return
 	}
 
-	// Field (9) 'Eth1DataVotes'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Eth1DataVotes))
-		if num > eth1DataVotes {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Eth1DataVotes {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, eth1DataVotes)
+	// Offset (9) 'Eth1DataVotes'
+	if o9, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
 	// Field (10) 'Eth1DepositIndex'
-	hh.PutUint64(b.Eth1DepositIndex)
+	b.Eth1DepositIndex, buf = ssz.UnmarshallValue[uint64](buf)
 
-	// Field (11) 'Validators'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Validators))
-		if num > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Validators {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+	// Offset (11) 'Validators'
+	if o11, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
-	// Field (12) 'Balances'
-	{
-		if size := uint64(len(b.Balances)); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Balances", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Balances {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.Balances))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	// Offset (12) 'Balances'
+	if o12, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
 	// Field (13) 'RandaoMixes'
-	{
-		if size := uint64(len(b.RandaoMixes)); size != randaoMixes {
-			err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.RandaoMixes", size, randaoMixes)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.RandaoMixes {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
+	b.RandaoMixes = make([][]byte, randaoMixes)
+	for ii := uint64(0); ii < randaoMixes; ii++ {
+		b.RandaoMixes[ii], buf = ssz.UnmarshalBytes(b.RandaoMixes[ii], buf, 32)
 	}
 
 	// Field (14) 'Slashings'
-	{
-		if size := uint64(len(b.Slashings)); size != slashings {
-			err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.Slashings", size, slashings)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Slashings {
-			hh.AppendUint64(i)
-		}
-		hh.Merkleize(subIndx)
+	b.Slashings = ssz.Extend(b.Slashings, slashings)
+	for ii := uint64(0); ii < slashings; ii++ {
+		b.Slashings[ii], buf = ssz.UnmarshallValue[uint64](buf)
 	}
 
-	// Field (15) 'PreviousEpochParticipation'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(b.PreviousEpochParticipation))
-		if byteLen > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(b.PreviousEpochParticipation)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+	// Offset (15) 'PreviousEpochParticipation'
+	if o15, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
-	// Field (16) 'CurrentEpochParticipation'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(b.CurrentEpochParticipation))
-		if byteLen > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(b.CurrentEpochParticipation)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+	// Offset (16) 'CurrentEpochParticipation'
+	if o16, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
 	// Field (17) 'JustificationBits'
-	if size := uint64(len(b.JustificationBits)); size != 1 {
-		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.JustificationBits", size, 1)
-		return
-	}
-	hh.PutBytes(b.JustificationBits)
+	b.JustificationBits, buf = ssz.UnmarshalBytes(b.JustificationBits, buf, 1)
 
 	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&b.PreviousJustifiedCheckpoint, buf); err != nil {
 		return
 	}
 
 	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&b.CurrentJustifiedCheckpoint, buf); err != nil {
 		return
 	}
 
 	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&b.FinalizedCheckpoint, buf); err != nil {
 		return
 	}
 
-	// Field (21) 'InactivityScores'
-	{
-		if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconStateBellatrix.InactivityScores", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.InactivityScores {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.InactivityScores))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	// Offset (21) 'InactivityScores'
+	if o21, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
 	}
 
 	// Field (22) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&b.CurrentSyncCommittee, buf); err != nil {
 		return
 	}
 
 	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&b.NextSyncCommittee, buf); err != nil {
 		return
 	}
 
-	// Field (24) 'LatestExecutionPayloadHeader'
-	if err = b.LatestExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
-		return
+	// Field (7) 'HistoricalRoots'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.HistoricalRoots, tail[o7:o9], 32, 16777216, func(ii uint64, buf []byte) (err error) {
+		b.HistoricalRoots[ii], buf = ssz.UnmarshalBytes(b.HistoricalRoots[ii], buf, 32)
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	hh.Merkleize(indx)
-	return
-}
-
-// GetTree ssz hashes the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
-}
-
-// MarshalSSZ ssz marshals the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
-}
+	// Field (9) 'Eth1DataVotes'
+	if err = ssz.UnmarshalSliceSSZ(&b.Eth1DataVotes, tail[o9:o11], eth1DataVotes); err != nil {
+		return nil, err
+	}
 
-// MarshalSSZTo ssz marshals the SignedBeaconBlockHeader object to a target array
-func (s *SignedBeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	// Field (11) 'Validators'
+	if err = ssz.UnmarshalSliceSSZ(&b.Validators, tail[o11:o12], 1099511627776); err != nil {
+		return nil, err
+	}
 
-	// Field (0) 'Header'
-	if s.Header == nil {
-		s.Header = new(BeaconBlockHeader)
+	// Field (12) 'Balances'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.Balances, tail[o12:o15], 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+		b.Balances[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		return nil
+	}); err != nil {
+		return nil, err
 	}
-	if dst, err = s.Header.MarshalSSZTo(dst); err != nil {
+
+	// Field (15) 'PreviousEpochParticipation'
+	if b.PreviousEpochParticipation, err = ssz.UnmarshalDynamicBytes(b.PreviousEpochParticipation, tail[o15:o16], 1099511627776); err != nil {
 		return
 	}
 
-	// Field (1) 'Signature'
-	if size := uint64(len(s.Signature)); size != 96 {
-		err = ssz.ErrBytesLengthFn("SignedBeaconBlockHeader.Signature", size, 96)
+	// Field (16) 'CurrentEpochParticipation'
+	if b.CurrentEpochParticipation, err = ssz.UnmarshalDynamicBytes(b.CurrentEpochParticipation, tail[o16:o21], 1099511627776); err != nil {
 		return
 	}
-	dst = append(dst, s.Signature...)
 
-	return
-}
+	// Field (21) 'InactivityScores'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.InactivityScores, tail[o21:], 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+		b.InactivityScores[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
 
-// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(s, buf)
+	return
 }
 
-// UnmarshalSSZTail unmarshals the SignedBeaconBlockHeader object and returns the remaining bufferº
-func (s *SignedBeaconBlockHeader) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
-	fixedSize := s.fixedSize()
+// UnmarshalSSZReader ssz unmarshals the BeaconStateAltair object from a reader with the size of the encoding
+func (b *BeaconStateAltair) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
 	if size < fixedSize {
-		return nil, ssz.ErrSize
+		return ssz.ErrSize
 	}
 
-	// Field (0) 'Header'
-	if buf, err = ssz.UnmarshalFieldTail(&s.Header, buf); err != nil {
-		return
-	}
+	var o7, o9, o11, o12, o15, o16, o21 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
 
-	// Field (1) 'Signature'
-	s.Signature, buf = ssz.UnmarshalBytes(s.Signature, buf, 96)
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'GenesisTime'
+		b.GenesisTime, buf = ssz.UnmarshallValue[uint64](buf)
 
-	return buf, nil
-}
+		// Field (1) 'GenesisValidatorsRoot'
+		b.GenesisValidatorsRoot, buf = ssz.UnmarshalBytes(b.GenesisValidatorsRoot, buf, 32)
 
-// fixedSize returns the fixed size of the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) fixedSize() int {
-	return int(208)
-}
+		// Field (2) 'Slot'
+		b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) SizeSSZ() (size int) {
-	size = s.fixedSize()
-	return
-}
+		// Field (3) 'Fork'
+		if buf, err = ssz.UnmarshalFieldTail(&b.Fork, buf); err != nil {
+			return
+		}
 
-// HashTreeRoot ssz hashes the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
-}
+		// Field (4) 'LatestBlockHeader'
+		if buf, err = ssz.UnmarshalFieldTail(&b.LatestBlockHeader, buf); err != nil {
+			return
+		}
 
-// HashTreeRootWith ssz hashes the SignedBeaconBlockHeader object with a hasher
-func (s *SignedBeaconBlockHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+		// Field (5) 'BlockRoots'
+		b.BlockRoots = make([][]byte, rootsSize)
+		for ii := uint64(0); ii < rootsSize; ii++ {
+			b.BlockRoots[ii], buf = ssz.UnmarshalBytes(b.BlockRoots[ii], buf, 32)
+		}
 
-	// Field (0) 'Header'
-	if s.Header == nil {
-		s.Header = new(BeaconBlockHeader)
-	}
-	if err = s.Header.HashTreeRootWith(hh); err != nil {
-		return
-	}
+		// Field (6) 'StateRoots'
+		b.StateRoots = make([][]byte, rootsSize)
+		for ii := uint64(0); ii < rootsSize; ii++ {
+			b.StateRoots[ii], buf = ssz.UnmarshalBytes(b.StateRoots[ii], buf, 32)
+		}
 
-	// Field (1) 'Signature'
-	if size := uint64(len(s.Signature)); size != 96 {
-		err = ssz.ErrBytesLengthFn("SignedBeaconBlockHeader.Signature", size, 96)
-		return
-	}
-	hh.PutBytes(s.Signature)
+		// Offset (7) 'HistoricalRoots'
+		if o7, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (8) 'Eth1Data'
+		if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
+			return
+		}
 
-	hh.Merkleize(indx)
-	return
-}
+		// Offset (9) 'Eth1DataVotes'
+		if o9, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (10) 'Eth1DepositIndex'
+		b.Eth1DepositIndex, buf = ssz.UnmarshallValue[uint64](buf)
 
-// GetTree ssz hashes the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(s)
-}
+		// Offset (11) 'Validators'
+		if o11, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (12) 'Balances'
+		if o12, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (13) 'RandaoMixes'
+		b.RandaoMixes = make([][]byte, randaoMixes)
+		for ii := uint64(0); ii < randaoMixes; ii++ {
+			b.RandaoMixes[ii], buf = ssz.UnmarshalBytes(b.RandaoMixes[ii], buf, 32)
+		}
 
-// MarshalSSZ ssz marshals the BeaconBlockHeader object
-func (b *BeaconBlockHeader) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
+		// Field (14) 'Slashings'
+		b.Slashings = ssz.Extend(b.Slashings, slashings)
+		for ii := uint64(0); ii < slashings; ii++ {
+			b.Slashings[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		}
 
-// MarshalSSZTo ssz marshals the BeaconBlockHeader object to a target array
-func (b *BeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+		// Offset (15) 'PreviousEpochParticipation'
+		if o15, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (16) 'CurrentEpochParticipation'
+		if o16, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (17) 'JustificationBits'
+		b.JustificationBits, buf = ssz.UnmarshalBytes(b.JustificationBits, buf, 1)
 
-	// Field (0) 'Slot'
-	dst = ssz.MarshalValue(dst, b.Slot)
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if buf, err = ssz.UnmarshalFieldTail(&b.PreviousJustifiedCheckpoint, buf); err != nil {
+			return
+		}
 
-	// Field (1) 'ProposerIndex'
-	dst = ssz.MarshalValue(dst, b.ProposerIndex)
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if buf, err = ssz.UnmarshalFieldTail(&b.CurrentJustifiedCheckpoint, buf); err != nil {
+			return
+		}
 
-	// Field (2) 'ParentRoot'
-	if size := uint64(len(b.ParentRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.ParentRoot", size, 32)
+		// Field (20) 'FinalizedCheckpoint'
+		if buf, err = ssz.UnmarshalFieldTail(&b.FinalizedCheckpoint, buf); err != nil {
+			return
+		}
+
+		// Offset (21) 'InactivityScores'
+		if o21, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (22) 'CurrentSyncCommittee'
+		if buf, err = ssz.UnmarshalFieldTail(&b.CurrentSyncCommittee, buf); err != nil {
+			return
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if buf, err = ssz.UnmarshalFieldTail(&b.NextSyncCommittee, buf); err != nil {
+			return
+		}
+
+		return buf, nil
+	}); err != nil {
 		return
 	}
-	dst = append(dst, b.ParentRoot...)
 
-	// Field (3) 'StateRoot'
-	if size := uint64(len(b.StateRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.StateRoot", size, 32)
+	// Field (7) 'HistoricalRoots'
+	if o9-o7 > 536870912 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o9-o7), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&b.HistoricalRoots, buf, 32, 16777216, func(ii uint64, buf []byte) (err error) {
+			b.HistoricalRoots[ii], buf = ssz.UnmarshalBytes(b.HistoricalRoots[ii], buf, 32)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
 		return
 	}
-	dst = append(dst, b.StateRoot...)
 
-	// Field (4) 'BodyRoot'
-	if size := uint64(len(b.BodyRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.BodyRoot", size, 32)
+	// Field (9) 'Eth1DataVotes'
+	if err = ssz.ReadSliceSSZ(rr, &b.Eth1DataVotes, int(o11-o9), eth1DataVotes); err != nil {
 		return
 	}
-	dst = append(dst, b.BodyRoot...)
 
-	return
-}
+	// Field (11) 'Validators'
+	if err = ssz.ReadSliceSSZ(rr, &b.Validators, int(o12-o11), 1099511627776); err != nil {
+		return
+	}
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockHeader object
-func (b *BeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(b, buf)
-}
-
-// UnmarshalSSZTail unmarshals the BeaconBlockHeader object and returns the remaining bufferº
-func (b *BeaconBlockHeader) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
-	fixedSize := b.fixedSize()
-	if size < fixedSize {
-		return nil, ssz.ErrSize
+	// Field (12) 'Balances'
+	if o15-o12 > 8796093022208 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o15-o12), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&b.Balances, buf, 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+			b.Balances[ii], buf = ssz.UnmarshallValue[uint64](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
 	}
 
-	// Field (0) 'Slot'
-	b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
-
-	// Field (1) 'ProposerIndex'
-	b.ProposerIndex, buf = ssz.UnmarshallValue[uint64](buf)
-
-	// Field (2) 'ParentRoot'
-	b.ParentRoot, buf = ssz.UnmarshalBytes(b.ParentRoot, buf, 32)
+	// Field (15) 'PreviousEpochParticipation'
+	if o16-o15 > 1099511627776 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o16-o15), func(buf []byte) (_ []byte, err error) {
+		if b.PreviousEpochParticipation, err = ssz.UnmarshalDynamicBytes(b.PreviousEpochParticipation, buf, 1099511627776); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
 
-	// Field (3) 'StateRoot'
-	b.StateRoot, buf = ssz.UnmarshalBytes(b.StateRoot, buf, 32)
+	// Field (16) 'CurrentEpochParticipation'
+	if o21-o16 > 1099511627776 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o21-o16), func(buf []byte) (_ []byte, err error) {
+		if b.CurrentEpochParticipation, err = ssz.UnmarshalDynamicBytes(b.CurrentEpochParticipation, buf, 1099511627776); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
 
-	// Field (4) 'BodyRoot'
-	b.BodyRoot, buf = ssz.UnmarshalBytes(b.BodyRoot, buf, 32)
+	// Field (21) 'InactivityScores'
+	if uint64(size-int(o21)) > 8796093022208 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o21), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&b.InactivityScores, buf, 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+			b.InactivityScores[ii], buf = ssz.UnmarshallValue[uint64](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
 
-	return buf, nil
+	return
 }
 
-// fixedSize returns the fixed size of the BeaconBlockHeader object
-func (b *BeaconBlockHeader) fixedSize() int {
-	return int(112)
+// fixedSize returns the fixed size of the BeaconStateAltair object
+func (b *BeaconStateAltair) fixedSize() int {
+	return int((405 + (rootsSize * 32) + (rootsSize * 32) + (randaoMixes * 32) + (slashings * 8) + (48 + (syncCommitteePubKeys * 48)) + (48 + (syncCommitteePubKeys * 48))))
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockHeader object
-func (b *BeaconBlockHeader) SizeSSZ() (size int) {
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateAltair object
+func (b *BeaconStateAltair) SizeSSZ() (size int) {
 	size = b.fixedSize()
-	return
-}
 
-// HashTreeRoot ssz hashes the BeaconBlockHeader object
-func (b *BeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
-}
+	// Field (7) 'HistoricalRoots'
+	size += len(b.HistoricalRoots) * 32
 
-// HashTreeRootWith ssz hashes the BeaconBlockHeader object with a hasher
-func (b *BeaconBlockHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+	// Field (9) 'Eth1DataVotes'
+	size += len(b.Eth1DataVotes) * 72
 
-	// Field (0) 'Slot'
-	hh.PutUint64(b.Slot)
+	// Field (11) 'Validators'
+	size += len(b.Validators) * 121
 
-	// Field (1) 'ProposerIndex'
-	hh.PutUint64(b.ProposerIndex)
+	// Field (12) 'Balances'
+	size += len(b.Balances) * 8
 
-	// Field (2) 'ParentRoot'
-	if size := uint64(len(b.ParentRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.ParentRoot", size, 32)
-		return
-	}
-	hh.PutBytes(b.ParentRoot)
+	// Field (15) 'PreviousEpochParticipation'
+	size += len(b.PreviousEpochParticipation)
 
-	// Field (3) 'StateRoot'
-	if size := uint64(len(b.StateRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.StateRoot", size, 32)
-		return
-	}
-	hh.PutBytes(b.StateRoot)
+	// Field (16) 'CurrentEpochParticipation'
+	size += len(b.CurrentEpochParticipation)
 
-	// Field (4) 'BodyRoot'
-	if size := uint64(len(b.BodyRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.BodyRoot", size, 32)
-		return
-	}
-	hh.PutBytes(b.BodyRoot)
+	// Field (21) 'InactivityScores'
+	size += len(b.InactivityScores) * 8
 
-	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the BeaconBlockHeader object
-func (b *BeaconBlockHeader) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
+// HashTreeRoot ssz hashes the BeaconStateAltair object
+func (b *BeaconStateAltair) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
 }
 
-// MarshalSSZ ssz marshals the ErrorResponse object
-func (e *ErrorResponse) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+// HashTreeRootCtx ssz hashes the BeaconStateAltair object and stops with the error of the context once it is done
+func (b *BeaconStateAltair) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
 }
 
-// MarshalSSZTo ssz marshals the ErrorResponse object to a target array
-func (e *ErrorResponse) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := e.fixedSize()
+// HashTreeRootWith ssz hashes the BeaconStateAltair object with a hasher
+func (b *BeaconStateAltair) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Offset (0) 'Message'
-	dst = ssz.WriteOffset(dst, offset)
+	// Field (0) 'GenesisTime'
+	hh.PutUint64(b.GenesisTime)
 
-	// Field (0) 'Message'
-	if size := uint64(len(e.Message)); size > 256 {
-		err = ssz.ErrBytesLengthFn("ErrorResponse.Message", size, 256)
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := uint64(len(b.GenesisValidatorsRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconStateAltair.GenesisValidatorsRoot", size, 32)
 		return
 	}
-	dst = append(dst, e.Message...)
-
-	return
-}
+	hh.PutBytes(b.GenesisValidatorsRoot)
 
-// UnmarshalSSZ ssz unmarshals the ErrorResponse object
-func (e *ErrorResponse) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(e, buf)
-}
+	// Field (2) 'Slot'
+	hh.PutUint64(b.Slot)
 
-// UnmarshalSSZTail unmarshals the ErrorResponse object and returns the remaining bufferº
-func (e *ErrorResponse) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
-	fixedSize := e.fixedSize()
-	if size < fixedSize {
-		return nil, ssz.ErrSize
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
 	}
-
-	tail := buf
-	var o0 uint64
-	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
-
-	// Offset (0) 'Message'
-	if o0, _, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
+	if err = b.Fork.HashTreeRootWith(hh); err != nil {
+		return
 	}
 
-	// Field (0) 'Message'
-	if e.Message, err = ssz.UnmarshalDynamicBytes(e.Message, tail[o0:], 256); err != nil {
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	return
-}
-
-// fixedSize returns the fixed size of the ErrorResponse object
-func (e *ErrorResponse) fixedSize() int {
-	return int(4)
-}
-
-// SizeSSZ returns the ssz encoded size in bytes for the ErrorResponse object
-func (e *ErrorResponse) SizeSSZ() (size int) {
-	size = e.fixedSize()
-
-	// Field (0) 'Message'
-	size += len(e.Message)
-
-	return
-}
-
-// HashTreeRoot ssz hashes the ErrorResponse object
-func (e *ErrorResponse) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(e)
-}
+	// Field (5) 'BlockRoots'
+	{
+		if size := uint64(len(b.BlockRoots)); size != rootsSize {
+			err = ssz.ErrVectorLengthFn("BeaconStateAltair.BlockRoots", size, rootsSize)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.BlockRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
 
-// HashTreeRootWith ssz hashes the ErrorResponse object with a hasher
-func (e *ErrorResponse) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+	// Field (6) 'StateRoots'
+	{
+		if size := uint64(len(b.StateRoots)); size != rootsSize {
+			err = ssz.ErrVectorLengthFn("BeaconStateAltair.StateRoots", size, rootsSize)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.StateRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
 
-	// Field (0) 'Message'
+	// Field (7) 'HistoricalRoots'
 	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(e.Message))
-		if byteLen > 256 {
-			err = ssz.ErrIncorrectListSize
+		if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
+			err = ssz.ErrListTooBigFn("BeaconStateAltair.HistoricalRoots", size, 16777216)
 			return
 		}
-		hh.Append(e.Message)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+		subIndx := hh.Index()
+		for _, i := range b.HistoricalRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		numItems := uint64(len(b.HistoricalRoots))
+		hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
 	}
 
-	hh.Merkleize(indx)
-	return
-}
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
 
-// GetTree ssz hashes the ErrorResponse object
-func (e *ErrorResponse) GetTree() (*ssz.Node, error) {
+	// Field (9) 'Eth1DataVotes'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Eth1DataVotes))
+		if num > eth1DataVotes {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = ssz.PutObjects(hh, b.Eth1DataVotes); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, eth1DataVotes)
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	hh.PutUint64(b.Eth1DepositIndex)
+
+	// Field (11) 'Validators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Validators))
+		if num > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = ssz.PutObjects(hh, b.Validators); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+	}
+
+	// Field (12) 'Balances'
+	{
+		if size := uint64(len(b.Balances)); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconStateAltair.Balances", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Balances {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.Balances))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (13) 'RandaoMixes'
+	{
+		if size := uint64(len(b.RandaoMixes)); size != randaoMixes {
+			err = ssz.ErrVectorLengthFn("BeaconStateAltair.RandaoMixes", size, randaoMixes)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.RandaoMixes {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (14) 'Slashings'
+	{
+		if size := uint64(len(b.Slashings)); size != slashings {
+			err = ssz.ErrVectorLengthFn("BeaconStateAltair.Slashings", size, slashings)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Slashings {
+			hh.AppendUint64(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.PreviousEpochParticipation))
+		if byteLen > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(b.PreviousEpochParticipation)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.CurrentEpochParticipation))
+		if byteLen > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(b.CurrentEpochParticipation)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+	}
+
+	// Field (17) 'JustificationBits'
+	if size := uint64(len(b.JustificationBits)); size != 1 {
+		err = ssz.ErrBytesLengthFn("BeaconStateAltair.JustificationBits", size, 1)
+		return
+	}
+	hh.PutBytes(b.JustificationBits)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (21) 'InactivityScores'
+	{
+		if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconStateAltair.InactivityScores", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.InactivityScores {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.InactivityScores))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (22) 'CurrentSyncCommittee'
+	if b.CurrentSyncCommittee == nil {
+		b.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommittee)
+	}
+	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BeaconStateAltair object
+func (b *BeaconStateAltair) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// GetTreeCtx ssz hashes the BeaconStateAltair object and stops with the error of the context once it is done
+func (b *BeaconStateAltair) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
+}
+
+// Generalized indices of the BeaconStateAltair fields
+const (
+	BeaconStateAltairGindexGenesisTime                 = 32
+	BeaconStateAltairGindexGenesisValidatorsRoot       = 33
+	BeaconStateAltairGindexSlot                        = 34
+	BeaconStateAltairGindexFork                        = 35
+	BeaconStateAltairGindexLatestBlockHeader           = 36
+	BeaconStateAltairGindexBlockRoots                  = 37
+	BeaconStateAltairGindexStateRoots                  = 38
+	BeaconStateAltairGindexHistoricalRoots             = 39
+	BeaconStateAltairGindexEth1Data                    = 40
+	BeaconStateAltairGindexEth1DataVotes               = 41
+	BeaconStateAltairGindexEth1DepositIndex            = 42
+	BeaconStateAltairGindexValidators                  = 43
+	BeaconStateAltairGindexBalances                    = 44
+	BeaconStateAltairGindexRandaoMixes                 = 45
+	BeaconStateAltairGindexSlashings                   = 46
+	BeaconStateAltairGindexPreviousEpochParticipation  = 47
+	BeaconStateAltairGindexCurrentEpochParticipation   = 48
+	BeaconStateAltairGindexJustificationBits           = 49
+	BeaconStateAltairGindexPreviousJustifiedCheckpoint = 50
+	BeaconStateAltairGindexCurrentJustifiedCheckpoint  = 51
+	BeaconStateAltairGindexFinalizedCheckpoint         = 52
+	BeaconStateAltairGindexInactivityScores            = 53
+	BeaconStateAltairGindexCurrentSyncCommittee        = 54
+	BeaconStateAltairGindexNextSyncCommittee           = 55
+)
+
+// BeaconStateAltairGindexBlockRootsElem returns the generalized index of the chunk of the i-th element of the BlockRoots field,
+// or zero if it does not fit in an int
+func BeaconStateAltairGindexBlockRootsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateAltairGindexBlockRoots, ssz.VectorElemGindex(i, rootsSize, 0))
+}
+
+// BeaconStateAltairGindexStateRootsElem returns the generalized index of the chunk of the i-th element of the StateRoots field,
+// or zero if it does not fit in an int
+func BeaconStateAltairGindexStateRootsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateAltairGindexStateRoots, ssz.VectorElemGindex(i, rootsSize, 0))
+}
+
+// BeaconStateAltairGindexHistoricalRootsElem returns the generalized index of the chunk of the i-th element of the HistoricalRoots field,
+// or zero if it does not fit in an int
+func BeaconStateAltairGindexHistoricalRootsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateAltairGindexHistoricalRoots, ssz.ListElemGindex(i, 16777216, 0))
+}
+
+// BeaconStateAltairGindexEth1DataVotesElem returns the generalized index of the chunk of the i-th element of the Eth1DataVotes field,
+// or zero if it does not fit in an int
+func BeaconStateAltairGindexEth1DataVotesElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateAltairGindexEth1DataVotes, ssz.ListElemGindex(i, eth1DataVotes, 0))
+}
+
+// BeaconStateAltairGindexValidatorsElem returns the generalized index of the chunk of the i-th element of the Validators field,
+// or zero if it does not fit in an int
+func BeaconStateAltairGindexValidatorsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateAltairGindexValidators, ssz.ListElemGindex(i, 1099511627776, 0))
+}
+
+// BeaconStateAltairGindexBalancesElem returns the generalized index of the chunk of the i-th element of the Balances field,
+// or zero if it does not fit in an int
+func BeaconStateAltairGindexBalancesElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateAltairGindexBalances, ssz.ListElemGindex(i, 1099511627776, 8))
+}
+
+// BeaconStateAltairGindexRandaoMixesElem returns the generalized index of the chunk of the i-th element of the RandaoMixes field,
+// or zero if it does not fit in an int
+func BeaconStateAltairGindexRandaoMixesElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateAltairGindexRandaoMixes, ssz.VectorElemGindex(i, randaoMixes, 0))
+}
+
+// BeaconStateAltairGindexSlashingsElem returns the generalized index of the chunk of the i-th element of the Slashings field,
+// or zero if it does not fit in an int
+func BeaconStateAltairGindexSlashingsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateAltairGindexSlashings, ssz.VectorElemGindex(i, slashings, 8))
+}
+
+// BeaconStateAltairGindexInactivityScoresElem returns the generalized index of the chunk of the i-th element of the InactivityScores field,
+// or zero if it does not fit in an int
+func BeaconStateAltairGindexInactivityScoresElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateAltairGindexInactivityScores, ssz.ListElemGindex(i, 1099511627776, 8))
+}
+
+// BeaconStateAltairGindex returns the generalized index of a field path in the BeaconStateAltair object
+func BeaconStateAltairGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "genesis_time":
+		return ssz.FieldGindex(BeaconStateAltairGindexGenesisTime, rest, nil)
+	case "genesis_validators_root":
+		return ssz.FieldGindex(BeaconStateAltairGindexGenesisValidatorsRoot, rest, nil)
+	case "slot":
+		return ssz.FieldGindex(BeaconStateAltairGindexSlot, rest, nil)
+	case "fork":
+		return ssz.FieldGindex(BeaconStateAltairGindexFork, rest, ForkGindex)
+	case "latest_block_header":
+		return ssz.FieldGindex(BeaconStateAltairGindexLatestBlockHeader, rest, BeaconBlockHeaderGindex)
+	case "block_roots":
+		return ssz.FieldGindex(BeaconStateAltairGindexBlockRoots, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, rootsSize, 0, nil)
+		})
+	case "state_roots":
+		return ssz.FieldGindex(BeaconStateAltairGindexStateRoots, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, rootsSize, 0, nil)
+		})
+	case "historical_roots":
+		return ssz.FieldGindex(BeaconStateAltairGindexHistoricalRoots, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16777216, 0, nil)
+		})
+	case "eth1_data":
+		return ssz.FieldGindex(BeaconStateAltairGindexEth1Data, rest, Eth1DataGindex)
+	case "eth1_data_votes":
+		return ssz.FieldGindex(BeaconStateAltairGindexEth1DataVotes, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, eth1DataVotes, 0, Eth1DataGindex)
+		})
+	case "eth1_deposit_index":
+		return ssz.FieldGindex(BeaconStateAltairGindexEth1DepositIndex, rest, nil)
+	case "validators":
+		return ssz.FieldGindex(BeaconStateAltairGindexValidators, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1099511627776, 0, ValidatorGindex)
+		})
+	case "balances":
+		return ssz.FieldGindex(BeaconStateAltairGindexBalances, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1099511627776, 8, nil)
+		})
+	case "randao_mixes":
+		return ssz.FieldGindex(BeaconStateAltairGindexRandaoMixes, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, randaoMixes, 0, nil)
+		})
+	case "slashings":
+		return ssz.FieldGindex(BeaconStateAltairGindexSlashings, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, slashings, 8, nil)
+		})
+	case "previous_epoch_participation":
+		return ssz.FieldGindex(BeaconStateAltairGindexPreviousEpochParticipation, rest, nil)
+	case "current_epoch_participation":
+		return ssz.FieldGindex(BeaconStateAltairGindexCurrentEpochParticipation, rest, nil)
+	case "justification_bits":
+		return ssz.FieldGindex(BeaconStateAltairGindexJustificationBits, rest, nil)
+	case "previous_justified_checkpoint":
+		return ssz.FieldGindex(BeaconStateAltairGindexPreviousJustifiedCheckpoint, rest, CheckpointGindex)
+	case "current_justified_checkpoint":
+		return ssz.FieldGindex(BeaconStateAltairGindexCurrentJustifiedCheckpoint, rest, CheckpointGindex)
+	case "finalized_checkpoint":
+		return ssz.FieldGindex(BeaconStateAltairGindexFinalizedCheckpoint, rest, CheckpointGindex)
+	case "inactivity_scores":
+		return ssz.FieldGindex(BeaconStateAltairGindexInactivityScores, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1099511627776, 8, nil)
+		})
+	case "current_sync_committee":
+		return ssz.FieldGindex(BeaconStateAltairGindexCurrentSyncCommittee, rest, SyncCommitteeGindex)
+	case "next_sync_committee":
+		return ssz.FieldGindex(BeaconStateAltairGindexNextSyncCommittee, rest, SyncCommitteeGindex)
+	}
+	return 0, ssz.ErrUnknownFieldFn("BeaconStateAltair", field)
+}
+
+// ProveField returns a proof of the field path in the BeaconStateAltair object
+func (b *BeaconStateAltair) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BeaconStateAltairGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the BeaconStateAltair object
+func (b *BeaconStateAltair) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BeaconStateAltairGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconStateBellatrix object to a target array
+func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := b.fixedSize()
+
+	// Field (0) 'GenesisTime'
+	dst = ssz.MarshalValue(dst, b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := uint64(len(b.GenesisValidatorsRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.GenesisValidatorsRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.GenesisValidatorsRoot...)
+
+	// Field (2) 'Slot'
+	dst = ssz.MarshalValue(dst, b.Slot)
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	if size := uint64(len(b.BlockRoots)); size != rootsSize {
+		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.BlockRoots", size, rootsSize)
+		return
+	}
+	for ii := uint64(0); ii < rootsSize; ii++ {
+		if size := uint64(len(b.BlockRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.BlockRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.BlockRoots[ii]...)
+	}
+
+	// Field (6) 'StateRoots'
+	if size := uint64(len(b.StateRoots)); size != rootsSize {
+		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.StateRoots", size, rootsSize)
+		return
+	}
+	for ii := uint64(0); ii < rootsSize; ii++ {
+		if size := uint64(len(b.StateRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.StateRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.StateRoots[ii]...)
+	}
+
+	// Offset (7) 'HistoricalRoots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.HistoricalRoots) * 32
+
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (9) 'Eth1DataVotes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Eth1DataVotes) * 72
+
+	// Field (10) 'Eth1DepositIndex'
+	dst = ssz.MarshalValue(dst, b.Eth1DepositIndex)
+
+	// Offset (11) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Validators) * 121
+
+	// Offset (12) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Balances) * 8
+
+	// Field (13) 'RandaoMixes'
+	if size := uint64(len(b.RandaoMixes)); size != randaoMixes {
+		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.RandaoMixes", size, randaoMixes)
+		return
+	}
+	for ii := uint64(0); ii < randaoMixes; ii++ {
+		if size := uint64(len(b.RandaoMixes[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.RandaoMixes[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.RandaoMixes[ii]...)
+	}
+
+	// Field (14) 'Slashings'
+	if size := uint64(len(b.Slashings)); size != slashings {
+		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.Slashings", size, slashings)
+		return
+	}
+	for ii := uint64(0); ii < slashings; ii++ {
+		dst = ssz.MarshalValue(dst, b.Slashings[ii])
+	}
+
+	// Offset (15) 'PreviousEpochParticipation'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.PreviousEpochParticipation)
+
+	// Offset (16) 'CurrentEpochParticipation'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.CurrentEpochParticipation)
+
+	// Field (17) 'JustificationBits'
+	if size := uint64(len(b.JustificationBits)); size != 1 {
+		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.JustificationBits", size, 1)
+		return
+	}
+	dst = append(dst, b.JustificationBits...)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (21) 'InactivityScores'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.InactivityScores) * 8
+
+	// Field (22) 'CurrentSyncCommittee'
+	if b.CurrentSyncCommittee == nil {
+		b.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = b.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (24) 'LatestExecutionPayloadHeader'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (7) 'HistoricalRoots'
+	if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
+		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.HistoricalRoots", size, 16777216)
+		return
+	}
+	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+		if size := uint64(len(b.HistoricalRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.HistoricalRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.HistoricalRoots[ii]...)
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	if size := uint64(len(b.Eth1DataVotes)); size > eth1DataVotes {
+		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Eth1DataVotes", size, eth1DataVotes)
+		return
+	}
+	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (11) 'Validators'
+	if size := uint64(len(b.Validators)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Validators", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Validators); ii++ {
+		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (12) 'Balances'
+	if size := uint64(len(b.Balances)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Balances); ii++ {
+		dst = ssz.MarshalValue(dst, b.Balances[ii])
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	if size := uint64(len(b.PreviousEpochParticipation)); size > 1099511627776 {
+		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.PreviousEpochParticipation", size, 1099511627776)
+		return
+	}
+	dst = append(dst, b.PreviousEpochParticipation...)
+
+	// Field (16) 'CurrentEpochParticipation'
+	if size := uint64(len(b.CurrentEpochParticipation)); size > 1099511627776 {
+		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.CurrentEpochParticipation", size, 1099511627776)
+		return
+	}
+	dst = append(dst, b.CurrentEpochParticipation...)
+
+	// Field (21) 'InactivityScores'
+	if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.InactivityScores", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.InactivityScores); ii++ {
+		dst = ssz.MarshalValue(dst, b.InactivityScores[ii])
+	}
+
+	// Field (24) 'LatestExecutionPayloadHeader'
+	if dst, err = b.LatestExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the BeaconStateBellatrix object to a writer
+func (b *BeaconStateBellatrix) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := b.fixedSize()
+
+	// Field (0) 'GenesisTime'
+	dst = ssz.MarshalValue(dst, b.GenesisTime)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := uint64(len(b.GenesisValidatorsRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.GenesisValidatorsRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.GenesisValidatorsRoot...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Slot'
+	dst = ssz.MarshalValue(dst, b.Slot)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if dst, err = ww.WriteObject(dst, b.Fork); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if dst, err = ww.WriteObject(dst, b.LatestBlockHeader); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	if size := uint64(len(b.BlockRoots)); size != rootsSize {
+		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.BlockRoots", size, rootsSize)
+		return
+	}
+	for ii := 0; ii < len(b.BlockRoots); ii++ {
+		if size := uint64(len(b.BlockRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.BlockRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.BlockRoots[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'StateRoots'
+	if size := uint64(len(b.StateRoots)); size != rootsSize {
+		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.StateRoots", size, rootsSize)
+		return
+	}
+	for ii := 0; ii < len(b.StateRoots); ii++ {
+		if size := uint64(len(b.StateRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.StateRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.StateRoots[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Offset (7) 'HistoricalRoots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.HistoricalRoots) * 32
+
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = ww.WriteObject(dst, b.Eth1Data); err != nil {
+		return
+	}
+
+	// Offset (9) 'Eth1DataVotes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Eth1DataVotes) * 72
+
+	// Field (10) 'Eth1DepositIndex'
+	dst = ssz.MarshalValue(dst, b.Eth1DepositIndex)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (11) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Validators) * 121
+
+	// Offset (12) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Balances) * 8
+
+	// Field (13) 'RandaoMixes'
+	if size := uint64(len(b.RandaoMixes)); size != randaoMixes {
+		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.RandaoMixes", size, randaoMixes)
+		return
+	}
+	for ii := 0; ii < len(b.RandaoMixes); ii++ {
+		if size := uint64(len(b.RandaoMixes[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.RandaoMixes[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.RandaoMixes[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (14) 'Slashings'
+	if size := uint64(len(b.Slashings)); size != slashings {
+		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.Slashings", size, slashings)
+		return
+	}
+	for ii := 0; ii < len(b.Slashings); ii++ {
+		dst = ssz.MarshalValue(dst, b.Slashings[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Offset (15) 'PreviousEpochParticipation'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.PreviousEpochParticipation)
+
+	// Offset (16) 'CurrentEpochParticipation'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.CurrentEpochParticipation)
+
+	// Field (17) 'JustificationBits'
+	if size := uint64(len(b.JustificationBits)); size != 1 {
+		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.JustificationBits", size, 1)
+		return
+	}
+	dst = append(dst, b.JustificationBits...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = ww.WriteObject(dst, b.PreviousJustifiedCheckpoint); err != nil {
+		return
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = ww.WriteObject(dst, b.CurrentJustifiedCheckpoint); err != nil {
+		return
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = ww.WriteObject(dst, b.FinalizedCheckpoint); err != nil {
+		return
+	}
+
+	// Offset (21) 'InactivityScores'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.InactivityScores) * 8
+
+	// Field (22) 'CurrentSyncCommittee'
+	if b.CurrentSyncCommittee == nil {
+		b.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = ww.WriteObject(dst, b.CurrentSyncCommittee); err != nil {
+		return
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = ww.WriteObject(dst, b.NextSyncCommittee); err != nil {
+		return
+	}
+
+	// Offset (24) 'LatestExecutionPayloadHeader'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (7) 'HistoricalRoots'
+	if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
+		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.HistoricalRoots", size, 16777216)
+		return
+	}
+	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+		if size := uint64(len(b.HistoricalRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.HistoricalRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.HistoricalRoots[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	if size := uint64(len(b.Eth1DataVotes)); size > eth1DataVotes {
+		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Eth1DataVotes", size, eth1DataVotes)
+		return
+	}
+	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+		if dst, err = ww.WriteObject(dst, b.Eth1DataVotes[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (11) 'Validators'
+	if size := uint64(len(b.Validators)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Validators", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Validators); ii++ {
+		if dst, err = ww.WriteObject(dst, b.Validators[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (12) 'Balances'
+	if size := uint64(len(b.Balances)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Balances); ii++ {
+		dst = ssz.MarshalValue(dst, b.Balances[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	if size := uint64(len(b.PreviousEpochParticipation)); size > 1099511627776 {
+		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.PreviousEpochParticipation", size, 1099511627776)
+		return
+	}
+	dst = append(dst, b.PreviousEpochParticipation...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	if size := uint64(len(b.CurrentEpochParticipation)); size > 1099511627776 {
+		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.CurrentEpochParticipation", size, 1099511627776)
+		return
+	}
+	dst = append(dst, b.CurrentEpochParticipation...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (21) 'InactivityScores'
+	if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.InactivityScores", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.InactivityScores); ii++ {
+		dst = ssz.MarshalValue(dst, b.InactivityScores[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (24) 'LatestExecutionPayloadHeader'
+	if dst, err = ww.WriteObject(dst, b.LatestExecutionPayloadHeader); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(b, buf)
+}
+
+// UnmarshalSSZTail unmarshals the BeaconStateBellatrix object and returns the remaining bufferº
+func (b *BeaconStateBellatrix) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o7, o9, o11, o12, o15, o16, o21, o24 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'GenesisTime'
+	b.GenesisTime, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	b.GenesisValidatorsRoot, buf = ssz.UnmarshalBytes(b.GenesisValidatorsRoot, buf, 32)
+
+	// Field (2) 'Slot'
+	b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (3) 'Fork'
+	if buf, err = ssz.UnmarshalFieldTail(&b.Fork, buf); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if buf, err = ssz.UnmarshalFieldTail(&b.LatestBlockHeader, buf); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	b.BlockRoots = make([][]byte, rootsSize)
+	for ii := uint64(0); ii < rootsSize; ii++ {
+		b.BlockRoots[ii], buf = ssz.UnmarshalBytes(b.BlockRoots[ii], buf, 32)
+	}
+
+	// Field (6) 'StateRoots'
+	b.StateRoots = make([][]byte, rootsSize)
+	for ii := uint64(0); ii < rootsSize; ii++ {
+		b.StateRoots[ii], buf = ssz.UnmarshalBytes(b.StateRoots[ii], buf, 32)
+	}
+
+	// Offset (7) 'HistoricalRoots'
+	if o7, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (8) 'Eth1Data'
+	if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
+		return
+	}
+
+	// Offset (9) 'Eth1DataVotes'
+	if o9, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	b.Eth1DepositIndex, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Offset (11) 'Validators'
+	if o11, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (12) 'Balances'
+	if o12, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (13) 'RandaoMixes'
+	b.RandaoMixes = make([][]byte, randaoMixes)
+	for ii := uint64(0); ii < randaoMixes; ii++ {
+		b.RandaoMixes[ii], buf = ssz.UnmarshalBytes(b.RandaoMixes[ii], buf, 32)
+	}
+
+	// Field (14) 'Slashings'
+	b.Slashings = ssz.Extend(b.Slashings, slashings)
+	for ii := uint64(0); ii < slashings; ii++ {
+		b.Slashings[ii], buf = ssz.UnmarshallValue[uint64](buf)
+	}
+
+	// Offset (15) 'PreviousEpochParticipation'
+	if o15, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (16) 'CurrentEpochParticipation'
+	if o16, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (17) 'JustificationBits'
+	b.JustificationBits, buf = ssz.UnmarshalBytes(b.JustificationBits, buf, 1)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if buf, err = ssz.UnmarshalFieldTail(&b.PreviousJustifiedCheckpoint, buf); err != nil {
+		return
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if buf, err = ssz.UnmarshalFieldTail(&b.CurrentJustifiedCheckpoint, buf); err != nil {
+		return
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if buf, err = ssz.UnmarshalFieldTail(&b.FinalizedCheckpoint, buf); err != nil {
+		return
+	}
+
+	// Offset (21) 'InactivityScores'
+	if o21, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (22) 'CurrentSyncCommittee'
+	if buf, err = ssz.UnmarshalFieldTail(&b.CurrentSyncCommittee, buf); err != nil {
+		return
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if buf, err = ssz.UnmarshalFieldTail(&b.NextSyncCommittee, buf); err != nil {
+		return
+	}
+
+	// Offset (24) 'LatestExecutionPayloadHeader'
+	if o24, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (7) 'HistoricalRoots'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.HistoricalRoots, tail[o7:o9], 32, 16777216, func(ii uint64, buf []byte) (err error) {
+		b.HistoricalRoots[ii], buf = ssz.UnmarshalBytes(b.HistoricalRoots[ii], buf, 32)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	if err = ssz.UnmarshalSliceSSZ(&b.Eth1DataVotes, tail[o9:o11], eth1DataVotes); err != nil {
+		return nil, err
+	}
+
+	// Field (11) 'Validators'
+	if err = ssz.UnmarshalSliceSSZ(&b.Validators, tail[o11:o12], 1099511627776); err != nil {
+		return nil, err
+	}
+
+	// Field (12) 'Balances'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.Balances, tail[o12:o15], 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+		b.Balances[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	if b.PreviousEpochParticipation, err = ssz.UnmarshalDynamicBytes(b.PreviousEpochParticipation, tail[o15:o16], 1099511627776); err != nil {
+		return
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	if b.CurrentEpochParticipation, err = ssz.UnmarshalDynamicBytes(b.CurrentEpochParticipation, tail[o16:o21], 1099511627776); err != nil {
+		return
+	}
+
+	// Field (21) 'InactivityScores'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.InactivityScores, tail[o21:o24], 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+		b.InactivityScores[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Field (24) 'LatestExecutionPayloadHeader'
+	if err = ssz.UnmarshalField(&b.LatestExecutionPayloadHeader, tail[o24:]); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the BeaconStateBellatrix object from a reader with the size of the encoding
+func (b *BeaconStateBellatrix) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o7, o9, o11, o12, o15, o16, o21, o24 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'GenesisTime'
+		b.GenesisTime, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (1) 'GenesisValidatorsRoot'
+		b.GenesisValidatorsRoot, buf = ssz.UnmarshalBytes(b.GenesisValidatorsRoot, buf, 32)
+
+		// Field (2) 'Slot'
+		b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (3) 'Fork'
+		if buf, err = ssz.UnmarshalFieldTail(&b.Fork, buf); err != nil {
+			return
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if buf, err = ssz.UnmarshalFieldTail(&b.LatestBlockHeader, buf); err != nil {
+			return
+		}
+
+		// Field (5) 'BlockRoots'
+		b.BlockRoots = make([][]byte, rootsSize)
+		for ii := uint64(0); ii < rootsSize; ii++ {
+			b.BlockRoots[ii], buf = ssz.UnmarshalBytes(b.BlockRoots[ii], buf, 32)
+		}
+
+		// Field (6) 'StateRoots'
+		b.StateRoots = make([][]byte, rootsSize)
+		for ii := uint64(0); ii < rootsSize; ii++ {
+			b.StateRoots[ii], buf = ssz.UnmarshalBytes(b.StateRoots[ii], buf, 32)
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		if o7, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (8) 'Eth1Data'
+		if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
+			return
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		if o9, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (10) 'Eth1DepositIndex'
+		b.Eth1DepositIndex, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (11) 'Validators'
+		if o11, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (12) 'Balances'
+		if o12, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (13) 'RandaoMixes'
+		b.RandaoMixes = make([][]byte, randaoMixes)
+		for ii := uint64(0); ii < randaoMixes; ii++ {
+			b.RandaoMixes[ii], buf = ssz.UnmarshalBytes(b.RandaoMixes[ii], buf, 32)
+		}
+
+		// Field (14) 'Slashings'
+		b.Slashings = ssz.Extend(b.Slashings, slashings)
+		for ii := uint64(0); ii < slashings; ii++ {
+			b.Slashings[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		}
+
+		// Offset (15) 'PreviousEpochParticipation'
+		if o15, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (16) 'CurrentEpochParticipation'
+		if o16, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (17) 'JustificationBits'
+		b.JustificationBits, buf = ssz.UnmarshalBytes(b.JustificationBits, buf, 1)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if buf, err = ssz.UnmarshalFieldTail(&b.PreviousJustifiedCheckpoint, buf); err != nil {
+			return
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if buf, err = ssz.UnmarshalFieldTail(&b.CurrentJustifiedCheckpoint, buf); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if buf, err = ssz.UnmarshalFieldTail(&b.FinalizedCheckpoint, buf); err != nil {
+			return
+		}
+
+		// Offset (21) 'InactivityScores'
+		if o21, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (22) 'CurrentSyncCommittee'
+		if buf, err = ssz.UnmarshalFieldTail(&b.CurrentSyncCommittee, buf); err != nil {
+			return
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if buf, err = ssz.UnmarshalFieldTail(&b.NextSyncCommittee, buf); err != nil {
+			return
+		}
+
+		// Offset (24) 'LatestExecutionPayloadHeader'
+		if o24, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (7) 'HistoricalRoots'
+	if o9-o7 > 536870912 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o9-o7), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&b.HistoricalRoots, buf, 32, 16777216, func(ii uint64, buf []byte) (err error) {
+			b.HistoricalRoots[ii], buf = ssz.UnmarshalBytes(b.HistoricalRoots[ii], buf, 32)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	if err = ssz.ReadSliceSSZ(rr, &b.Eth1DataVotes, int(o11-o9), eth1DataVotes); err != nil {
+		return
+	}
+
+	// Field (11) 'Validators'
+	if err = ssz.ReadSliceSSZ(rr, &b.Validators, int(o12-o11), 1099511627776); err != nil {
+		return
+	}
+
+	// Field (12) 'Balances'
+	if o15-o12 > 8796093022208 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o15-o12), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&b.Balances, buf, 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+			b.Balances[ii], buf = ssz.UnmarshallValue[uint64](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	if o16-o15 > 1099511627776 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o16-o15), func(buf []byte) (_ []byte, err error) {
+		if b.PreviousEpochParticipation, err = ssz.UnmarshalDynamicBytes(b.PreviousEpochParticipation, buf, 1099511627776); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	if o21-o16 > 1099511627776 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o21-o16), func(buf []byte) (_ []byte, err error) {
+		if b.CurrentEpochParticipation, err = ssz.UnmarshalDynamicBytes(b.CurrentEpochParticipation, buf, 1099511627776); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (21) 'InactivityScores'
+	if o24-o21 > 8796093022208 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o24-o21), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&b.InactivityScores, buf, 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+			b.InactivityScores[ii], buf = ssz.UnmarshallValue[uint64](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (24) 'LatestExecutionPayloadHeader'
+	if err = ssz.ReadField(rr, &b.LatestExecutionPayloadHeader, size-int(o24)); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) fixedSize() int {
+	return int((409 + (rootsSize * 32) + (rootsSize * 32) + (randaoMixes * 32) + (slashings * 8) + (48 + (syncCommitteePubKeys * 48)) + (48 + (syncCommitteePubKeys * 48))))
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) SizeSSZ() (size int) {
+	size = b.fixedSize()
+
+	// Field (7) 'HistoricalRoots'
+	size += len(b.HistoricalRoots) * 32
+
+	// Field (9) 'Eth1DataVotes'
+	size += len(b.Eth1DataVotes) * 72
+
+	// Field (11) 'Validators'
+	size += len(b.Validators) * 121
+
+	// Field (12) 'Balances'
+	size += len(b.Balances) * 8
+
+	// Field (15) 'PreviousEpochParticipation'
+	size += len(b.PreviousEpochParticipation)
+
+	// Field (16) 'CurrentEpochParticipation'
+	size += len(b.CurrentEpochParticipation)
+
+	// Field (21) 'InactivityScores'
+	size += len(b.InactivityScores) * 8
+
+	// Field (24) 'LatestExecutionPayloadHeader'
+	if b.LatestExecutionPayloadHeader == nil {
+		b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeader)
+	}
+	size += b.LatestExecutionPayloadHeader.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootCtx ssz hashes the BeaconStateBellatrix object and stops with the error of the context once it is done
+func (b *BeaconStateBellatrix) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconStateBellatrix object with a hasher
+func (b *BeaconStateBellatrix) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'GenesisTime'
+	hh.PutUint64(b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := uint64(len(b.GenesisValidatorsRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.GenesisValidatorsRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.GenesisValidatorsRoot)
+
+	// Field (2) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if err = b.Fork.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	{
+		if size := uint64(len(b.BlockRoots)); size != rootsSize {
+			err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.BlockRoots", size, rootsSize)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.BlockRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (6) 'StateRoots'
+	{
+		if size := uint64(len(b.StateRoots)); size != rootsSize {
+			err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.StateRoots", size, rootsSize)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.StateRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (7) 'HistoricalRoots'
+	{
+		if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
+			err = ssz.ErrListTooBigFn("BeaconStateBellatrix.HistoricalRoots", size, 16777216)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.HistoricalRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		numItems := uint64(len(b.HistoricalRoots))
+		hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
+	}
+
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Eth1DataVotes))
+		if num > eth1DataVotes {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = ssz.PutObjects(hh, b.Eth1DataVotes); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, eth1DataVotes)
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	hh.PutUint64(b.Eth1DepositIndex)
+
+	// Field (11) 'Validators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Validators))
+		if num > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = ssz.PutObjects(hh, b.Validators); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+	}
+
+	// Field (12) 'Balances'
+	{
+		if size := uint64(len(b.Balances)); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Balances", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Balances {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.Balances))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (13) 'RandaoMixes'
+	{
+		if size := uint64(len(b.RandaoMixes)); size != randaoMixes {
+			err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.RandaoMixes", size, randaoMixes)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.RandaoMixes {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (14) 'Slashings'
+	{
+		if size := uint64(len(b.Slashings)); size != slashings {
+			err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.Slashings", size, slashings)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Slashings {
+			hh.AppendUint64(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.PreviousEpochParticipation))
+		if byteLen > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(b.PreviousEpochParticipation)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.CurrentEpochParticipation))
+		if byteLen > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(b.CurrentEpochParticipation)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+	}
+
+	// Field (17) 'JustificationBits'
+	if size := uint64(len(b.JustificationBits)); size != 1 {
+		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.JustificationBits", size, 1)
+		return
+	}
+	hh.PutBytes(b.JustificationBits)
+
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (21) 'InactivityScores'
+	{
+		if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconStateBellatrix.InactivityScores", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.InactivityScores {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.InactivityScores))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+	}
+
+	// Field (22) 'CurrentSyncCommittee'
+	if b.CurrentSyncCommittee == nil {
+		b.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommittee)
+	}
+	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (24) 'LatestExecutionPayloadHeader'
+	if err = b.LatestExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// GetTreeCtx ssz hashes the BeaconStateBellatrix object and stops with the error of the context once it is done
+func (b *BeaconStateBellatrix) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
+}
+
+// Generalized indices of the BeaconStateBellatrix fields
+const (
+	BeaconStateBellatrixGindexGenesisTime                  = 32
+	BeaconStateBellatrixGindexGenesisValidatorsRoot        = 33
+	BeaconStateBellatrixGindexSlot                         = 34
+	BeaconStateBellatrixGindexFork                         = 35
+	BeaconStateBellatrixGindexLatestBlockHeader            = 36
+	BeaconStateBellatrixGindexBlockRoots                   = 37
+	BeaconStateBellatrixGindexStateRoots                   = 38
+	BeaconStateBellatrixGindexHistoricalRoots              = 39
+	BeaconStateBellatrixGindexEth1Data                     = 40
+	BeaconStateBellatrixGindexEth1DataVotes                = 41
+	BeaconStateBellatrixGindexEth1DepositIndex             = 42
+	BeaconStateBellatrixGindexValidators                   = 43
+	BeaconStateBellatrixGindexBalances                     = 44
+	BeaconStateBellatrixGindexRandaoMixes                  = 45
+	BeaconStateBellatrixGindexSlashings                    = 46
+	BeaconStateBellatrixGindexPreviousEpochParticipation   = 47
+	BeaconStateBellatrixGindexCurrentEpochParticipation    = 48
+	BeaconStateBellatrixGindexJustificationBits            = 49
+	BeaconStateBellatrixGindexPreviousJustifiedCheckpoint  = 50
+	BeaconStateBellatrixGindexCurrentJustifiedCheckpoint   = 51
+	BeaconStateBellatrixGindexFinalizedCheckpoint          = 52
+	BeaconStateBellatrixGindexInactivityScores             = 53
+	BeaconStateBellatrixGindexCurrentSyncCommittee         = 54
+	BeaconStateBellatrixGindexNextSyncCommittee            = 55
+	BeaconStateBellatrixGindexLatestExecutionPayloadHeader = 56
+)
+
+// BeaconStateBellatrixGindexBlockRootsElem returns the generalized index of the chunk of the i-th element of the BlockRoots field,
+// or zero if it does not fit in an int
+func BeaconStateBellatrixGindexBlockRootsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateBellatrixGindexBlockRoots, ssz.VectorElemGindex(i, rootsSize, 0))
+}
+
+// BeaconStateBellatrixGindexStateRootsElem returns the generalized index of the chunk of the i-th element of the StateRoots field,
+// or zero if it does not fit in an int
+func BeaconStateBellatrixGindexStateRootsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateBellatrixGindexStateRoots, ssz.VectorElemGindex(i, rootsSize, 0))
+}
+
+// BeaconStateBellatrixGindexHistoricalRootsElem returns the generalized index of the chunk of the i-th element of the HistoricalRoots field,
+// or zero if it does not fit in an int
+func BeaconStateBellatrixGindexHistoricalRootsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateBellatrixGindexHistoricalRoots, ssz.ListElemGindex(i, 16777216, 0))
+}
+
+// BeaconStateBellatrixGindexEth1DataVotesElem returns the generalized index of the chunk of the i-th element of the Eth1DataVotes field,
+// or zero if it does not fit in an int
+func BeaconStateBellatrixGindexEth1DataVotesElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateBellatrixGindexEth1DataVotes, ssz.ListElemGindex(i, eth1DataVotes, 0))
+}
+
+// BeaconStateBellatrixGindexValidatorsElem returns the generalized index of the chunk of the i-th element of the Validators field,
+// or zero if it does not fit in an int
+func BeaconStateBellatrixGindexValidatorsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateBellatrixGindexValidators, ssz.ListElemGindex(i, 1099511627776, 0))
+}
+
+// BeaconStateBellatrixGindexBalancesElem returns the generalized index of the chunk of the i-th element of the Balances field,
+// or zero if it does not fit in an int
+func BeaconStateBellatrixGindexBalancesElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateBellatrixGindexBalances, ssz.ListElemGindex(i, 1099511627776, 8))
+}
+
+// BeaconStateBellatrixGindexRandaoMixesElem returns the generalized index of the chunk of the i-th element of the RandaoMixes field,
+// or zero if it does not fit in an int
+func BeaconStateBellatrixGindexRandaoMixesElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateBellatrixGindexRandaoMixes, ssz.VectorElemGindex(i, randaoMixes, 0))
+}
+
+// BeaconStateBellatrixGindexSlashingsElem returns the generalized index of the chunk of the i-th element of the Slashings field,
+// or zero if it does not fit in an int
+func BeaconStateBellatrixGindexSlashingsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateBellatrixGindexSlashings, ssz.VectorElemGindex(i, slashings, 8))
+}
+
+// BeaconStateBellatrixGindexInactivityScoresElem returns the generalized index of the chunk of the i-th element of the InactivityScores field,
+// or zero if it does not fit in an int
+func BeaconStateBellatrixGindexInactivityScoresElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateBellatrixGindexInactivityScores, ssz.ListElemGindex(i, 1099511627776, 8))
+}
+
+// BeaconStateBellatrixGindex returns the generalized index of a field path in the BeaconStateBellatrix object
+func BeaconStateBellatrixGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "genesis_time":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexGenesisTime, rest, nil)
+	case "genesis_validators_root":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexGenesisValidatorsRoot, rest, nil)
+	case "slot":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexSlot, rest, nil)
+	case "fork":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexFork, rest, ForkGindex)
+	case "latest_block_header":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexLatestBlockHeader, rest, BeaconBlockHeaderGindex)
+	case "block_roots":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexBlockRoots, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, rootsSize, 0, nil)
+		})
+	case "state_roots":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexStateRoots, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, rootsSize, 0, nil)
+		})
+	case "historical_roots":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexHistoricalRoots, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16777216, 0, nil)
+		})
+	case "eth1_data":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexEth1Data, rest, Eth1DataGindex)
+	case "eth1_data_votes":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexEth1DataVotes, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, eth1DataVotes, 0, Eth1DataGindex)
+		})
+	case "eth1_deposit_index":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexEth1DepositIndex, rest, nil)
+	case "validators":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexValidators, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1099511627776, 0, ValidatorGindex)
+		})
+	case "balances":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexBalances, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1099511627776, 8, nil)
+		})
+	case "randao_mixes":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexRandaoMixes, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, randaoMixes, 0, nil)
+		})
+	case "slashings":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexSlashings, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, slashings, 8, nil)
+		})
+	case "previous_epoch_participation":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexPreviousEpochParticipation, rest, nil)
+	case "current_epoch_participation":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexCurrentEpochParticipation, rest, nil)
+	case "justification_bits":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexJustificationBits, rest, nil)
+	case "previous_justified_checkpoint":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexPreviousJustifiedCheckpoint, rest, CheckpointGindex)
+	case "current_justified_checkpoint":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexCurrentJustifiedCheckpoint, rest, CheckpointGindex)
+	case "finalized_checkpoint":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexFinalizedCheckpoint, rest, CheckpointGindex)
+	case "inactivity_scores":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexInactivityScores, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1099511627776, 8, nil)
+		})
+	case "current_sync_committee":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexCurrentSyncCommittee, rest, SyncCommitteeGindex)
+	case "next_sync_committee":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexNextSyncCommittee, rest, SyncCommitteeGindex)
+	case "latest_execution_payload_header":
+		return ssz.FieldGindex(BeaconStateBellatrixGindexLatestExecutionPayloadHeader, rest, ExecutionPayloadHeaderGindex)
+	}
+	return 0, ssz.ErrUnknownFieldFn("BeaconStateBellatrix", field)
+}
+
+// ProveField returns a proof of the field path in the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BeaconStateBellatrixGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BeaconStateBellatrixGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBeaconBlockHeader object to a target array
+func (s *SignedBeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if dst, err = s.Header.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := uint64(len(s.Signature)); size != 96 {
+		err = ssz.ErrBytesLengthFn("SignedBeaconBlockHeader.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the SignedBeaconBlockHeader object to a writer
+func (s *SignedBeaconBlockHeader) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if dst, err = ww.WriteObject(dst, s.Header); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := uint64(len(s.Signature)); size != 96 {
+		err = ssz.ErrBytesLengthFn("SignedBeaconBlockHeader.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(s, buf)
+}
+
+// UnmarshalSSZTail unmarshals the SignedBeaconBlockHeader object and returns the remaining bufferº
+func (s *SignedBeaconBlockHeader) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := s.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'Header'
+	if buf, err = ssz.UnmarshalFieldTail(&s.Header, buf); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	s.Signature, buf = ssz.UnmarshalBytes(s.Signature, buf, 96)
+
+	return buf, nil
+}
+
+// UnmarshalSSZReader ssz unmarshals the SignedBeaconBlockHeader object from a reader with the size of the encoding
+func (s *SignedBeaconBlockHeader) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := s.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Header'
+		if buf, err = ssz.UnmarshalFieldTail(&s.Header, buf); err != nil {
+			return
+		}
+
+		// Field (1) 'Signature'
+		s.Signature, buf = ssz.UnmarshalBytes(s.Signature, buf, 96)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) fixedSize() int {
+	return int(208)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) SizeSSZ() (size int) {
+	size = s.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootCtx ssz hashes the SignedBeaconBlockHeader object and stops with the error of the context once it is done
+func (s *SignedBeaconBlockHeader) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBeaconBlockHeader object with a hasher
+func (s *SignedBeaconBlockHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if err = s.Header.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := uint64(len(s.Signature)); size != 96 {
+		err = ssz.ErrBytesLengthFn("SignedBeaconBlockHeader.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// GetTreeCtx ssz hashes the SignedBeaconBlockHeader object and stops with the error of the context once it is done
+func (s *SignedBeaconBlockHeader) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, s)
+}
+
+// Generalized indices of the SignedBeaconBlockHeader fields
+const (
+	SignedBeaconBlockHeaderGindexHeader    = 2
+	SignedBeaconBlockHeaderGindexSignature = 3
+)
+
+// SignedBeaconBlockHeaderGindex returns the generalized index of a field path in the SignedBeaconBlockHeader object
+func SignedBeaconBlockHeaderGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "message":
+		return ssz.FieldGindex(SignedBeaconBlockHeaderGindexHeader, rest, BeaconBlockHeaderGindex)
+	case "signature":
+		return ssz.FieldGindex(SignedBeaconBlockHeaderGindexSignature, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("SignedBeaconBlockHeader", field)
+}
+
+// ProveField returns a proof of the field path in the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(s, SignedBeaconBlockHeaderGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(s, SignedBeaconBlockHeaderGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockHeader object
+func (b *BeaconBlockHeader) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockHeader object to a target array
+func (b *BeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalValue(dst, b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if size := uint64(len(b.ParentRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.ParentRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.ParentRoot...)
+
+	// Field (3) 'StateRoot'
+	if size := uint64(len(b.StateRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.StateRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.StateRoot...)
+
+	// Field (4) 'BodyRoot'
+	if size := uint64(len(b.BodyRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.BodyRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.BodyRoot...)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the BeaconBlockHeader object to a writer
+func (b *BeaconBlockHeader) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, b.Slot)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalValue(dst, b.ProposerIndex)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'ParentRoot'
+	if size := uint64(len(b.ParentRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.ParentRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.ParentRoot...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'StateRoot'
+	if size := uint64(len(b.StateRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.StateRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.StateRoot...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'BodyRoot'
+	if size := uint64(len(b.BodyRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.BodyRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.BodyRoot...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockHeader object
+func (b *BeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(b, buf)
+}
+
+// UnmarshalSSZTail unmarshals the BeaconBlockHeader object and returns the remaining bufferº
+func (b *BeaconBlockHeader) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'Slot'
+	b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (1) 'ProposerIndex'
+	b.ProposerIndex, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (2) 'ParentRoot'
+	b.ParentRoot, buf = ssz.UnmarshalBytes(b.ParentRoot, buf, 32)
+
+	// Field (3) 'StateRoot'
+	b.StateRoot, buf = ssz.UnmarshalBytes(b.StateRoot, buf, 32)
+
+	// Field (4) 'BodyRoot'
+	b.BodyRoot, buf = ssz.UnmarshalBytes(b.BodyRoot, buf, 32)
+
+	return buf, nil
+}
+
+// UnmarshalSSZReader ssz unmarshals the BeaconBlockHeader object from a reader with the size of the encoding
+func (b *BeaconBlockHeader) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Slot'
+		b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (1) 'ProposerIndex'
+		b.ProposerIndex, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (2) 'ParentRoot'
+		b.ParentRoot, buf = ssz.UnmarshalBytes(b.ParentRoot, buf, 32)
+
+		// Field (3) 'StateRoot'
+		b.StateRoot, buf = ssz.UnmarshalBytes(b.StateRoot, buf, 32)
+
+		// Field (4) 'BodyRoot'
+		b.BodyRoot, buf = ssz.UnmarshalBytes(b.BodyRoot, buf, 32)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) fixedSize() int {
+	return int(112)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockHeader object
+func (b *BeaconBlockHeader) SizeSSZ() (size int) {
+	size = b.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockHeader object
+func (b *BeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootCtx ssz hashes the BeaconBlockHeader object and stops with the error of the context once it is done
+func (b *BeaconBlockHeader) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockHeader object with a hasher
+func (b *BeaconBlockHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if size := uint64(len(b.ParentRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.ParentRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	if size := uint64(len(b.StateRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.StateRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.StateRoot)
+
+	// Field (4) 'BodyRoot'
+	if size := uint64(len(b.BodyRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.BodyRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.BodyRoot)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BeaconBlockHeader object
+func (b *BeaconBlockHeader) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// GetTreeCtx ssz hashes the BeaconBlockHeader object and stops with the error of the context once it is done
+func (b *BeaconBlockHeader) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
+}
+
+// Generalized indices of the BeaconBlockHeader fields
+const (
+	BeaconBlockHeaderGindexSlot          = 8
+	BeaconBlockHeaderGindexProposerIndex = 9
+	BeaconBlockHeaderGindexParentRoot    = 10
+	BeaconBlockHeaderGindexStateRoot     = 11
+	BeaconBlockHeaderGindexBodyRoot      = 12
+)
+
+// BeaconBlockHeaderGindex returns the generalized index of a field path in the BeaconBlockHeader object
+func BeaconBlockHeaderGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "slot":
+		return ssz.FieldGindex(BeaconBlockHeaderGindexSlot, rest, nil)
+	case "proposer_index":
+		return ssz.FieldGindex(BeaconBlockHeaderGindexProposerIndex, rest, nil)
+	case "parent_root":
+		return ssz.FieldGindex(BeaconBlockHeaderGindexParentRoot, rest, nil)
+	case "state_root":
+		return ssz.FieldGindex(BeaconBlockHeaderGindexStateRoot, rest, nil)
+	case "body_root":
+		return ssz.FieldGindex(BeaconBlockHeaderGindexBodyRoot, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("BeaconBlockHeader", field)
+}
+
+// ProveField returns a proof of the field path in the BeaconBlockHeader object
+func (b *BeaconBlockHeader) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BeaconBlockHeaderGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the BeaconBlockHeader object
+func (b *BeaconBlockHeader) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BeaconBlockHeaderGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the ErrorResponse object
+func (e *ErrorResponse) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ErrorResponse object to a target array
+func (e *ErrorResponse) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := e.fixedSize()
+
+	// Offset (0) 'Message'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Message'
+	if size := uint64(len(e.Message)); size > 256 {
+		err = ssz.ErrBytesLengthFn("ErrorResponse.Message", size, 256)
+		return
+	}
+	dst = append(dst, e.Message...)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the ErrorResponse object to a writer
+func (e *ErrorResponse) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := e.fixedSize()
+
+	// Offset (0) 'Message'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Message'
+	if size := uint64(len(e.Message)); size > 256 {
+		err = ssz.ErrBytesLengthFn("ErrorResponse.Message", size, 256)
+		return
+	}
+	dst = append(dst, e.Message...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the ErrorResponse object
+func (e *ErrorResponse) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(e, buf)
+}
+
+// UnmarshalSSZTail unmarshals the ErrorResponse object and returns the remaining bufferº
+func (e *ErrorResponse) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := e.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Offset (0) 'Message'
+	if o0, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (0) 'Message'
+	if e.Message, err = ssz.UnmarshalDynamicBytes(e.Message, tail[o0:], 256); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the ErrorResponse object from a reader with the size of the encoding
+func (e *ErrorResponse) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := e.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'Message'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'Message'
+	if uint64(size-int(o0)) > 256 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o0), func(buf []byte) (_ []byte, err error) {
+		if e.Message, err = ssz.UnmarshalDynamicBytes(e.Message, buf, 256); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the ErrorResponse object
+func (e *ErrorResponse) fixedSize() int {
+	return int(4)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ErrorResponse object
+func (e *ErrorResponse) SizeSSZ() (size int) {
+	size = e.fixedSize()
+
+	// Field (0) 'Message'
+	size += len(e.Message)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ErrorResponse object
+func (e *ErrorResponse) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootCtx ssz hashes the ErrorResponse object and stops with the error of the context once it is done
+func (e *ErrorResponse) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, e)
+}
+
+// HashTreeRootWith ssz hashes the ErrorResponse object with a hasher
+func (e *ErrorResponse) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(e.Message))
+		if byteLen > 256 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(e.Message)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ErrorResponse object
+func (e *ErrorResponse) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
+
+// GetTreeCtx ssz hashes the ErrorResponse object and stops with the error of the context once it is done
+func (e *ErrorResponse) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, e)
+}
+
+// Generalized indices of the ErrorResponse fields
+const (
+	ErrorResponseGindexMessage = 1
+)
+
+// ErrorResponseGindex returns the generalized index of a field path in the ErrorResponse object
+func ErrorResponseGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "message":
+		return ssz.FieldGindex(ErrorResponseGindexMessage, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("ErrorResponse", field)
+}
+
+// ProveField returns a proof of the field path in the ErrorResponse object
+func (e *ErrorResponse) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(e, ErrorResponseGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the ErrorResponse object
+func (e *ErrorResponse) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(e, ErrorResponseGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the Dummy object
+func (d *Dummy) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
+}
+
+// MarshalSSZTo ssz marshals the Dummy object to a target array
+func (d *Dummy) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the Dummy object to a writer
+func (d *Dummy) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the Dummy object
+func (d *Dummy) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(d, buf)
+}
+
+// UnmarshalSSZTail unmarshals the Dummy object and returns the remaining bufferº
+func (d *Dummy) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := d.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	return buf, nil
+}
+
+// UnmarshalSSZReader ssz unmarshals the Dummy object from a reader with the size of the encoding
+func (d *Dummy) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := d.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the Dummy object
+func (d *Dummy) fixedSize() int {
+	return int(0)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Dummy object
+func (d *Dummy) SizeSSZ() (size int) {
+	size = d.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the Dummy object
+func (d *Dummy) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootCtx ssz hashes the Dummy object and stops with the error of the context once it is done
+func (d *Dummy) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, d)
+}
+
+// HashTreeRootWith ssz hashes the Dummy object with a hasher
+func (d *Dummy) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Dummy object
+func (d *Dummy) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(d)
+}
+
+// GetTreeCtx ssz hashes the Dummy object and stops with the error of the context once it is done
+func (d *Dummy) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, d)
+}
+
+// DummyGindex returns the generalized index of a field path in the Dummy object
+func DummyGindex(path string) (int, error) {
+	field, _, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	return 0, ssz.ErrUnknownFieldFn("Dummy", field)
+}
+
+// ProveField returns a proof of the field path in the Dummy object
+func (d *Dummy) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(d, DummyGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Dummy object
+func (d *Dummy) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(d, DummyGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the SyncCommittee object
+func (s *SyncCommittee) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SyncCommittee object to a target array
+func (s *SyncCommittee) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'PubKeys'
+	if size := uint64(len(s.PubKeys)); size != syncCommitteePubKeys {
+		err = ssz.ErrVectorLengthFn("SyncCommittee.PubKeys", size, syncCommitteePubKeys)
+		return
+	}
+	for ii := uint64(0); ii < syncCommitteePubKeys; ii++ {
+		if size := uint64(len(s.PubKeys[ii])); size != 48 {
+			err = ssz.ErrBytesLengthFn("SyncCommittee.PubKeys[ii]", size, 48)
+			return
+		}
+		dst = append(dst, s.PubKeys[ii]...)
+	}
+
+	// Field (1) 'AggregatePubKey'
+	dst = append(dst, s.AggregatePubKey[:]...)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the SyncCommittee object to a writer
+func (s *SyncCommittee) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'PubKeys'
+	if size := uint64(len(s.PubKeys)); size != syncCommitteePubKeys {
+		err = ssz.ErrVectorLengthFn("SyncCommittee.PubKeys", size, syncCommitteePubKeys)
+		return
+	}
+	for ii := 0; ii < len(s.PubKeys); ii++ {
+		if size := uint64(len(s.PubKeys[ii])); size != 48 {
+			err = ssz.ErrBytesLengthFn("SyncCommittee.PubKeys[ii]", size, 48)
+			return
+		}
+		dst = append(dst, s.PubKeys[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'AggregatePubKey'
+	dst = append(dst, s.AggregatePubKey[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the SyncCommittee object
+func (s *SyncCommittee) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(s, buf)
+}
+
+// UnmarshalSSZTail unmarshals the SyncCommittee object and returns the remaining bufferº
+func (s *SyncCommittee) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := s.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'PubKeys'
+	s.PubKeys = make([][]byte, syncCommitteePubKeys)
+	for ii := uint64(0); ii < syncCommitteePubKeys; ii++ {
+		s.PubKeys[ii], buf = ssz.UnmarshalBytes(s.PubKeys[ii], buf, 48)
+	}
+
+	// Field (1) 'AggregatePubKey'
+	buf = ssz.UnmarshalFixedBytes(s.AggregatePubKey[:], buf)
+
+	return buf, nil
+}
+
+// UnmarshalSSZReader ssz unmarshals the SyncCommittee object from a reader with the size of the encoding
+func (s *SyncCommittee) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := s.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'PubKeys'
+		s.PubKeys = make([][]byte, syncCommitteePubKeys)
+		for ii := uint64(0); ii < syncCommitteePubKeys; ii++ {
+			s.PubKeys[ii], buf = ssz.UnmarshalBytes(s.PubKeys[ii], buf, 48)
+		}
+
+		// Field (1) 'AggregatePubKey'
+		buf = ssz.UnmarshalFixedBytes(s.AggregatePubKey[:], buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the SyncCommittee object
+func (s *SyncCommittee) fixedSize() int {
+	return int((48 + (syncCommitteePubKeys * 48)))
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SyncCommittee object
+func (s *SyncCommittee) SizeSSZ() (size int) {
+	size = s.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the SyncCommittee object
+func (s *SyncCommittee) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootCtx ssz hashes the SyncCommittee object and stops with the error of the context once it is done
+func (s *SyncCommittee) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, s)
+}
+
+// HashTreeRootWith ssz hashes the SyncCommittee object with a hasher
+func (s *SyncCommittee) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'PubKeys'
+	{
+		if size := uint64(len(s.PubKeys)); size != syncCommitteePubKeys {
+			err = ssz.ErrVectorLengthFn("SyncCommittee.PubKeys", size, syncCommitteePubKeys)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range s.PubKeys {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (1) 'AggregatePubKey'
+	hh.PutBytes(s.AggregatePubKey[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SyncCommittee object
+func (s *SyncCommittee) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// GetTreeCtx ssz hashes the SyncCommittee object and stops with the error of the context once it is done
+func (s *SyncCommittee) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, s)
+}
+
+// Generalized indices of the SyncCommittee fields
+const (
+	SyncCommitteeGindexPubKeys         = 2
+	SyncCommitteeGindexAggregatePubKey = 3
+)
+
+// SyncCommitteeGindexPubKeysElem returns the generalized index of the chunk of the i-th element of the PubKeys field,
+// or zero if it does not fit in an int
+func SyncCommitteeGindexPubKeysElem(i int) int {
+	return ssz.ConcatGindices(SyncCommitteeGindexPubKeys, ssz.VectorElemGindex(i, syncCommitteePubKeys, 0))
+}
+
+// SyncCommitteeGindex returns the generalized index of a field path in the SyncCommittee object
+func SyncCommitteeGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "pubkeys":
+		return ssz.FieldGindex(SyncCommitteeGindexPubKeys, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, syncCommitteePubKeys, 0, nil)
+		})
+	case "aggregate_pubkey":
+		return ssz.FieldGindex(SyncCommitteeGindexAggregatePubKey, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("SyncCommittee", field)
+}
+
+// ProveField returns a proof of the field path in the SyncCommittee object
+func (s *SyncCommittee) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(s, SyncCommitteeGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the SyncCommittee object
+func (s *SyncCommittee) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(s, SyncCommitteeGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the SyncAggregate object
+func (s *SyncAggregate) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SyncAggregate object to a target array
+func (s *SyncAggregate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'SyncCommiteeBits'
+	if size := uint64(len(s.SyncCommiteeBits)); size != syncCommitteeBits {
+		err = ssz.ErrBytesLengthFn("SyncAggregate.SyncCommiteeBits", size, syncCommitteeBits)
+		return
+	}
+	dst = append(dst, s.SyncCommiteeBits...)
+
+	// Field (1) 'SyncCommiteeSignature'
+	dst = append(dst, s.SyncCommiteeSignature[:]...)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the SyncAggregate object to a writer
+func (s *SyncAggregate) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'SyncCommiteeBits'
+	if size := uint64(len(s.SyncCommiteeBits)); size != syncCommitteeBits {
+		err = ssz.ErrBytesLengthFn("SyncAggregate.SyncCommiteeBits", size, syncCommitteeBits)
+		return
+	}
+	dst = append(dst, s.SyncCommiteeBits...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'SyncCommiteeSignature'
+	dst = append(dst, s.SyncCommiteeSignature[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the SyncAggregate object
+func (s *SyncAggregate) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(s, buf)
+}
+
+// UnmarshalSSZTail unmarshals the SyncAggregate object and returns the remaining bufferº
+func (s *SyncAggregate) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := s.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'SyncCommiteeBits'
+	s.SyncCommiteeBits, buf = ssz.UnmarshalBytes(s.SyncCommiteeBits, buf, syncCommitteeBits)
+
+	// Field (1) 'SyncCommiteeSignature'
+	buf = ssz.UnmarshalFixedBytes(s.SyncCommiteeSignature[:], buf)
+
+	return buf, nil
+}
+
+// UnmarshalSSZReader ssz unmarshals the SyncAggregate object from a reader with the size of the encoding
+func (s *SyncAggregate) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := s.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'SyncCommiteeBits'
+		s.SyncCommiteeBits, buf = ssz.UnmarshalBytes(s.SyncCommiteeBits, buf, syncCommitteeBits)
+
+		// Field (1) 'SyncCommiteeSignature'
+		buf = ssz.UnmarshalFixedBytes(s.SyncCommiteeSignature[:], buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the SyncAggregate object
+func (s *SyncAggregate) fixedSize() int {
+	return int((96 + syncCommitteeBits))
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SyncAggregate object
+func (s *SyncAggregate) SizeSSZ() (size int) {
+	size = s.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the SyncAggregate object
+func (s *SyncAggregate) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootCtx ssz hashes the SyncAggregate object and stops with the error of the context once it is done
+func (s *SyncAggregate) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, s)
+}
+
+// HashTreeRootWith ssz hashes the SyncAggregate object with a hasher
+func (s *SyncAggregate) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SyncCommiteeBits'
+	if size := uint64(len(s.SyncCommiteeBits)); size != syncCommitteeBits {
+		err = ssz.ErrBytesLengthFn("SyncAggregate.SyncCommiteeBits", size, syncCommitteeBits)
+		return
+	}
+	hh.PutBytes(s.SyncCommiteeBits)
+
+	// Field (1) 'SyncCommiteeSignature'
+	hh.PutBytes(s.SyncCommiteeSignature[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SyncAggregate object
+func (s *SyncAggregate) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// GetTreeCtx ssz hashes the SyncAggregate object and stops with the error of the context once it is done
+func (s *SyncAggregate) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, s)
+}
+
+// Generalized indices of the SyncAggregate fields
+const (
+	SyncAggregateGindexSyncCommiteeBits      = 2
+	SyncAggregateGindexSyncCommiteeSignature = 3
+)
+
+// SyncAggregateGindex returns the generalized index of a field path in the SyncAggregate object
+func SyncAggregateGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "sync_committee_bits":
+		return ssz.FieldGindex(SyncAggregateGindexSyncCommiteeBits, rest, nil)
+	case "sync_committee_signature":
+		return ssz.FieldGindex(SyncAggregateGindexSyncCommiteeSignature, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("SyncAggregate", field)
+}
+
+// ProveField returns a proof of the field path in the SyncAggregate object
+func (s *SyncAggregate) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(s, SyncAggregateGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the SyncAggregate object
+func (s *SyncAggregate) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(s, SyncAggregateGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the ExecutionPayload object
+func (e *ExecutionPayload) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ExecutionPayload object to a target array
+func (e *ExecutionPayload) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := e.fixedSize()
+
+	// Field (0) 'ParentHash'
+	dst = append(dst, e.ParentHash[:]...)
+
+	// Field (1) 'FeeRecipient'
+	dst = append(dst, e.FeeRecipient[:]...)
+
+	// Field (2) 'StateRoot'
+	dst = append(dst, e.StateRoot[:]...)
+
+	// Field (3) 'ReceiptsRoot'
+	dst = append(dst, e.ReceiptsRoot[:]...)
+
+	// Field (4) 'LogsBloom'
+	dst = append(dst, e.LogsBloom[:]...)
+
+	// Field (5) 'PrevRandao'
+	dst = append(dst, e.PrevRandao[:]...)
+
+	// Field (6) 'BlockNumber'
+	dst = ssz.MarshalValue(dst, e.BlockNumber)
+
+	// Field (7) 'GasLimit'
+	dst = ssz.MarshalValue(dst, e.GasLimit)
+
+	// Field (8) 'GasUsed'
+	dst = ssz.MarshalValue(dst, e.GasUsed)
+
+	// Field (9) 'Timestamp'
+	dst = ssz.MarshalValue(dst, e.Timestamp)
+
+	// Offset (10) 'ExtraData'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(e.ExtraData)
+
+	// Field (11) 'BaseFeePerGas'
+	dst = append(dst, e.BaseFeePerGas[:]...)
+
+	// Field (12) 'BlockHash'
+	dst = append(dst, e.BlockHash[:]...)
+
+	// Offset (13) 'Transactions'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (10) 'ExtraData'
+	if size := uint64(len(e.ExtraData)); size > 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayload.ExtraData", size, 32)
+		return
+	}
+	dst = append(dst, e.ExtraData...)
+
+	// Field (13) 'Transactions'
+	if size := uint64(len(e.Transactions)); size > 1048576 {
+		err = ssz.ErrListTooBigFn("ExecutionPayload.Transactions", size, 1048576)
+		return
+	}
+	{
+		offset = 4 * len(e.Transactions)
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(e.Transactions[ii])
+		}
+	}
+	for ii := 0; ii < len(e.Transactions); ii++ {
+		if size := uint64(len(e.Transactions[ii])); size > 1073741824 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayload.Transactions[ii]", size, 1073741824)
+			return
+		}
+		dst = append(dst, e.Transactions[ii]...)
+	}
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the ExecutionPayload object to a writer
+func (e *ExecutionPayload) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := e.fixedSize()
+
+	// Field (0) 'ParentHash'
+	dst = append(dst, e.ParentHash[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'FeeRecipient'
+	dst = append(dst, e.FeeRecipient[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'StateRoot'
+	dst = append(dst, e.StateRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'ReceiptsRoot'
+	dst = append(dst, e.ReceiptsRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'LogsBloom'
+	dst = append(dst, e.LogsBloom[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'PrevRandao'
+	dst = append(dst, e.PrevRandao[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (6) 'BlockNumber'
+	dst = ssz.MarshalValue(dst, e.BlockNumber)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (7) 'GasLimit'
+	dst = ssz.MarshalValue(dst, e.GasLimit)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (8) 'GasUsed'
+	dst = ssz.MarshalValue(dst, e.GasUsed)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (9) 'Timestamp'
+	dst = ssz.MarshalValue(dst, e.Timestamp)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (10) 'ExtraData'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(e.ExtraData)
+
+	// Field (11) 'BaseFeePerGas'
+	dst = append(dst, e.BaseFeePerGas[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (12) 'BlockHash'
+	dst = append(dst, e.BlockHash[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (13) 'Transactions'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (10) 'ExtraData'
+	if size := uint64(len(e.ExtraData)); size > 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayload.ExtraData", size, 32)
+		return
+	}
+	dst = append(dst, e.ExtraData...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (13) 'Transactions'
+	if size := uint64(len(e.Transactions)); size > 1048576 {
+		err = ssz.ErrListTooBigFn("ExecutionPayload.Transactions", size, 1048576)
+		return
+	}
+	{
+		offset = 4 * len(e.Transactions)
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(e.Transactions[ii])
+		}
+	}
+	for ii := 0; ii < len(e.Transactions); ii++ {
+		if size := uint64(len(e.Transactions[ii])); size > 1073741824 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayload.Transactions[ii]", size, 1073741824)
+			return
+		}
+		dst = append(dst, e.Transactions[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the ExecutionPayload object
+func (e *ExecutionPayload) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(e, buf)
+}
+
+// UnmarshalSSZTail unmarshals the ExecutionPayload object and returns the remaining bufferº
+func (e *ExecutionPayload) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := e.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o10, o13 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'ParentHash'
+	buf = ssz.UnmarshalFixedBytes(e.ParentHash[:], buf)
+
+	// Field (1) 'FeeRecipient'
+	buf = ssz.UnmarshalFixedBytes(e.FeeRecipient[:], buf)
+
+	// Field (2) 'StateRoot'
+	buf = ssz.UnmarshalFixedBytes(e.StateRoot[:], buf)
+
+	// Field (3) 'ReceiptsRoot'
+	buf = ssz.UnmarshalFixedBytes(e.ReceiptsRoot[:], buf)
+
+	// Field (4) 'LogsBloom'
+	buf = ssz.UnmarshalFixedBytes(e.LogsBloom[:], buf)
+
+	// Field (5) 'PrevRandao'
+	buf = ssz.UnmarshalFixedBytes(e.PrevRandao[:], buf)
+
+	// Field (6) 'BlockNumber'
+	e.BlockNumber, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (7) 'GasLimit'
+	e.GasLimit, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (8) 'GasUsed'
+	e.GasUsed, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (9) 'Timestamp'
+	e.Timestamp, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Offset (10) 'ExtraData'
+	if o10, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (11) 'BaseFeePerGas'
+	buf = ssz.UnmarshalFixedBytes(e.BaseFeePerGas[:], buf)
+
+	// Field (12) 'BlockHash'
+	buf = ssz.UnmarshalFixedBytes(e.BlockHash[:], buf)
+
+	// Offset (13) 'Transactions'
+	if o13, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (10) 'ExtraData'
+	if e.ExtraData, err = ssz.UnmarshalDynamicBytes(e.ExtraData, tail[o10:o13], 32); err != nil {
+		return
+	}
+
+	// Field (13) 'Transactions'
+	if err = ssz.UnmarshalDynamicSliceWithCallback(&e.Transactions, tail[o13:], 1048576, func(indx uint64, buf []byte) (err error) {
+		if e.Transactions[indx], err = ssz.UnmarshalDynamicBytes(e.Transactions[indx], buf, 1073741824); err != nil {
+			return
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the ExecutionPayload object from a reader with the size of the encoding
+func (e *ExecutionPayload) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := e.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o10, o13 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'ParentHash'
+		buf = ssz.UnmarshalFixedBytes(e.ParentHash[:], buf)
+
+		// Field (1) 'FeeRecipient'
+		buf = ssz.UnmarshalFixedBytes(e.FeeRecipient[:], buf)
+
+		// Field (2) 'StateRoot'
+		buf = ssz.UnmarshalFixedBytes(e.StateRoot[:], buf)
+
+		// Field (3) 'ReceiptsRoot'
+		buf = ssz.UnmarshalFixedBytes(e.ReceiptsRoot[:], buf)
+
+		// Field (4) 'LogsBloom'
+		buf = ssz.UnmarshalFixedBytes(e.LogsBloom[:], buf)
+
+		// Field (5) 'PrevRandao'
+		buf = ssz.UnmarshalFixedBytes(e.PrevRandao[:], buf)
+
+		// Field (6) 'BlockNumber'
+		e.BlockNumber, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (7) 'GasLimit'
+		e.GasLimit, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (8) 'GasUsed'
+		e.GasUsed, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (9) 'Timestamp'
+		e.Timestamp, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (10) 'ExtraData'
+		if o10, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (11) 'BaseFeePerGas'
+		buf = ssz.UnmarshalFixedBytes(e.BaseFeePerGas[:], buf)
+
+		// Field (12) 'BlockHash'
+		buf = ssz.UnmarshalFixedBytes(e.BlockHash[:], buf)
+
+		// Offset (13) 'Transactions'
+		if o13, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (10) 'ExtraData'
+	if o13-o10 > 32 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o13-o10), func(buf []byte) (_ []byte, err error) {
+		if e.ExtraData, err = ssz.UnmarshalDynamicBytes(e.ExtraData, buf, 32); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (13) 'Transactions'
+	{
+		var sizes []int
+		if sizes, err = rr.ReadOffsets(size-int(o13), 1048576); err != nil {
+			return
+		}
+		num := len(sizes)
+		e.Transactions = make([][]byte, num)
+		for ii, size := range sizes {
+			if uint64(size) > 1073741824 {
+				return ssz.ErrListTooBig
+			}
+			if err = rr.Decode(size, func(buf []byte) (_ []byte, err error) {
+				if e.Transactions[ii], err = ssz.UnmarshalDynamicBytes(e.Transactions[ii], buf, 1073741824); err != nil {
+					return
+				}
+				return buf, nil
+			}); err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the ExecutionPayload object
+func (e *ExecutionPayload) fixedSize() int {
+	return int(508)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayload object
+func (e *ExecutionPayload) SizeSSZ() (size int) {
+	size = e.fixedSize()
+
+	// Field (10) 'ExtraData'
+	size += len(e.ExtraData)
+
+	// Field (13) 'Transactions'
+	for ii := 0; ii < len(e.Transactions); ii++ {
+		size += 4
+		size += len(e.Transactions[ii])
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ExecutionPayload object
+func (e *ExecutionPayload) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootCtx ssz hashes the ExecutionPayload object and stops with the error of the context once it is done
+func (e *ExecutionPayload) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, e)
+}
+
+// HashTreeRootWith ssz hashes the ExecutionPayload object with a hasher
+func (e *ExecutionPayload) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ParentHash'
+	hh.PutBytes(e.ParentHash[:])
+
+	// Field (1) 'FeeRecipient'
+	hh.PutBytes(e.FeeRecipient[:])
+
+	// Field (2) 'StateRoot'
+	hh.PutBytes(e.StateRoot[:])
+
+	// Field (3) 'ReceiptsRoot'
+	hh.PutBytes(e.ReceiptsRoot[:])
+
+	// Field (4) 'LogsBloom'
+	hh.PutBytes(e.LogsBloom[:])
+
+	// Field (5) 'PrevRandao'
+	hh.PutBytes(e.PrevRandao[:])
+
+	// Field (6) 'BlockNumber'
+	hh.PutUint64(e.BlockNumber)
+
+	// Field (7) 'GasLimit'
+	hh.PutUint64(e.GasLimit)
+
+	// Field (8) 'GasUsed'
+	hh.PutUint64(e.GasUsed)
+
+	// Field (9) 'Timestamp'
+	hh.PutUint64(e.Timestamp)
+
+	// Field (10) 'ExtraData'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(e.ExtraData))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(e.ExtraData)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (11) 'BaseFeePerGas'
+	hh.PutBytes(e.BaseFeePerGas[:])
+
+	// Field (12) 'BlockHash'
+	hh.PutBytes(e.BlockHash[:])
+
+	// Field (13) 'Transactions'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Transactions))
+		if num > 1048576 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range e.Transactions {
+			{
+				elemIndx := hh.Index()
+				byteLen := uint64(len(elem))
+				if byteLen > 1073741824 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				hh.AppendBytes32(elem)
+				hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1048576)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ExecutionPayload object
+func (e *ExecutionPayload) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
 
-// MarshalSSZ ssz marshals the Dummy object
-func (d *Dummy) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(d)
+// GetTreeCtx ssz hashes the ExecutionPayload object and stops with the error of the context once it is done
+func (e *ExecutionPayload) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, e)
+}
+
+// Generalized indices of the ExecutionPayload fields
+const (
+	ExecutionPayloadGindexParentHash    = 16
+	ExecutionPayloadGindexFeeRecipient  = 17
+	ExecutionPayloadGindexStateRoot     = 18
+	ExecutionPayloadGindexReceiptsRoot  = 19
+	ExecutionPayloadGindexLogsBloom     = 20
+	ExecutionPayloadGindexPrevRandao    = 21
+	ExecutionPayloadGindexBlockNumber   = 22
+	ExecutionPayloadGindexGasLimit      = 23
+	ExecutionPayloadGindexGasUsed       = 24
+	ExecutionPayloadGindexTimestamp     = 25
+	ExecutionPayloadGindexExtraData     = 26
+	ExecutionPayloadGindexBaseFeePerGas = 27
+	ExecutionPayloadGindexBlockHash     = 28
+	ExecutionPayloadGindexTransactions  = 29
+)
+
+// ExecutionPayloadGindexTransactionsElem returns the generalized index of the chunk of the i-th element of the Transactions field,
+// or zero if it does not fit in an int
+func ExecutionPayloadGindexTransactionsElem(i int) int {
+	return ssz.ConcatGindices(ExecutionPayloadGindexTransactions, ssz.ListElemGindex(i, 1048576, 0))
+}
+
+// ExecutionPayloadGindex returns the generalized index of a field path in the ExecutionPayload object
+func ExecutionPayloadGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "parent_hash":
+		return ssz.FieldGindex(ExecutionPayloadGindexParentHash, rest, nil)
+	case "fee_recipient":
+		return ssz.FieldGindex(ExecutionPayloadGindexFeeRecipient, rest, nil)
+	case "state_root":
+		return ssz.FieldGindex(ExecutionPayloadGindexStateRoot, rest, nil)
+	case "receipts_root":
+		return ssz.FieldGindex(ExecutionPayloadGindexReceiptsRoot, rest, nil)
+	case "logs_bloom":
+		return ssz.FieldGindex(ExecutionPayloadGindexLogsBloom, rest, nil)
+	case "prev_randao":
+		return ssz.FieldGindex(ExecutionPayloadGindexPrevRandao, rest, nil)
+	case "block_number":
+		return ssz.FieldGindex(ExecutionPayloadGindexBlockNumber, rest, nil)
+	case "gas_limit":
+		return ssz.FieldGindex(ExecutionPayloadGindexGasLimit, rest, nil)
+	case "gas_used":
+		return ssz.FieldGindex(ExecutionPayloadGindexGasUsed, rest, nil)
+	case "timestamp":
+		return ssz.FieldGindex(ExecutionPayloadGindexTimestamp, rest, nil)
+	case "extra_data":
+		return ssz.FieldGindex(ExecutionPayloadGindexExtraData, rest, nil)
+	case "base_fee_per_gas":
+		return ssz.FieldGindex(ExecutionPayloadGindexBaseFeePerGas, rest, nil)
+	case "block_hash":
+		return ssz.FieldGindex(ExecutionPayloadGindexBlockHash, rest, nil)
+	case "transactions":
+		return ssz.FieldGindex(ExecutionPayloadGindexTransactions, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1048576, 0, nil)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("ExecutionPayload", field)
+}
+
+// ProveField returns a proof of the field path in the ExecutionPayload object
+func (e *ExecutionPayload) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(e, ExecutionPayloadGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the ExecutionPayload object
+func (e *ExecutionPayload) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(e, ExecutionPayloadGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ExecutionPayloadHeader object to a target array
+func (e *ExecutionPayloadHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := e.fixedSize()
+
+	// Field (0) 'ParentHash'
+	if size := uint64(len(e.ParentHash)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ParentHash", size, 32)
+		return
+	}
+	dst = append(dst, e.ParentHash...)
+
+	// Field (1) 'FeeRecipient'
+	if size := uint64(len(e.FeeRecipient)); size != 20 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.FeeRecipient", size, 20)
+		return
+	}
+	dst = append(dst, e.FeeRecipient...)
+
+	// Field (2) 'StateRoot'
+	if size := uint64(len(e.StateRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.StateRoot", size, 32)
+		return
+	}
+	dst = append(dst, e.StateRoot...)
+
+	// Field (3) 'ReceiptsRoot'
+	if size := uint64(len(e.ReceiptsRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ReceiptsRoot", size, 32)
+		return
+	}
+	dst = append(dst, e.ReceiptsRoot...)
+
+	// Field (4) 'LogsBloom'
+	if size := uint64(len(e.LogsBloom)); size != 256 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.LogsBloom", size, 256)
+		return
+	}
+	dst = append(dst, e.LogsBloom...)
+
+	// Field (5) 'PrevRandao'
+	if size := uint64(len(e.PrevRandao)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.PrevRandao", size, 32)
+		return
+	}
+	dst = append(dst, e.PrevRandao...)
+
+	// Field (6) 'BlockNumber'
+	dst = ssz.MarshalValue(dst, e.BlockNumber)
+
+	// Field (7) 'GasLimit'
+	dst = ssz.MarshalValue(dst, e.GasLimit)
+
+	// Field (8) 'GasUsed'
+	dst = ssz.MarshalValue(dst, e.GasUsed)
+
+	// Field (9) 'Timestamp'
+	dst = ssz.MarshalValue(dst, e.Timestamp)
+
+	// Offset (10) 'ExtraData'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (11) 'BaseFeePerGas'
+	if size := uint64(len(e.BaseFeePerGas)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BaseFeePerGas", size, 32)
+		return
+	}
+	dst = append(dst, e.BaseFeePerGas...)
+
+	// Field (12) 'BlockHash'
+	if size := uint64(len(e.BlockHash)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BlockHash", size, 32)
+		return
+	}
+	dst = append(dst, e.BlockHash...)
+
+	// Field (13) 'TransactionsRoot'
+	if size := uint64(len(e.TransactionsRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.TransactionsRoot", size, 32)
+		return
+	}
+	dst = append(dst, e.TransactionsRoot...)
+
+	// Field (10) 'ExtraData'
+	if size := uint64(len(e.ExtraData)); size > 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ExtraData", size, 32)
+		return
+	}
+	dst = append(dst, e.ExtraData...)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the ExecutionPayloadHeader object to a writer
+func (e *ExecutionPayloadHeader) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := e.fixedSize()
+
+	// Field (0) 'ParentHash'
+	if size := uint64(len(e.ParentHash)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ParentHash", size, 32)
+		return
+	}
+	dst = append(dst, e.ParentHash...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'FeeRecipient'
+	if size := uint64(len(e.FeeRecipient)); size != 20 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.FeeRecipient", size, 20)
+		return
+	}
+	dst = append(dst, e.FeeRecipient...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'StateRoot'
+	if size := uint64(len(e.StateRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.StateRoot", size, 32)
+		return
+	}
+	dst = append(dst, e.StateRoot...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'ReceiptsRoot'
+	if size := uint64(len(e.ReceiptsRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ReceiptsRoot", size, 32)
+		return
+	}
+	dst = append(dst, e.ReceiptsRoot...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'LogsBloom'
+	if size := uint64(len(e.LogsBloom)); size != 256 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.LogsBloom", size, 256)
+		return
+	}
+	dst = append(dst, e.LogsBloom...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'PrevRandao'
+	if size := uint64(len(e.PrevRandao)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.PrevRandao", size, 32)
+		return
+	}
+	dst = append(dst, e.PrevRandao...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (6) 'BlockNumber'
+	dst = ssz.MarshalValue(dst, e.BlockNumber)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (7) 'GasLimit'
+	dst = ssz.MarshalValue(dst, e.GasLimit)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (8) 'GasUsed'
+	dst = ssz.MarshalValue(dst, e.GasUsed)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (9) 'Timestamp'
+	dst = ssz.MarshalValue(dst, e.Timestamp)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (10) 'ExtraData'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (11) 'BaseFeePerGas'
+	if size := uint64(len(e.BaseFeePerGas)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BaseFeePerGas", size, 32)
+		return
+	}
+	dst = append(dst, e.BaseFeePerGas...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (12) 'BlockHash'
+	if size := uint64(len(e.BlockHash)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BlockHash", size, 32)
+		return
+	}
+	dst = append(dst, e.BlockHash...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (13) 'TransactionsRoot'
+	if size := uint64(len(e.TransactionsRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.TransactionsRoot", size, 32)
+		return
+	}
+	dst = append(dst, e.TransactionsRoot...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (10) 'ExtraData'
+	if size := uint64(len(e.ExtraData)); size > 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ExtraData", size, 32)
+		return
+	}
+	dst = append(dst, e.ExtraData...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(e, buf)
+}
+
+// UnmarshalSSZTail unmarshals the ExecutionPayloadHeader object and returns the remaining bufferº
+func (e *ExecutionPayloadHeader) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := e.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o10 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'ParentHash'
+	e.ParentHash, buf = ssz.UnmarshalBytes(e.ParentHash, buf, 32)
+
+	// Field (1) 'FeeRecipient'
+	e.FeeRecipient, buf = ssz.UnmarshalBytes(e.FeeRecipient, buf, 20)
+
+	// Field (2) 'StateRoot'
+	e.StateRoot, buf = ssz.UnmarshalBytes(e.StateRoot, buf, 32)
+
+	// Field (3) 'ReceiptsRoot'
+	e.ReceiptsRoot, buf = ssz.UnmarshalBytes(e.ReceiptsRoot, buf, 32)
+
+	// Field (4) 'LogsBloom'
+	e.LogsBloom, buf = ssz.UnmarshalBytes(e.LogsBloom, buf, 256)
+
+	// Field (5) 'PrevRandao'
+	e.PrevRandao, buf = ssz.UnmarshalBytes(e.PrevRandao, buf, 32)
+
+	// Field (6) 'BlockNumber'
+	e.BlockNumber, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (7) 'GasLimit'
+	e.GasLimit, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (8) 'GasUsed'
+	e.GasUsed, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (9) 'Timestamp'
+	e.Timestamp, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Offset (10) 'ExtraData'
+	if o10, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (11) 'BaseFeePerGas'
+	e.BaseFeePerGas, buf = ssz.UnmarshalBytes(e.BaseFeePerGas, buf, 32)
+
+	// Field (12) 'BlockHash'
+	e.BlockHash, buf = ssz.UnmarshalBytes(e.BlockHash, buf, 32)
+
+	// Field (13) 'TransactionsRoot'
+	e.TransactionsRoot, buf = ssz.UnmarshalBytes(e.TransactionsRoot, buf, 32)
+
+	// Field (10) 'ExtraData'
+	if e.ExtraData, err = ssz.UnmarshalDynamicBytes(e.ExtraData, tail[o10:], 32); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the ExecutionPayloadHeader object from a reader with the size of the encoding
+func (e *ExecutionPayloadHeader) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := e.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o10 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'ParentHash'
+		e.ParentHash, buf = ssz.UnmarshalBytes(e.ParentHash, buf, 32)
+
+		// Field (1) 'FeeRecipient'
+		e.FeeRecipient, buf = ssz.UnmarshalBytes(e.FeeRecipient, buf, 20)
+
+		// Field (2) 'StateRoot'
+		e.StateRoot, buf = ssz.UnmarshalBytes(e.StateRoot, buf, 32)
+
+		// Field (3) 'ReceiptsRoot'
+		e.ReceiptsRoot, buf = ssz.UnmarshalBytes(e.ReceiptsRoot, buf, 32)
+
+		// Field (4) 'LogsBloom'
+		e.LogsBloom, buf = ssz.UnmarshalBytes(e.LogsBloom, buf, 256)
+
+		// Field (5) 'PrevRandao'
+		e.PrevRandao, buf = ssz.UnmarshalBytes(e.PrevRandao, buf, 32)
+
+		// Field (6) 'BlockNumber'
+		e.BlockNumber, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (7) 'GasLimit'
+		e.GasLimit, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (8) 'GasUsed'
+		e.GasUsed, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (9) 'Timestamp'
+		e.Timestamp, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (10) 'ExtraData'
+		if o10, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (11) 'BaseFeePerGas'
+		e.BaseFeePerGas, buf = ssz.UnmarshalBytes(e.BaseFeePerGas, buf, 32)
+
+		// Field (12) 'BlockHash'
+		e.BlockHash, buf = ssz.UnmarshalBytes(e.BlockHash, buf, 32)
+
+		// Field (13) 'TransactionsRoot'
+		e.TransactionsRoot, buf = ssz.UnmarshalBytes(e.TransactionsRoot, buf, 32)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (10) 'ExtraData'
+	if uint64(size-int(o10)) > 32 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o10), func(buf []byte) (_ []byte, err error) {
+		if e.ExtraData, err = ssz.UnmarshalDynamicBytes(e.ExtraData, buf, 32); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) fixedSize() int {
+	return int(536)
 }
 
-// MarshalSSZTo ssz marshals the Dummy object to a target array
-func (d *Dummy) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) SizeSSZ() (size int) {
+	size = e.fixedSize()
+
+	// Field (10) 'ExtraData'
+	size += len(e.ExtraData)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the Dummy object
-func (d *Dummy) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(d, buf)
+// HashTreeRoot ssz hashes the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
 }
 
-// UnmarshalSSZTail unmarshals the Dummy object and returns the remaining bufferº
-func (d *Dummy) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
-	fixedSize := d.fixedSize()
-	if size < fixedSize {
-		return nil, ssz.ErrSize
+// HashTreeRootCtx ssz hashes the ExecutionPayloadHeader object and stops with the error of the context once it is done
+func (e *ExecutionPayloadHeader) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, e)
+}
+
+// HashTreeRootWith ssz hashes the ExecutionPayloadHeader object with a hasher
+func (e *ExecutionPayloadHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ParentHash'
+	if size := uint64(len(e.ParentHash)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ParentHash", size, 32)
+		return
 	}
+	hh.PutBytes(e.ParentHash)
 
-	return buf, nil
+	// Field (1) 'FeeRecipient'
+	if size := uint64(len(e.FeeRecipient)); size != 20 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.FeeRecipient", size, 20)
+		return
+	}
+	hh.PutBytes(e.FeeRecipient)
+
+	// Field (2) 'StateRoot'
+	if size := uint64(len(e.StateRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.StateRoot", size, 32)
+		return
+	}
+	hh.PutBytes(e.StateRoot)
+
+	// Field (3) 'ReceiptsRoot'
+	if size := uint64(len(e.ReceiptsRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ReceiptsRoot", size, 32)
+		return
+	}
+	hh.PutBytes(e.ReceiptsRoot)
+
+	// Field (4) 'LogsBloom'
+	if size := uint64(len(e.LogsBloom)); size != 256 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.LogsBloom", size, 256)
+		return
+	}
+	hh.PutBytes(e.LogsBloom)
+
+	// Field (5) 'PrevRandao'
+	if size := uint64(len(e.PrevRandao)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.PrevRandao", size, 32)
+		return
+	}
+	hh.PutBytes(e.PrevRandao)
+
+	// Field (6) 'BlockNumber'
+	hh.PutUint64(e.BlockNumber)
+
+	// Field (7) 'GasLimit'
+	hh.PutUint64(e.GasLimit)
+
+	// Field (8) 'GasUsed'
+	hh.PutUint64(e.GasUsed)
+
+	// Field (9) 'Timestamp'
+	hh.PutUint64(e.Timestamp)
+
+	// Field (10) 'ExtraData'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(e.ExtraData))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(e.ExtraData)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (11) 'BaseFeePerGas'
+	if size := uint64(len(e.BaseFeePerGas)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BaseFeePerGas", size, 32)
+		return
+	}
+	hh.PutBytes(e.BaseFeePerGas)
+
+	// Field (12) 'BlockHash'
+	if size := uint64(len(e.BlockHash)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BlockHash", size, 32)
+		return
+	}
+	hh.PutBytes(e.BlockHash)
+
+	// Field (13) 'TransactionsRoot'
+	if size := uint64(len(e.TransactionsRoot)); size != 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.TransactionsRoot", size, 32)
+		return
+	}
+	hh.PutBytes(e.TransactionsRoot)
+
+	hh.Merkleize(indx)
+	return
 }
 
-// fixedSize returns the fixed size of the Dummy object
-func (d *Dummy) fixedSize() int {
-	return int(0)
+// GetTree ssz hashes the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Dummy object
-func (d *Dummy) SizeSSZ() (size int) {
-	size = d.fixedSize()
-	return
+// GetTreeCtx ssz hashes the ExecutionPayloadHeader object and stops with the error of the context once it is done
+func (e *ExecutionPayloadHeader) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, e)
+}
+
+// Generalized indices of the ExecutionPayloadHeader fields
+const (
+	ExecutionPayloadHeaderGindexParentHash       = 16
+	ExecutionPayloadHeaderGindexFeeRecipient     = 17
+	ExecutionPayloadHeaderGindexStateRoot        = 18
+	ExecutionPayloadHeaderGindexReceiptsRoot     = 19
+	ExecutionPayloadHeaderGindexLogsBloom        = 20
+	ExecutionPayloadHeaderGindexPrevRandao       = 21
+	ExecutionPayloadHeaderGindexBlockNumber      = 22
+	ExecutionPayloadHeaderGindexGasLimit         = 23
+	ExecutionPayloadHeaderGindexGasUsed          = 24
+	ExecutionPayloadHeaderGindexTimestamp        = 25
+	ExecutionPayloadHeaderGindexExtraData        = 26
+	ExecutionPayloadHeaderGindexBaseFeePerGas    = 27
+	ExecutionPayloadHeaderGindexBlockHash        = 28
+	ExecutionPayloadHeaderGindexTransactionsRoot = 29
+)
+
+// ExecutionPayloadHeaderGindex returns the generalized index of a field path in the ExecutionPayloadHeader object
+func ExecutionPayloadHeaderGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "parent_hash":
+		return ssz.FieldGindex(ExecutionPayloadHeaderGindexParentHash, rest, nil)
+	case "fee_recipient":
+		return ssz.FieldGindex(ExecutionPayloadHeaderGindexFeeRecipient, rest, nil)
+	case "state_root":
+		return ssz.FieldGindex(ExecutionPayloadHeaderGindexStateRoot, rest, nil)
+	case "receipts_root":
+		return ssz.FieldGindex(ExecutionPayloadHeaderGindexReceiptsRoot, rest, nil)
+	case "logs_bloom":
+		return ssz.FieldGindex(ExecutionPayloadHeaderGindexLogsBloom, rest, nil)
+	case "prev_randao":
+		return ssz.FieldGindex(ExecutionPayloadHeaderGindexPrevRandao, rest, nil)
+	case "block_number":
+		return ssz.FieldGindex(ExecutionPayloadHeaderGindexBlockNumber, rest, nil)
+	case "gas_limit":
+		return ssz.FieldGindex(ExecutionPayloadHeaderGindexGasLimit, rest, nil)
+	case "gas_used":
+		return ssz.FieldGindex(ExecutionPayloadHeaderGindexGasUsed, rest, nil)
+	case "timestamp":
+		return ssz.FieldGindex(ExecutionPayloadHeaderGindexTimestamp, rest, nil)
+	case "extra_data":
+		return ssz.FieldGindex(ExecutionPayloadHeaderGindexExtraData, rest, nil)
+	case "base_fee_per_gas":
+		return ssz.FieldGindex(ExecutionPayloadHeaderGindexBaseFeePerGas, rest, nil)
+	case "block_hash":
+		return ssz.FieldGindex(ExecutionPayloadHeaderGindexBlockHash, rest, nil)
+	case "transactions_root":
+		return ssz.FieldGindex(ExecutionPayloadHeaderGindexTransactionsRoot, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("ExecutionPayloadHeader", field)
+}
+
+// ProveField returns a proof of the field path in the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(e, ExecutionPayloadHeaderGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(e, ExecutionPayloadHeaderGindex, paths...)
 }
 
-// HashTreeRoot ssz hashes the Dummy object
-func (d *Dummy) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(d)
+// MarshalSSZ ssz marshals the ExecutionPayloadTransactions object
+func (e *ExecutionPayloadTransactions) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
 }
 
-// HashTreeRootWith ssz hashes the Dummy object with a hasher
-func (d *Dummy) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// MarshalSSZTo ssz marshals the ExecutionPayloadTransactions object to a target array
+func (e *ExecutionPayloadTransactions) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := e.fixedSize()
+
+	// Offset (0) 'Transactions'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Transactions'
+	if size := uint64(len(e.Transactions)); size > 1048576 {
+		err = ssz.ErrListTooBigFn("ExecutionPayloadTransactions.Transactions", size, 1048576)
+		return
+	}
+	{
+		offset = 4 * len(e.Transactions)
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(e.Transactions[ii])
+		}
+	}
+	for ii := 0; ii < len(e.Transactions); ii++ {
+		if size := uint64(len(e.Transactions[ii])); size > 1073741824 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayloadTransactions.Transactions[ii]", size, 1073741824)
+			return
+		}
+		dst = append(dst, e.Transactions[ii]...)
+	}
 
-	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the Dummy object
-func (d *Dummy) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(d)
+// MarshalSSZWriter ssz marshals the ExecutionPayloadTransactions object to a writer
+func (e *ExecutionPayloadTransactions) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := e.fixedSize()
+
+	// Offset (0) 'Transactions'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Transactions'
+	if size := uint64(len(e.Transactions)); size > 1048576 {
+		err = ssz.ErrListTooBigFn("ExecutionPayloadTransactions.Transactions", size, 1048576)
+		return
+	}
+	{
+		offset = 4 * len(e.Transactions)
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(e.Transactions[ii])
+		}
+	}
+	for ii := 0; ii < len(e.Transactions); ii++ {
+		if size := uint64(len(e.Transactions[ii])); size > 1073741824 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayloadTransactions.Transactions[ii]", size, 1073741824)
+			return
+		}
+		dst = append(dst, e.Transactions[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the ExecutionPayloadTransactions object
+func (e *ExecutionPayloadTransactions) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(e, buf)
+}
+
+// UnmarshalSSZTail unmarshals the ExecutionPayloadTransactions object and returns the remaining bufferº
+func (e *ExecutionPayloadTransactions) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := e.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Offset (0) 'Transactions'
+	if o0, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (0) 'Transactions'
+	if err = ssz.UnmarshalDynamicSliceWithCallback(&e.Transactions, tail[o0:], 1048576, func(indx uint64, buf []byte) (err error) {
+		if e.Transactions[indx], err = ssz.UnmarshalDynamicBytes(e.Transactions[indx], buf, 1073741824); err != nil {
+			return
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return
 }
 
-// MarshalSSZ ssz marshals the SyncCommittee object
-func (s *SyncCommittee) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
-}
+// UnmarshalSSZReader ssz unmarshals the ExecutionPayloadTransactions object from a reader with the size of the encoding
+func (e *ExecutionPayloadTransactions) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := e.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
 
-// MarshalSSZTo ssz marshals the SyncCommittee object to a target array
-func (s *SyncCommittee) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
 
-	// Field (0) 'PubKeys'
-	if size := uint64(len(s.PubKeys)); size != syncCommitteePubKeys {
-		err = ssz.ErrVectorLengthFn("SyncCommittee.PubKeys", size, syncCommitteePubKeys)
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'Transactions'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
 		return
 	}
-	for ii := uint64(0); ii < syncCommitteePubKeys; ii++ {
-		if size := uint64(len(s.PubKeys[ii])); size != 48 {
-			err = ssz.ErrBytesLengthFn("SyncCommittee.PubKeys[ii]", size, 48)
+
+	// Field (0) 'Transactions'
+	{
+		var sizes []int
+		if sizes, err = rr.ReadOffsets(size-int(o0), 1048576); err != nil {
 			return
 		}
-		dst = append(dst, s.PubKeys[ii]...)
+		num := len(sizes)
+		e.Transactions = make([][]byte, num)
+		for ii, size := range sizes {
+			if uint64(size) > 1073741824 {
+				return ssz.ErrListTooBig
+			}
+			if err = rr.Decode(size, func(buf []byte) (_ []byte, err error) {
+				if e.Transactions[ii], err = ssz.UnmarshalDynamicBytes(e.Transactions[ii], buf, 1073741824); err != nil {
+					return
+				}
+				return buf, nil
+			}); err != nil {
+				return
+			}
+		}
 	}
 
-	// Field (1) 'AggregatePubKey'
-	dst = append(dst, s.AggregatePubKey[:]...)
-
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SyncCommittee object
-func (s *SyncCommittee) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(s, buf)
+// fixedSize returns the fixed size of the ExecutionPayloadTransactions object
+func (e *ExecutionPayloadTransactions) fixedSize() int {
+	return int(4)
 }
 
-// UnmarshalSSZTail unmarshals the SyncCommittee object and returns the remaining bufferº
-func (s *SyncCommittee) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
-	fixedSize := s.fixedSize()
-	if size < fixedSize {
-		return nil, ssz.ErrSize
-	}
+// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadTransactions object
+func (e *ExecutionPayloadTransactions) SizeSSZ() (size int) {
+	size = e.fixedSize()
 
-	// Field (0) 'PubKeys'
-	s.PubKeys = make([][]byte, syncCommitteePubKeys)
-	for ii := uint64(0); ii < syncCommitteePubKeys; ii++ {
-		s.PubKeys[ii], buf = ssz.UnmarshalBytes(s.PubKeys[ii], buf, 48)
+	// Field (0) 'Transactions'
+	for ii := 0; ii < len(e.Transactions); ii++ {
+		size += 4
+		size += len(e.Transactions[ii])
 	}
 
-	// Field (1) 'AggregatePubKey'
-	buf = ssz.UnmarshalFixedBytes(s.AggregatePubKey[:], buf)
-
-	return buf, nil
-}
-
-// fixedSize returns the fixed size of the SyncCommittee object
-func (s *SyncCommittee) fixedSize() int {
-	return int((48 + (syncCommitteePubKeys * 48)))
+	return
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SyncCommittee object
-func (s *SyncCommittee) SizeSSZ() (size int) {
-	size = s.fixedSize()
-	return
+// HashTreeRoot ssz hashes the ExecutionPayloadTransactions object
+func (e *ExecutionPayloadTransactions) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
 }
 
-// HashTreeRoot ssz hashes the SyncCommittee object
-func (s *SyncCommittee) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// HashTreeRootCtx ssz hashes the ExecutionPayloadTransactions object and stops with the error of the context once it is done
+func (e *ExecutionPayloadTransactions) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, e)
 }
 
-// HashTreeRootWith ssz hashes the SyncCommittee object with a hasher
-func (s *SyncCommittee) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// HashTreeRootWith ssz hashes the ExecutionPayloadTransactions object with a hasher
+func (e *ExecutionPayloadTransactions) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'PubKeys'
+	// Field (0) 'Transactions'
 	{
-		if size := uint64(len(s.PubKeys)); size != syncCommitteePubKeys {
-			err = ssz.ErrVectorLengthFn("SyncCommittee.PubKeys", size, syncCommitteePubKeys)
+		subIndx := hh.Index()
+		num := uint64(len(e.Transactions))
+		if num > 1048576 {
+			err = ssz.ErrIncorrectListSize
 			return
 		}
-		subIndx := hh.Index()
-		for _, i := range s.PubKeys {
-			if len(i) != 48 {
-				err = ssz.ErrBytesLength
-				return
+		for _, elem := range e.Transactions {
+			{
+				elemIndx := hh.Index()
+				byteLen := uint64(len(elem))
+				if byteLen > 1073741824 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				hh.AppendBytes32(elem)
+				hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
 			}
-			hh.PutBytes(i)
 		}
-		hh.Merkleize(subIndx)
+		hh.MerkleizeWithMixin(subIndx, num, 1048576)
 	}
 
-	// Field (1) 'AggregatePubKey'
-	hh.PutBytes(s.AggregatePubKey[:])
-
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the SyncCommittee object
-func (s *SyncCommittee) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(s)
+// GetTree ssz hashes the ExecutionPayloadTransactions object
+func (e *ExecutionPayloadTransactions) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
 }
 
-// MarshalSSZ ssz marshals the SyncAggregate object
-func (s *SyncAggregate) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// GetTreeCtx ssz hashes the ExecutionPayloadTransactions object and stops with the error of the context once it is done
+func (e *ExecutionPayloadTransactions) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, e)
 }
 
-// MarshalSSZTo ssz marshals the SyncAggregate object to a target array
-func (s *SyncAggregate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// Generalized indices of the ExecutionPayloadTransactions fields
+const (
+	ExecutionPayloadTransactionsGindexTransactions = 1
+)
 
-	// Field (0) 'SyncCommiteeBits'
-	if size := uint64(len(s.SyncCommiteeBits)); size != syncCommitteeBits {
-		err = ssz.ErrBytesLengthFn("SyncAggregate.SyncCommiteeBits", size, syncCommitteeBits)
-		return
+// ExecutionPayloadTransactionsGindexTransactionsElem returns the generalized index of the chunk of the i-th element of the Transactions field,
+// or zero if it does not fit in an int
+func ExecutionPayloadTransactionsGindexTransactionsElem(i int) int {
+	return ssz.ConcatGindices(ExecutionPayloadTransactionsGindexTransactions, ssz.ListElemGindex(i, 1048576, 0))
+}
+
+// ExecutionPayloadTransactionsGindex returns the generalized index of a field path in the ExecutionPayloadTransactions object
+func ExecutionPayloadTransactionsGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
 	}
-	dst = append(dst, s.SyncCommiteeBits...)
+	switch field {
+	case "transactions":
+		return ssz.FieldGindex(ExecutionPayloadTransactionsGindexTransactions, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1048576, 0, nil)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("ExecutionPayloadTransactions", field)
+}
 
-	// Field (1) 'SyncCommiteeSignature'
-	dst = append(dst, s.SyncCommiteeSignature[:]...)
+// ProveField returns a proof of the field path in the ExecutionPayloadTransactions object
+func (e *ExecutionPayloadTransactions) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(e, ExecutionPayloadTransactionsGindex, path)
+}
 
-	return
+// ProveFields returns a multiproof of the field paths in the ExecutionPayloadTransactions object
+func (e *ExecutionPayloadTransactions) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(e, ExecutionPayloadTransactionsGindex, paths...)
 }
 
-// UnmarshalSSZ ssz unmarshals the SyncAggregate object
-func (s *SyncAggregate) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(s, buf)
+// MarshalSSZ ssz marshals the ExecutionPayloadCapella object
+func (e *ExecutionPayloadCapella) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
 }
 
-// UnmarshalSSZTail unmarshals the SyncAggregate object and returns the remaining bufferº
-func (s *SyncAggregate) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
-	fixedSize := s.fixedSize()
-	if size < fixedSize {
-		return nil, ssz.ErrSize
-	}
+// MarshalSSZTo ssz marshals the ExecutionPayloadCapella object to a target array
+func (e *ExecutionPayloadCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := e.fixedSize()
 
-	// Field (0) 'SyncCommiteeBits'
-	s.SyncCommiteeBits, buf = ssz.UnmarshalBytes(s.SyncCommiteeBits, buf, syncCommitteeBits)
+	// Field (0) 'ParentHash'
+	dst = append(dst, e.ParentHash[:]...)
 
-	// Field (1) 'SyncCommiteeSignature'
-	buf = ssz.UnmarshalFixedBytes(s.SyncCommiteeSignature[:], buf)
+	// Field (1) 'FeeRecipient'
+	dst = append(dst, e.FeeRecipient[:]...)
 
-	return buf, nil
-}
+	// Field (2) 'StateRoot'
+	dst = append(dst, e.StateRoot[:]...)
 
-// fixedSize returns the fixed size of the SyncAggregate object
-func (s *SyncAggregate) fixedSize() int {
-	return int((96 + syncCommitteeBits))
-}
+	// Field (3) 'ReceiptsRoot'
+	dst = append(dst, e.ReceiptsRoot[:]...)
 
-// SizeSSZ returns the ssz encoded size in bytes for the SyncAggregate object
-func (s *SyncAggregate) SizeSSZ() (size int) {
-	size = s.fixedSize()
-	return
-}
+	// Field (4) 'LogsBloom'
+	dst = append(dst, e.LogsBloom[:]...)
 
-// HashTreeRoot ssz hashes the SyncAggregate object
-func (s *SyncAggregate) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
-}
+	// Field (5) 'PrevRandao'
+	dst = append(dst, e.PrevRandao[:]...)
 
-// HashTreeRootWith ssz hashes the SyncAggregate object with a hasher
-func (s *SyncAggregate) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+	// Field (6) 'BlockNumber'
+	dst = ssz.MarshalValue(dst, e.BlockNumber)
 
-	// Field (0) 'SyncCommiteeBits'
-	if size := uint64(len(s.SyncCommiteeBits)); size != syncCommitteeBits {
-		err = ssz.ErrBytesLengthFn("SyncAggregate.SyncCommiteeBits", size, syncCommitteeBits)
+	// Field (7) 'GasLimit'
+	dst = ssz.MarshalValue(dst, e.GasLimit)
+
+	// Field (8) 'GasUsed'
+	dst = ssz.MarshalValue(dst, e.GasUsed)
+
+	// Field (9) 'Timestamp'
+	dst = ssz.MarshalValue(dst, e.Timestamp)
+
+	// Offset (10) 'ExtraData'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(e.ExtraData)
+
+	// Field (11) 'BaseFeePerGas'
+	dst = ssz.MarshalValue(dst, ssz.Uint256(e.BaseFeePerGas))
+
+	// Field (12) 'BlockHash'
+	dst = append(dst, e.BlockHash[:]...)
+
+	// Offset (13) 'Transactions'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(e.Transactions); ii++ {
+		offset += 4
+		offset += len(e.Transactions[ii])
+	}
+
+	// Offset (14) 'Withdrawals'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (10) 'ExtraData'
+	if size := uint64(len(e.ExtraData)); size > 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadCapella.ExtraData", size, 32)
+		return
+	}
+	dst = append(dst, e.ExtraData...)
+
+	// Field (13) 'Transactions'
+	if size := uint64(len(e.Transactions)); size > 1048576 {
+		err = ssz.ErrListTooBigFn("ExecutionPayloadCapella.Transactions", size, 1048576)
+		return
+	}
+	{
+		offset = 4 * len(e.Transactions)
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(e.Transactions[ii])
+		}
+	}
+	for ii := 0; ii < len(e.Transactions); ii++ {
+		if size := uint64(len(e.Transactions[ii])); size > 1073741824 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayloadCapella.Transactions[ii]", size, 1073741824)
+			return
+		}
+		dst = append(dst, e.Transactions[ii]...)
+	}
+
+	// Field (14) 'Withdrawals'
+	if size := uint64(len(e.Withdrawals)); size > withdrawals {
+		err = ssz.ErrListTooBigFn("ExecutionPayloadCapella.Withdrawals", size, withdrawals)
 		return
 	}
-	hh.PutBytes(s.SyncCommiteeBits)
+	for ii := 0; ii < len(e.Withdrawals); ii++ {
+		if dst, err = e.Withdrawals[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
-	// Field (1) 'SyncCommiteeSignature'
-	hh.PutBytes(s.SyncCommiteeSignature[:])
-
-	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the SyncAggregate object
-func (s *SyncAggregate) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(s)
-}
-
-// MarshalSSZ ssz marshals the ExecutionPayload object
-func (e *ExecutionPayload) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
-}
-
-// MarshalSSZTo ssz marshals the ExecutionPayload object to a target array
-func (e *ExecutionPayload) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// MarshalSSZWriter ssz marshals the ExecutionPayloadCapella object to a writer
+func (e *ExecutionPayloadCapella) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
 	offset := e.fixedSize()
 
 	// Field (0) 'ParentHash'
 	dst = append(dst, e.ParentHash[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (1) 'FeeRecipient'
 	dst = append(dst, e.FeeRecipient[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (2) 'StateRoot'
 	dst = append(dst, e.StateRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (3) 'ReceiptsRoot'
 	dst = append(dst, e.ReceiptsRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (4) 'LogsBloom'
 	dst = append(dst, e.LogsBloom[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (5) 'PrevRandao'
 	dst = append(dst, e.PrevRandao[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (6) 'BlockNumber'
 	dst = ssz.MarshalValue(dst, e.BlockNumber)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (7) 'GasLimit'
 	dst = ssz.MarshalValue(dst, e.GasLimit)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (8) 'GasUsed'
 	dst = ssz.MarshalValue(dst, e.GasUsed)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (9) 'Timestamp'
 	dst = ssz.MarshalValue(dst, e.Timestamp)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Offset (10) 'ExtraData'
 	dst = ssz.WriteOffset(dst, offset)
 	offset += len(e.ExtraData)
 
 	// Field (11) 'BaseFeePerGas'
-	dst = append(dst, e.BaseFeePerGas[:]...)
+	dst = ssz.MarshalValue(dst, ssz.Uint256(e.BaseFeePerGas))
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (12) 'BlockHash'
 	dst = append(dst, e.BlockHash[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Offset (13) 'Transactions'
 	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(e.Transactions); ii++ {
+		offset += 4
+		offset += len(e.Transactions[ii])
+	}
+
+	// Offset (14) 'Withdrawals'
+	dst = ssz.WriteOffset(dst, offset)
 
 	// Field (10) 'ExtraData'
 	if size := uint64(len(e.ExtraData)); size > 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayload.ExtraData", size, 32)
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadCapella.ExtraData", size, 32)
 		return
 	}
 	dst = append(dst, e.ExtraData...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (13) 'Transactions'
 	if size := uint64(len(e.Transactions)); size > 1048576 {
-		err = ssz.ErrListTooBigFn("ExecutionPayload.Transactions", size, 1048576)
+		err = ssz.ErrListTooBigFn("ExecutionPayloadCapella.Transactions", size, 1048576)
 		return
 	}
 	{
@@ -6396,22 +14026,39 @@ func (e *ExecutionPayload) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 	for ii := 0; ii < len(e.Transactions); ii++ {
 		if size := uint64(len(e.Transactions[ii])); size > 1073741824 {
-			err = ssz.ErrBytesLengthFn("ExecutionPayload.Transactions[ii]", size, 1073741824)
+			err = ssz.ErrBytesLengthFn("ExecutionPayloadCapella.Transactions[ii]", size, 1073741824)
 			return
 		}
 		dst = append(dst, e.Transactions[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
-	return
+	// Field (14) 'Withdrawals'
+	if size := uint64(len(e.Withdrawals)); size > withdrawals {
+		err = ssz.ErrListTooBigFn("ExecutionPayloadCapella.Withdrawals", size, withdrawals)
+		return
+	}
+	for ii := 0; ii < len(e.Withdrawals); ii++ {
+		if dst, err = ww.WriteObject(dst, e.Withdrawals[ii]); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
 }
 
-// UnmarshalSSZ ssz unmarshals the ExecutionPayload object
-func (e *ExecutionPayload) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the ExecutionPayloadCapella object
+func (e *ExecutionPayloadCapella) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(e, buf)
 }
 
-// UnmarshalSSZTail unmarshals the ExecutionPayload object and returns the remaining bufferº
-func (e *ExecutionPayload) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+// UnmarshalSSZTail unmarshals the ExecutionPayloadCapella object and returns the remaining bufferº
+func (e *ExecutionPayloadCapella) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	size := len(buf)
 	fixedSize := e.fixedSize()
 	if size < fixedSize {
@@ -6419,7 +14066,7 @@ func (e *ExecutionPayload) UnmarshalSSZTail(buf []byte) (rest []byte, err error)
 	}
 
 	tail := buf
-	var o10, o13 uint64
+	var o10, o13, o14 uint64
 	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
 
 	// Field (0) 'ParentHash'
@@ -6458,13 +14105,22 @@ func (e *ExecutionPayload) UnmarshalSSZTail(buf []byte) (rest []byte, err error)
 	}
 
 	// Field (11) 'BaseFeePerGas'
-	buf = ssz.UnmarshalFixedBytes(e.BaseFeePerGas[:], buf)
+	{
+		var val ssz.Uint256
+		val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+		e.BaseFeePerGas = Uint256(val)
+	}
 
 	// Field (12) 'BlockHash'
 	buf = ssz.UnmarshalFixedBytes(e.BlockHash[:], buf)
 
 	// Offset (13) 'Transactions'
-	if o13, _, err = marker.ReadOffset(buf); err != nil {
+	if o13, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (14) 'Withdrawals'
+	if o14, _, err = marker.ReadOffset(buf); err != nil {
 		return nil, err
 	}
 
@@ -6474,7 +14130,7 @@ func (e *ExecutionPayload) UnmarshalSSZTail(buf []byte) (rest []byte, err error)
 	}
 
 	// Field (13) 'Transactions'
-	if err = ssz.UnmarshalDynamicSliceWithCallback(&e.Transactions, tail[o13:], 1048576, func(indx uint64, buf []byte) (err error) {
+	if err = ssz.UnmarshalDynamicSliceWithCallback(&e.Transactions, tail[o13:o14], 1048576, func(indx uint64, buf []byte) (err error) {
 		if e.Transactions[indx], err = ssz.UnmarshalDynamicBytes(e.Transactions[indx], buf, 1073741824); err != nil {
 			return
 		}
@@ -6483,16 +14139,134 @@ func (e *ExecutionPayload) UnmarshalSSZTail(buf []byte) (rest []byte, err error)
 		return nil, err
 	}
 
+	// Field (14) 'Withdrawals'
+	if err = ssz.UnmarshalSliceSSZ(&e.Withdrawals, tail[o14:], withdrawals); err != nil {
+		return nil, err
+	}
+
 	return
 }
 
-// fixedSize returns the fixed size of the ExecutionPayload object
-func (e *ExecutionPayload) fixedSize() int {
-	return int(508)
+// UnmarshalSSZReader ssz unmarshals the ExecutionPayloadCapella object from a reader with the size of the encoding
+func (e *ExecutionPayloadCapella) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := e.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o10, o13, o14 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'ParentHash'
+		buf = ssz.UnmarshalFixedBytes(e.ParentHash[:], buf)
+
+		// Field (1) 'FeeRecipient'
+		buf = ssz.UnmarshalFixedBytes(e.FeeRecipient[:], buf)
+
+		// Field (2) 'StateRoot'
+		buf = ssz.UnmarshalFixedBytes(e.StateRoot[:], buf)
+
+		// Field (3) 'ReceiptsRoot'
+		buf = ssz.UnmarshalFixedBytes(e.ReceiptsRoot[:], buf)
+
+		// Field (4) 'LogsBloom'
+		buf = ssz.UnmarshalFixedBytes(e.LogsBloom[:], buf)
+
+		// Field (5) 'PrevRandao'
+		buf = ssz.UnmarshalFixedBytes(e.PrevRandao[:], buf)
+
+		// Field (6) 'BlockNumber'
+		e.BlockNumber, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (7) 'GasLimit'
+		e.GasLimit, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (8) 'GasUsed'
+		e.GasUsed, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (9) 'Timestamp'
+		e.Timestamp, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (10) 'ExtraData'
+		if o10, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (11) 'BaseFeePerGas'
+		{
+			var val ssz.Uint256
+			val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+			e.BaseFeePerGas = Uint256(val)
+		}
+
+		// Field (12) 'BlockHash'
+		buf = ssz.UnmarshalFixedBytes(e.BlockHash[:], buf)
+
+		// Offset (13) 'Transactions'
+		if o13, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (14) 'Withdrawals'
+		if o14, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (10) 'ExtraData'
+	if o13-o10 > 32 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o13-o10), func(buf []byte) (_ []byte, err error) {
+		if e.ExtraData, err = ssz.UnmarshalDynamicBytes(e.ExtraData, buf, 32); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (13) 'Transactions'
+	{
+		var sizes []int
+		if sizes, err = rr.ReadOffsets(int(o14-o13), 1048576); err != nil {
+			return
+		}
+		num := len(sizes)
+		e.Transactions = make([][]byte, num)
+		for ii, size := range sizes {
+			if uint64(size) > 1073741824 {
+				return ssz.ErrListTooBig
+			}
+			if err = rr.Decode(size, func(buf []byte) (_ []byte, err error) {
+				if e.Transactions[ii], err = ssz.UnmarshalDynamicBytes(e.Transactions[ii], buf, 1073741824); err != nil {
+					return
+				}
+				return buf, nil
+			}); err != nil {
+				return
+			}
+		}
+	}
+
+	// Field (14) 'Withdrawals'
+	if err = ssz.ReadSliceSSZ(rr, &e.Withdrawals, size-int(o14), withdrawals); err != nil {
+		return
+	}
+
+	return
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayload object
-func (e *ExecutionPayload) SizeSSZ() (size int) {
+// fixedSize returns the fixed size of the ExecutionPayloadCapella object
+func (e *ExecutionPayloadCapella) fixedSize() int {
+	return int(512)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadCapella object
+func (e *ExecutionPayloadCapella) SizeSSZ() (size int) {
 	size = e.fixedSize()
 
 	// Field (10) 'ExtraData'
@@ -6504,16 +14278,24 @@ func (e *ExecutionPayload) SizeSSZ() (size int) {
 		size += len(e.Transactions[ii])
 	}
 
+	// Field (14) 'Withdrawals'
+	size += len(e.Withdrawals) * 44
+
 	return
 }
 
-// HashTreeRoot ssz hashes the ExecutionPayload object
-func (e *ExecutionPayload) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the ExecutionPayloadCapella object
+func (e *ExecutionPayloadCapella) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(e)
 }
 
-// HashTreeRootWith ssz hashes the ExecutionPayload object with a hasher
-func (e *ExecutionPayload) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// HashTreeRootCtx ssz hashes the ExecutionPayloadCapella object and stops with the error of the context once it is done
+func (e *ExecutionPayloadCapella) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, e)
+}
+
+// HashTreeRootWith ssz hashes the ExecutionPayloadCapella object with a hasher
+func (e *ExecutionPayloadCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'ParentHash'
@@ -6559,7 +14341,7 @@ func (e *ExecutionPayload) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	}
 
 	// Field (11) 'BaseFeePerGas'
-	hh.PutBytes(e.BaseFeePerGas[:])
+	ssz.PutUint256(hh, ssz.Uint256(e.BaseFeePerGas))
 
 	// Field (12) 'BlockHash'
 	hh.PutBytes(e.BlockHash[:])
@@ -6587,120 +14369,301 @@ func (e *ExecutionPayload) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		hh.MerkleizeWithMixin(subIndx, num, 1048576)
 	}
 
-	hh.Merkleize(indx)
-	return
-}
+	// Field (14) 'Withdrawals'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Withdrawals))
+		if num > withdrawals {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = ssz.PutObjects(hh, e.Withdrawals); err != nil {
+			return
+		}
+		hh.MerkleizeWithMixin(subIndx, num, withdrawals)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ExecutionPayloadCapella object
+func (e *ExecutionPayloadCapella) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
+
+// GetTreeCtx ssz hashes the ExecutionPayloadCapella object and stops with the error of the context once it is done
+func (e *ExecutionPayloadCapella) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, e)
+}
+
+// Generalized indices of the ExecutionPayloadCapella fields
+const (
+	ExecutionPayloadCapellaGindexParentHash    = 16
+	ExecutionPayloadCapellaGindexFeeRecipient  = 17
+	ExecutionPayloadCapellaGindexStateRoot     = 18
+	ExecutionPayloadCapellaGindexReceiptsRoot  = 19
+	ExecutionPayloadCapellaGindexLogsBloom     = 20
+	ExecutionPayloadCapellaGindexPrevRandao    = 21
+	ExecutionPayloadCapellaGindexBlockNumber   = 22
+	ExecutionPayloadCapellaGindexGasLimit      = 23
+	ExecutionPayloadCapellaGindexGasUsed       = 24
+	ExecutionPayloadCapellaGindexTimestamp     = 25
+	ExecutionPayloadCapellaGindexExtraData     = 26
+	ExecutionPayloadCapellaGindexBaseFeePerGas = 27
+	ExecutionPayloadCapellaGindexBlockHash     = 28
+	ExecutionPayloadCapellaGindexTransactions  = 29
+	ExecutionPayloadCapellaGindexWithdrawals   = 30
+)
+
+// ExecutionPayloadCapellaGindexTransactionsElem returns the generalized index of the chunk of the i-th element of the Transactions field,
+// or zero if it does not fit in an int
+func ExecutionPayloadCapellaGindexTransactionsElem(i int) int {
+	return ssz.ConcatGindices(ExecutionPayloadCapellaGindexTransactions, ssz.ListElemGindex(i, 1048576, 0))
+}
+
+// ExecutionPayloadCapellaGindexWithdrawalsElem returns the generalized index of the chunk of the i-th element of the Withdrawals field,
+// or zero if it does not fit in an int
+func ExecutionPayloadCapellaGindexWithdrawalsElem(i int) int {
+	return ssz.ConcatGindices(ExecutionPayloadCapellaGindexWithdrawals, ssz.ListElemGindex(i, withdrawals, 0))
+}
+
+// ExecutionPayloadCapellaGindex returns the generalized index of a field path in the ExecutionPayloadCapella object
+func ExecutionPayloadCapellaGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "parent_hash":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexParentHash, rest, nil)
+	case "fee_recipient":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexFeeRecipient, rest, nil)
+	case "state_root":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexStateRoot, rest, nil)
+	case "receipts_root":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexReceiptsRoot, rest, nil)
+	case "logs_bloom":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexLogsBloom, rest, nil)
+	case "prev_randao":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexPrevRandao, rest, nil)
+	case "block_number":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexBlockNumber, rest, nil)
+	case "gas_limit":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexGasLimit, rest, nil)
+	case "gas_used":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexGasUsed, rest, nil)
+	case "timestamp":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexTimestamp, rest, nil)
+	case "extra_data":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexExtraData, rest, nil)
+	case "base_fee_per_gas":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexBaseFeePerGas, rest, nil)
+	case "block_hash":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexBlockHash, rest, nil)
+	case "transactions":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexTransactions, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1048576, 0, nil)
+		})
+	case "withdrawals":
+		return ssz.FieldGindex(ExecutionPayloadCapellaGindexWithdrawals, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, withdrawals, 0, WithdrawalGindex)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("ExecutionPayloadCapella", field)
+}
+
+// ProveField returns a proof of the field path in the ExecutionPayloadCapella object
+func (e *ExecutionPayloadCapella) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(e, ExecutionPayloadCapellaGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the ExecutionPayloadCapella object
+func (e *ExecutionPayloadCapella) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(e, ExecutionPayloadCapellaGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the ExecutionPayloadHeaderCapella object
+func (e *ExecutionPayloadHeaderCapella) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ExecutionPayloadHeaderCapella object to a target array
+func (e *ExecutionPayloadHeaderCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := e.fixedSize()
+
+	// Field (0) 'ParentHash'
+	dst = append(dst, e.ParentHash[:]...)
+
+	// Field (1) 'FeeRecipient'
+	dst = append(dst, e.FeeRecipient[:]...)
+
+	// Field (2) 'StateRoot'
+	dst = append(dst, e.StateRoot[:]...)
+
+	// Field (3) 'ReceiptsRoot'
+	dst = append(dst, e.ReceiptsRoot[:]...)
+
+	// Field (4) 'LogsBloom'
+	dst = append(dst, e.LogsBloom[:]...)
+
+	// Field (5) 'PrevRandao'
+	dst = append(dst, e.PrevRandao[:]...)
+
+	// Field (6) 'BlockNumber'
+	dst = ssz.MarshalValue(dst, e.BlockNumber)
+
+	// Field (7) 'GasLimit'
+	dst = ssz.MarshalValue(dst, e.GasLimit)
+
+	// Field (8) 'GasUsed'
+	dst = ssz.MarshalValue(dst, e.GasUsed)
+
+	// Field (9) 'Timestamp'
+	dst = ssz.MarshalValue(dst, e.Timestamp)
+
+	// Offset (10) 'ExtraData'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (11) 'BaseFeePerGas'
+	dst = ssz.MarshalValue(dst, ssz.Uint256(e.BaseFeePerGas))
+
+	// Field (12) 'BlockHash'
+	dst = append(dst, e.BlockHash[:]...)
+
+	// Field (13) 'TransactionsRoot'
+	dst = append(dst, e.TransactionsRoot[:]...)
+
+	// Field (14) 'WithdrawalRoot'
+	dst = append(dst, e.WithdrawalRoot[:]...)
 
-// GetTree ssz hashes the ExecutionPayload object
-func (e *ExecutionPayload) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(e)
-}
+	// Field (10) 'ExtraData'
+	if size := uint64(len(e.ExtraData)); size > 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeaderCapella.ExtraData", size, 32)
+		return
+	}
+	dst = append(dst, e.ExtraData...)
 
-// MarshalSSZ ssz marshals the ExecutionPayloadHeader object
-func (e *ExecutionPayloadHeader) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+	return
 }
 
-// MarshalSSZTo ssz marshals the ExecutionPayloadHeader object to a target array
-func (e *ExecutionPayloadHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// MarshalSSZWriter ssz marshals the ExecutionPayloadHeaderCapella object to a writer
+func (e *ExecutionPayloadHeaderCapella) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
 	offset := e.fixedSize()
 
 	// Field (0) 'ParentHash'
-	if size := uint64(len(e.ParentHash)); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ParentHash", size, 32)
+	dst = append(dst, e.ParentHash[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
-	dst = append(dst, e.ParentHash...)
 
 	// Field (1) 'FeeRecipient'
-	if size := uint64(len(e.FeeRecipient)); size != 20 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.FeeRecipient", size, 20)
+	dst = append(dst, e.FeeRecipient[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
-	dst = append(dst, e.FeeRecipient...)
 
 	// Field (2) 'StateRoot'
-	if size := uint64(len(e.StateRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.StateRoot", size, 32)
+	dst = append(dst, e.StateRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
-	dst = append(dst, e.StateRoot...)
 
 	// Field (3) 'ReceiptsRoot'
-	if size := uint64(len(e.ReceiptsRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ReceiptsRoot", size, 32)
+	dst = append(dst, e.ReceiptsRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
-	dst = append(dst, e.ReceiptsRoot...)
 
 	// Field (4) 'LogsBloom'
-	if size := uint64(len(e.LogsBloom)); size != 256 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.LogsBloom", size, 256)
+	dst = append(dst, e.LogsBloom[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
-	dst = append(dst, e.LogsBloom...)
 
 	// Field (5) 'PrevRandao'
-	if size := uint64(len(e.PrevRandao)); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.PrevRandao", size, 32)
+	dst = append(dst, e.PrevRandao[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
-	dst = append(dst, e.PrevRandao...)
 
 	// Field (6) 'BlockNumber'
 	dst = ssz.MarshalValue(dst, e.BlockNumber)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (7) 'GasLimit'
 	dst = ssz.MarshalValue(dst, e.GasLimit)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (8) 'GasUsed'
 	dst = ssz.MarshalValue(dst, e.GasUsed)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (9) 'Timestamp'
 	dst = ssz.MarshalValue(dst, e.Timestamp)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Offset (10) 'ExtraData'
 	dst = ssz.WriteOffset(dst, offset)
 
 	// Field (11) 'BaseFeePerGas'
-	if size := uint64(len(e.BaseFeePerGas)); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BaseFeePerGas", size, 32)
+	dst = ssz.MarshalValue(dst, ssz.Uint256(e.BaseFeePerGas))
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
-	dst = append(dst, e.BaseFeePerGas...)
 
 	// Field (12) 'BlockHash'
-	if size := uint64(len(e.BlockHash)); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BlockHash", size, 32)
+	dst = append(dst, e.BlockHash[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
-	dst = append(dst, e.BlockHash...)
 
 	// Field (13) 'TransactionsRoot'
-	if size := uint64(len(e.TransactionsRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.TransactionsRoot", size, 32)
+	dst = append(dst, e.TransactionsRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (14) 'WithdrawalRoot'
+	dst = append(dst, e.WithdrawalRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
-	dst = append(dst, e.TransactionsRoot...)
 
 	// Field (10) 'ExtraData'
 	if size := uint64(len(e.ExtraData)); size > 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ExtraData", size, 32)
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeaderCapella.ExtraData", size, 32)
 		return
 	}
 	dst = append(dst, e.ExtraData...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
-	return
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
 }
 
-// UnmarshalSSZ ssz unmarshals the ExecutionPayloadHeader object
-func (e *ExecutionPayloadHeader) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the ExecutionPayloadHeaderCapella object
+func (e *ExecutionPayloadHeaderCapella) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(e, buf)
 }
 
-// UnmarshalSSZTail unmarshals the ExecutionPayloadHeader object and returns the remaining bufferº
-func (e *ExecutionPayloadHeader) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+// UnmarshalSSZTail unmarshals the ExecutionPayloadHeaderCapella object and returns the remaining bufferº
+func (e *ExecutionPayloadHeaderCapella) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	size := len(buf)
 	fixedSize := e.fixedSize()
 	if size < fixedSize {
@@ -6712,22 +14675,22 @@ func (e *ExecutionPayloadHeader) UnmarshalSSZTail(buf []byte) (rest []byte, err
 	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
 
 	// Field (0) 'ParentHash'
-	e.ParentHash, buf = ssz.UnmarshalBytes(e.ParentHash, buf, 32)
+	buf = ssz.UnmarshalFixedBytes(e.ParentHash[:], buf)
 
 	// Field (1) 'FeeRecipient'
-	e.FeeRecipient, buf = ssz.UnmarshalBytes(e.FeeRecipient, buf, 20)
+	buf = ssz.UnmarshalFixedBytes(e.FeeRecipient[:], buf)
 
 	// Field (2) 'StateRoot'
-	e.StateRoot, buf = ssz.UnmarshalBytes(e.StateRoot, buf, 32)
+	buf = ssz.UnmarshalFixedBytes(e.StateRoot[:], buf)
 
 	// Field (3) 'ReceiptsRoot'
-	e.ReceiptsRoot, buf = ssz.UnmarshalBytes(e.ReceiptsRoot, buf, 32)
+	buf = ssz.UnmarshalFixedBytes(e.ReceiptsRoot[:], buf)
 
 	// Field (4) 'LogsBloom'
-	e.LogsBloom, buf = ssz.UnmarshalBytes(e.LogsBloom, buf, 256)
+	buf = ssz.UnmarshalFixedBytes(e.LogsBloom[:], buf)
 
 	// Field (5) 'PrevRandao'
-	e.PrevRandao, buf = ssz.UnmarshalBytes(e.PrevRandao, buf, 32)
+	buf = ssz.UnmarshalFixedBytes(e.PrevRandao[:], buf)
 
 	// Field (6) 'BlockNumber'
 	e.BlockNumber, buf = ssz.UnmarshallValue[uint64](buf)
@@ -6743,1184 +14706,1306 @@ func (e *ExecutionPayloadHeader) UnmarshalSSZTail(buf []byte) (rest []byte, err
 
 	// Offset (10) 'ExtraData'
 	if o10, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
-
-	// Field (11) 'BaseFeePerGas'
-	e.BaseFeePerGas, buf = ssz.UnmarshalBytes(e.BaseFeePerGas, buf, 32)
-
-	// Field (12) 'BlockHash'
-	e.BlockHash, buf = ssz.UnmarshalBytes(e.BlockHash, buf, 32)
-
-	// Field (13) 'TransactionsRoot'
-	e.TransactionsRoot, buf = ssz.UnmarshalBytes(e.TransactionsRoot, buf, 32)
-
-	// Field (10) 'ExtraData'
-	if e.ExtraData, err = ssz.UnmarshalDynamicBytes(e.ExtraData, tail[o10:], 32); err != nil {
-		return
-	}
-
-	return
-}
-
-// fixedSize returns the fixed size of the ExecutionPayloadHeader object
-func (e *ExecutionPayloadHeader) fixedSize() int {
-	return int(536)
-}
-
-// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadHeader object
-func (e *ExecutionPayloadHeader) SizeSSZ() (size int) {
-	size = e.fixedSize()
-
-	// Field (10) 'ExtraData'
-	size += len(e.ExtraData)
-
-	return
-}
-
-// HashTreeRoot ssz hashes the ExecutionPayloadHeader object
-func (e *ExecutionPayloadHeader) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(e)
-}
-
-// HashTreeRootWith ssz hashes the ExecutionPayloadHeader object with a hasher
-func (e *ExecutionPayloadHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'ParentHash'
-	if size := uint64(len(e.ParentHash)); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ParentHash", size, 32)
-		return
-	}
-	hh.PutBytes(e.ParentHash)
-
-	// Field (1) 'FeeRecipient'
-	if size := uint64(len(e.FeeRecipient)); size != 20 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.FeeRecipient", size, 20)
-		return
-	}
-	hh.PutBytes(e.FeeRecipient)
-
-	// Field (2) 'StateRoot'
-	if size := uint64(len(e.StateRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.StateRoot", size, 32)
-		return
-	}
-	hh.PutBytes(e.StateRoot)
-
-	// Field (3) 'ReceiptsRoot'
-	if size := uint64(len(e.ReceiptsRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ReceiptsRoot", size, 32)
-		return
-	}
-	hh.PutBytes(e.ReceiptsRoot)
-
-	// Field (4) 'LogsBloom'
-	if size := uint64(len(e.LogsBloom)); size != 256 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.LogsBloom", size, 256)
-		return
-	}
-	hh.PutBytes(e.LogsBloom)
-
-	// Field (5) 'PrevRandao'
-	if size := uint64(len(e.PrevRandao)); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.PrevRandao", size, 32)
-		return
-	}
-	hh.PutBytes(e.PrevRandao)
-
-	// Field (6) 'BlockNumber'
-	hh.PutUint64(e.BlockNumber)
-
-	// Field (7) 'GasLimit'
-	hh.PutUint64(e.GasLimit)
-
-	// Field (8) 'GasUsed'
-	hh.PutUint64(e.GasUsed)
-
-	// Field (9) 'Timestamp'
-	hh.PutUint64(e.Timestamp)
-
-	// Field (10) 'ExtraData'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(e.ExtraData))
-		if byteLen > 32 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(e.ExtraData)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
-	}
-
-	// Field (11) 'BaseFeePerGas'
-	if size := uint64(len(e.BaseFeePerGas)); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BaseFeePerGas", size, 32)
-		return
-	}
-	hh.PutBytes(e.BaseFeePerGas)
-
-	// Field (12) 'BlockHash'
-	if size := uint64(len(e.BlockHash)); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BlockHash", size, 32)
-		return
-	}
-	hh.PutBytes(e.BlockHash)
-
-	// Field (13) 'TransactionsRoot'
-	if size := uint64(len(e.TransactionsRoot)); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.TransactionsRoot", size, 32)
-		return
-	}
-	hh.PutBytes(e.TransactionsRoot)
-
-	hh.Merkleize(indx)
-	return
-}
-
-// GetTree ssz hashes the ExecutionPayloadHeader object
-func (e *ExecutionPayloadHeader) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(e)
-}
-
-// MarshalSSZ ssz marshals the ExecutionPayloadTransactions object
-func (e *ExecutionPayloadTransactions) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
-}
-
-// MarshalSSZTo ssz marshals the ExecutionPayloadTransactions object to a target array
-func (e *ExecutionPayloadTransactions) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := e.fixedSize()
-
-	// Offset (0) 'Transactions'
-	dst = ssz.WriteOffset(dst, offset)
-
-	// Field (0) 'Transactions'
-	if size := uint64(len(e.Transactions)); size > 1048576 {
-		err = ssz.ErrListTooBigFn("ExecutionPayloadTransactions.Transactions", size, 1048576)
-		return
+		return nil, err
 	}
+
+	// Field (11) 'BaseFeePerGas'
 	{
-		offset = 4 * len(e.Transactions)
-		for ii := 0; ii < len(e.Transactions); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += len(e.Transactions[ii])
-		}
+		var val ssz.Uint256
+		val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+		e.BaseFeePerGas = Uint256(val)
 	}
-	for ii := 0; ii < len(e.Transactions); ii++ {
-		if size := uint64(len(e.Transactions[ii])); size > 1073741824 {
-			err = ssz.ErrBytesLengthFn("ExecutionPayloadTransactions.Transactions[ii]", size, 1073741824)
-			return
-		}
-		dst = append(dst, e.Transactions[ii]...)
+
+	// Field (12) 'BlockHash'
+	buf = ssz.UnmarshalFixedBytes(e.BlockHash[:], buf)
+
+	// Field (13) 'TransactionsRoot'
+	buf = ssz.UnmarshalFixedBytes(e.TransactionsRoot[:], buf)
+
+	// Field (14) 'WithdrawalRoot'
+	buf = ssz.UnmarshalFixedBytes(e.WithdrawalRoot[:], buf)
+
+	// Field (10) 'ExtraData'
+	if e.ExtraData, err = ssz.UnmarshalDynamicBytes(e.ExtraData, tail[o10:], 32); err != nil {
+		return
 	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the ExecutionPayloadTransactions object
-func (e *ExecutionPayloadTransactions) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(e, buf)
-}
-
-// UnmarshalSSZTail unmarshals the ExecutionPayloadTransactions object and returns the remaining bufferº
-func (e *ExecutionPayloadTransactions) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
+// UnmarshalSSZReader ssz unmarshals the ExecutionPayloadHeaderCapella object from a reader with the size of the encoding
+func (e *ExecutionPayloadHeaderCapella) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
 	fixedSize := e.fixedSize()
 	if size < fixedSize {
-		return nil, ssz.ErrSize
+		return ssz.ErrSize
 	}
 
-	tail := buf
-	var o0 uint64
+	var o10 uint64
 	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
 
-	// Offset (0) 'Transactions'
-	if o0, _, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'ParentHash'
+		buf = ssz.UnmarshalFixedBytes(e.ParentHash[:], buf)
+
+		// Field (1) 'FeeRecipient'
+		buf = ssz.UnmarshalFixedBytes(e.FeeRecipient[:], buf)
+
+		// Field (2) 'StateRoot'
+		buf = ssz.UnmarshalFixedBytes(e.StateRoot[:], buf)
+
+		// Field (3) 'ReceiptsRoot'
+		buf = ssz.UnmarshalFixedBytes(e.ReceiptsRoot[:], buf)
+
+		// Field (4) 'LogsBloom'
+		buf = ssz.UnmarshalFixedBytes(e.LogsBloom[:], buf)
+
+		// Field (5) 'PrevRandao'
+		buf = ssz.UnmarshalFixedBytes(e.PrevRandao[:], buf)
+
+		// Field (6) 'BlockNumber'
+		e.BlockNumber, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (7) 'GasLimit'
+		e.GasLimit, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (8) 'GasUsed'
+		e.GasUsed, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (9) 'Timestamp'
+		e.Timestamp, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (10) 'ExtraData'
+		if o10, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (11) 'BaseFeePerGas'
+		{
+			var val ssz.Uint256
+			val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+			e.BaseFeePerGas = Uint256(val)
+		}
+
+		// Field (12) 'BlockHash'
+		buf = ssz.UnmarshalFixedBytes(e.BlockHash[:], buf)
+
+		// Field (13) 'TransactionsRoot'
+		buf = ssz.UnmarshalFixedBytes(e.TransactionsRoot[:], buf)
+
+		// Field (14) 'WithdrawalRoot'
+		buf = ssz.UnmarshalFixedBytes(e.WithdrawalRoot[:], buf)
+
+		return buf, nil
+	}); err != nil {
+		return
 	}
 
-	// Field (0) 'Transactions'
-	if err = ssz.UnmarshalDynamicSliceWithCallback(&e.Transactions, tail[o0:], 1048576, func(indx uint64, buf []byte) (err error) {
-		if e.Transactions[indx], err = ssz.UnmarshalDynamicBytes(e.Transactions[indx], buf, 1073741824); err != nil {
+	// Field (10) 'ExtraData'
+	if uint64(size-int(o10)) > 32 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o10), func(buf []byte) (_ []byte, err error) {
+		if e.ExtraData, err = ssz.UnmarshalDynamicBytes(e.ExtraData, buf, 32); err != nil {
 			return
 		}
-		return nil
+		return buf, nil
 	}); err != nil {
-		return nil, err
+		return
 	}
 
 	return
 }
 
-// fixedSize returns the fixed size of the ExecutionPayloadTransactions object
-func (e *ExecutionPayloadTransactions) fixedSize() int {
-	return int(4)
+// fixedSize returns the fixed size of the ExecutionPayloadHeaderCapella object
+func (e *ExecutionPayloadHeaderCapella) fixedSize() int {
+	return int(568)
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadTransactions object
-func (e *ExecutionPayloadTransactions) SizeSSZ() (size int) {
+// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadHeaderCapella object
+func (e *ExecutionPayloadHeaderCapella) SizeSSZ() (size int) {
 	size = e.fixedSize()
 
-	// Field (0) 'Transactions'
-	for ii := 0; ii < len(e.Transactions); ii++ {
-		size += 4
-		size += len(e.Transactions[ii])
-	}
+	// Field (10) 'ExtraData'
+	size += len(e.ExtraData)
 
 	return
 }
 
-// HashTreeRoot ssz hashes the ExecutionPayloadTransactions object
-func (e *ExecutionPayloadTransactions) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the ExecutionPayloadHeaderCapella object
+func (e *ExecutionPayloadHeaderCapella) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(e)
 }
 
-// HashTreeRootWith ssz hashes the ExecutionPayloadTransactions object with a hasher
-func (e *ExecutionPayloadTransactions) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'Transactions'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(e.Transactions))
-		if num > 1048576 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range e.Transactions {
-			{
-				elemIndx := hh.Index()
-				byteLen := uint64(len(elem))
-				if byteLen > 1073741824 {
-					err = ssz.ErrIncorrectListSize
-					return
-				}
-				hh.AppendBytes32(elem)
-				hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 1048576)
-	}
-
-	hh.Merkleize(indx)
-	return
-}
-
-// GetTree ssz hashes the ExecutionPayloadTransactions object
-func (e *ExecutionPayloadTransactions) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(e)
-}
-
-// MarshalSSZ ssz marshals the ExecutionPayloadCapella object
-func (e *ExecutionPayloadCapella) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+// HashTreeRootCtx ssz hashes the ExecutionPayloadHeaderCapella object and stops with the error of the context once it is done
+func (e *ExecutionPayloadHeaderCapella) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, e)
 }
 
-// MarshalSSZTo ssz marshals the ExecutionPayloadCapella object to a target array
-func (e *ExecutionPayloadCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := e.fixedSize()
+// HashTreeRootWith ssz hashes the ExecutionPayloadHeaderCapella object with a hasher
+func (e *ExecutionPayloadHeaderCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
 	// Field (0) 'ParentHash'
-	dst = append(dst, e.ParentHash[:]...)
+	hh.PutBytes(e.ParentHash[:])
 
 	// Field (1) 'FeeRecipient'
-	dst = append(dst, e.FeeRecipient[:]...)
+	hh.PutBytes(e.FeeRecipient[:])
 
 	// Field (2) 'StateRoot'
-	dst = append(dst, e.StateRoot[:]...)
+	hh.PutBytes(e.StateRoot[:])
 
 	// Field (3) 'ReceiptsRoot'
-	dst = append(dst, e.ReceiptsRoot[:]...)
+	hh.PutBytes(e.ReceiptsRoot[:])
 
 	// Field (4) 'LogsBloom'
-	dst = append(dst, e.LogsBloom[:]...)
+	hh.PutBytes(e.LogsBloom[:])
 
 	// Field (5) 'PrevRandao'
-	dst = append(dst, e.PrevRandao[:]...)
+	hh.PutBytes(e.PrevRandao[:])
 
 	// Field (6) 'BlockNumber'
-	dst = ssz.MarshalValue(dst, e.BlockNumber)
+	hh.PutUint64(e.BlockNumber)
 
 	// Field (7) 'GasLimit'
-	dst = ssz.MarshalValue(dst, e.GasLimit)
+	hh.PutUint64(e.GasLimit)
 
 	// Field (8) 'GasUsed'
-	dst = ssz.MarshalValue(dst, e.GasUsed)
+	hh.PutUint64(e.GasUsed)
 
 	// Field (9) 'Timestamp'
-	dst = ssz.MarshalValue(dst, e.Timestamp)
+	hh.PutUint64(e.Timestamp)
 
-	// Offset (10) 'ExtraData'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(e.ExtraData)
+	// Field (10) 'ExtraData'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(e.ExtraData))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(e.ExtraData)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
 
 	// Field (11) 'BaseFeePerGas'
-	dst = append(dst, e.BaseFeePerGas[:]...)
+	ssz.PutUint256(hh, ssz.Uint256(e.BaseFeePerGas))
 
 	// Field (12) 'BlockHash'
-	dst = append(dst, e.BlockHash[:]...)
+	hh.PutBytes(e.BlockHash[:])
 
-	// Offset (13) 'Transactions'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(e.Transactions); ii++ {
-		offset += 4
-		offset += len(e.Transactions[ii])
-	}
+	// Field (13) 'TransactionsRoot'
+	hh.PutBytes(e.TransactionsRoot[:])
 
-	// Offset (14) 'Withdrawals'
-	dst = ssz.WriteOffset(dst, offset)
+	// Field (14) 'WithdrawalRoot'
+	hh.PutBytes(e.WithdrawalRoot[:])
 
-	// Field (10) 'ExtraData'
-	if size := uint64(len(e.ExtraData)); size > 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadCapella.ExtraData", size, 32)
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ExecutionPayloadHeaderCapella object
+func (e *ExecutionPayloadHeaderCapella) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
+
+// GetTreeCtx ssz hashes the ExecutionPayloadHeaderCapella object and stops with the error of the context once it is done
+func (e *ExecutionPayloadHeaderCapella) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, e)
+}
+
+// Generalized indices of the ExecutionPayloadHeaderCapella fields
+const (
+	ExecutionPayloadHeaderCapellaGindexParentHash       = 16
+	ExecutionPayloadHeaderCapellaGindexFeeRecipient     = 17
+	ExecutionPayloadHeaderCapellaGindexStateRoot        = 18
+	ExecutionPayloadHeaderCapellaGindexReceiptsRoot     = 19
+	ExecutionPayloadHeaderCapellaGindexLogsBloom        = 20
+	ExecutionPayloadHeaderCapellaGindexPrevRandao       = 21
+	ExecutionPayloadHeaderCapellaGindexBlockNumber      = 22
+	ExecutionPayloadHeaderCapellaGindexGasLimit         = 23
+	ExecutionPayloadHeaderCapellaGindexGasUsed          = 24
+	ExecutionPayloadHeaderCapellaGindexTimestamp        = 25
+	ExecutionPayloadHeaderCapellaGindexExtraData        = 26
+	ExecutionPayloadHeaderCapellaGindexBaseFeePerGas    = 27
+	ExecutionPayloadHeaderCapellaGindexBlockHash        = 28
+	ExecutionPayloadHeaderCapellaGindexTransactionsRoot = 29
+	ExecutionPayloadHeaderCapellaGindexWithdrawalRoot   = 30
+)
+
+// ExecutionPayloadHeaderCapellaGindex returns the generalized index of a field path in the ExecutionPayloadHeaderCapella object
+func ExecutionPayloadHeaderCapellaGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "parent_hash":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexParentHash, rest, nil)
+	case "fee_recipient":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexFeeRecipient, rest, nil)
+	case "state_root":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexStateRoot, rest, nil)
+	case "receipts_root":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexReceiptsRoot, rest, nil)
+	case "logs_bloom":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexLogsBloom, rest, nil)
+	case "prev_randao":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexPrevRandao, rest, nil)
+	case "block_number":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexBlockNumber, rest, nil)
+	case "gas_limit":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexGasLimit, rest, nil)
+	case "gas_used":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexGasUsed, rest, nil)
+	case "timestamp":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexTimestamp, rest, nil)
+	case "extra_data":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexExtraData, rest, nil)
+	case "base_fee_per_gas":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexBaseFeePerGas, rest, nil)
+	case "block_hash":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexBlockHash, rest, nil)
+	case "transactions_root":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexTransactionsRoot, rest, nil)
+	case "withdrawals_root":
+		return ssz.FieldGindex(ExecutionPayloadHeaderCapellaGindexWithdrawalRoot, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("ExecutionPayloadHeaderCapella", field)
+}
+
+// ProveField returns a proof of the field path in the ExecutionPayloadHeaderCapella object
+func (e *ExecutionPayloadHeaderCapella) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(e, ExecutionPayloadHeaderCapellaGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the ExecutionPayloadHeaderCapella object
+func (e *ExecutionPayloadHeaderCapella) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(e, ExecutionPayloadHeaderCapellaGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the BLSToExecutionChange object
+func (b *BLSToExecutionChange) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BLSToExecutionChange object to a target array
+func (b *BLSToExecutionChange) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ValidatorIndex'
+	dst = ssz.MarshalValue(dst, b.ValidatorIndex)
+
+	// Field (1) 'FromBLSPubKey'
+	dst = append(dst, b.FromBLSPubKey[:]...)
+
+	// Field (2) 'ToExecutionAddress'
+	dst = append(dst, b.ToExecutionAddress[:]...)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the BLSToExecutionChange object to a writer
+func (b *BLSToExecutionChange) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'ValidatorIndex'
+	dst = ssz.MarshalValue(dst, b.ValidatorIndex)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
-	dst = append(dst, e.ExtraData...)
 
-	// Field (13) 'Transactions'
-	if size := uint64(len(e.Transactions)); size > 1048576 {
-		err = ssz.ErrListTooBigFn("ExecutionPayloadCapella.Transactions", size, 1048576)
+	// Field (1) 'FromBLSPubKey'
+	dst = append(dst, b.FromBLSPubKey[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
-	{
-		offset = 4 * len(e.Transactions)
-		for ii := 0; ii < len(e.Transactions); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += len(e.Transactions[ii])
-		}
-	}
-	for ii := 0; ii < len(e.Transactions); ii++ {
-		if size := uint64(len(e.Transactions[ii])); size > 1073741824 {
-			err = ssz.ErrBytesLengthFn("ExecutionPayloadCapella.Transactions[ii]", size, 1073741824)
-			return
-		}
-		dst = append(dst, e.Transactions[ii]...)
-	}
 
-	// Field (14) 'Withdrawals'
-	if size := uint64(len(e.Withdrawals)); size > withdrawals {
-		err = ssz.ErrListTooBigFn("ExecutionPayloadCapella.Withdrawals", size, withdrawals)
+	// Field (2) 'ToExecutionAddress'
+	dst = append(dst, b.ToExecutionAddress[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
 		return
 	}
-	for ii := 0; ii < len(e.Withdrawals); ii++ {
-		if dst, err = e.Withdrawals[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
 
-	return
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
 }
 
-// UnmarshalSSZ ssz unmarshals the ExecutionPayloadCapella object
-func (e *ExecutionPayloadCapella) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(e, buf)
+// UnmarshalSSZ ssz unmarshals the BLSToExecutionChange object
+func (b *BLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(b, buf)
 }
 
-// UnmarshalSSZTail unmarshals the ExecutionPayloadCapella object and returns the remaining bufferº
-func (e *ExecutionPayloadCapella) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+// UnmarshalSSZTail unmarshals the BLSToExecutionChange object and returns the remaining bufferº
+func (b *BLSToExecutionChange) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	size := len(buf)
-	fixedSize := e.fixedSize()
+	fixedSize := b.fixedSize()
 	if size < fixedSize {
 		return nil, ssz.ErrSize
 	}
 
-	tail := buf
-	var o10, o13, o14 uint64
-	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
-
-	// Field (0) 'ParentHash'
-	buf = ssz.UnmarshalFixedBytes(e.ParentHash[:], buf)
+	// Field (0) 'ValidatorIndex'
+	b.ValidatorIndex, buf = ssz.UnmarshallValue[uint64](buf)
 
-	// Field (1) 'FeeRecipient'
-	buf = ssz.UnmarshalFixedBytes(e.FeeRecipient[:], buf)
+	// Field (1) 'FromBLSPubKey'
+	buf = ssz.UnmarshalFixedBytes(b.FromBLSPubKey[:], buf)
 
-	// Field (2) 'StateRoot'
-	buf = ssz.UnmarshalFixedBytes(e.StateRoot[:], buf)
+	// Field (2) 'ToExecutionAddress'
+	buf = ssz.UnmarshalFixedBytes(b.ToExecutionAddress[:], buf)
 
-	// Field (3) 'ReceiptsRoot'
-	buf = ssz.UnmarshalFixedBytes(e.ReceiptsRoot[:], buf)
+	return buf, nil
+}
 
-	// Field (4) 'LogsBloom'
-	buf = ssz.UnmarshalFixedBytes(e.LogsBloom[:], buf)
+// UnmarshalSSZReader ssz unmarshals the BLSToExecutionChange object from a reader with the size of the encoding
+func (b *BLSToExecutionChange) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
 
-	// Field (5) 'PrevRandao'
-	buf = ssz.UnmarshalFixedBytes(e.PrevRandao[:], buf)
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'ValidatorIndex'
+		b.ValidatorIndex, buf = ssz.UnmarshallValue[uint64](buf)
 
-	// Field (6) 'BlockNumber'
-	e.BlockNumber, buf = ssz.UnmarshallValue[uint64](buf)
+		// Field (1) 'FromBLSPubKey'
+		buf = ssz.UnmarshalFixedBytes(b.FromBLSPubKey[:], buf)
 
-	// Field (7) 'GasLimit'
-	e.GasLimit, buf = ssz.UnmarshallValue[uint64](buf)
+		// Field (2) 'ToExecutionAddress'
+		buf = ssz.UnmarshalFixedBytes(b.ToExecutionAddress[:], buf)
 
-	// Field (8) 'GasUsed'
-	e.GasUsed, buf = ssz.UnmarshallValue[uint64](buf)
+		return buf, nil
+	}); err != nil {
+		return
+	}
 
-	// Field (9) 'Timestamp'
-	e.Timestamp, buf = ssz.UnmarshallValue[uint64](buf)
+	return
+}
 
-	// Offset (10) 'ExtraData'
-	if o10, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+// fixedSize returns the fixed size of the BLSToExecutionChange object
+func (b *BLSToExecutionChange) fixedSize() int {
+	return int(76)
+}
 
-	// Field (11) 'BaseFeePerGas'
-	buf = ssz.UnmarshalFixedBytes(e.BaseFeePerGas[:], buf)
+// SizeSSZ returns the ssz encoded size in bytes for the BLSToExecutionChange object
+func (b *BLSToExecutionChange) SizeSSZ() (size int) {
+	size = b.fixedSize()
+	return
+}
 
-	// Field (12) 'BlockHash'
-	buf = ssz.UnmarshalFixedBytes(e.BlockHash[:], buf)
+// HashTreeRoot ssz hashes the BLSToExecutionChange object
+func (b *BLSToExecutionChange) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
 
-	// Offset (13) 'Transactions'
-	if o13, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+// HashTreeRootCtx ssz hashes the BLSToExecutionChange object and stops with the error of the context once it is done
+func (b *BLSToExecutionChange) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
+}
 
-	// Offset (14) 'Withdrawals'
-	if o14, _, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
-	}
+// HashTreeRootWith ssz hashes the BLSToExecutionChange object with a hasher
+func (b *BLSToExecutionChange) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Field (10) 'ExtraData'
-	if e.ExtraData, err = ssz.UnmarshalDynamicBytes(e.ExtraData, tail[o10:o13], 32); err != nil {
-		return
-	}
+	// Field (0) 'ValidatorIndex'
+	hh.PutUint64(b.ValidatorIndex)
 
-	// Field (13) 'Transactions'
-	if err = ssz.UnmarshalDynamicSliceWithCallback(&e.Transactions, tail[o13:o14], 1048576, func(indx uint64, buf []byte) (err error) {
-		if e.Transactions[indx], err = ssz.UnmarshalDynamicBytes(e.Transactions[indx], buf, 1073741824); err != nil {
-			return
-		}
-		return nil
-	}); err != nil {
-		return nil, err
-	}
+	// Field (1) 'FromBLSPubKey'
+	hh.PutBytes(b.FromBLSPubKey[:])
 
-	// Field (14) 'Withdrawals'
-	if err = ssz.UnmarshalSliceSSZ(&e.Withdrawals, tail[o14:], withdrawals); err != nil {
-		return nil, err
-	}
+	// Field (2) 'ToExecutionAddress'
+	hh.PutBytes(b.ToExecutionAddress[:])
 
+	hh.Merkleize(indx)
 	return
 }
 
-// fixedSize returns the fixed size of the ExecutionPayloadCapella object
-func (e *ExecutionPayloadCapella) fixedSize() int {
-	return int(512)
+// GetTree ssz hashes the BLSToExecutionChange object
+func (b *BLSToExecutionChange) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadCapella object
-func (e *ExecutionPayloadCapella) SizeSSZ() (size int) {
-	size = e.fixedSize()
+// GetTreeCtx ssz hashes the BLSToExecutionChange object and stops with the error of the context once it is done
+func (b *BLSToExecutionChange) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
+}
 
-	// Field (10) 'ExtraData'
-	size += len(e.ExtraData)
+// Generalized indices of the BLSToExecutionChange fields
+const (
+	BLSToExecutionChangeGindexValidatorIndex     = 4
+	BLSToExecutionChangeGindexFromBLSPubKey      = 5
+	BLSToExecutionChangeGindexToExecutionAddress = 6
+)
 
-	// Field (13) 'Transactions'
-	for ii := 0; ii < len(e.Transactions); ii++ {
-		size += 4
-		size += len(e.Transactions[ii])
+// BLSToExecutionChangeGindex returns the generalized index of a field path in the BLSToExecutionChange object
+func BLSToExecutionChangeGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
 	}
+	switch field {
+	case "validator_index":
+		return ssz.FieldGindex(BLSToExecutionChangeGindexValidatorIndex, rest, nil)
+	case "from_bls_pubkey":
+		return ssz.FieldGindex(BLSToExecutionChangeGindexFromBLSPubKey, rest, nil)
+	case "to_execution_address":
+		return ssz.FieldGindex(BLSToExecutionChangeGindexToExecutionAddress, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("BLSToExecutionChange", field)
+}
 
-	// Field (14) 'Withdrawals'
-	size += len(e.Withdrawals) * 44
+// ProveField returns a proof of the field path in the BLSToExecutionChange object
+func (b *BLSToExecutionChange) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BLSToExecutionChangeGindex, path)
+}
 
-	return
+// ProveFields returns a multiproof of the field paths in the BLSToExecutionChange object
+func (b *BLSToExecutionChange) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BLSToExecutionChangeGindex, paths...)
 }
 
-// HashTreeRoot ssz hashes the ExecutionPayloadCapella object
-func (e *ExecutionPayloadCapella) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(e)
+// MarshalSSZ ssz marshals the HistoricalSummary object
+func (h *HistoricalSummary) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(h)
 }
 
-// HashTreeRootWith ssz hashes the ExecutionPayloadCapella object with a hasher
-func (e *ExecutionPayloadCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// MarshalSSZTo ssz marshals the HistoricalSummary object to a target array
+func (h *HistoricalSummary) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (0) 'ParentHash'
-	hh.PutBytes(e.ParentHash[:])
+	// Field (0) 'BlockSummaryRoot'
+	dst = append(dst, h.BlockSummaryRoot[:]...)
 
-	// Field (1) 'FeeRecipient'
-	hh.PutBytes(e.FeeRecipient[:])
+	// Field (1) 'StateSummaryRoot'
+	dst = append(dst, h.StateSummaryRoot[:]...)
 
-	// Field (2) 'StateRoot'
-	hh.PutBytes(e.StateRoot[:])
+	return
+}
 
-	// Field (3) 'ReceiptsRoot'
-	hh.PutBytes(e.ReceiptsRoot[:])
+// MarshalSSZWriter ssz marshals the HistoricalSummary object to a writer
+func (h *HistoricalSummary) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
 
-	// Field (4) 'LogsBloom'
-	hh.PutBytes(e.LogsBloom[:])
+	// Field (0) 'BlockSummaryRoot'
+	dst = append(dst, h.BlockSummaryRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
-	// Field (5) 'PrevRandao'
-	hh.PutBytes(e.PrevRandao[:])
+	// Field (1) 'StateSummaryRoot'
+	dst = append(dst, h.StateSummaryRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
-	// Field (6) 'BlockNumber'
-	hh.PutUint64(e.BlockNumber)
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
 
-	// Field (7) 'GasLimit'
-	hh.PutUint64(e.GasLimit)
+// UnmarshalSSZ ssz unmarshals the HistoricalSummary object
+func (h *HistoricalSummary) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(h, buf)
+}
 
-	// Field (8) 'GasUsed'
-	hh.PutUint64(e.GasUsed)
+// UnmarshalSSZTail unmarshals the HistoricalSummary object and returns the remaining bufferº
+func (h *HistoricalSummary) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := h.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
 
-	// Field (9) 'Timestamp'
-	hh.PutUint64(e.Timestamp)
+	// Field (0) 'BlockSummaryRoot'
+	buf = ssz.UnmarshalFixedBytes(h.BlockSummaryRoot[:], buf)
 
-	// Field (10) 'ExtraData'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(e.ExtraData))
-		if byteLen > 32 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(e.ExtraData)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	// Field (1) 'StateSummaryRoot'
+	buf = ssz.UnmarshalFixedBytes(h.StateSummaryRoot[:], buf)
+
+	return buf, nil
+}
+
+// UnmarshalSSZReader ssz unmarshals the HistoricalSummary object from a reader with the size of the encoding
+func (h *HistoricalSummary) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := h.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
 	}
 
-	// Field (11) 'BaseFeePerGas'
-	hh.PutBytes(e.BaseFeePerGas[:])
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'BlockSummaryRoot'
+		buf = ssz.UnmarshalFixedBytes(h.BlockSummaryRoot[:], buf)
 
-	// Field (12) 'BlockHash'
-	hh.PutBytes(e.BlockHash[:])
+		// Field (1) 'StateSummaryRoot'
+		buf = ssz.UnmarshalFixedBytes(h.StateSummaryRoot[:], buf)
 
-	// Field (13) 'Transactions'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(e.Transactions))
-		if num > 1048576 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range e.Transactions {
-			{
-				elemIndx := hh.Index()
-				byteLen := uint64(len(elem))
-				if byteLen > 1073741824 {
-					err = ssz.ErrIncorrectListSize
-					return
-				}
-				hh.AppendBytes32(elem)
-				hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 1048576)
+		return buf, nil
+	}); err != nil {
+		return
 	}
 
-	// Field (14) 'Withdrawals'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(e.Withdrawals))
-		if num > withdrawals {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range e.Withdrawals {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, withdrawals)
-	}
+	return
+}
+
+// fixedSize returns the fixed size of the HistoricalSummary object
+func (h *HistoricalSummary) fixedSize() int {
+	return int(64)
+}
 
-	hh.Merkleize(indx)
+// SizeSSZ returns the ssz encoded size in bytes for the HistoricalSummary object
+func (h *HistoricalSummary) SizeSSZ() (size int) {
+	size = h.fixedSize()
 	return
 }
 
-// GetTree ssz hashes the ExecutionPayloadCapella object
-func (e *ExecutionPayloadCapella) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(e)
+// HashTreeRoot ssz hashes the HistoricalSummary object
+func (h *HistoricalSummary) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(h)
 }
 
-// MarshalSSZ ssz marshals the ExecutionPayloadHeaderCapella object
-func (e *ExecutionPayloadHeaderCapella) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+// HashTreeRootCtx ssz hashes the HistoricalSummary object and stops with the error of the context once it is done
+func (h *HistoricalSummary) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, h)
 }
 
-// MarshalSSZTo ssz marshals the ExecutionPayloadHeaderCapella object to a target array
-func (e *ExecutionPayloadHeaderCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := e.fixedSize()
+// HashTreeRootWith ssz hashes the HistoricalSummary object with a hasher
+func (h *HistoricalSummary) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Field (0) 'ParentHash'
-	dst = append(dst, e.ParentHash[:]...)
+	// Field (0) 'BlockSummaryRoot'
+	hh.PutBytes(h.BlockSummaryRoot[:])
 
-	// Field (1) 'FeeRecipient'
-	dst = append(dst, e.FeeRecipient[:]...)
+	// Field (1) 'StateSummaryRoot'
+	hh.PutBytes(h.StateSummaryRoot[:])
 
-	// Field (2) 'StateRoot'
-	dst = append(dst, e.StateRoot[:]...)
+	hh.Merkleize(indx)
+	return
+}
 
-	// Field (3) 'ReceiptsRoot'
-	dst = append(dst, e.ReceiptsRoot[:]...)
+// GetTree ssz hashes the HistoricalSummary object
+func (h *HistoricalSummary) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(h)
+}
 
-	// Field (4) 'LogsBloom'
-	dst = append(dst, e.LogsBloom[:]...)
+// GetTreeCtx ssz hashes the HistoricalSummary object and stops with the error of the context once it is done
+func (h *HistoricalSummary) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, h)
+}
 
-	// Field (5) 'PrevRandao'
-	dst = append(dst, e.PrevRandao[:]...)
+// Generalized indices of the HistoricalSummary fields
+const (
+	HistoricalSummaryGindexBlockSummaryRoot = 2
+	HistoricalSummaryGindexStateSummaryRoot = 3
+)
 
-	// Field (6) 'BlockNumber'
-	dst = ssz.MarshalValue(dst, e.BlockNumber)
+// HistoricalSummaryGindex returns the generalized index of a field path in the HistoricalSummary object
+func HistoricalSummaryGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "block_summary_root":
+		return ssz.FieldGindex(HistoricalSummaryGindexBlockSummaryRoot, rest, nil)
+	case "state_summary_root":
+		return ssz.FieldGindex(HistoricalSummaryGindexStateSummaryRoot, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("HistoricalSummary", field)
+}
 
-	// Field (7) 'GasLimit'
-	dst = ssz.MarshalValue(dst, e.GasLimit)
+// ProveField returns a proof of the field path in the HistoricalSummary object
+func (h *HistoricalSummary) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(h, HistoricalSummaryGindex, path)
+}
 
-	// Field (8) 'GasUsed'
-	dst = ssz.MarshalValue(dst, e.GasUsed)
+// ProveFields returns a multiproof of the field paths in the HistoricalSummary object
+func (h *HistoricalSummary) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(h, HistoricalSummaryGindex, paths...)
+}
 
-	// Field (9) 'Timestamp'
-	dst = ssz.MarshalValue(dst, e.Timestamp)
+// MarshalSSZ ssz marshals the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
 
-	// Offset (10) 'ExtraData'
-	dst = ssz.WriteOffset(dst, offset)
+// MarshalSSZTo ssz marshals the SignedBLSToExecutionChange object to a target array
+func (s *SignedBLSToExecutionChange) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (11) 'BaseFeePerGas'
-	dst = append(dst, e.BaseFeePerGas[:]...)
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BLSToExecutionChange)
+	}
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
 
-	// Field (12) 'BlockHash'
-	dst = append(dst, e.BlockHash[:]...)
+	// Field (1) 'Signature'
+	dst = append(dst, s.Signature[:]...)
 
-	// Field (13) 'TransactionsRoot'
-	dst = append(dst, e.TransactionsRoot[:]...)
+	return
+}
 
-	// Field (14) 'WithdrawalRoot'
-	dst = append(dst, e.WithdrawalRoot[:]...)
+// MarshalSSZWriter ssz marshals the SignedBLSToExecutionChange object to a writer
+func (s *SignedBLSToExecutionChange) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
 
-	// Field (10) 'ExtraData'
-	if size := uint64(len(e.ExtraData)); size > 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeaderCapella.ExtraData", size, 32)
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BLSToExecutionChange)
+	}
+	if dst, err = ww.WriteObject(dst, s.Message); err != nil {
 		return
 	}
-	dst = append(dst, e.ExtraData...)
 
-	return
+	// Field (1) 'Signature'
+	dst = append(dst, s.Signature[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
 }
 
-// UnmarshalSSZ ssz unmarshals the ExecutionPayloadHeaderCapella object
-func (e *ExecutionPayloadHeaderCapella) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(e, buf)
+// UnmarshalSSZ ssz unmarshals the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(s, buf)
 }
 
-// UnmarshalSSZTail unmarshals the ExecutionPayloadHeaderCapella object and returns the remaining bufferº
-func (e *ExecutionPayloadHeaderCapella) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+// UnmarshalSSZTail unmarshals the SignedBLSToExecutionChange object and returns the remaining bufferº
+func (s *SignedBLSToExecutionChange) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	size := len(buf)
-	fixedSize := e.fixedSize()
+	fixedSize := s.fixedSize()
 	if size < fixedSize {
 		return nil, ssz.ErrSize
 	}
 
-	tail := buf
-	var o10 uint64
-	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
-
-	// Field (0) 'ParentHash'
-	buf = ssz.UnmarshalFixedBytes(e.ParentHash[:], buf)
-
-	// Field (1) 'FeeRecipient'
-	buf = ssz.UnmarshalFixedBytes(e.FeeRecipient[:], buf)
-
-	// Field (2) 'StateRoot'
-	buf = ssz.UnmarshalFixedBytes(e.StateRoot[:], buf)
-
-	// Field (3) 'ReceiptsRoot'
-	buf = ssz.UnmarshalFixedBytes(e.ReceiptsRoot[:], buf)
-
-	// Field (4) 'LogsBloom'
-	buf = ssz.UnmarshalFixedBytes(e.LogsBloom[:], buf)
-
-	// Field (5) 'PrevRandao'
-	buf = ssz.UnmarshalFixedBytes(e.PrevRandao[:], buf)
-
-	// Field (6) 'BlockNumber'
-	e.BlockNumber, buf = ssz.UnmarshallValue[uint64](buf)
-
-	// Field (7) 'GasLimit'
-	e.GasLimit, buf = ssz.UnmarshallValue[uint64](buf)
+	// Field (0) 'Message'
+	if buf, err = ssz.UnmarshalFieldTail(&s.Message, buf); err != nil {
+		return
+	}
 
-	// Field (8) 'GasUsed'
-	e.GasUsed, buf = ssz.UnmarshallValue[uint64](buf)
+	// Field (1) 'Signature'
+	buf = ssz.UnmarshalFixedBytes(s.Signature[:], buf)
 
-	// Field (9) 'Timestamp'
-	e.Timestamp, buf = ssz.UnmarshallValue[uint64](buf)
+	return buf, nil
+}
 
-	// Offset (10) 'ExtraData'
-	if o10, buf, err = marker.ReadOffset(buf); err != nil {
-		return nil, err
+// UnmarshalSSZReader ssz unmarshals the SignedBLSToExecutionChange object from a reader with the size of the encoding
+func (s *SignedBLSToExecutionChange) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := s.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
 	}
 
-	// Field (11) 'BaseFeePerGas'
-	buf = ssz.UnmarshalFixedBytes(e.BaseFeePerGas[:], buf)
-
-	// Field (12) 'BlockHash'
-	buf = ssz.UnmarshalFixedBytes(e.BlockHash[:], buf)
-
-	// Field (13) 'TransactionsRoot'
-	buf = ssz.UnmarshalFixedBytes(e.TransactionsRoot[:], buf)
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Message'
+		if buf, err = ssz.UnmarshalFieldTail(&s.Message, buf); err != nil {
+			return
+		}
 
-	// Field (14) 'WithdrawalRoot'
-	buf = ssz.UnmarshalFixedBytes(e.WithdrawalRoot[:], buf)
+		// Field (1) 'Signature'
+		buf = ssz.UnmarshalFixedBytes(s.Signature[:], buf)
 
-	// Field (10) 'ExtraData'
-	if e.ExtraData, err = ssz.UnmarshalDynamicBytes(e.ExtraData, tail[o10:], 32); err != nil {
+		return buf, nil
+	}); err != nil {
 		return
 	}
 
 	return
 }
 
-// fixedSize returns the fixed size of the ExecutionPayloadHeaderCapella object
-func (e *ExecutionPayloadHeaderCapella) fixedSize() int {
-	return int(568)
+// fixedSize returns the fixed size of the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) fixedSize() int {
+	return int(172)
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadHeaderCapella object
-func (e *ExecutionPayloadHeaderCapella) SizeSSZ() (size int) {
-	size = e.fixedSize()
-
-	// Field (10) 'ExtraData'
-	size += len(e.ExtraData)
-
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) SizeSSZ() (size int) {
+	size = s.fixedSize()
 	return
 }
 
-// HashTreeRoot ssz hashes the ExecutionPayloadHeaderCapella object
-func (e *ExecutionPayloadHeaderCapella) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(e)
+// HashTreeRoot ssz hashes the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
 }
 
-// HashTreeRootWith ssz hashes the ExecutionPayloadHeaderCapella object with a hasher
-func (e *ExecutionPayloadHeaderCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'ParentHash'
-	hh.PutBytes(e.ParentHash[:])
-
-	// Field (1) 'FeeRecipient'
-	hh.PutBytes(e.FeeRecipient[:])
-
-	// Field (2) 'StateRoot'
-	hh.PutBytes(e.StateRoot[:])
-
-	// Field (3) 'ReceiptsRoot'
-	hh.PutBytes(e.ReceiptsRoot[:])
-
-	// Field (4) 'LogsBloom'
-	hh.PutBytes(e.LogsBloom[:])
-
-	// Field (5) 'PrevRandao'
-	hh.PutBytes(e.PrevRandao[:])
-
-	// Field (6) 'BlockNumber'
-	hh.PutUint64(e.BlockNumber)
+// HashTreeRootCtx ssz hashes the SignedBLSToExecutionChange object and stops with the error of the context once it is done
+func (s *SignedBLSToExecutionChange) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, s)
+}
 
-	// Field (7) 'GasLimit'
-	hh.PutUint64(e.GasLimit)
+// HashTreeRootWith ssz hashes the SignedBLSToExecutionChange object with a hasher
+func (s *SignedBLSToExecutionChange) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Field (8) 'GasUsed'
-	hh.PutUint64(e.GasUsed)
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BLSToExecutionChange)
+	}
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
 
-	// Field (9) 'Timestamp'
-	hh.PutUint64(e.Timestamp)
+	// Field (1) 'Signature'
+	hh.PutBytes(s.Signature[:])
 
-	// Field (10) 'ExtraData'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(e.ExtraData))
-		if byteLen > 32 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(e.ExtraData)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
-	}
+	hh.Merkleize(indx)
+	return
+}
 
-	// Field (11) 'BaseFeePerGas'
-	hh.PutBytes(e.BaseFeePerGas[:])
+// GetTree ssz hashes the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
 
-	// Field (12) 'BlockHash'
-	hh.PutBytes(e.BlockHash[:])
+// GetTreeCtx ssz hashes the SignedBLSToExecutionChange object and stops with the error of the context once it is done
+func (s *SignedBLSToExecutionChange) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, s)
+}
 
-	// Field (13) 'TransactionsRoot'
-	hh.PutBytes(e.TransactionsRoot[:])
+// Generalized indices of the SignedBLSToExecutionChange fields
+const (
+	SignedBLSToExecutionChangeGindexMessage   = 2
+	SignedBLSToExecutionChangeGindexSignature = 3
+)
 
-	// Field (14) 'WithdrawalRoot'
-	hh.PutBytes(e.WithdrawalRoot[:])
+// SignedBLSToExecutionChangeGindex returns the generalized index of a field path in the SignedBLSToExecutionChange object
+func SignedBLSToExecutionChangeGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "message":
+		return ssz.FieldGindex(SignedBLSToExecutionChangeGindexMessage, rest, BLSToExecutionChangeGindex)
+	case "signature":
+		return ssz.FieldGindex(SignedBLSToExecutionChangeGindexSignature, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("SignedBLSToExecutionChange", field)
+}
 
-	hh.Merkleize(indx)
-	return
+// ProveField returns a proof of the field path in the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(s, SignedBLSToExecutionChangeGindex, path)
 }
 
-// GetTree ssz hashes the ExecutionPayloadHeaderCapella object
-func (e *ExecutionPayloadHeaderCapella) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(e)
+// ProveFields returns a multiproof of the field paths in the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(s, SignedBLSToExecutionChangeGindex, paths...)
 }
 
-// MarshalSSZ ssz marshals the BLSToExecutionChange object
-func (b *BLSToExecutionChange) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// MarshalSSZ ssz marshals the Withdrawal object
+func (w *Withdrawal) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(w)
 }
 
-// MarshalSSZTo ssz marshals the BLSToExecutionChange object to a target array
-func (b *BLSToExecutionChange) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the Withdrawal object to a target array
+func (w *Withdrawal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 
-	// Field (0) 'ValidatorIndex'
-	dst = ssz.MarshalValue(dst, b.ValidatorIndex)
+	// Field (0) 'Index'
+	dst = ssz.MarshalValue(dst, w.Index)
 
-	// Field (1) 'FromBLSPubKey'
-	dst = append(dst, b.FromBLSPubKey[:]...)
+	// Field (1) 'ValidatorIndex'
+	dst = ssz.MarshalValue(dst, w.ValidatorIndex)
 
-	// Field (2) 'ToExecutionAddress'
-	dst = append(dst, b.ToExecutionAddress[:]...)
+	// Field (2) 'Address'
+	dst = append(dst, w.Address[:]...)
+
+	// Field (3) 'Amount'
+	dst = ssz.MarshalValue(dst, w.Amount)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BLSToExecutionChange object
-func (b *BLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(b, buf)
+// MarshalSSZWriter ssz marshals the Withdrawal object to a writer
+func (w *Withdrawal) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalValue(dst, w.Index)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'ValidatorIndex'
+	dst = ssz.MarshalValue(dst, w.ValidatorIndex)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Address'
+	dst = append(dst, w.Address[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'Amount'
+	dst = ssz.MarshalValue(dst, w.Amount)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
 }
 
-// UnmarshalSSZTail unmarshals the BLSToExecutionChange object and returns the remaining bufferº
-func (b *BLSToExecutionChange) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+// UnmarshalSSZ ssz unmarshals the Withdrawal object
+func (w *Withdrawal) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(w, buf)
+}
+
+// UnmarshalSSZTail unmarshals the Withdrawal object and returns the remaining bufferº
+func (w *Withdrawal) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	size := len(buf)
-	fixedSize := b.fixedSize()
+	fixedSize := w.fixedSize()
 	if size < fixedSize {
 		return nil, ssz.ErrSize
 	}
 
-	// Field (0) 'ValidatorIndex'
-	b.ValidatorIndex, buf = ssz.UnmarshallValue[uint64](buf)
+	// Field (0) 'Index'
+	w.Index, buf = ssz.UnmarshallValue[uint64](buf)
 
-	// Field (1) 'FromBLSPubKey'
-	buf = ssz.UnmarshalFixedBytes(b.FromBLSPubKey[:], buf)
+	// Field (1) 'ValidatorIndex'
+	w.ValidatorIndex, buf = ssz.UnmarshallValue[uint64](buf)
 
-	// Field (2) 'ToExecutionAddress'
-	buf = ssz.UnmarshalFixedBytes(b.ToExecutionAddress[:], buf)
+	// Field (2) 'Address'
+	buf = ssz.UnmarshalFixedBytes(w.Address[:], buf)
 
-	return buf, nil
-}
+	// Field (3) 'Amount'
+	w.Amount, buf = ssz.UnmarshallValue[uint64](buf)
 
-// fixedSize returns the fixed size of the BLSToExecutionChange object
-func (b *BLSToExecutionChange) fixedSize() int {
-	return int(76)
+	return buf, nil
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BLSToExecutionChange object
-func (b *BLSToExecutionChange) SizeSSZ() (size int) {
-	size = b.fixedSize()
-	return
-}
+// UnmarshalSSZReader ssz unmarshals the Withdrawal object from a reader with the size of the encoding
+func (w *Withdrawal) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := w.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
 
-// HashTreeRoot ssz hashes the BLSToExecutionChange object
-func (b *BLSToExecutionChange) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
-}
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Index'
+		w.Index, buf = ssz.UnmarshallValue[uint64](buf)
 
-// HashTreeRootWith ssz hashes the BLSToExecutionChange object with a hasher
-func (b *BLSToExecutionChange) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+		// Field (1) 'ValidatorIndex'
+		w.ValidatorIndex, buf = ssz.UnmarshallValue[uint64](buf)
 
-	// Field (0) 'ValidatorIndex'
-	hh.PutUint64(b.ValidatorIndex)
+		// Field (2) 'Address'
+		buf = ssz.UnmarshalFixedBytes(w.Address[:], buf)
 
-	// Field (1) 'FromBLSPubKey'
-	hh.PutBytes(b.FromBLSPubKey[:])
+		// Field (3) 'Amount'
+		w.Amount, buf = ssz.UnmarshallValue[uint64](buf)
 
-	// Field (2) 'ToExecutionAddress'
-	hh.PutBytes(b.ToExecutionAddress[:])
+		return buf, nil
+	}); err != nil {
+		return
+	}
 
-	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the BLSToExecutionChange object
-func (b *BLSToExecutionChange) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
+// fixedSize returns the fixed size of the Withdrawal object
+func (w *Withdrawal) fixedSize() int {
+	return int(44)
 }
 
-// MarshalSSZ ssz marshals the HistoricalSummary object
-func (h *HistoricalSummary) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(h)
+// SizeSSZ returns the ssz encoded size in bytes for the Withdrawal object
+func (w *Withdrawal) SizeSSZ() (size int) {
+	size = w.fixedSize()
+	return
 }
 
-// MarshalSSZTo ssz marshals the HistoricalSummary object to a target array
-func (h *HistoricalSummary) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-
-	// Field (0) 'BlockSummaryRoot'
-	dst = append(dst, h.BlockSummaryRoot[:]...)
-
-	// Field (1) 'StateSummaryRoot'
-	dst = append(dst, h.StateSummaryRoot[:]...)
-
-	return
+// HashTreeRoot ssz hashes the Withdrawal object
+func (w *Withdrawal) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(w)
 }
 
-// UnmarshalSSZ ssz unmarshals the HistoricalSummary object
-func (h *HistoricalSummary) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(h, buf)
+// HashTreeRootCtx ssz hashes the Withdrawal object and stops with the error of the context once it is done
+func (w *Withdrawal) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, w)
 }
 
-// UnmarshalSSZTail unmarshals the HistoricalSummary object and returns the remaining bufferº
-func (h *HistoricalSummary) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
-	fixedSize := h.fixedSize()
-	if size < fixedSize {
-		return nil, ssz.ErrSize
-	}
+// HashTreeRootWith ssz hashes the Withdrawal object with a hasher
+func (w *Withdrawal) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Field (0) 'BlockSummaryRoot'
-	buf = ssz.UnmarshalFixedBytes(h.BlockSummaryRoot[:], buf)
+	// Field (0) 'Index'
+	hh.PutUint64(w.Index)
 
-	// Field (1) 'StateSummaryRoot'
-	buf = ssz.UnmarshalFixedBytes(h.StateSummaryRoot[:], buf)
+	// Field (1) 'ValidatorIndex'
+	hh.PutUint64(w.ValidatorIndex)
 
-	return buf, nil
-}
+	// Field (2) 'Address'
+	hh.PutBytes(w.Address[:])
 
-// fixedSize returns the fixed size of the HistoricalSummary object
-func (h *HistoricalSummary) fixedSize() int {
-	return int(64)
-}
+	// Field (3) 'Amount'
+	hh.PutUint64(w.Amount)
 
-// SizeSSZ returns the ssz encoded size in bytes for the HistoricalSummary object
-func (h *HistoricalSummary) SizeSSZ() (size int) {
-	size = h.fixedSize()
+	hh.Merkleize(indx)
 	return
 }
 
-// HashTreeRoot ssz hashes the HistoricalSummary object
-func (h *HistoricalSummary) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(h)
+// GetTree ssz hashes the Withdrawal object
+func (w *Withdrawal) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(w)
 }
 
-// HashTreeRootWith ssz hashes the HistoricalSummary object with a hasher
-func (h *HistoricalSummary) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// GetTreeCtx ssz hashes the Withdrawal object and stops with the error of the context once it is done
+func (w *Withdrawal) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, w)
+}
 
-	// Field (0) 'BlockSummaryRoot'
-	hh.PutBytes(h.BlockSummaryRoot[:])
+// Generalized indices of the Withdrawal fields
+const (
+	WithdrawalGindexIndex          = 4
+	WithdrawalGindexValidatorIndex = 5
+	WithdrawalGindexAddress        = 6
+	WithdrawalGindexAmount         = 7
+)
 
-	// Field (1) 'StateSummaryRoot'
-	hh.PutBytes(h.StateSummaryRoot[:])
+// WithdrawalGindex returns the generalized index of a field path in the Withdrawal object
+func WithdrawalGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "index":
+		return ssz.FieldGindex(WithdrawalGindexIndex, rest, nil)
+	case "validator_index":
+		return ssz.FieldGindex(WithdrawalGindexValidatorIndex, rest, nil)
+	case "address":
+		return ssz.FieldGindex(WithdrawalGindexAddress, rest, nil)
+	case "amount":
+		return ssz.FieldGindex(WithdrawalGindexAmount, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Withdrawal", field)
+}
 
-	hh.Merkleize(indx)
-	return
+// ProveField returns a proof of the field path in the Withdrawal object
+func (w *Withdrawal) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(w, WithdrawalGindex, path)
 }
 
-// GetTree ssz hashes the HistoricalSummary object
-func (h *HistoricalSummary) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(h)
+// ProveFields returns a multiproof of the field paths in the Withdrawal object
+func (w *Withdrawal) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(w, WithdrawalGindex, paths...)
 }
 
-// MarshalSSZ ssz marshals the SignedBLSToExecutionChange object
-func (s *SignedBLSToExecutionChange) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// MarshalSSZ ssz marshals the BeaconStateCapella object
+func (b *BeaconStateCapella) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the SignedBLSToExecutionChange object to a target array
-func (s *SignedBLSToExecutionChange) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BeaconStateCapella object to a target array
+func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
+	offset := b.fixedSize()
 
-	// Field (0) 'Message'
-	if s.Message == nil {
-		s.Message = new(BLSToExecutionChange)
+	// Field (0) 'GenesisTime'
+	dst = ssz.MarshalValue(dst, b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	dst = append(dst, b.GenesisValidatorsRoot[:]...)
+
+	// Field (2) 'Slot'
+	dst = ssz.MarshalValue(dst, b.Slot)
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	if size := uint64(len(b.BlockRoots)); size != rootsSize {
+		err = ssz.ErrVectorLengthFn("BeaconStateCapella.BlockRoots", size, rootsSize)
+		return
+	}
+	for ii := uint64(0); ii < rootsSize; ii++ {
+		if size := uint64(len(b.BlockRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateCapella.BlockRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.BlockRoots[ii]...)
 	}
-	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+
+	// Field (6) 'StateRoots'
+	if size := uint64(len(b.StateRoots)); size != rootsSize {
+		err = ssz.ErrVectorLengthFn("BeaconStateCapella.StateRoots", size, rootsSize)
 		return
 	}
+	for ii := uint64(0); ii < rootsSize; ii++ {
+		if size := uint64(len(b.StateRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateCapella.StateRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.StateRoots[ii]...)
+	}
 
-	// Field (1) 'Signature'
-	dst = append(dst, s.Signature[:]...)
-
-	return
-}
-
-// UnmarshalSSZ ssz unmarshals the SignedBLSToExecutionChange object
-func (s *SignedBLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(s, buf)
-}
+	// Offset (7) 'HistoricalRoots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.HistoricalRoots) * 32
 
-// UnmarshalSSZTail unmarshals the SignedBLSToExecutionChange object and returns the remaining bufferº
-func (s *SignedBLSToExecutionChange) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
-	fixedSize := s.fixedSize()
-	if size < fixedSize {
-		return nil, ssz.ErrSize
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
 	}
-
-	// Field (0) 'Message'
-	if buf, err = ssz.UnmarshalFieldTail(&s.Message, buf); err != nil {
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
-	// Field (1) 'Signature'
-	buf = ssz.UnmarshalFixedBytes(s.Signature[:], buf)
-
-	return buf, nil
-}
-
-// fixedSize returns the fixed size of the SignedBLSToExecutionChange object
-func (s *SignedBLSToExecutionChange) fixedSize() int {
-	return int(172)
-}
+	// Offset (9) 'Eth1DataVotes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Eth1DataVotes) * 72
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedBLSToExecutionChange object
-func (s *SignedBLSToExecutionChange) SizeSSZ() (size int) {
-	size = s.fixedSize()
-	return
-}
+	// Field (10) 'Eth1DepositIndex'
+	dst = ssz.MarshalValue(dst, b.Eth1DepositIndex)
 
-// HashTreeRoot ssz hashes the SignedBLSToExecutionChange object
-func (s *SignedBLSToExecutionChange) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
-}
+	// Offset (11) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Validators) * 121
 
-// HashTreeRootWith ssz hashes the SignedBLSToExecutionChange object with a hasher
-func (s *SignedBLSToExecutionChange) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+	// Offset (12) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Balances) * 8
 
-	// Field (0) 'Message'
-	if s.Message == nil {
-		s.Message = new(BLSToExecutionChange)
-	}
-	if err = s.Message.HashTreeRootWith(hh); err != nil {
+	// Field (13) 'RandaoMixes'
+	if size := uint64(len(b.RandaoMixes)); size != randaoMixes {
+		err = ssz.ErrVectorLengthFn("BeaconStateCapella.RandaoMixes", size, randaoMixes)
 		return
 	}
+	for ii := uint64(0); ii < randaoMixes; ii++ {
+		if size := uint64(len(b.RandaoMixes[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateCapella.RandaoMixes[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.RandaoMixes[ii]...)
+	}
 
-	// Field (1) 'Signature'
-	hh.PutBytes(s.Signature[:])
-
-	hh.Merkleize(indx)
-	return
-}
-
-// GetTree ssz hashes the SignedBLSToExecutionChange object
-func (s *SignedBLSToExecutionChange) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(s)
-}
+	// Field (14) 'Slashings'
+	if size := uint64(len(b.Slashings)); size != slashings {
+		err = ssz.ErrVectorLengthFn("BeaconStateCapella.Slashings", size, slashings)
+		return
+	}
+	for ii := uint64(0); ii < slashings; ii++ {
+		dst = ssz.MarshalValue(dst, b.Slashings[ii])
+	}
 
-// MarshalSSZ ssz marshals the Withdrawal object
-func (w *Withdrawal) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(w)
-}
+	// Offset (15) 'PreviousEpochParticipation'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.PreviousEpochParticipation)
 
-// MarshalSSZTo ssz marshals the Withdrawal object to a target array
-func (w *Withdrawal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	// Offset (16) 'CurrentEpochParticipation'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.CurrentEpochParticipation)
 
-	// Field (0) 'Index'
-	dst = ssz.MarshalValue(dst, w.Index)
+	// Field (17) 'JustificationBits'
+	dst = append(dst, b.JustificationBits[:]...)
 
-	// Field (1) 'ValidatorIndex'
-	dst = ssz.MarshalValue(dst, w.ValidatorIndex)
+	// Field (18) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
 
-	// Field (2) 'Address'
-	dst = append(dst, w.Address[:]...)
+	// Field (19) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
 
-	// Field (3) 'Amount'
-	dst = ssz.MarshalValue(dst, w.Amount)
+	// Field (20) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
 
-	return
-}
+	// Offset (21) 'InactivityScores'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.InactivityScores) * 8
 
-// UnmarshalSSZ ssz unmarshals the Withdrawal object
-func (w *Withdrawal) UnmarshalSSZ(buf []byte) error {
-	return ssz.UnmarshalSSZ(w, buf)
-}
+	// Field (22) 'CurrentSyncCommittee'
+	if b.CurrentSyncCommittee == nil {
+		b.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = b.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
 
-// UnmarshalSSZTail unmarshals the Withdrawal object and returns the remaining bufferº
-func (w *Withdrawal) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-	size := len(buf)
-	fixedSize := w.fixedSize()
-	if size < fixedSize {
-		return nil, ssz.ErrSize
+	// Field (23) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
 	}
 
-	// Field (0) 'Index'
-	w.Index, buf = ssz.UnmarshallValue[uint64](buf)
+	// Offset (24) 'LatestExecutionPayloadHeader'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.LatestExecutionPayloadHeader == nil {
+		b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeaderCapella)
+	}
+	offset += b.LatestExecutionPayloadHeader.SizeSSZ()
 
-	// Field (1) 'ValidatorIndex'
-	w.ValidatorIndex, buf = ssz.UnmarshallValue[uint64](buf)
+	// Field (25) 'NextWithdrawalIndex'
+	dst = ssz.MarshalValue(dst, b.NextWithdrawalIndex)
 
-	// Field (2) 'Address'
-	buf = ssz.UnmarshalFixedBytes(w.Address[:], buf)
+	// Field (26) 'NextWithdrawalValidatorIndex'
+	dst = ssz.MarshalValue(dst, b.NextWithdrawalValidatorIndex)
 
-	// Field (3) 'Amount'
-	w.Amount, buf = ssz.UnmarshallValue[uint64](buf)
+	// Offset (27) 'HistoricalSummaries'
+	dst = ssz.WriteOffset(dst, offset)
 
-	return buf, nil
-}
+	// Field (7) 'HistoricalRoots'
+	if size := uint64(len(b.HistoricalRoots)); size > 16777216 {
+		err = ssz.ErrListTooBigFn("BeaconStateCapella.HistoricalRoots", size, 16777216)
+		return
+	}
+	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+		if size := uint64(len(b.HistoricalRoots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("BeaconStateCapella.HistoricalRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.HistoricalRoots[ii]...)
+	}
 
-// fixedSize returns the fixed size of the Withdrawal object
-func (w *Withdrawal) fixedSize() int {
-	return int(44)
-}
+	// Field (9) 'Eth1DataVotes'
+	if size := uint64(len(b.Eth1DataVotes)); size > eth1DataVotes {
+		err = ssz.ErrListTooBigFn("BeaconStateCapella.Eth1DataVotes", size, eth1DataVotes)
+		return
+	}
+	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
-// SizeSSZ returns the ssz encoded size in bytes for the Withdrawal object
-func (w *Withdrawal) SizeSSZ() (size int) {
-	size = w.fixedSize()
-	return
-}
+	// Field (11) 'Validators'
+	if size := uint64(len(b.Validators)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconStateCapella.Validators", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Validators); ii++ {
+		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
-// HashTreeRoot ssz hashes the Withdrawal object
-func (w *Withdrawal) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(w)
-}
+	// Field (12) 'Balances'
+	if size := uint64(len(b.Balances)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconStateCapella.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Balances); ii++ {
+		dst = ssz.MarshalValue(dst, b.Balances[ii])
+	}
 
-// HashTreeRootWith ssz hashes the Withdrawal object with a hasher
-func (w *Withdrawal) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+	// Field (15) 'PreviousEpochParticipation'
+	if size := uint64(len(b.PreviousEpochParticipation)); size > 1099511627776 {
+		err = ssz.ErrBytesLengthFn("BeaconStateCapella.PreviousEpochParticipation", size, 1099511627776)
+		return
+	}
+	dst = append(dst, b.PreviousEpochParticipation...)
 
-	// Field (0) 'Index'
-	hh.PutUint64(w.Index)
+	// Field (16) 'CurrentEpochParticipation'
+	if size := uint64(len(b.CurrentEpochParticipation)); size > 1099511627776 {
+		err = ssz.ErrBytesLengthFn("BeaconStateCapella.CurrentEpochParticipation", size, 1099511627776)
+		return
+	}
+	dst = append(dst, b.CurrentEpochParticipation...)
 
-	// Field (1) 'ValidatorIndex'
-	hh.PutUint64(w.ValidatorIndex)
+	// Field (21) 'InactivityScores'
+	if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BeaconStateCapella.InactivityScores", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.InactivityScores); ii++ {
+		dst = ssz.MarshalValue(dst, b.InactivityScores[ii])
+	}
 
-	// Field (2) 'Address'
-	hh.PutBytes(w.Address[:])
+	// Field (24) 'LatestExecutionPayloadHeader'
+	if dst, err = b.LatestExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
 
-	// Field (3) 'Amount'
-	hh.PutUint64(w.Amount)
+	// Field (27) 'HistoricalSummaries'
+	if size := uint64(len(b.HistoricalSummaries)); size > 16777216 {
+		err = ssz.ErrListTooBigFn("BeaconStateCapella.HistoricalSummaries", size, 16777216)
+		return
+	}
+	for ii := 0; ii < len(b.HistoricalSummaries); ii++ {
+		if dst, err = b.HistoricalSummaries[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
-	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the Withdrawal object
-func (w *Withdrawal) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(w)
-}
-
-// MarshalSSZ ssz marshals the BeaconStateCapella object
-func (b *BeaconStateCapella) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
-
-// MarshalSSZTo ssz marshals the BeaconStateCapella object to a target array
-func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// MarshalSSZWriter ssz marshals the BeaconStateCapella object to a writer
+func (b *BeaconStateCapella) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
 	offset := b.fixedSize()
 
 	// Field (0) 'GenesisTime'
 	dst = ssz.MarshalValue(dst, b.GenesisTime)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (1) 'GenesisValidatorsRoot'
 	dst = append(dst, b.GenesisValidatorsRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (2) 'Slot'
 	dst = ssz.MarshalValue(dst, b.Slot)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (3) 'Fork'
 	if b.Fork == nil {
 		b.Fork = new(Fork)
 	}
-	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, b.Fork); err != nil {
 		return
 	}
 
@@ -7928,7 +16013,7 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	if b.LatestBlockHeader == nil {
 		b.LatestBlockHeader = new(BeaconBlockHeader)
 	}
-	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, b.LatestBlockHeader); err != nil {
 		return
 	}
 
@@ -7937,12 +16022,15 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		err = ssz.ErrVectorLengthFn("BeaconStateCapella.BlockRoots", size, rootsSize)
 		return
 	}
-	for ii := uint64(0); ii < rootsSize; ii++ {
+	for ii := 0; ii < len(b.BlockRoots); ii++ {
 		if size := uint64(len(b.BlockRoots[ii])); size != 32 {
 			err = ssz.ErrBytesLengthFn("BeaconStateCapella.BlockRoots[ii]", size, 32)
 			return
 		}
 		dst = append(dst, b.BlockRoots[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (6) 'StateRoots'
@@ -7950,12 +16038,15 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		err = ssz.ErrVectorLengthFn("BeaconStateCapella.StateRoots", size, rootsSize)
 		return
 	}
-	for ii := uint64(0); ii < rootsSize; ii++ {
+	for ii := 0; ii < len(b.StateRoots); ii++ {
 		if size := uint64(len(b.StateRoots[ii])); size != 32 {
 			err = ssz.ErrBytesLengthFn("BeaconStateCapella.StateRoots[ii]", size, 32)
 			return
 		}
 		dst = append(dst, b.StateRoots[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Offset (7) 'HistoricalRoots'
@@ -7966,7 +16057,7 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	if b.Eth1Data == nil {
 		b.Eth1Data = new(Eth1Data)
 	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, b.Eth1Data); err != nil {
 		return
 	}
 
@@ -7976,6 +16067,9 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (10) 'Eth1DepositIndex'
 	dst = ssz.MarshalValue(dst, b.Eth1DepositIndex)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Offset (11) 'Validators'
 	dst = ssz.WriteOffset(dst, offset)
@@ -7990,12 +16084,15 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		err = ssz.ErrVectorLengthFn("BeaconStateCapella.RandaoMixes", size, randaoMixes)
 		return
 	}
-	for ii := uint64(0); ii < randaoMixes; ii++ {
+	for ii := 0; ii < len(b.RandaoMixes); ii++ {
 		if size := uint64(len(b.RandaoMixes[ii])); size != 32 {
 			err = ssz.ErrBytesLengthFn("BeaconStateCapella.RandaoMixes[ii]", size, 32)
 			return
 		}
 		dst = append(dst, b.RandaoMixes[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (14) 'Slashings'
@@ -8003,8 +16100,11 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		err = ssz.ErrVectorLengthFn("BeaconStateCapella.Slashings", size, slashings)
 		return
 	}
-	for ii := uint64(0); ii < slashings; ii++ {
+	for ii := 0; ii < len(b.Slashings); ii++ {
 		dst = ssz.MarshalValue(dst, b.Slashings[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Offset (15) 'PreviousEpochParticipation'
@@ -8017,12 +16117,15 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (17) 'JustificationBits'
 	dst = append(dst, b.JustificationBits[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (18) 'PreviousJustifiedCheckpoint'
 	if b.PreviousJustifiedCheckpoint == nil {
 		b.PreviousJustifiedCheckpoint = new(Checkpoint)
 	}
-	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, b.PreviousJustifiedCheckpoint); err != nil {
 		return
 	}
 
@@ -8030,7 +16133,7 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	if b.CurrentJustifiedCheckpoint == nil {
 		b.CurrentJustifiedCheckpoint = new(Checkpoint)
 	}
-	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, b.CurrentJustifiedCheckpoint); err != nil {
 		return
 	}
 
@@ -8038,7 +16141,7 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	if b.FinalizedCheckpoint == nil {
 		b.FinalizedCheckpoint = new(Checkpoint)
 	}
-	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, b.FinalizedCheckpoint); err != nil {
 		return
 	}
 
@@ -8050,7 +16153,7 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	if b.CurrentSyncCommittee == nil {
 		b.CurrentSyncCommittee = new(SyncCommittee)
 	}
-	if dst, err = b.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, b.CurrentSyncCommittee); err != nil {
 		return
 	}
 
@@ -8058,7 +16161,7 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	if b.NextSyncCommittee == nil {
 		b.NextSyncCommittee = new(SyncCommittee)
 	}
-	if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, b.NextSyncCommittee); err != nil {
 		return
 	}
 
@@ -8071,9 +16174,15 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Field (25) 'NextWithdrawalIndex'
 	dst = ssz.MarshalValue(dst, b.NextWithdrawalIndex)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (26) 'NextWithdrawalValidatorIndex'
 	dst = ssz.MarshalValue(dst, b.NextWithdrawalValidatorIndex)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Offset (27) 'HistoricalSummaries'
 	dst = ssz.WriteOffset(dst, offset)
@@ -8089,6 +16198,9 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 			return
 		}
 		dst = append(dst, b.HistoricalRoots[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (9) 'Eth1DataVotes'
@@ -8097,7 +16209,7 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		return
 	}
 	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
-		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+		if dst, err = ww.WriteObject(dst, b.Eth1DataVotes[ii]); err != nil {
 			return
 		}
 	}
@@ -8108,7 +16220,7 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		return
 	}
 	for ii := 0; ii < len(b.Validators); ii++ {
-		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
+		if dst, err = ww.WriteObject(dst, b.Validators[ii]); err != nil {
 			return
 		}
 	}
@@ -8120,6 +16232,9 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 	for ii := 0; ii < len(b.Balances); ii++ {
 		dst = ssz.MarshalValue(dst, b.Balances[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (15) 'PreviousEpochParticipation'
@@ -8128,6 +16243,9 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		return
 	}
 	dst = append(dst, b.PreviousEpochParticipation...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (16) 'CurrentEpochParticipation'
 	if size := uint64(len(b.CurrentEpochParticipation)); size > 1099511627776 {
@@ -8135,6 +16253,9 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		return
 	}
 	dst = append(dst, b.CurrentEpochParticipation...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (21) 'InactivityScores'
 	if size := uint64(len(b.InactivityScores)); size > 1099511627776 {
@@ -8143,10 +16264,13 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 	for ii := 0; ii < len(b.InactivityScores); ii++ {
 		dst = ssz.MarshalValue(dst, b.InactivityScores[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (24) 'LatestExecutionPayloadHeader'
-	if dst, err = b.LatestExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, b.LatestExecutionPayloadHeader); err != nil {
 		return
 	}
 
@@ -8156,12 +16280,15 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		return
 	}
 	for ii := 0; ii < len(b.HistoricalSummaries); ii++ {
-		if dst, err = b.HistoricalSummaries[ii].MarshalSSZTo(dst); err != nil {
+		if dst, err = ww.WriteObject(dst, b.HistoricalSummaries[ii]); err != nil {
 			return
 		}
 	}
 
-	return
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
 }
 
 // UnmarshalSSZ ssz unmarshals the BeaconStateCapella object
@@ -8368,6 +16495,241 @@ func (b *BeaconStateCapella) UnmarshalSSZTail(buf []byte) (rest []byte, err erro
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the BeaconStateCapella object from a reader with the size of the encoding
+func (b *BeaconStateCapella) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o7, o9, o11, o12, o15, o16, o21, o24, o27 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'GenesisTime'
+		b.GenesisTime, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (1) 'GenesisValidatorsRoot'
+		buf = ssz.UnmarshalFixedBytes(b.GenesisValidatorsRoot[:], buf)
+
+		// Field (2) 'Slot'
+		b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (3) 'Fork'
+		if buf, err = ssz.UnmarshalFieldTail(&b.Fork, buf); err != nil {
+			return
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if buf, err = ssz.UnmarshalFieldTail(&b.LatestBlockHeader, buf); err != nil {
+			return
+		}
+
+		// Field (5) 'BlockRoots'
+		b.BlockRoots = make([][]byte, rootsSize)
+		for ii := uint64(0); ii < rootsSize; ii++ {
+			b.BlockRoots[ii], buf = ssz.UnmarshalBytes(b.BlockRoots[ii], buf, 32)
+		}
+
+		// Field (6) 'StateRoots'
+		b.StateRoots = make([][]byte, rootsSize)
+		for ii := uint64(0); ii < rootsSize; ii++ {
+			b.StateRoots[ii], buf = ssz.UnmarshalBytes(b.StateRoots[ii], buf, 32)
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		if o7, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (8) 'Eth1Data'
+		if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
+			return
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		if o9, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (10) 'Eth1DepositIndex'
+		b.Eth1DepositIndex, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (11) 'Validators'
+		if o11, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (12) 'Balances'
+		if o12, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (13) 'RandaoMixes'
+		b.RandaoMixes = make([][]byte, randaoMixes)
+		for ii := uint64(0); ii < randaoMixes; ii++ {
+			b.RandaoMixes[ii], buf = ssz.UnmarshalBytes(b.RandaoMixes[ii], buf, 32)
+		}
+
+		// Field (14) 'Slashings'
+		b.Slashings = ssz.Extend(b.Slashings, slashings)
+		for ii := uint64(0); ii < slashings; ii++ {
+			b.Slashings[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		}
+
+		// Offset (15) 'PreviousEpochParticipation'
+		if o15, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (16) 'CurrentEpochParticipation'
+		if o16, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (17) 'JustificationBits'
+		buf = ssz.UnmarshalFixedBytes(b.JustificationBits[:], buf)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if buf, err = ssz.UnmarshalFieldTail(&b.PreviousJustifiedCheckpoint, buf); err != nil {
+			return
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if buf, err = ssz.UnmarshalFieldTail(&b.CurrentJustifiedCheckpoint, buf); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if buf, err = ssz.UnmarshalFieldTail(&b.FinalizedCheckpoint, buf); err != nil {
+			return
+		}
+
+		// Offset (21) 'InactivityScores'
+		if o21, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (22) 'CurrentSyncCommittee'
+		if buf, err = ssz.UnmarshalFieldTail(&b.CurrentSyncCommittee, buf); err != nil {
+			return
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if buf, err = ssz.UnmarshalFieldTail(&b.NextSyncCommittee, buf); err != nil {
+			return
+		}
+
+		// Offset (24) 'LatestExecutionPayloadHeader'
+		if o24, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (25) 'NextWithdrawalIndex'
+		b.NextWithdrawalIndex, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (26) 'NextWithdrawalValidatorIndex'
+		b.NextWithdrawalValidatorIndex, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (27) 'HistoricalSummaries'
+		if o27, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (7) 'HistoricalRoots'
+	if o9-o7 > 536870912 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o9-o7), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&b.HistoricalRoots, buf, 32, 16777216, func(ii uint64, buf []byte) (err error) {
+			b.HistoricalRoots[ii], buf = ssz.UnmarshalBytes(b.HistoricalRoots[ii], buf, 32)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	if err = ssz.ReadSliceSSZ(rr, &b.Eth1DataVotes, int(o11-o9), eth1DataVotes); err != nil {
+		return
+	}
+
+	// Field (11) 'Validators'
+	if err = ssz.ReadSliceSSZ(rr, &b.Validators, int(o12-o11), 1099511627776); err != nil {
+		return
+	}
+
+	// Field (12) 'Balances'
+	if o15-o12 > 8796093022208 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o15-o12), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&b.Balances, buf, 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+			b.Balances[ii], buf = ssz.UnmarshallValue[uint64](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (15) 'PreviousEpochParticipation'
+	if o16-o15 > 1099511627776 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o16-o15), func(buf []byte) (_ []byte, err error) {
+		if b.PreviousEpochParticipation, err = ssz.UnmarshalDynamicBytes(b.PreviousEpochParticipation, buf, 1099511627776); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (16) 'CurrentEpochParticipation'
+	if o21-o16 > 1099511627776 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o21-o16), func(buf []byte) (_ []byte, err error) {
+		if b.CurrentEpochParticipation, err = ssz.UnmarshalDynamicBytes(b.CurrentEpochParticipation, buf, 1099511627776); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (21) 'InactivityScores'
+	if o24-o21 > 8796093022208 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o24-o21), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&b.InactivityScores, buf, 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+			b.InactivityScores[ii], buf = ssz.UnmarshallValue[uint64](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (24) 'LatestExecutionPayloadHeader'
+	if err = ssz.ReadField(rr, &b.LatestExecutionPayloadHeader, int(o27-o24)); err != nil {
+		return
+	}
+
+	// Field (27) 'HistoricalSummaries'
+	if err = ssz.ReadSliceSSZ(rr, &b.HistoricalSummaries, size-int(o27), 16777216); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the BeaconStateCapella object
 func (b *BeaconStateCapella) fixedSize() int {
 	return int((429 + (rootsSize * 32) + (rootsSize * 32) + (randaoMixes * 32) + (slashings * 8) + (48 + (syncCommitteePubKeys * 48)) + (48 + (syncCommitteePubKeys * 48))))
@@ -8415,6 +16777,11 @@ func (b *BeaconStateCapella) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
+// HashTreeRootCtx ssz hashes the BeaconStateCapella object and stops with the error of the context once it is done
+func (b *BeaconStateCapella) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
+}
+
 // HashTreeRootWith ssz hashes the BeaconStateCapella object with a hasher
 func (b *BeaconStateCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -8512,10 +16879,8 @@ func (b *BeaconStateCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.Eth1DataVotes {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.Eth1DataVotes); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, eth1DataVotes)
 	}
@@ -8531,10 +16896,8 @@ func (b *BeaconStateCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.Validators {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.Validators); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
 	}
@@ -8685,10 +17048,8 @@ func (b *BeaconStateCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.HistoricalSummaries {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.HistoricalSummaries); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 16777216)
 	}
@@ -8702,14 +17063,232 @@ func (b *BeaconStateCapella) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
-// MarshalSSZ ssz marshals the SignedBeaconBlockCapella object
-func (s *SignedBeaconBlockCapella) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// GetTreeCtx ssz hashes the BeaconStateCapella object and stops with the error of the context once it is done
+func (b *BeaconStateCapella) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
+}
+
+// Generalized indices of the BeaconStateCapella fields
+const (
+	BeaconStateCapellaGindexGenesisTime                  = 32
+	BeaconStateCapellaGindexGenesisValidatorsRoot        = 33
+	BeaconStateCapellaGindexSlot                         = 34
+	BeaconStateCapellaGindexFork                         = 35
+	BeaconStateCapellaGindexLatestBlockHeader            = 36
+	BeaconStateCapellaGindexBlockRoots                   = 37
+	BeaconStateCapellaGindexStateRoots                   = 38
+	BeaconStateCapellaGindexHistoricalRoots              = 39
+	BeaconStateCapellaGindexEth1Data                     = 40
+	BeaconStateCapellaGindexEth1DataVotes                = 41
+	BeaconStateCapellaGindexEth1DepositIndex             = 42
+	BeaconStateCapellaGindexValidators                   = 43
+	BeaconStateCapellaGindexBalances                     = 44
+	BeaconStateCapellaGindexRandaoMixes                  = 45
+	BeaconStateCapellaGindexSlashings                    = 46
+	BeaconStateCapellaGindexPreviousEpochParticipation   = 47
+	BeaconStateCapellaGindexCurrentEpochParticipation    = 48
+	BeaconStateCapellaGindexJustificationBits            = 49
+	BeaconStateCapellaGindexPreviousJustifiedCheckpoint  = 50
+	BeaconStateCapellaGindexCurrentJustifiedCheckpoint   = 51
+	BeaconStateCapellaGindexFinalizedCheckpoint          = 52
+	BeaconStateCapellaGindexInactivityScores             = 53
+	BeaconStateCapellaGindexCurrentSyncCommittee         = 54
+	BeaconStateCapellaGindexNextSyncCommittee            = 55
+	BeaconStateCapellaGindexLatestExecutionPayloadHeader = 56
+	BeaconStateCapellaGindexNextWithdrawalIndex          = 57
+	BeaconStateCapellaGindexNextWithdrawalValidatorIndex = 58
+	BeaconStateCapellaGindexHistoricalSummaries          = 59
+)
+
+// BeaconStateCapellaGindexBlockRootsElem returns the generalized index of the chunk of the i-th element of the BlockRoots field,
+// or zero if it does not fit in an int
+func BeaconStateCapellaGindexBlockRootsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateCapellaGindexBlockRoots, ssz.VectorElemGindex(i, rootsSize, 0))
+}
+
+// BeaconStateCapellaGindexStateRootsElem returns the generalized index of the chunk of the i-th element of the StateRoots field,
+// or zero if it does not fit in an int
+func BeaconStateCapellaGindexStateRootsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateCapellaGindexStateRoots, ssz.VectorElemGindex(i, rootsSize, 0))
+}
+
+// BeaconStateCapellaGindexHistoricalRootsElem returns the generalized index of the chunk of the i-th element of the HistoricalRoots field,
+// or zero if it does not fit in an int
+func BeaconStateCapellaGindexHistoricalRootsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateCapellaGindexHistoricalRoots, ssz.ListElemGindex(i, 16777216, 0))
+}
+
+// BeaconStateCapellaGindexEth1DataVotesElem returns the generalized index of the chunk of the i-th element of the Eth1DataVotes field,
+// or zero if it does not fit in an int
+func BeaconStateCapellaGindexEth1DataVotesElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateCapellaGindexEth1DataVotes, ssz.ListElemGindex(i, eth1DataVotes, 0))
+}
+
+// BeaconStateCapellaGindexValidatorsElem returns the generalized index of the chunk of the i-th element of the Validators field,
+// or zero if it does not fit in an int
+func BeaconStateCapellaGindexValidatorsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateCapellaGindexValidators, ssz.ListElemGindex(i, 1099511627776, 0))
+}
+
+// BeaconStateCapellaGindexBalancesElem returns the generalized index of the chunk of the i-th element of the Balances field,
+// or zero if it does not fit in an int
+func BeaconStateCapellaGindexBalancesElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateCapellaGindexBalances, ssz.ListElemGindex(i, 1099511627776, 8))
+}
+
+// BeaconStateCapellaGindexRandaoMixesElem returns the generalized index of the chunk of the i-th element of the RandaoMixes field,
+// or zero if it does not fit in an int
+func BeaconStateCapellaGindexRandaoMixesElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateCapellaGindexRandaoMixes, ssz.VectorElemGindex(i, randaoMixes, 0))
+}
+
+// BeaconStateCapellaGindexSlashingsElem returns the generalized index of the chunk of the i-th element of the Slashings field,
+// or zero if it does not fit in an int
+func BeaconStateCapellaGindexSlashingsElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateCapellaGindexSlashings, ssz.VectorElemGindex(i, slashings, 8))
+}
+
+// BeaconStateCapellaGindexInactivityScoresElem returns the generalized index of the chunk of the i-th element of the InactivityScores field,
+// or zero if it does not fit in an int
+func BeaconStateCapellaGindexInactivityScoresElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateCapellaGindexInactivityScores, ssz.ListElemGindex(i, 1099511627776, 8))
+}
+
+// BeaconStateCapellaGindexHistoricalSummariesElem returns the generalized index of the chunk of the i-th element of the HistoricalSummaries field,
+// or zero if it does not fit in an int
+func BeaconStateCapellaGindexHistoricalSummariesElem(i int) int {
+	return ssz.ConcatGindices(BeaconStateCapellaGindexHistoricalSummaries, ssz.ListElemGindex(i, 16777216, 0))
+}
+
+// BeaconStateCapellaGindex returns the generalized index of a field path in the BeaconStateCapella object
+func BeaconStateCapellaGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "genesis_time":
+		return ssz.FieldGindex(BeaconStateCapellaGindexGenesisTime, rest, nil)
+	case "genesis_validators_root":
+		return ssz.FieldGindex(BeaconStateCapellaGindexGenesisValidatorsRoot, rest, nil)
+	case "slot":
+		return ssz.FieldGindex(BeaconStateCapellaGindexSlot, rest, nil)
+	case "fork":
+		return ssz.FieldGindex(BeaconStateCapellaGindexFork, rest, ForkGindex)
+	case "latest_block_header":
+		return ssz.FieldGindex(BeaconStateCapellaGindexLatestBlockHeader, rest, BeaconBlockHeaderGindex)
+	case "block_roots":
+		return ssz.FieldGindex(BeaconStateCapellaGindexBlockRoots, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, rootsSize, 0, nil)
+		})
+	case "state_roots":
+		return ssz.FieldGindex(BeaconStateCapellaGindexStateRoots, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, rootsSize, 0, nil)
+		})
+	case "historical_roots":
+		return ssz.FieldGindex(BeaconStateCapellaGindexHistoricalRoots, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16777216, 0, nil)
+		})
+	case "eth1_data":
+		return ssz.FieldGindex(BeaconStateCapellaGindexEth1Data, rest, Eth1DataGindex)
+	case "eth1_data_votes":
+		return ssz.FieldGindex(BeaconStateCapellaGindexEth1DataVotes, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, eth1DataVotes, 0, Eth1DataGindex)
+		})
+	case "eth1_deposit_index":
+		return ssz.FieldGindex(BeaconStateCapellaGindexEth1DepositIndex, rest, nil)
+	case "validators":
+		return ssz.FieldGindex(BeaconStateCapellaGindexValidators, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1099511627776, 0, ValidatorGindex)
+		})
+	case "balances":
+		return ssz.FieldGindex(BeaconStateCapellaGindexBalances, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1099511627776, 8, nil)
+		})
+	case "randao_mixes":
+		return ssz.FieldGindex(BeaconStateCapellaGindexRandaoMixes, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, randaoMixes, 0, nil)
+		})
+	case "slashings":
+		return ssz.FieldGindex(BeaconStateCapellaGindexSlashings, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, slashings, 8, nil)
+		})
+	case "previous_epoch_participation":
+		return ssz.FieldGindex(BeaconStateCapellaGindexPreviousEpochParticipation, rest, nil)
+	case "current_epoch_participation":
+		return ssz.FieldGindex(BeaconStateCapellaGindexCurrentEpochParticipation, rest, nil)
+	case "justification_bits":
+		return ssz.FieldGindex(BeaconStateCapellaGindexJustificationBits, rest, nil)
+	case "previous_justified_checkpoint":
+		return ssz.FieldGindex(BeaconStateCapellaGindexPreviousJustifiedCheckpoint, rest, CheckpointGindex)
+	case "current_justified_checkpoint":
+		return ssz.FieldGindex(BeaconStateCapellaGindexCurrentJustifiedCheckpoint, rest, CheckpointGindex)
+	case "finalized_checkpoint":
+		return ssz.FieldGindex(BeaconStateCapellaGindexFinalizedCheckpoint, rest, CheckpointGindex)
+	case "inactivity_scores":
+		return ssz.FieldGindex(BeaconStateCapellaGindexInactivityScores, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1099511627776, 8, nil)
+		})
+	case "current_sync_committee":
+		return ssz.FieldGindex(BeaconStateCapellaGindexCurrentSyncCommittee, rest, SyncCommitteeGindex)
+	case "next_sync_committee":
+		return ssz.FieldGindex(BeaconStateCapellaGindexNextSyncCommittee, rest, SyncCommitteeGindex)
+	case "latest_execution_payload_header":
+		return ssz.FieldGindex(BeaconStateCapellaGindexLatestExecutionPayloadHeader, rest, ExecutionPayloadHeaderCapellaGindex)
+	case "next_withdrawal_index":
+		return ssz.FieldGindex(BeaconStateCapellaGindexNextWithdrawalIndex, rest, nil)
+	case "next_withdrawal_validator_index":
+		return ssz.FieldGindex(BeaconStateCapellaGindexNextWithdrawalValidatorIndex, rest, nil)
+	case "historical_summaries":
+		return ssz.FieldGindex(BeaconStateCapellaGindexHistoricalSummaries, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16777216, 0, HistoricalSummaryGindex)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("BeaconStateCapella", field)
+}
+
+// ProveField returns a proof of the field path in the BeaconStateCapella object
+func (b *BeaconStateCapella) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BeaconStateCapellaGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the BeaconStateCapella object
+func (b *BeaconStateCapella) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BeaconStateCapellaGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the SignedBeaconBlockCapella object
+func (s *SignedBeaconBlockCapella) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBeaconBlockCapella object to a target array
+func (s *SignedBeaconBlockCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := s.fixedSize()
+
+	// Offset (0) 'Block'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Signature'
+	if size := uint64(len(s.Signature)); size != 96 {
+		err = ssz.ErrBytesLengthFn("SignedBeaconBlockCapella.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	// Field (0) 'Block'
+	if dst, err = s.Block.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
 }
 
-// MarshalSSZTo ssz marshals the SignedBeaconBlockCapella object to a target array
-func (s *SignedBeaconBlockCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// MarshalSSZWriter ssz marshals the SignedBeaconBlockCapella object to a writer
+func (s *SignedBeaconBlockCapella) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
 	offset := s.fixedSize()
 
 	// Offset (0) 'Block'
@@ -8721,13 +17300,19 @@ func (s *SignedBeaconBlockCapella) MarshalSSZTo(buf []byte) (dst []byte, err err
 		return
 	}
 	dst = append(dst, s.Signature...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (0) 'Block'
-	if dst, err = s.Block.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, s.Block); err != nil {
 		return
 	}
 
-	return
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
 }
 
 // UnmarshalSSZ ssz unmarshals the SignedBeaconBlockCapella object
@@ -8763,6 +17348,38 @@ func (s *SignedBeaconBlockCapella) UnmarshalSSZTail(buf []byte) (rest []byte, er
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the SignedBeaconBlockCapella object from a reader with the size of the encoding
+func (s *SignedBeaconBlockCapella) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := s.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'Block'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (1) 'Signature'
+		s.Signature, buf = ssz.UnmarshalBytes(s.Signature, buf, 96)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'Block'
+	if err = ssz.ReadField(rr, &s.Block, size-int(o0)); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the SignedBeaconBlockCapella object
 func (s *SignedBeaconBlockCapella) fixedSize() int {
 	return int(100)
@@ -8786,6 +17403,11 @@ func (s *SignedBeaconBlockCapella) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
 }
 
+// HashTreeRootCtx ssz hashes the SignedBeaconBlockCapella object and stops with the error of the context once it is done
+func (s *SignedBeaconBlockCapella) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, s)
+}
+
 // HashTreeRootWith ssz hashes the SignedBeaconBlockCapella object with a hasher
 func (s *SignedBeaconBlockCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -8811,6 +17433,42 @@ func (s *SignedBeaconBlockCapella) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
+// GetTreeCtx ssz hashes the SignedBeaconBlockCapella object and stops with the error of the context once it is done
+func (s *SignedBeaconBlockCapella) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, s)
+}
+
+// Generalized indices of the SignedBeaconBlockCapella fields
+const (
+	SignedBeaconBlockCapellaGindexBlock     = 2
+	SignedBeaconBlockCapellaGindexSignature = 3
+)
+
+// SignedBeaconBlockCapellaGindex returns the generalized index of a field path in the SignedBeaconBlockCapella object
+func SignedBeaconBlockCapellaGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "message":
+		return ssz.FieldGindex(SignedBeaconBlockCapellaGindexBlock, rest, BeaconBlockCapellaGindex)
+	case "signature":
+		return ssz.FieldGindex(SignedBeaconBlockCapellaGindexSignature, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("SignedBeaconBlockCapella", field)
+}
+
+// ProveField returns a proof of the field path in the SignedBeaconBlockCapella object
+func (s *SignedBeaconBlockCapella) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(s, SignedBeaconBlockCapellaGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the SignedBeaconBlockCapella object
+func (s *SignedBeaconBlockCapella) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(s, SignedBeaconBlockCapellaGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the BeaconBlockCapella object
 func (b *BeaconBlockCapella) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
@@ -8844,6 +17502,50 @@ func (b *BeaconBlockCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the BeaconBlockCapella object to a writer
+func (b *BeaconBlockCapella) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := b.fixedSize()
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, b.Slot)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalValue(dst, b.ProposerIndex)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'ParentRoot'
+	dst = append(dst, b.ParentRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'StateRoot'
+	dst = append(dst, b.StateRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (4) 'Body'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (4) 'Body'
+	if dst, err = ww.WriteObject(dst, b.Body); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the BeaconBlockCapella object
 func (b *BeaconBlockCapella) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(b, buf)
@@ -8886,6 +17588,47 @@ func (b *BeaconBlockCapella) UnmarshalSSZTail(buf []byte) (rest []byte, err erro
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the BeaconBlockCapella object from a reader with the size of the encoding
+func (b *BeaconBlockCapella) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o4 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Slot'
+		b.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (1) 'ProposerIndex'
+		b.ProposerIndex, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (2) 'ParentRoot'
+		buf = ssz.UnmarshalFixedBytes(b.ParentRoot[:], buf)
+
+		// Field (3) 'StateRoot'
+		buf = ssz.UnmarshalFixedBytes(b.StateRoot[:], buf)
+
+		// Offset (4) 'Body'
+		if o4, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (4) 'Body'
+	if err = ssz.ReadField(rr, &b.Body, size-int(o4)); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the BeaconBlockCapella object
 func (b *BeaconBlockCapella) fixedSize() int {
 	return int(84)
@@ -8909,6 +17652,11 @@ func (b *BeaconBlockCapella) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
+// HashTreeRootCtx ssz hashes the BeaconBlockCapella object and stops with the error of the context once it is done
+func (b *BeaconBlockCapella) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
+}
+
 // HashTreeRootWith ssz hashes the BeaconBlockCapella object with a hasher
 func (b *BeaconBlockCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -8916,37 +17664,238 @@ func (b *BeaconBlockCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (0) 'Slot'
 	hh.PutUint64(b.Slot)
 
-	// Field (1) 'ProposerIndex'
-	hh.PutUint64(b.ProposerIndex)
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	hh.PutBytes(b.ParentRoot[:])
+
+	// Field (3) 'StateRoot'
+	hh.PutBytes(b.StateRoot[:])
+
+	// Field (4) 'Body'
+	if err = b.Body.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BeaconBlockCapella object
+func (b *BeaconBlockCapella) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// GetTreeCtx ssz hashes the BeaconBlockCapella object and stops with the error of the context once it is done
+func (b *BeaconBlockCapella) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
+}
+
+// Generalized indices of the BeaconBlockCapella fields
+const (
+	BeaconBlockCapellaGindexSlot          = 8
+	BeaconBlockCapellaGindexProposerIndex = 9
+	BeaconBlockCapellaGindexParentRoot    = 10
+	BeaconBlockCapellaGindexStateRoot     = 11
+	BeaconBlockCapellaGindexBody          = 12
+)
+
+// BeaconBlockCapellaGindex returns the generalized index of a field path in the BeaconBlockCapella object
+func BeaconBlockCapellaGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "slot":
+		return ssz.FieldGindex(BeaconBlockCapellaGindexSlot, rest, nil)
+	case "proposer_index":
+		return ssz.FieldGindex(BeaconBlockCapellaGindexProposerIndex, rest, nil)
+	case "parent_root":
+		return ssz.FieldGindex(BeaconBlockCapellaGindexParentRoot, rest, nil)
+	case "state_root":
+		return ssz.FieldGindex(BeaconBlockCapellaGindexStateRoot, rest, nil)
+	case "body":
+		return ssz.FieldGindex(BeaconBlockCapellaGindexBody, rest, BeaconBlockBodyCapellaGindex)
+	}
+	return 0, ssz.ErrUnknownFieldFn("BeaconBlockCapella", field)
+}
+
+// ProveField returns a proof of the field path in the BeaconBlockCapella object
+func (b *BeaconBlockCapella) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BeaconBlockCapellaGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the BeaconBlockCapella object
+func (b *BeaconBlockCapella) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BeaconBlockCapellaGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBodyCapella object
+func (b *BeaconBlockBodyCapella) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockBodyCapella object to a target array
+func (b *BeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := b.fixedSize()
+
+	// Field (0) 'RandaoReveal'
+	if size := uint64(len(b.RandaoReveal)); size != 96 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockBodyCapella.RandaoReveal", size, 96)
+		return
+	}
+	dst = append(dst, b.RandaoReveal...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	dst = append(dst, b.Graffiti[:]...)
+
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1240
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
+	}
+	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (9) 'ExecutionPayload'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(ExecutionPayloadCapella)
+	}
+	offset += b.ExecutionPayload.SizeSSZ()
+
+	// Offset (10) 'BlsToExecutionChanges'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (3) 'ProposerSlashings'
+	if size := uint64(len(b.ProposerSlashings)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyCapella.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := uint64(len(b.AttesterSlashings)); size > 2 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyCapella.AttesterSlashings", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := uint64(len(b.Attestations)); size > 128 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyCapella.Attestations", size, 128)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := uint64(len(b.Deposits)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyCapella.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
-	// Field (2) 'ParentRoot'
-	hh.PutBytes(b.ParentRoot[:])
+	// Field (7) 'VoluntaryExits'
+	if size := uint64(len(b.VoluntaryExits)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyCapella.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
-	// Field (3) 'StateRoot'
-	hh.PutBytes(b.StateRoot[:])
+	// Field (9) 'ExecutionPayload'
+	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
+		return
+	}
 
-	// Field (4) 'Body'
-	if err = b.Body.HashTreeRootWith(hh); err != nil {
+	// Field (10) 'BlsToExecutionChanges'
+	if size := uint64(len(b.BlsToExecutionChanges)); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyCapella.BlsToExecutionChanges", size, 16)
 		return
 	}
+	for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
+		if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
-	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the BeaconBlockCapella object
-func (b *BeaconBlockCapella) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
-}
-
-// MarshalSSZ ssz marshals the BeaconBlockBodyCapella object
-func (b *BeaconBlockBodyCapella) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
-
-// MarshalSSZTo ssz marshals the BeaconBlockBodyCapella object to a target array
-func (b *BeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// MarshalSSZWriter ssz marshals the BeaconBlockBodyCapella object to a writer
+func (b *BeaconBlockBodyCapella) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
 	offset := b.fixedSize()
 
 	// Field (0) 'RandaoReveal'
@@ -8955,17 +17904,23 @@ func (b *BeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error
 		return
 	}
 	dst = append(dst, b.RandaoReveal...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (1) 'Eth1Data'
 	if b.Eth1Data == nil {
 		b.Eth1Data = new(Eth1Data)
 	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, b.Eth1Data); err != nil {
 		return
 	}
 
 	// Field (2) 'Graffiti'
 	dst = append(dst, b.Graffiti[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Offset (3) 'ProposerSlashings'
 	dst = ssz.WriteOffset(dst, offset)
@@ -8997,7 +17952,7 @@ func (b *BeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error
 	if b.SyncAggregate == nil {
 		b.SyncAggregate = new(SyncAggregate)
 	}
-	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, b.SyncAggregate); err != nil {
 		return
 	}
 
@@ -9017,7 +17972,7 @@ func (b *BeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error
 		return
 	}
 	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
-		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+		if dst, err = ww.WriteObject(dst, b.ProposerSlashings[ii]); err != nil {
 			return
 		}
 	}
@@ -9035,7 +17990,7 @@ func (b *BeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error
 		}
 	}
 	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+		if dst, err = ww.WriteObject(dst, b.AttesterSlashings[ii]); err != nil {
 			return
 		}
 	}
@@ -9053,7 +18008,7 @@ func (b *BeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error
 		}
 	}
 	for ii := 0; ii < len(b.Attestations); ii++ {
-		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+		if dst, err = ww.WriteObject(dst, b.Attestations[ii]); err != nil {
 			return
 		}
 	}
@@ -9064,7 +18019,7 @@ func (b *BeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error
 		return
 	}
 	for ii := 0; ii < len(b.Deposits); ii++ {
-		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+		if dst, err = ww.WriteObject(dst, b.Deposits[ii]); err != nil {
 			return
 		}
 	}
@@ -9075,13 +18030,13 @@ func (b *BeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error
 		return
 	}
 	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
-		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+		if dst, err = ww.WriteObject(dst, b.VoluntaryExits[ii]); err != nil {
 			return
 		}
 	}
 
 	// Field (9) 'ExecutionPayload'
-	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
+	if dst, err = ww.WriteObject(dst, b.ExecutionPayload); err != nil {
 		return
 	}
 
@@ -9091,12 +18046,15 @@ func (b *BeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error
 		return
 	}
 	for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
-		if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
+		if dst, err = ww.WriteObject(dst, b.BlsToExecutionChanges[ii]); err != nil {
 			return
 		}
 	}
 
-	return
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
 }
 
 // UnmarshalSSZ ssz unmarshals the BeaconBlockBodyCapella object
@@ -9205,6 +18163,105 @@ func (b *BeaconBlockBodyCapella) UnmarshalSSZTail(buf []byte) (rest []byte, err
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the BeaconBlockBodyCapella object from a reader with the size of the encoding
+func (b *BeaconBlockBodyCapella) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o3, o4, o5, o6, o7, o9, o10 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'RandaoReveal'
+		b.RandaoReveal, buf = ssz.UnmarshalBytes(b.RandaoReveal, buf, 96)
+
+		// Field (1) 'Eth1Data'
+		if buf, err = ssz.UnmarshalFieldTail(&b.Eth1Data, buf); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		buf = ssz.UnmarshalFixedBytes(b.Graffiti[:], buf)
+
+		// Offset (3) 'ProposerSlashings'
+		if o3, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (4) 'AttesterSlashings'
+		if o4, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (5) 'Attestations'
+		if o5, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (6) 'Deposits'
+		if o6, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (7) 'VoluntaryExits'
+		if o7, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (8) 'SyncAggregate'
+		if buf, err = ssz.UnmarshalFieldTail(&b.SyncAggregate, buf); err != nil {
+			return
+		}
+
+		// Offset (9) 'ExecutionPayload'
+		if o9, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (10) 'BlsToExecutionChanges'
+		if o10, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (3) 'ProposerSlashings'
+	if err = ssz.ReadSliceSSZ(rr, &b.ProposerSlashings, int(o4-o3), 16); err != nil {
+		return
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if err = ssz.ReadDynamicSliceSSZ(rr, &b.AttesterSlashings, int(o5-o4), 2); err != nil {
+		return
+	}
+
+	// Field (5) 'Attestations'
+	if err = ssz.ReadDynamicSliceSSZ(rr, &b.Attestations, int(o6-o5), 128); err != nil {
+		return
+	}
+
+	// Field (6) 'Deposits'
+	if err = ssz.ReadSliceSSZ(rr, &b.Deposits, int(o7-o6), 16); err != nil {
+		return
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if err = ssz.ReadSliceSSZ(rr, &b.VoluntaryExits, int(o9-o7), 16); err != nil {
+		return
+	}
+
+	// Field (9) 'ExecutionPayload'
+	if err = ssz.ReadField(rr, &b.ExecutionPayload, int(o10-o9)); err != nil {
+		return
+	}
+
+	// Field (10) 'BlsToExecutionChanges'
+	if err = ssz.ReadSliceSSZ(rr, &b.BlsToExecutionChanges, size-int(o10), 16); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the BeaconBlockBodyCapella object
 func (b *BeaconBlockBodyCapella) fixedSize() int {
 	return int((228 + (96 + syncCommitteeBits)))
@@ -9252,6 +18309,11 @@ func (b *BeaconBlockBodyCapella) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
+// HashTreeRootCtx ssz hashes the BeaconBlockBodyCapella object and stops with the error of the context once it is done
+func (b *BeaconBlockBodyCapella) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
+}
+
 // HashTreeRootWith ssz hashes the BeaconBlockBodyCapella object with a hasher
 func (b *BeaconBlockBodyCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -9282,10 +18344,8 @@ func (b *BeaconBlockBodyCapella) HashTreeRootWith(hh ssz.HashWalker) (err error)
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.ProposerSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.ProposerSlashings); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
@@ -9298,10 +18358,8 @@ func (b *BeaconBlockBodyCapella) HashTreeRootWith(hh ssz.HashWalker) (err error)
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.AttesterSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.AttesterSlashings); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 2)
 	}
@@ -9314,10 +18372,8 @@ func (b *BeaconBlockBodyCapella) HashTreeRootWith(hh ssz.HashWalker) (err error)
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.Attestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.Attestations); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 128)
 	}
@@ -9330,10 +18386,8 @@ func (b *BeaconBlockBodyCapella) HashTreeRootWith(hh ssz.HashWalker) (err error)
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.Deposits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.Deposits); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
@@ -9346,10 +18400,8 @@ func (b *BeaconBlockBodyCapella) HashTreeRootWith(hh ssz.HashWalker) (err error)
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.VoluntaryExits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.VoluntaryExits); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
@@ -9375,10 +18427,8 @@ func (b *BeaconBlockBodyCapella) HashTreeRootWith(hh ssz.HashWalker) (err error)
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.BlsToExecutionChanges {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, b.BlsToExecutionChanges); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
@@ -9392,6 +18442,117 @@ func (b *BeaconBlockBodyCapella) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
+// GetTreeCtx ssz hashes the BeaconBlockBodyCapella object and stops with the error of the context once it is done
+func (b *BeaconBlockBodyCapella) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
+}
+
+// Generalized indices of the BeaconBlockBodyCapella fields
+const (
+	BeaconBlockBodyCapellaGindexRandaoReveal          = 16
+	BeaconBlockBodyCapellaGindexEth1Data              = 17
+	BeaconBlockBodyCapellaGindexGraffiti              = 18
+	BeaconBlockBodyCapellaGindexProposerSlashings     = 19
+	BeaconBlockBodyCapellaGindexAttesterSlashings     = 20
+	BeaconBlockBodyCapellaGindexAttestations          = 21
+	BeaconBlockBodyCapellaGindexDeposits              = 22
+	BeaconBlockBodyCapellaGindexVoluntaryExits        = 23
+	BeaconBlockBodyCapellaGindexSyncAggregate         = 24
+	BeaconBlockBodyCapellaGindexExecutionPayload      = 25
+	BeaconBlockBodyCapellaGindexBlsToExecutionChanges = 26
+)
+
+// BeaconBlockBodyCapellaGindexProposerSlashingsElem returns the generalized index of the chunk of the i-th element of the ProposerSlashings field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyCapellaGindexProposerSlashingsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyCapellaGindexProposerSlashings, ssz.ListElemGindex(i, 16, 0))
+}
+
+// BeaconBlockBodyCapellaGindexAttesterSlashingsElem returns the generalized index of the chunk of the i-th element of the AttesterSlashings field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyCapellaGindexAttesterSlashingsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyCapellaGindexAttesterSlashings, ssz.ListElemGindex(i, 2, 0))
+}
+
+// BeaconBlockBodyCapellaGindexAttestationsElem returns the generalized index of the chunk of the i-th element of the Attestations field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyCapellaGindexAttestationsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyCapellaGindexAttestations, ssz.ListElemGindex(i, 128, 0))
+}
+
+// BeaconBlockBodyCapellaGindexDepositsElem returns the generalized index of the chunk of the i-th element of the Deposits field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyCapellaGindexDepositsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyCapellaGindexDeposits, ssz.ListElemGindex(i, 16, 0))
+}
+
+// BeaconBlockBodyCapellaGindexVoluntaryExitsElem returns the generalized index of the chunk of the i-th element of the VoluntaryExits field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyCapellaGindexVoluntaryExitsElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyCapellaGindexVoluntaryExits, ssz.ListElemGindex(i, 16, 0))
+}
+
+// BeaconBlockBodyCapellaGindexBlsToExecutionChangesElem returns the generalized index of the chunk of the i-th element of the BlsToExecutionChanges field,
+// or zero if it does not fit in an int
+func BeaconBlockBodyCapellaGindexBlsToExecutionChangesElem(i int) int {
+	return ssz.ConcatGindices(BeaconBlockBodyCapellaGindexBlsToExecutionChanges, ssz.ListElemGindex(i, 16, 0))
+}
+
+// BeaconBlockBodyCapellaGindex returns the generalized index of a field path in the BeaconBlockBodyCapella object
+func BeaconBlockBodyCapellaGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "randao_reveal":
+		return ssz.FieldGindex(BeaconBlockBodyCapellaGindexRandaoReveal, rest, nil)
+	case "eth1_data":
+		return ssz.FieldGindex(BeaconBlockBodyCapellaGindexEth1Data, rest, Eth1DataGindex)
+	case "graffiti":
+		return ssz.FieldGindex(BeaconBlockBodyCapellaGindexGraffiti, rest, nil)
+	case "proposer_slashings":
+		return ssz.FieldGindex(BeaconBlockBodyCapellaGindexProposerSlashings, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, ProposerSlashingGindex)
+		})
+	case "attester_slashings":
+		return ssz.FieldGindex(BeaconBlockBodyCapellaGindexAttesterSlashings, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 2, 0, AttesterSlashingGindex)
+		})
+	case "attestations":
+		return ssz.FieldGindex(BeaconBlockBodyCapellaGindexAttestations, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 128, 0, AttestationGindex)
+		})
+	case "deposits":
+		return ssz.FieldGindex(BeaconBlockBodyCapellaGindexDeposits, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, DepositGindex)
+		})
+	case "voluntary_exits":
+		return ssz.FieldGindex(BeaconBlockBodyCapellaGindexVoluntaryExits, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, SignedVoluntaryExitGindex)
+		})
+	case "sync_aggregate":
+		return ssz.FieldGindex(BeaconBlockBodyCapellaGindexSyncAggregate, rest, SyncAggregateGindex)
+	case "execution_payload":
+		return ssz.FieldGindex(BeaconBlockBodyCapellaGindexExecutionPayload, rest, ExecutionPayloadCapellaGindex)
+	case "bls_to_execution_changes":
+		return ssz.FieldGindex(BeaconBlockBodyCapellaGindexBlsToExecutionChanges, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, SignedBLSToExecutionChangeGindex)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("BeaconBlockBodyCapella", field)
+}
+
+// ProveField returns a proof of the field path in the BeaconBlockBodyCapella object
+func (b *BeaconBlockBodyCapella) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BeaconBlockBodyCapellaGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the BeaconBlockBodyCapella object
+func (b *BeaconBlockBodyCapella) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BeaconBlockBodyCapellaGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the ExecutionPayloadDeneb object
 func (e *ExecutionPayloadDeneb) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
@@ -9437,10 +18598,149 @@ func (e *ExecutionPayloadDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error)
 	offset += len(e.ExtraData)
 
 	// Field (11) 'BaseFeePerGas'
-	dst = append(dst, e.BaseFeePerGas[:]...)
+	dst = ssz.MarshalValue(dst, ssz.Uint256(e.BaseFeePerGas))
+
+	// Field (12) 'BlockHash'
+	dst = append(dst, e.BlockHash[:]...)
+
+	// Offset (13) 'Transactions'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(e.Transactions); ii++ {
+		offset += 4
+		offset += len(e.Transactions[ii])
+	}
+
+	// Offset (14) 'Withdrawals'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (15) 'BlobGasUsed'
+	dst = ssz.MarshalValue(dst, e.BlobGasUsed)
+
+	// Field (16) 'ExcessBlobGas'
+	dst = ssz.MarshalValue(dst, e.ExcessBlobGas)
+
+	// Field (10) 'ExtraData'
+	if size := uint64(len(e.ExtraData)); size > 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadDeneb.ExtraData", size, 32)
+		return
+	}
+	dst = append(dst, e.ExtraData...)
+
+	// Field (13) 'Transactions'
+	if size := uint64(len(e.Transactions)); size > 1048576 {
+		err = ssz.ErrListTooBigFn("ExecutionPayloadDeneb.Transactions", size, 1048576)
+		return
+	}
+	{
+		offset = 4 * len(e.Transactions)
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(e.Transactions[ii])
+		}
+	}
+	for ii := 0; ii < len(e.Transactions); ii++ {
+		if size := uint64(len(e.Transactions[ii])); size > 1073741824 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayloadDeneb.Transactions[ii]", size, 1073741824)
+			return
+		}
+		dst = append(dst, e.Transactions[ii]...)
+	}
+
+	// Field (14) 'Withdrawals'
+	if size := uint64(len(e.Withdrawals)); size > withdrawals {
+		err = ssz.ErrListTooBigFn("ExecutionPayloadDeneb.Withdrawals", size, withdrawals)
+		return
+	}
+	for ii := 0; ii < len(e.Withdrawals); ii++ {
+		if dst, err = e.Withdrawals[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the ExecutionPayloadDeneb object to a writer
+func (e *ExecutionPayloadDeneb) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := e.fixedSize()
+
+	// Field (0) 'ParentHash'
+	dst = append(dst, e.ParentHash[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'FeeRecipient'
+	dst = append(dst, e.FeeRecipient[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'StateRoot'
+	dst = append(dst, e.StateRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'ReceiptsRoot'
+	dst = append(dst, e.ReceiptsRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'LogsBloom'
+	dst = append(dst, e.LogsBloom[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'PrevRandao'
+	dst = append(dst, e.PrevRandao[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (6) 'BlockNumber'
+	dst = ssz.MarshalValue(dst, e.BlockNumber)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (7) 'GasLimit'
+	dst = ssz.MarshalValue(dst, e.GasLimit)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (8) 'GasUsed'
+	dst = ssz.MarshalValue(dst, e.GasUsed)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (9) 'Timestamp'
+	dst = ssz.MarshalValue(dst, e.Timestamp)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (10) 'ExtraData'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(e.ExtraData)
+
+	// Field (11) 'BaseFeePerGas'
+	dst = ssz.MarshalValue(dst, ssz.Uint256(e.BaseFeePerGas))
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (12) 'BlockHash'
 	dst = append(dst, e.BlockHash[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Offset (13) 'Transactions'
 	dst = ssz.WriteOffset(dst, offset)
@@ -9454,9 +18754,15 @@ func (e *ExecutionPayloadDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error)
 
 	// Field (15) 'BlobGasUsed'
 	dst = ssz.MarshalValue(dst, e.BlobGasUsed)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (16) 'ExcessBlobGas'
 	dst = ssz.MarshalValue(dst, e.ExcessBlobGas)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (10) 'ExtraData'
 	if size := uint64(len(e.ExtraData)); size > 32 {
@@ -9464,6 +18770,9 @@ func (e *ExecutionPayloadDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error)
 		return
 	}
 	dst = append(dst, e.ExtraData...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
 
 	// Field (13) 'Transactions'
 	if size := uint64(len(e.Transactions)); size > 1048576 {
@@ -9483,6 +18792,9 @@ func (e *ExecutionPayloadDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error)
 			return
 		}
 		dst = append(dst, e.Transactions[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (14) 'Withdrawals'
@@ -9491,12 +18803,15 @@ func (e *ExecutionPayloadDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error)
 		return
 	}
 	for ii := 0; ii < len(e.Withdrawals); ii++ {
-		if dst, err = e.Withdrawals[ii].MarshalSSZTo(dst); err != nil {
+		if dst, err = ww.WriteObject(dst, e.Withdrawals[ii]); err != nil {
 			return
 		}
 	}
 
-	return
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
 }
 
 // UnmarshalSSZ ssz unmarshals the ExecutionPayloadDeneb object
@@ -9552,7 +18867,11 @@ func (e *ExecutionPayloadDeneb) UnmarshalSSZTail(buf []byte) (rest []byte, err e
 	}
 
 	// Field (11) 'BaseFeePerGas'
-	buf = ssz.UnmarshalFixedBytes(e.BaseFeePerGas[:], buf)
+	{
+		var val ssz.Uint256
+		val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+		e.BaseFeePerGas = Uint256(val)
+	}
 
 	// Field (12) 'BlockHash'
 	buf = ssz.UnmarshalFixedBytes(e.BlockHash[:], buf)
@@ -9596,6 +18915,125 @@ func (e *ExecutionPayloadDeneb) UnmarshalSSZTail(buf []byte) (rest []byte, err e
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the ExecutionPayloadDeneb object from a reader with the size of the encoding
+func (e *ExecutionPayloadDeneb) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := e.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o10, o13, o14 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'ParentHash'
+		buf = ssz.UnmarshalFixedBytes(e.ParentHash[:], buf)
+
+		// Field (1) 'FeeRecipient'
+		buf = ssz.UnmarshalFixedBytes(e.FeeRecipient[:], buf)
+
+		// Field (2) 'StateRoot'
+		buf = ssz.UnmarshalFixedBytes(e.StateRoot[:], buf)
+
+		// Field (3) 'ReceiptsRoot'
+		buf = ssz.UnmarshalFixedBytes(e.ReceiptsRoot[:], buf)
+
+		// Field (4) 'LogsBloom'
+		buf = ssz.UnmarshalFixedBytes(e.LogsBloom[:], buf)
+
+		// Field (5) 'PrevRandao'
+		buf = ssz.UnmarshalFixedBytes(e.PrevRandao[:], buf)
+
+		// Field (6) 'BlockNumber'
+		e.BlockNumber, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (7) 'GasLimit'
+		e.GasLimit, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (8) 'GasUsed'
+		e.GasUsed, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (9) 'Timestamp'
+		e.Timestamp, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (10) 'ExtraData'
+		if o10, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (11) 'BaseFeePerGas'
+		{
+			var val ssz.Uint256
+			val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+			e.BaseFeePerGas = Uint256(val)
+		}
+
+		// Field (12) 'BlockHash'
+		buf = ssz.UnmarshalFixedBytes(e.BlockHash[:], buf)
+
+		// Offset (13) 'Transactions'
+		if o13, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (14) 'Withdrawals'
+		if o14, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (15) 'BlobGasUsed'
+		e.BlobGasUsed, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (16) 'ExcessBlobGas'
+		e.ExcessBlobGas, buf = ssz.UnmarshallValue[uint64](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (10) 'ExtraData'
+	if o13-o10 > 32 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o13-o10), func(buf []byte) (_ []byte, err error) {
+		if e.ExtraData, err = ssz.UnmarshalDynamicBytes(e.ExtraData, buf, 32); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (13) 'Transactions'
+	{
+		var sizes []int
+		if sizes, err = rr.ReadOffsets(int(o14-o13), 1048576); err != nil {
+			return
+		}
+		num := len(sizes)
+		e.Transactions = make([][]byte, num)
+		for ii, size := range sizes {
+			if uint64(size) > 1073741824 {
+				return ssz.ErrListTooBig
+			}
+			if err = rr.Decode(size, func(buf []byte) (_ []byte, err error) {
+				if e.Transactions[ii], err = ssz.UnmarshalDynamicBytes(e.Transactions[ii], buf, 1073741824); err != nil {
+					return
+				}
+				return buf, nil
+			}); err != nil {
+				return
+			}
+		}
+	}
+
+	// Field (14) 'Withdrawals'
+	if err = ssz.ReadSliceSSZ(rr, &e.Withdrawals, size-int(o14), withdrawals); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the ExecutionPayloadDeneb object
 func (e *ExecutionPayloadDeneb) fixedSize() int {
 	return int(528)
@@ -9625,6 +19063,11 @@ func (e *ExecutionPayloadDeneb) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(e)
 }
 
+// HashTreeRootCtx ssz hashes the ExecutionPayloadDeneb object and stops with the error of the context once it is done
+func (e *ExecutionPayloadDeneb) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, e)
+}
+
 // HashTreeRootWith ssz hashes the ExecutionPayloadDeneb object with a hasher
 func (e *ExecutionPayloadDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -9672,7 +19115,7 @@ func (e *ExecutionPayloadDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error)
 	}
 
 	// Field (11) 'BaseFeePerGas'
-	hh.PutBytes(e.BaseFeePerGas[:])
+	ssz.PutUint256(hh, ssz.Uint256(e.BaseFeePerGas))
 
 	// Field (12) 'BlockHash'
 	hh.PutBytes(e.BlockHash[:])
@@ -9708,10 +19151,8 @@ func (e *ExecutionPayloadDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error)
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range e.Withdrawals {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, e.Withdrawals); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, withdrawals)
 	}
@@ -9731,6 +19172,103 @@ func (e *ExecutionPayloadDeneb) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
 
+// GetTreeCtx ssz hashes the ExecutionPayloadDeneb object and stops with the error of the context once it is done
+func (e *ExecutionPayloadDeneb) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, e)
+}
+
+// Generalized indices of the ExecutionPayloadDeneb fields
+const (
+	ExecutionPayloadDenebGindexParentHash    = 32
+	ExecutionPayloadDenebGindexFeeRecipient  = 33
+	ExecutionPayloadDenebGindexStateRoot     = 34
+	ExecutionPayloadDenebGindexReceiptsRoot  = 35
+	ExecutionPayloadDenebGindexLogsBloom     = 36
+	ExecutionPayloadDenebGindexPrevRandao    = 37
+	ExecutionPayloadDenebGindexBlockNumber   = 38
+	ExecutionPayloadDenebGindexGasLimit      = 39
+	ExecutionPayloadDenebGindexGasUsed       = 40
+	ExecutionPayloadDenebGindexTimestamp     = 41
+	ExecutionPayloadDenebGindexExtraData     = 42
+	ExecutionPayloadDenebGindexBaseFeePerGas = 43
+	ExecutionPayloadDenebGindexBlockHash     = 44
+	ExecutionPayloadDenebGindexTransactions  = 45
+	ExecutionPayloadDenebGindexWithdrawals   = 46
+	ExecutionPayloadDenebGindexBlobGasUsed   = 47
+	ExecutionPayloadDenebGindexExcessBlobGas = 48
+)
+
+// ExecutionPayloadDenebGindexTransactionsElem returns the generalized index of the chunk of the i-th element of the Transactions field,
+// or zero if it does not fit in an int
+func ExecutionPayloadDenebGindexTransactionsElem(i int) int {
+	return ssz.ConcatGindices(ExecutionPayloadDenebGindexTransactions, ssz.ListElemGindex(i, 1048576, 0))
+}
+
+// ExecutionPayloadDenebGindexWithdrawalsElem returns the generalized index of the chunk of the i-th element of the Withdrawals field,
+// or zero if it does not fit in an int
+func ExecutionPayloadDenebGindexWithdrawalsElem(i int) int {
+	return ssz.ConcatGindices(ExecutionPayloadDenebGindexWithdrawals, ssz.ListElemGindex(i, withdrawals, 0))
+}
+
+// ExecutionPayloadDenebGindex returns the generalized index of a field path in the ExecutionPayloadDeneb object
+func ExecutionPayloadDenebGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "parent_hash":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexParentHash, rest, nil)
+	case "fee_recipient":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexFeeRecipient, rest, nil)
+	case "state_root":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexStateRoot, rest, nil)
+	case "receipts_root":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexReceiptsRoot, rest, nil)
+	case "logs_bloom":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexLogsBloom, rest, nil)
+	case "prev_randao":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexPrevRandao, rest, nil)
+	case "block_number":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexBlockNumber, rest, nil)
+	case "gas_limit":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexGasLimit, rest, nil)
+	case "gas_used":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexGasUsed, rest, nil)
+	case "timestamp":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexTimestamp, rest, nil)
+	case "extra_data":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexExtraData, rest, nil)
+	case "base_fee_per_gas":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexBaseFeePerGas, rest, nil)
+	case "block_hash":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexBlockHash, rest, nil)
+	case "transactions":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexTransactions, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1048576, 0, nil)
+		})
+	case "withdrawals":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexWithdrawals, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, withdrawals, 0, WithdrawalGindex)
+		})
+	case "blob_gas_used":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexBlobGasUsed, rest, nil)
+	case "excess_blob_gas":
+		return ssz.FieldGindex(ExecutionPayloadDenebGindexExcessBlobGas, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("ExecutionPayloadDeneb", field)
+}
+
+// ProveField returns a proof of the field path in the ExecutionPayloadDeneb object
+func (e *ExecutionPayloadDeneb) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(e, ExecutionPayloadDenebGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the ExecutionPayloadDeneb object
+func (e *ExecutionPayloadDeneb) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(e, ExecutionPayloadDenebGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the ExecutionPayloadHeaderDeneb object
 func (e *ExecutionPayloadHeaderDeneb) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
@@ -9775,7 +19313,7 @@ func (e *ExecutionPayloadHeaderDeneb) MarshalSSZTo(buf []byte) (dst []byte, err
 	dst = ssz.WriteOffset(dst, offset)
 
 	// Field (11) 'BaseFeePerGas'
-	dst = append(dst, e.BaseFeePerGas[:]...)
+	dst = ssz.MarshalValue(dst, ssz.Uint256(e.BaseFeePerGas))
 
 	// Field (12) 'BlockHash'
 	dst = append(dst, e.BlockHash[:]...)
@@ -9802,6 +19340,127 @@ func (e *ExecutionPayloadHeaderDeneb) MarshalSSZTo(buf []byte) (dst []byte, err
 	return
 }
 
+// MarshalSSZWriter ssz marshals the ExecutionPayloadHeaderDeneb object to a writer
+func (e *ExecutionPayloadHeaderDeneb) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := e.fixedSize()
+
+	// Field (0) 'ParentHash'
+	dst = append(dst, e.ParentHash[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'FeeRecipient'
+	dst = append(dst, e.FeeRecipient[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'StateRoot'
+	dst = append(dst, e.StateRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'ReceiptsRoot'
+	dst = append(dst, e.ReceiptsRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'LogsBloom'
+	dst = append(dst, e.LogsBloom[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'PrevRandao'
+	dst = append(dst, e.PrevRandao[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (6) 'BlockNumber'
+	dst = ssz.MarshalValue(dst, e.BlockNumber)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (7) 'GasLimit'
+	dst = ssz.MarshalValue(dst, e.GasLimit)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (8) 'GasUsed'
+	dst = ssz.MarshalValue(dst, e.GasUsed)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (9) 'Timestamp'
+	dst = ssz.MarshalValue(dst, e.Timestamp)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (10) 'ExtraData'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (11) 'BaseFeePerGas'
+	dst = ssz.MarshalValue(dst, ssz.Uint256(e.BaseFeePerGas))
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (12) 'BlockHash'
+	dst = append(dst, e.BlockHash[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (13) 'TransactionsRoot'
+	dst = append(dst, e.TransactionsRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (14) 'WithdrawalRoot'
+	dst = append(dst, e.WithdrawalRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (15) 'BlobGasUsed'
+	dst = ssz.MarshalValue(dst, e.BlobGasUsed)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (16) 'ExcessBlobGas'
+	dst = ssz.MarshalValue(dst, e.ExcessBlobGas)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (10) 'ExtraData'
+	if size := uint64(len(e.ExtraData)); size > 32 {
+		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeaderDeneb.ExtraData", size, 32)
+		return
+	}
+	dst = append(dst, e.ExtraData...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the ExecutionPayloadHeaderDeneb object
 func (e *ExecutionPayloadHeaderDeneb) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(e, buf)
@@ -9855,7 +19514,11 @@ func (e *ExecutionPayloadHeaderDeneb) UnmarshalSSZTail(buf []byte) (rest []byte,
 	}
 
 	// Field (11) 'BaseFeePerGas'
-	buf = ssz.UnmarshalFixedBytes(e.BaseFeePerGas[:], buf)
+	{
+		var val ssz.Uint256
+		val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+		e.BaseFeePerGas = Uint256(val)
+	}
 
 	// Field (12) 'BlockHash'
 	buf = ssz.UnmarshalFixedBytes(e.BlockHash[:], buf)
@@ -9880,6 +19543,95 @@ func (e *ExecutionPayloadHeaderDeneb) UnmarshalSSZTail(buf []byte) (rest []byte,
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the ExecutionPayloadHeaderDeneb object from a reader with the size of the encoding
+func (e *ExecutionPayloadHeaderDeneb) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := e.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o10 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'ParentHash'
+		buf = ssz.UnmarshalFixedBytes(e.ParentHash[:], buf)
+
+		// Field (1) 'FeeRecipient'
+		buf = ssz.UnmarshalFixedBytes(e.FeeRecipient[:], buf)
+
+		// Field (2) 'StateRoot'
+		buf = ssz.UnmarshalFixedBytes(e.StateRoot[:], buf)
+
+		// Field (3) 'ReceiptsRoot'
+		buf = ssz.UnmarshalFixedBytes(e.ReceiptsRoot[:], buf)
+
+		// Field (4) 'LogsBloom'
+		buf = ssz.UnmarshalFixedBytes(e.LogsBloom[:], buf)
+
+		// Field (5) 'PrevRandao'
+		buf = ssz.UnmarshalFixedBytes(e.PrevRandao[:], buf)
+
+		// Field (6) 'BlockNumber'
+		e.BlockNumber, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (7) 'GasLimit'
+		e.GasLimit, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (8) 'GasUsed'
+		e.GasUsed, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (9) 'Timestamp'
+		e.Timestamp, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (10) 'ExtraData'
+		if o10, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (11) 'BaseFeePerGas'
+		{
+			var val ssz.Uint256
+			val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+			e.BaseFeePerGas = Uint256(val)
+		}
+
+		// Field (12) 'BlockHash'
+		buf = ssz.UnmarshalFixedBytes(e.BlockHash[:], buf)
+
+		// Field (13) 'TransactionsRoot'
+		buf = ssz.UnmarshalFixedBytes(e.TransactionsRoot[:], buf)
+
+		// Field (14) 'WithdrawalRoot'
+		buf = ssz.UnmarshalFixedBytes(e.WithdrawalRoot[:], buf)
+
+		// Field (15) 'BlobGasUsed'
+		e.BlobGasUsed, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (16) 'ExcessBlobGas'
+		e.ExcessBlobGas, buf = ssz.UnmarshallValue[uint64](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (10) 'ExtraData'
+	if uint64(size-int(o10)) > 32 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o10), func(buf []byte) (_ []byte, err error) {
+		if e.ExtraData, err = ssz.UnmarshalDynamicBytes(e.ExtraData, buf, 32); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the ExecutionPayloadHeaderDeneb object
 func (e *ExecutionPayloadHeaderDeneb) fixedSize() int {
 	return int(584)
@@ -9900,6 +19652,11 @@ func (e *ExecutionPayloadHeaderDeneb) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(e)
 }
 
+// HashTreeRootCtx ssz hashes the ExecutionPayloadHeaderDeneb object and stops with the error of the context once it is done
+func (e *ExecutionPayloadHeaderDeneb) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, e)
+}
+
 // HashTreeRootWith ssz hashes the ExecutionPayloadHeaderDeneb object with a hasher
 func (e *ExecutionPayloadHeaderDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -9947,7 +19704,7 @@ func (e *ExecutionPayloadHeaderDeneb) HashTreeRootWith(hh ssz.HashWalker) (err e
 	}
 
 	// Field (11) 'BaseFeePerGas'
-	hh.PutBytes(e.BaseFeePerGas[:])
+	ssz.PutUint256(hh, ssz.Uint256(e.BaseFeePerGas))
 
 	// Field (12) 'BlockHash'
 	hh.PutBytes(e.BlockHash[:])
@@ -9972,3 +19729,84 @@ func (e *ExecutionPayloadHeaderDeneb) HashTreeRootWith(hh ssz.HashWalker) (err e
 func (e *ExecutionPayloadHeaderDeneb) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
+
+// GetTreeCtx ssz hashes the ExecutionPayloadHeaderDeneb object and stops with the error of the context once it is done
+func (e *ExecutionPayloadHeaderDeneb) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, e)
+}
+
+// Generalized indices of the ExecutionPayloadHeaderDeneb fields
+const (
+	ExecutionPayloadHeaderDenebGindexParentHash       = 32
+	ExecutionPayloadHeaderDenebGindexFeeRecipient     = 33
+	ExecutionPayloadHeaderDenebGindexStateRoot        = 34
+	ExecutionPayloadHeaderDenebGindexReceiptsRoot     = 35
+	ExecutionPayloadHeaderDenebGindexLogsBloom        = 36
+	ExecutionPayloadHeaderDenebGindexPrevRandao       = 37
+	ExecutionPayloadHeaderDenebGindexBlockNumber      = 38
+	ExecutionPayloadHeaderDenebGindexGasLimit         = 39
+	ExecutionPayloadHeaderDenebGindexGasUsed          = 40
+	ExecutionPayloadHeaderDenebGindexTimestamp        = 41
+	ExecutionPayloadHeaderDenebGindexExtraData        = 42
+	ExecutionPayloadHeaderDenebGindexBaseFeePerGas    = 43
+	ExecutionPayloadHeaderDenebGindexBlockHash        = 44
+	ExecutionPayloadHeaderDenebGindexTransactionsRoot = 45
+	ExecutionPayloadHeaderDenebGindexWithdrawalRoot   = 46
+	ExecutionPayloadHeaderDenebGindexBlobGasUsed      = 47
+	ExecutionPayloadHeaderDenebGindexExcessBlobGas    = 48
+)
+
+// ExecutionPayloadHeaderDenebGindex returns the generalized index of a field path in the ExecutionPayloadHeaderDeneb object
+func ExecutionPayloadHeaderDenebGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "parent_hash":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexParentHash, rest, nil)
+	case "fee_recipient":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexFeeRecipient, rest, nil)
+	case "state_root":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexStateRoot, rest, nil)
+	case "receipts_root":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexReceiptsRoot, rest, nil)
+	case "logs_bloom":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexLogsBloom, rest, nil)
+	case "prev_randao":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexPrevRandao, rest, nil)
+	case "block_number":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexBlockNumber, rest, nil)
+	case "gas_limit":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexGasLimit, rest, nil)
+	case "gas_used":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexGasUsed, rest, nil)
+	case "timestamp":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexTimestamp, rest, nil)
+	case "extra_data":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexExtraData, rest, nil)
+	case "base_fee_per_gas":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexBaseFeePerGas, rest, nil)
+	case "block_hash":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexBlockHash, rest, nil)
+	case "transactions_root":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexTransactionsRoot, rest, nil)
+	case "withdrawals_root":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexWithdrawalRoot, rest, nil)
+	case "blob_gas_used":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexBlobGasUsed, rest, nil)
+	case "excess_blob_gas":
+		return ssz.FieldGindex(ExecutionPayloadHeaderDenebGindexExcessBlobGas, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("ExecutionPayloadHeaderDeneb", field)
+}
+
+// ProveField returns a proof of the field path in the ExecutionPayloadHeaderDeneb object
+func (e *ExecutionPayloadHeaderDeneb) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(e, ExecutionPayloadHeaderDenebGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the ExecutionPayloadHeaderDeneb object
+func (e *ExecutionPayloadHeaderDeneb) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(e, ExecutionPayloadHeaderDenebGindex, paths...)
+}
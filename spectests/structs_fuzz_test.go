@@ -116,6 +116,22 @@ func TestFuzzEncoding(t *testing.T) {
 			if !deepEqual(obj, obj2) {
 				t.Fatal("bad")
 			}
+
+			var buf bytes.Buffer
+			if err := obj.MarshalSSZWriter(&buf); err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(dst, buf.Bytes()) {
+				t.Fatal("bad writer")
+			}
+
+			obj3 := codec("")
+			if err := obj3.UnmarshalSSZReader(&buf, len(dst)); err != nil {
+				t.Fatal(err)
+			}
+			if !deepEqual(obj, obj3) {
+				t.Fatal("bad reader")
+			}
 		}
 	}
 }
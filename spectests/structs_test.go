@@ -18,7 +18,9 @@ import (
 
 type codec interface {
 	ssz.Marshaler
+	ssz.WriterMarshaler
 	ssz.Unmarshaler
+	ssz.ReaderUnmarshaler
 	ssz.HashRoot
 }
 
@@ -212,6 +214,15 @@ func checkSSZEncoding(t *testing.T, rootObj ssz.Unmarshaler, fork fork, fileName
 		fatal("marshalSSZ_equal", fmt.Errorf("bad marshal"))
 	}
 
+	// Marshal to a writer
+	var buf bytes.Buffer
+	if err := obj.MarshalSSZWriter(&buf); err != nil {
+		fatal("MarshalSSZWriter", err)
+	}
+	if !bytes.Equal(buf.Bytes(), output.ssz) {
+		fatal("MarshalSSZWriter_equal", fmt.Errorf("bad marshal"))
+	}
+
 	// Unmarshal
 	obj2 := base(fork)
 	if err := obj2.UnmarshalSSZ(output.ssz); err != nil {
@@ -221,6 +232,15 @@ func checkSSZEncoding(t *testing.T, rootObj ssz.Unmarshaler, fork fork, fileName
 		fatal("UnmarshalSSZ_equal", fmt.Errorf("bad unmarshal"))
 	}
 
+	// Unmarshal from a reader
+	obj3 := base(fork)
+	if err := obj3.UnmarshalSSZReader(bytes.NewReader(output.ssz), len(output.ssz)); err != nil {
+		fatal("UnmarshalSSZReader", err)
+	}
+	if !deepEqual(obj, obj3) {
+		fatal("UnmarshalSSZReader_equal", fmt.Errorf("bad unmarshal"))
+	}
+
 	// Try to decode on top of rootObj to ensure the fast unmarshalling works
 	if err := rootObj.UnmarshalSSZ(output.ssz); err != nil {
 		fatal("UnmarshalFast", err)
@@ -350,6 +370,18 @@ func BenchmarkProof_Tree(b *testing.B) {
 	}
 }
 
+func BenchmarkProof_ProvingHasher(b *testing.B) {
+	obj := new(BeaconBlock)
+	readValidGenericSSZ(nil, benchmarkTestCase, obj)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		obj.ProveField("body.eth1_data.block_hash")
+	}
+}
+
 const (
 	testsPath      = "../eth2.0-spec-tests/tests"
 	serializedFile = "serialized.ssz_snappy"
@@ -1,8 +1,9 @@
 package spectests
 
 import (
-	"fmt"
 	"math/big"
+
+	ssz "github.com/ferranbt/fastssz"
 )
 
 func (u *Uint256) UnmarshalText(text []byte) error {
@@ -10,27 +11,14 @@ func (u *Uint256) UnmarshalText(text []byte) error {
 	if err := x.UnmarshalText(text); err != nil {
 		return err
 	}
-	if x.BitLen() > 256 {
-		return fmt.Errorf("too big")
+	val, err := ssz.Uint256FromBig(x)
+	if err != nil {
+		return err
 	}
-
-	buf := reverse(x.Bytes())
-	copy(u[:], buf)
+	*u = Uint256(val)
 	return nil
 }
 
 func (u Uint256) MarshalText() (text []byte, err error) {
-	buf := reverse(u[:])
-	x := new(big.Int).SetBytes(buf[:])
-	return []byte(x.String()), nil
-}
-
-func reverse(in []byte) (out []byte) {
-	out = make([]byte, len(in))
-	copy(out[:], in[:])
-
-	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
-		out[i], out[j] = out[j], out[i]
-	}
-	return
+	return ssz.Uint256(u).BigInt().MarshalText()
 }
@@ -0,0 +1,217 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// cacheKind is how the root of a field is cached by a container with a hash cache
+type cacheKind int
+
+const (
+	// the field is walked every time, either because it is a container
+	// with its own cache or because it does not take any hashing
+	cacheNone cacheKind = iota
+	// the root of the field is cached
+	cacheRoot
+	// the merkle tree of the chunks of a list or a vector is cached
+	cacheChunks
+)
+
+func (v *Value) cacheKind() cacheKind {
+	switch obj := v.typ.(type) {
+	case *Bytes:
+		if !obj.IsList && obj.Size.VarSize == "" && obj.Size.Size <= 32 {
+			return cacheNone
+		}
+		return cacheRoot
+
+	case *BitList, *BitVector:
+		return cacheRoot
+
+	case *Vector:
+		switch getElem(v.typ).typ.(type) {
+		case *Uint, *Bytes:
+			return cacheChunks
+		}
+		return cacheNone
+
+	case *List:
+		if obj.IsProgressive {
+			return cacheRoot
+		}
+		return cacheChunks
+	}
+	return cacheNone
+}
+
+// cacheField returns the name of the hash cache field of a container if it has one
+func (v *Value) cacheField() string {
+	if obj, ok := v.typ.(*Container); ok {
+		return obj.Cache
+	}
+	return ""
+}
+
+// resetCache returns the code that marks all the fields of a container as dirty
+func (v *Value) resetCache() string {
+	if cache := v.cacheField(); cache != "" {
+		return fmt.Sprintf("::.%s.Reset()\n\n", cache)
+	}
+	return ""
+}
+
+// hashTreeRootCached hashes the fields of a container with a hash cache
+func (v *Value) hashTreeRootCached() string {
+	obj := v.typ.(*Container)
+
+	out := []string{}
+	for indx, i := range obj.Elems {
+		var str string
+		switch i.cacheKind() {
+		case cacheRoot:
+			tmpl := `if !::.{{.cache}}.PutField(hh, {{.indx}}) {
+				{{.htr}}
+				::.{{.cache}}.SetField(hh, {{.indx}})
+			}`
+			str = execTmpl(tmpl, map[string]interface{}{
+				"cache": obj.Cache,
+				"indx":  indx,
+				"htr":   i.hashTreeRoot("", false),
+			})
+		case cacheChunks:
+			str = i.hashTreeRootChunks(indx, obj.Cache)
+		default:
+			str = i.hashTreeRoot("", false)
+		}
+		out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, str))
+	}
+
+	tmpl := `indx := hh.Index()
+
+	{{.fields}}
+
+	hh.Merkleize(indx)`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"fields": strings.Join(out, "\n"),
+	})
+}
+
+// hashTreeRootChunks hashes a list or a vector field with the cached merkle
+// tree of its chunks. Only the chunks of the dirty elements are appended.
+func (v *Value) hashTreeRootChunks(indx int, cache string) string {
+	if obj, ok := v.typ.(*List); ok && !obj.isBasicList() {
+		tmpl := `{
+			if size := uint64(len(::.{{.name}})); size > {{.num}} {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			if err = ::.{{.cache}}.PutList(hh, {{.indx}}, len(::.{{.name}}), 0, {{.num}}, func(start, end int) (err error) {
+				{{if .putObjects}}return ssz.PutObjects(hh, ::.{{.name}}[start:end]){{else}}for _, elem := range ::.{{.name}}[start:end] {
+					{{.htrCall}}
+				}
+				return{{end}}
+			}); err != nil {
+				return
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":       v.name,
+			"cache":      cache,
+			"indx":       indx,
+			"num":        obj.MaxSize,
+			"htrCall":    obj.hashTreeRootElem(),
+			"putObjects": obj.isObjectList(),
+		})
+	}
+
+	inner, appendCall, elemSize := v.hashRootsElem()
+
+	put, limit, packed := "PutVector", "", elemSize
+	if _, ok := v.typ.(*List); ok {
+		put = "PutList"
+		limit = v.hashRootsLimit(fmt.Sprintf("uint64(len(::.%s))", v.name), elemSize) + ", "
+	}
+	if _, ok := getElem(v.typ).typ.(*Bytes); ok {
+		// each element takes its own chunk
+		packed = 0
+	}
+
+	tmpl := `{
+		{{.outer}}if err = ::.{{.cache}}.{{.put}}(hh, {{.indx}}, len(::.{{.name}}), {{.packed}}, {{.limit}}func(start, end int) (err error) {
+			for _, i := range ::.{{.name}}[start:end] {
+				{{.inner}}{{.appendCall}}
+			}
+			return
+		}); err != nil {
+			return
+		}
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"outer":      v.validate(),
+		"cache":      cache,
+		"put":        put,
+		"indx":       indx,
+		"name":       v.name,
+		"packed":     packed,
+		"limit":      limit,
+		"inner":      inner,
+		"appendCall": appendCall,
+	})
+}
+
+// isBasicList returns true if the list is hashed as a list of basic
+// values or fixed bytes instead of a list of objects
+func (l *List) isBasicList() bool {
+	if !l.Elem.isFixed() {
+		return false
+	}
+	switch l.Elem.typ.(type) {
+	case *Uint, *Bytes:
+		return true
+	}
+	return false
+}
+
+// markDirty creates the methods that mark the cached fields of a container as changed
+func (e *env) markDirty(name string, v *Value) string {
+	obj := v.typ.(*Container)
+
+	methods := []string{}
+	for indx, i := range obj.Elems {
+		var tmpl string
+		switch i.cacheKind() {
+		case cacheRoot:
+			tmpl = `// Mark{{.field}}Dirty marks the {{.field}} field of the {{.name}} object as changed since it was hashed
+			func (:: *{{.name}}) Mark{{.field}}Dirty() {
+				::.{{.cache}}.MarkDirty({{.indx}})
+			}`
+		case cacheChunks:
+			tmpl = `// Mark{{.field}}Dirty marks the {{.field}} field of the {{.name}} object as changed since it was hashed.
+			// If indices are given, only the elements at those indices changed.
+			func (:: *{{.name}}) Mark{{.field}}Dirty(indices ...int) {
+				::.{{.cache}}.MarkDirty({{.indx}}, indices...)
+			}`
+		default:
+			continue
+		}
+		methods = append(methods, execTmpl(tmpl, map[string]interface{}{
+			"name":  name,
+			"field": i.name,
+			"cache": obj.Cache,
+			"indx":  indx,
+		}))
+	}
+
+	tmpl := `// ResetHashCache marks all the fields of the {{.name}} object as changed since it was hashed
+	func (:: *{{.name}}) ResetHashCache() {
+		::.{{.cache}}.Reset()
+	}`
+	methods = append(methods, execTmpl(tmpl, map[string]interface{}{
+		"name":  name,
+		"cache": obj.Cache,
+	}))
+
+	return strings.Join(methods, "\n\n")
+}
@@ -170,6 +170,8 @@ type Value struct {
 	ref string
 	// new determines if the value is a pointer
 	noPtr bool
+	// path is the name of the field in a field path
+	path string
 
 	typ Value2
 }
@@ -334,6 +336,9 @@ func (e *env) print(order []string) (string, bool, error) {
 	package {{.package}}
 
 	import (
+		"context"
+		"io"
+
 		ssz "github.com/ferranbt/fastssz" {{ if .imports }}{{ range $value := .imports }}
 			{{ $value }} {{ end }}
 		{{ end }}
@@ -341,10 +346,13 @@ func (e *env) print(order []string) (string, bool, error) {
 
 	{{ range .objs }}
 		{{ .Marshal }}
+		{{ .MarshalWriter }}
 		{{ .Unmarshal }}
+		{{ .UnmarshalReader }}
 		{{ .Size }}
 		{{ .HashTreeRoot }}
 		{{ .GetTree }}
+		{{ .Gindex }}
 	{{ end }}
 	`
 
@@ -355,7 +363,7 @@ func (e *env) print(order []string) (string, bool, error) {
 	}
 
 	type Obj struct {
-		Size, Marshal, Unmarshal, HashTreeRoot, GetTree string
+		Size, Marshal, MarshalWriter, Unmarshal, UnmarshalReader, HashTreeRoot, GetTree, Gindex string
 	}
 
 	objs := []*Obj{}
@@ -393,11 +401,14 @@ func (e *env) print(order []string) (string, bool, error) {
 		}
 
 		objs = append(objs, &Obj{
-			HashTreeRoot: e.hashTreeRoot(funcSigName, obj),
-			GetTree:      e.getTree(funcSigName, obj),
-			Marshal:      e.marshal(funcSigName, obj),
-			Unmarshal:    e.unmarshal(funcSigName, obj),
-			Size:         e.size(funcSigName, obj),
+			HashTreeRoot:    e.hashTreeRoot(funcSigName, obj),
+			GetTree:         e.getTree(funcSigName, obj),
+			Gindex:          e.gindex(funcSigName, obj),
+			Marshal:         e.marshal(funcSigName, obj),
+			MarshalWriter:   e.marshalWriter(funcSigName, obj),
+			Unmarshal:       e.unmarshal(funcSigName, obj),
+			UnmarshalReader: e.unmarshalReader(funcSigName, obj),
+			Size:            e.size(funcSigName, obj),
 		})
 	}
 	if len(objs) == 0 {
@@ -928,10 +939,15 @@ func (e *env) parseASTStructType(objTypeName string, name string) (*Value, error
 		}
 
 		for _, f := range item.obj.Fields.List {
+			if len(f.Names) == 1 && isHashCache(f.Type) {
+				// the container caches its hash tree root
+				v2.Cache = f.Names[0].Name
+				continue
+			}
 			if len(f.Names) == 1 {
 				// normal type
 				fieldName := f.Names[0].Name
-				if !isValidField(fieldName) {
+				if !isValidField(fieldName) && fieldName != "_" {
 					continue
 				}
 				fields = append(fields, f)
@@ -973,6 +989,18 @@ func (e *env) parseASTStructType(objTypeName string, name string) (*Value, error
 	if err != nil {
 		return nil, err
 	}
+	stableTags, fields, err := stableMarker(fields)
+	if err != nil {
+		return nil, err
+	}
+	if isUnion, err := isUnionStruct(fields); err != nil {
+		return nil, err
+	} else if isUnion {
+		if v2.Cache != "" {
+			return nil, fmt.Errorf("union %s cannot have a hash cache", name)
+		}
+		return e.parseASTUnionType(name, fields)
+	}
 	for _, f := range fields {
 		fieldName := f.Names[0].Name
 
@@ -989,13 +1017,274 @@ func (e *env) parseASTStructType(objTypeName string, name string) (*Value, error
 			continue
 		}
 		elem.name = fieldName
+		elem.path = fieldPath(fieldName, tags)
 		v2.Elems = append(v2.Elems, elem)
 	}
 
+	if stableTags != nil {
+		if v2.Cache != "" {
+			return nil, fmt.Errorf("stable container or profile %s cannot have a hash cache", name)
+		}
+		if v2.Stable, err = e.parseStable(name, stableTags, v2.Elems); err != nil {
+			return nil, err
+		}
+	}
+
 	v.typ = v2
 	return v, nil
 }
 
+// isHashCache returns true if the type of a field is ssz.HashCache
+func isHashCache(typ ast.Expr) bool {
+	sel, ok := typ.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "HashCache" {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && pkg.Name == "ssz"
+}
+
+// stableMarker looks for the '_' marker field of a stable container or a profile:
+//
+//	_ struct{} `ssz:"stable-container" ssz-max:"N"`
+//	_ struct{} `ssz:"profile" ssz-base:"B"`
+//
+// It returns the tags of the marker and the rest of the fields of the struct
+// without any of the blank '_' fields.
+func stableMarker(fields []*ast.Field) (map[string]string, []*ast.Field, error) {
+	var stableTags map[string]string
+	rest := []*ast.Field{}
+	for _, f := range fields {
+		if f.Names[0].Name != "_" {
+			rest = append(rest, f)
+			continue
+		}
+		tags, err := fieldTags(f)
+		if err != nil {
+			return nil, nil, err
+		}
+		if kind := tags["ssz"]; kind == "stable-container" || kind == "profile" {
+			if stableTags != nil {
+				return nil, nil, fmt.Errorf("only one stable container or profile marker is allowed")
+			}
+			stableTags = tags
+		}
+	}
+	return stableTags, rest, nil
+}
+
+// parseStable validates the fields of a stable container or a profile
+// and computes the index of each field in the stable container.
+func (e *env) parseStable(name string, tags map[string]string, elems []*Value) (*Stable, error) {
+	if len(elems) == 0 {
+		return nil, fmt.Errorf("%s does not have any fields", name)
+	}
+
+	if tags["ssz"] == "stable-container" {
+		maxFields, err := strconv.ParseUint(tags["ssz-max"], 10, 64)
+		if err != nil || maxFields == 0 {
+			return nil, fmt.Errorf("stable container %s requires a 'ssz-max' tag with the number of fields", name)
+		}
+		if uint64(len(elems)) > maxFields {
+			return nil, fmt.Errorf("stable container %s has %d fields but only %d are allowed", name, len(elems), maxFields)
+		}
+		stable := &Stable{
+			MaxFields: maxFields,
+			NumFields: len(elems),
+			Indices:   []int{},
+		}
+		for indx, elem := range elems {
+			if _, ok := elem.typ.(*Optional); !ok {
+				return nil, fmt.Errorf("field %s of stable container %s must be optional", elem.name, name)
+			}
+			stable.Indices = append(stable.Indices, indx)
+		}
+		return stable, nil
+	}
+
+	baseName := tags["ssz-base"]
+	if baseName == "" {
+		return nil, fmt.Errorf("profile %s requires a 'ssz-base' tag with the stable container", name)
+	}
+	base, err := e.encodeItem(baseName, "")
+	if err != nil {
+		return nil, err
+	}
+	baseObj, ok := base.typ.(*Container)
+	if !ok || baseObj.Stable == nil || baseObj.Stable.isProfile() {
+		return nil, fmt.Errorf("base %s of profile %s is not a stable container", baseName, name)
+	}
+
+	stable := &Stable{
+		MaxFields: baseObj.Stable.MaxFields,
+		NumFields: baseObj.Stable.NumFields,
+		Indices:   []int{},
+		Base:      baseName,
+	}
+	for _, elem := range elems {
+		indx := -1
+		for i, baseElem := range baseObj.Elems {
+			if baseElem.name == elem.name {
+				indx = i
+				break
+			}
+		}
+		if indx == -1 {
+			return nil, fmt.Errorf("field %s of profile %s is not defined in %s", elem.name, name, baseName)
+		}
+		if len(stable.Indices) != 0 && indx <= stable.Indices[len(stable.Indices)-1] {
+			return nil, fmt.Errorf("fields of profile %s must be in the same order as in %s", name, baseName)
+		}
+		if !isProfileFieldOf(elem, baseObj.Elems[indx]) {
+			return nil, fmt.Errorf("field %s of profile %s does not have the same type as in %s", elem.name, name, baseName)
+		}
+		stable.Indices = append(stable.Indices, indx)
+	}
+	return stable, nil
+}
+
+// isProfileFieldOf returns true if the field of a profile has the
+// same type as the optional field of its stable container
+func isProfileFieldOf(field, base *Value) bool {
+	if obj, ok := field.typ.(*Optional); ok {
+		field = obj.Elem
+	}
+	base = base.typ.(*Optional).Elem
+
+	if field.Type() != base.Type() {
+		return false
+	}
+	if obj, ok := field.typ.(*Uint); ok {
+		return obj.Size == base.typ.(*Uint).Size
+	}
+	return field.obj == base.obj
+}
+
+func fieldTags(f *ast.Field) (map[string]string, error) {
+	if f.Tag == nil {
+		return map[string]string{}, nil
+	}
+	return GetSSZTags(f.Tag.Value)
+}
+
+// isUnionStruct returns true if the struct describes a union. A union struct
+// has a first 'uint8' selector field tagged with 'ssz:"union"'.
+func isUnionStruct(fields []*ast.Field) (bool, error) {
+	for indx, f := range fields {
+		tags, err := fieldTags(f)
+		if err != nil {
+			return false, err
+		}
+		if isUnion, _ := isUnionSelector(tags); !isUnion {
+			continue
+		}
+		if indx != 0 {
+			return false, fmt.Errorf("union selector '%s' must be the first field", f.Names[0].Name)
+		}
+		if ident, ok := f.Type.(*ast.Ident); !ok || ident.Name != "uint8" {
+			return false, fmt.Errorf("union selector '%s' must be of type uint8", f.Names[0].Name)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// parse a Go AST struct that represents a union. The first field is the
+// selector and every other field is one of the options of the union.
+func (e *env) parseASTUnionType(name string, fields []*ast.Field) (*Value, error) {
+	tags, err := fieldTags(fields[0])
+	if err != nil {
+		return nil, err
+	}
+	_, hasNone := isUnionSelector(tags)
+
+	union := &Union{
+		Selector: fields[0].Names[0].Name,
+		HasNone:  hasNone,
+		Options:  []*Value{},
+	}
+	for _, f := range fields[1:] {
+		fieldName := f.Names[0].Name
+
+		var tags string
+		if f.Tag != nil {
+			tags = f.Tag.Value
+		}
+		elem, err := e.parseASTFieldType(fieldName, tags, f.Type)
+		if err != nil {
+			return nil, err
+		}
+		if elem == nil {
+			continue
+		}
+		elem.name = fieldName
+		union.Options = append(union.Options, elem)
+	}
+
+	if len(union.Options) == 0 {
+		return nil, fmt.Errorf("union %s does not have any option besides None", name)
+	}
+	// the selector is encoded as a single byte but only the
+	// values up to 127 are valid (https://github.com/ethereum/consensus-specs/blob/dev/ssz/simple-serialize.md)
+	if union.numSelectors() > 128 {
+		return nil, fmt.Errorf("union %s has more than 128 options", name)
+	}
+	return &Value{name: name, typ: union}, nil
+}
+
+// parse a pointer field tagged with 'ssz:"optional"'. Only pointers to
+// basic types and to containers can be optional values.
+func (e *env) parseASTOptionalType(name string, expr *ast.StarExpr) (*Value, error) {
+	var elem *Value
+	var err error
+
+	if ident, ok := expr.X.(*ast.Ident); ok {
+		switch ident.Name {
+		case "uint64", "uint32", "uint16", "uint8", "bool":
+			elem, err = e.parseASTFieldType(name, "", ident)
+		}
+	}
+	if elem == nil && err == nil {
+		// pointer to a struct
+		elem, err = e.parseASTFieldType(name, "", expr)
+		if err == nil && !elem.isContainer() {
+			if _, ok := elem.typ.(*Reference); !ok {
+				err = fmt.Errorf("optional %s must be a pointer to a basic type or a struct", name)
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Value{name: name, typ: &Optional{Elem: elem}}, nil
+}
+
+// parse a slice field tagged with 'ssz:"progressive"'. Progressive lists do not
+// have a max size, the elements of the list must be fixed or have a known max size.
+func parseProgressiveList(name string, v *Value) (*Value, error) {
+	switch obj := v.typ.(type) {
+	case *Bytes:
+		if obj.IsList {
+			obj.Size = NewSizeVar("ssz.ProgressiveListLimit")
+			obj.IsProgressive = true
+			return v, nil
+		}
+
+	case *List:
+		elem := obj.Elem
+		if _, ok := elem.typ.(*List); ok {
+			break
+		}
+		if bytes, ok := elem.typ.(*Bytes); ok && bytes.IsList {
+			break
+		}
+		obj.MaxSize = NewSizeVar("ssz.ProgressiveListLimit")
+		obj.IsProgressive = true
+		return v, nil
+	}
+	return nil, fmt.Errorf("progressive %s must be a slice with a known element size", name)
+}
+
 // parse the Go AST field
 func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error) {
 	if tag, ok := getTags(tags, "ssz"); ok && tag == "-" {
@@ -1005,7 +1294,26 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 
 	switch obj := expr.(type) {
 	case *ast.StarExpr:
+		if tag, ok := getTags(tags, "ssz"); ok && tag == "optional" {
+			return e.parseASTOptionalType(name, obj)
+		}
+
 		// *Struct
+		switch elem := obj.X.(type) {
+		case *ast.SelectorExpr:
+			if isBigInt(elem) {
+				// *big.Int is a uint256 unless the size is set to 16 (uint128)
+				size, ok := getTagsInt(tags, "ssz-size")
+				if !ok {
+					size = 32
+				}
+				if size != 16 && size != 32 {
+					return nil, fmt.Errorf("big.Int %s must have a size of 16 or 32 bytes", name)
+				}
+				return &Value{typ: &Uint{Size: size, Big: true}}, nil
+			}
+		}
+
 		switch elem := obj.X.(type) {
 		case *ast.Ident:
 			// reference to a local package
@@ -1013,6 +1321,10 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 			if err != nil {
 				return nil, err
 			}
+			if v.isContainer() {
+				// the type might be an alias of a struct that is not a pointer
+				v.noPtr = false
+			}
 			return v, nil
 
 		case *ast.SelectorExpr:
@@ -1101,6 +1413,12 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 			innerTyp = element
 		}
 
+		if innerTyp != nil {
+			if obj, ok := innerTyp.typ.(*Uint); ok && obj.Big {
+				return nil, fmt.Errorf("big.Int is not supported as a list or vector element in %s", name)
+			}
+		}
+
 		if outer.typ == nil {
 			if astSize != nil {
 				outer.typ = &Vector{
@@ -1119,6 +1437,10 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 			return outer, nil
 		}
 
+		if tag, ok := getTags(tags, "ssz"); ok && tag == "progressive" {
+			return parseProgressiveList(name, outer)
+		}
+
 		dims, err := extractSSZDimensions(tags)
 		if err != nil {
 			if err == errDimNotFound && outer.isFixed() {
@@ -1242,6 +1564,13 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 
 		if exprName == "time" && sel == "Time" {
 			return &Value{typ: &Time{}}, nil
+		} else if exprName == "ssz" && sel == "Uint128" {
+			return &Value{typ: &Uint{Size: 16}}, nil
+		} else if exprName == "ssz" && sel == "Uint256" {
+			return &Value{typ: &Uint{Size: 32}}, nil
+		} else if exprName == "uint256" && sel == "Int" {
+			// holiman/uint256 has the same layout as ssz.Uint256
+			return &Value{typ: &Uint{Size: 32}, ref: exprName, obj: sel, noPtr: true}, nil
 		} else if sel == "Bitlist" {
 			// go-bitfield/Bitlist
 			maxSize, ok := getTagsInt(tags, "ssz-max")
@@ -1249,6 +1578,13 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 				return nil, fmt.Errorf("bitlist %s does not have ssz-max tag", name)
 			}
 			return &Value{typ: &BitList{Size: maxSize}}, nil
+		} else if exprName == "ssz" && sel == "Bitvector" {
+			// the size of ssz.Bitvector is the number of bits
+			size, ok := getTagsInt(tags, "ssz-size")
+			if !ok {
+				return nil, fmt.Errorf("bitvector %s does not have ssz-size tag", name)
+			}
+			return &Value{typ: &BitVector{Size: size}}, nil
 		} else if strings.HasPrefix(sel, "Bitvector") {
 			// go-bitfield/Bitvector, fixed bytes
 			dims, err := extractSSZDimensions(tags)
@@ -1278,6 +1614,14 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 	}
 }
 
+// isBigInt returns true if the expression is the 'big.Int' struct
+func isBigInt(expr *ast.SelectorExpr) bool {
+	if ident, ok := expr.X.(*ast.Ident); ok {
+		return ident.Name == "big" && expr.Sel.Name == "Int"
+	}
+	return false
+}
+
 func isExportedField(str string) bool {
 	return str[0] <= 90
 }
@@ -1324,7 +1668,7 @@ func getTags(str string, field string) (string, bool) {
 
 func (v *Value) isFixed() bool {
 	switch obj := v.typ.(type) {
-	case *Uint, *Bool, *Time:
+	case *Uint, *Bool, *Time, *BitVector:
 		return true
 	case *BitList:
 		return false
@@ -1352,6 +1696,12 @@ func (v *Value) isFixed() bool {
 			return true
 		}
 		return false
+	case *Union:
+		// unions are always variable size since the size depends on the selector
+		return false
+	case *Optional:
+		// the value is only encoded if it is present
+		return false
 
 	default:
 		// TypeUndefined should be the only type to fallthrough to this case
@@ -1392,6 +1742,10 @@ func execTmpl(tpl string, input map[string]interface{}) string {
 
 func uintVToName2(v Uint) string {
 	switch v.Size {
+	case 32:
+		return "Uint256"
+	case 16:
+		return "Uint128"
 	case 8:
 		return "Uint64"
 	case 4:
@@ -1407,6 +1761,10 @@ func uintVToName2(v Uint) string {
 
 func uintVToLowerCaseName2(v *Uint) string {
 	switch v.Size {
+	case 32:
+		return "ssz.Uint256"
+	case 16:
+		return "ssz.Uint128"
 	case 8:
 		return "uint64"
 	case 4:
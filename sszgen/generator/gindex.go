@@ -0,0 +1,191 @@
+package generator
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+	"unicode"
+)
+
+// gindex creates the generalized index constants of the fields of a container,
+// the functions that resolve a field path to a generalized index and the
+// methods that prove a field path.
+// The indices follow the same layout as hashTreeRootContainer.
+func (e *env) gindex(name string, v *Value) string {
+	obj, ok := v.typ.(*Container)
+	if !ok || strings.Contains(name, "[") {
+		// unions and generic types do not have a fixed layout
+		return ""
+	}
+
+	tmpl := `{{if .consts}}// Generalized indices of the {{.name}} fields
+	const (
+		{{.consts}}
+	)
+
+	{{end}}{{.elems}}// {{.name}}Gindex returns the generalized index of a field path in the {{.name}} object
+	func {{.name}}Gindex(path string) (int, error) {
+		field, {{if .cases}}rest{{else}}_{{end}}, err := ssz.SplitFieldPath(path)
+		if err != nil {
+			return 0, err
+		}
+		{{if .cases}}switch field {
+		{{.cases}}
+		}
+		{{end}}return 0, ssz.ErrUnknownFieldFn("{{.name}}", field)
+	}`
+
+	consts := []string{}
+	elems := []string{}
+	cases := []string{}
+	for indx, elem := range obj.Elems {
+		constName := name + "Gindex" + elem.name
+		consts = append(consts, fmt.Sprintf("%s = %d", constName, obj.fieldGindex(indx)))
+
+		value := elem
+		if inner, ok := elem.isStableOptional(obj.Stable); ok {
+			value = inner
+		}
+		if fn := value.elemGindex(); fn != "" {
+			tmpl := `// {{.name}} returns the generalized index of the chunk of the i-th element of the {{.field}} field,
+			// or zero if it does not fit in an int
+			func {{.name}}(i int) int {
+				return ssz.ConcatGindices({{.const}}, {{.fn}})
+			}
+
+			`
+			elems = append(elems, execTmpl(tmpl, map[string]interface{}{
+				"name":  constName + "Elem",
+				"field": elem.name,
+				"const": constName,
+				"fn":    fn,
+			}))
+		}
+		cases = append(cases, fmt.Sprintf("case \"%s\":\nreturn ssz.FieldGindex(%s, rest, %s)", elem.path, constName, e.gindexFn(value)))
+	}
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":   name,
+		"consts": strings.Join(consts, "\n"),
+		"elems":  strings.Join(elems, ""),
+		"cases":  strings.Join(cases, "\n"),
+	})
+	return str + "\n\n" + e.proveField(name, v)
+}
+
+// proveField creates the methods that prove the field paths of a container
+func (e *env) proveField(name string, v *Value) string {
+	tmpl := `// ProveField returns a proof of the field path in the {{.name}} object
+	func (:: *{{.name}}) ProveField(path string) (*ssz.Proof, error) {
+		return ssz.ProveField(::, {{.name}}Gindex, path)
+	}
+
+	// ProveFields returns a multiproof of the field paths in the {{.name}} object
+	func (:: *{{.name}}) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+		return ssz.ProveFields(::, {{.name}}Gindex, paths...)
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name": name,
+	})
+	return appendObjSignature(str, v)
+}
+
+// fieldGindex returns the generalized index of the i-th field of the container
+func (c *Container) fieldGindex(i int) uint64 {
+	if c.Stable != nil {
+		// the fields are mixed in with the active fields bitvector
+		return 2*nextPowerOfTwo(c.Stable.MaxFields) + uint64(c.Stable.Indices[i])
+	}
+	return nextPowerOfTwo(uint64(len(c.Elems))) + uint64(i)
+}
+
+// elemGindex returns the expression with the generalized index of the i-th
+// element of a list or a vector relative to its root.
+func (v *Value) elemGindex() string {
+	switch obj := v.typ.(type) {
+	case *List:
+		if obj.IsProgressive {
+			return fmt.Sprintf("ssz.ProgressiveListElemGindex(i, %d)", obj.Elem.packedSize())
+		}
+		return fmt.Sprintf("ssz.ListElemGindex(i, %s, %d)", obj.MaxSize.MarshalTemplate(), obj.Elem.packedSize())
+	case *Vector:
+		return fmt.Sprintf("ssz.VectorElemGindex(i, %s, %d)", obj.Size.MarshalTemplate(), obj.Elem.packedSize())
+	}
+	return ""
+}
+
+// gindexFn returns the expression of a ssz.GindexFn that resolves a path
+// relative to the root of the value, or nil if the value is a leaf. Only the
+// containers generated from the sources of this package can be resolved.
+func (e *env) gindexFn(v *Value) string {
+	tmpl := `func(path string) (int, error) {
+		return %s
+	}`
+
+	switch obj := v.typ.(type) {
+	case *Container:
+		raw, ok := e.getRawItemByName(v.obj)
+		if !ok || raw.obj == nil || raw.isRef || raw.implFunc || len(raw.paramTypes) != 0 || e.excludeTypeNames[v.obj] {
+			return "nil"
+		}
+		return v.obj + "Gindex"
+
+	case *List:
+		if obj.IsProgressive {
+			return fmt.Sprintf(tmpl, fmt.Sprintf("ssz.ProgressiveListGindex(path, %d, %s)", obj.Elem.packedSize(), e.gindexFn(obj.Elem)))
+		}
+		return fmt.Sprintf(tmpl, fmt.Sprintf("ssz.ListGindex(path, %s, %d, %s)", obj.MaxSize.MarshalTemplate(), obj.Elem.packedSize(), e.gindexFn(obj.Elem)))
+
+	case *Vector:
+		return fmt.Sprintf(tmpl, fmt.Sprintf("ssz.VectorGindex(path, %s, %d, %s)", obj.Size.MarshalTemplate(), obj.Elem.packedSize(), e.gindexFn(obj.Elem)))
+
+	case *Optional:
+		fn := e.gindexFn(obj.Elem)
+		if fn == "nil" {
+			return fn
+		}
+		return fmt.Sprintf(tmpl, fmt.Sprintf("ssz.OptionalGindex(path, %s)", fn))
+	}
+	return "nil"
+}
+
+// packedSize returns the size of the value if it is packed with other
+// values in the same chunk, or zero if it has its own chunk.
+func (v *Value) packedSize() uint64 {
+	if obj, ok := v.typ.(*Uint); ok {
+		return obj.Size
+	}
+	return 0
+}
+
+// fieldPath returns the name of a field in a field path. It is the json
+// tag of the field if there is any or the snake case of the field name.
+func fieldPath(name, tags string) string {
+	if tag, ok := getTags(tags, "json"); ok {
+		if tag = strings.Split(tag, ",")[0]; tag != "" && tag != "-" {
+			return tag
+		}
+	}
+
+	runes := []rune(name)
+	res := []rune{}
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				res = append(res, '_')
+			}
+		}
+		res = append(res, unicode.ToLower(r))
+	}
+	return string(res)
+}
+
+func nextPowerOfTwo(v uint64) uint64 {
+	if v <= 1 {
+		return 1
+	}
+	return 1 << bits.Len64(v-1)
+}
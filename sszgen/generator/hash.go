@@ -11,6 +11,11 @@ func (e *env) hashTreeRoot(name string, v *Value) string {
 	func (:: *{{.name}}) HashTreeRoot() ([32]byte, error) {
 		return ssz.HashWithDefaultHasher(::)
 	}
+
+	// HashTreeRootCtx ssz hashes the {{.name}} object and stops with the error of the context once it is done
+	func (:: *{{.name}}) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+		return ssz.HashWithContext(ctx, ::)
+	}
 	
 	// HashTreeRootWith ssz hashes the {{.name}} object with a hasher	
 	func (:: *{{.name}}) HashTreeRootWith(hh ssz.HashWalker) (err error) {
@@ -20,18 +25,85 @@ func (e *env) hashTreeRoot(name string, v *Value) string {
 
 	data := map[string]interface{}{
 		"name":         name,
-		"hashTreeRoot": v.hashTreeRootContainer(true),
+		"hashTreeRoot": "",
+	}
+	if _, ok := v.typ.(*Union); ok {
+		data["hashTreeRoot"] = v.hashTreeRootUnion()
+	} else {
+		data["hashTreeRoot"] = v.hashTreeRootContainer(true)
 	}
 	str := execTmpl(tmpl, data)
+	if v.cacheField() != "" {
+		str += "\n\n" + e.markDirty(name, v)
+	}
 	return appendObjSignature(str, v)
 }
 
 func (v *Value) hashRoots(isList bool) string {
 	innerObj := getElem(v.typ)
+	inner, appendCall, elemSize := v.hashRootsElem()
+
+	var merkleize string
+	if isList {
+		tmpl := `numItems := uint64(len(::.{{.name}}))
+		{{if .isProgressive}}if err = ssz.MerkleizeProgressiveWithMixin(hh, subIndx, numItems); err != nil {
+			return
+		}{{ else }}hh.MerkleizeWithMixin(subIndx, numItems, {{.limit}}){{ end }}`
+
+		merkleize = execTmpl(tmpl, map[string]interface{}{
+			"name":          v.name,
+			"limit":         v.hashRootsLimit("numItems", elemSize),
+			"isProgressive": v.typ.(*List).IsProgressive,
+		})
+
+		// when doing []uint64 we need to round up the Hasher bytes to 32
+		if _, ok := innerObj.typ.(*Uint); ok {
+			merkleize = "hh.FillUpTo32()\n" + merkleize
+		}
+	} else {
+		merkleize = "hh.Merkleize(subIndx)"
+	}
+
+	tmpl := `{
+		{{.outer}}subIndx := hh.Index()
+		for _, i := range ::.{{.name}} {
+			{{.inner}}{{.appendCall}}
+		}
+		{{.merkleize}}
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"outer":      v.validate(),
+		"inner":      inner,
+		"name":       v.name,
+		"appendCall": appendCall,
+		"merkleize":  merkleize,
+	})
+}
+
+// hashRootsLimit returns the expression with the limit of chunks of a list of
+// basic values or bytes with the given number of items
+func (v *Value) hashRootsLimit(numItems string, elemSize uint64) string {
+	obj := v.typ.(*List)
+
+	// the limit for merkleize with mixin depends on the internal type
+	// if the type is basic, the size depends on CalculateLimit
+	// if the type is complex (TypeVector), the limit is the size.
+	// TODO: Generalize a list of complex objects
+	if _, ok := getElem(v.typ).typ.(*Bytes); ok {
+		// TypeVector alias
+		return obj.MaxSize.MarshalTemplate()
+	}
+	return fmt.Sprintf("ssz.CalculateLimit(%s, %s, %d)", obj.MaxSize.MarshalTemplate(), numItems, elemSize)
+}
+
+// hashRootsElem returns the code that appends each element 'i' of a list or a
+// vector of basic values or bytes to the hasher and the size of the elements
+func (v *Value) hashRootsElem() (inner, appendCall string, elemSize uint64) {
+	innerObj := getElem(v.typ)
 
 	subName := "i"
+	appendFn := ""
 
-	inner := ""
 	if obj, ok := innerObj.typ.(*Bytes); ok && (obj.IsGoDyn || obj.IsList) {
 		inner = `if len(i) != %s {
 			err = ssz.ErrBytesLength
@@ -41,9 +113,6 @@ func (v *Value) hashRoots(isList bool) string {
 		inner = fmt.Sprintf(inner, obj.Size.MarshalTemplate())
 	}
 
-	var appendFn string
-	var elemSize uint64
-
 	if obj, ok := innerObj.typ.(*Bytes); ok {
 		if !obj.IsGoDyn {
 			// If we have something like [32]byte we need to call append with [:] to convert it to a slice
@@ -65,55 +134,19 @@ func (v *Value) hashRoots(isList bool) string {
 		elem := *innerObj.typ.(*Uint)
 		appendFn = "Append" + uintVToName2(elem)
 		elemSize = elem.Size
-	}
-
-	var merkleize string
-	if isList {
-		innerListSize := v.typ.(*List).MaxSize
-
-		// the limit for merkleize with mixin depends on the internal type
-		// if the type is basic, the size depends on CalculateLimit
-		// if the type is complex (TypeVector), the limit is the size.
-		// TODO: Generalize a list of complex objects
-		isComplex := false
-		if _, ok := innerObj.typ.(*Bytes); ok {
-			// TypeVector alias
-			isComplex = true
+		if innerObj.ref != "" || innerObj.obj != "" {
+			// alias to uint*
+			subName = fmt.Sprintf("%s(%s)", uintVToLowerCaseName2(&elem), subName)
 		}
-
-		tmpl := `numItems := uint64(len(::.{{.name}}))
-		hh.MerkleizeWithMixin(subIndx, numItems, {{if .isComplex}} {{.listSize}} {{ else }} ssz.CalculateLimit({{.listSize}}, numItems, {{.elemSize}}) {{ end }})`
-
-		merkleize = execTmpl(tmpl, map[string]interface{}{
-			"name":      v.name,
-			"listSize":  innerListSize,
-			"elemSize":  elemSize,
-			"isComplex": isComplex,
-		})
-
-		// when doing []uint64 we need to round up the Hasher bytes to 32
-		if _, ok := innerObj.typ.(*Uint); ok {
-			merkleize = "hh.FillUpTo32()\n" + merkleize
+		if elem.Size > 8 {
+			// the uint128 and uint256 values are appended by an ExtendedHashWalker
+			appendCall = fmt.Sprintf("ssz.%s(hh, %s)", appendFn, subName)
+			return
 		}
-	} else {
-		merkleize = "hh.Merkleize(subIndx)"
 	}
 
-	tmpl := `{
-		{{.outer}}subIndx := hh.Index()
-		for _, i := range ::.{{.name}} {
-			{{.inner}}hh.{{.appendFn}}({{.subName}})
-		}
-		{{.merkleize}}
-	}`
-	return execTmpl(tmpl, map[string]interface{}{
-		"outer":     v.validate(),
-		"inner":     inner,
-		"name":      v.name,
-		"subName":   subName,
-		"appendFn":  appendFn,
-		"merkleize": merkleize,
-	})
+	appendCall = fmt.Sprintf("hh.%s(%s)", appendFn, subName)
+	return
 }
 
 // takes a "name" param so that the variable name can be replaced with a local name
@@ -132,15 +165,32 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 	}
 
 	switch obj := v.typ.(type) {
-	case *Container, *Reference:
+	case *Container, *Reference, *Union:
 		return v.hashTreeRootContainer(false)
 
 	case *Uint:
+		if obj.Big {
+			tmpl := `{
+				var val {{.type}}
+				if val, err = {{.type}}FromBig({{.name}}); err != nil {
+					return
+				}
+				ssz.PutUint{{.bitLen}}(hh, val)
+			}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"name":   name,
+				"type":   uintVToLowerCaseName2(obj),
+				"bitLen": obj.Size * 8,
+			})
+		}
 		if v.ref != "" || v.obj != "" {
 			// alias to uint*
 			name = fmt.Sprintf("%s(%s)", uintVToLowerCaseName2(obj), name)
 		}
 		bitLen := obj.Size * 8
+		if bitLen > 64 {
+			return fmt.Sprintf("ssz.PutUint%d(hh, %s)", bitLen, name)
+		}
 		return fmt.Sprintf("hh.PutUint%d(%s)", bitLen, name)
 
 	case *BitList:
@@ -155,6 +205,9 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 			"size": obj.Size,
 		})
 
+	case *BitVector:
+		return fmt.Sprintf("%shh.PutBytes(%s)", v.validate(), name)
+
 	case *Bool:
 		return fmt.Sprintf("hh.PutBool(%s)", name)
 
@@ -178,31 +231,42 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 				err = ssz.ErrIncorrectListSize
 				return
 			}
-			for _, elem := range {{.name}} {
+			{{if .putObjects}}if err = ssz.PutObjects(hh, {{.name}}); err != nil {
+				return
+			}
+			{{else}}for _, elem := range {{.name}} {
 {{.htrCall}}
 			}
-			hh.MerkleizeWithMixin(subIndx, num, {{.num}})
+			{{end}}{{if .isProgressive}}if err = ssz.MerkleizeProgressiveWithMixin(hh, subIndx, num); err != nil {
+				return
+			}{{ else }}hh.MerkleizeWithMixin(subIndx, num, {{.num}}){{ end }}
 		}`
-		var htrCall string
-		if _, ok := obj.Elem.typ.(*Bytes); ok {
-			eName := "elem"
-			// ByteLists should be represented as Value with TypeBytes and .m set instead of .s (isFixed == true)
-			htrCall = obj.Elem.hashTreeRoot(eName, true)
-		} else {
-			htrCall = execTmpl(`if err = elem.HashTreeRootWith(hh); err != nil {
-	return
-}`,
-				map[string]interface{}{"name": name})
-		}
 		return execTmpl(tmpl, map[string]interface{}{
-			"name":    name,
-			"num":     obj.MaxSize,
-			"htrCall": htrCall,
+			"name":          name,
+			"num":           obj.MaxSize,
+			"htrCall":       obj.hashTreeRootElem(),
+			"putObjects":    obj.isObjectList(),
+			"isProgressive": obj.IsProgressive,
 		})
 
 	case *Time:
 		return fmt.Sprintf("hh.PutUint64(uint64(%s.Unix()))", name)
 
+	case *Optional:
+		tmpl := `{
+			subIndx := hh.Index()
+			if {{.name}} != nil {
+				{{.htrCall}}
+			}
+			if err = ssz.MerkleizeOptional(hh, subIndx, {{.name}} != nil); err != nil {
+				return
+			}
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":    name,
+			"htrCall": v.hashTreeRootOptionalElem(name),
+		})
+
 	case *Bytes:
 		if !obj.IsGoDyn && !obj.IsList {
 			name += "[:]"
@@ -228,12 +292,15 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 		return
     }
 	hh.{{.hashMethod}}({{.name}})
-	hh.MerkleizeWithMixin(elemIndx, byteLen, ({{.maxLen}}+31)/32)
+	{{if .isProgressive}}if err = ssz.MerkleizeProgressiveWithMixin(hh, elemIndx, byteLen); err != nil {
+		return
+	}{{ else }}hh.MerkleizeWithMixin(elemIndx, byteLen, ({{.maxLen}}+31)/32){{ end }}
 }`
 			return execTmpl(tmpl, map[string]interface{}{
-				"hashMethod": hMethod,
-				"name":       name,
-				"maxLen":     obj.Size,
+				"hashMethod":    hMethod,
+				"name":          name,
+				"maxLen":        obj.Size,
+				"isProgressive": obj.IsProgressive,
 			})
 		}
 
@@ -242,6 +309,26 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 	}
 }
 
+// hashTreeRootElem returns the code that hashes each element 'elem' of a list
+// of containers or byte lists
+func (l *List) hashTreeRootElem() string {
+	if _, ok := l.Elem.typ.(*Bytes); ok {
+		eName := "elem"
+		// ByteLists should be represented as Value with TypeBytes and .m set instead of .s (isFixed == true)
+		return l.Elem.hashTreeRoot(eName, true)
+	}
+	return `if err = elem.HashTreeRootWith(hh); err != nil {
+	return
+}`
+}
+
+// isObjectList returns true if the list is made of pointers to containers,
+// which are hashed with ssz.PutObjects
+func (l *List) isObjectList() bool {
+	_, ok := l.Elem.typ.(*Container)
+	return ok && !l.Elem.noPtr
+}
+
 func (v *Value) hashTreeRootContainer(start bool) string {
 	if !start {
 		tmpl := `{{ if .check }}if ::.{{.name}} == nil {
@@ -265,6 +352,12 @@ func (v *Value) hashTreeRootContainer(start bool) string {
 		})
 	}
 
+	if obj := v.typ.(*Container); obj.Stable != nil {
+		return v.hashTreeRootStable()
+	} else if obj.Cache != "" {
+		return v.hashTreeRootCached()
+	}
+
 	out := []string{}
 	for indx, i := range v.getObjs() {
 		// the call to hashTreeRoot below is ugly because it's currently hacked to support ByteLists
@@ -287,3 +380,80 @@ func (v *Value) hashTreeRootContainer(start bool) string {
 		"fields": strings.Join(out, "\n"),
 	})
 }
+
+// hashTreeRootUnion hashes the selected option and mixes in the selector.
+// The None option is hashed as a zero chunk.
+func (v *Value) hashTreeRootUnion() string {
+	obj := v.typ.(*Union)
+
+	cases := []string{}
+	for indx, opt := range obj.Options {
+		cases = append(cases, fmt.Sprintf("case %d:\n%s", obj.selectorOf(indx), opt.hashTreeRoot("", false)))
+	}
+
+	tmpl := `if err = ssz.ValidateUnionSelector(::.{{.selector}}, {{.num}}); err != nil {
+		return
+	}
+	indx := hh.Index()
+
+	switch ::.{{.selector}} {
+	{{.cases}}
+	}
+
+	err = ssz.MerkleizeWithSelector(hh, indx, ::.{{.selector}})`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"selector": obj.Selector,
+		"num":      obj.numSelectors(),
+		"cases":    strings.Join(cases, "\n"),
+	})
+}
+
+// hashTreeRootOptionalElem hashes the value of an optional field that is present
+func (v *Value) hashTreeRootOptionalElem(name string) string {
+	obj := v.typ.(*Optional)
+	if obj.isObject() {
+		return fmt.Sprintf("if err = %s.HashTreeRootWith(hh); err != nil {\nreturn\n}", name)
+	}
+	return obj.Elem.hashTreeRoot("*"+name, false)
+}
+
+// hashTreeRootStable hashes the fields of a stable container or a profile in the
+// position they have in the stable container. The fields that are not present
+// are hashed as zero chunks and the active fields bitvector is mixed in.
+func (v *Value) hashTreeRootStable() string {
+	obj := v.typ.(*Container)
+
+	fields := make([]string, obj.Stable.NumFields)
+	for indx := range fields {
+		fields[indx] = fmt.Sprintf("// Field (%d) is not part of the profile\nssz.PutZeroChunk(hh)\n", indx)
+	}
+	for indx, i := range obj.Elems {
+		var str string
+		if _, ok := i.typ.(*Optional); ok {
+			tmpl := `if ::.{{.name}} != nil {
+				{{.htrCall}}
+			} else {
+				ssz.PutZeroChunk(hh)
+			}`
+			str = execTmpl(tmpl, map[string]interface{}{
+				"name":    i.name,
+				"htrCall": i.hashTreeRootOptionalElem("::." + i.name),
+			})
+		} else {
+			str = i.hashTreeRoot("", false)
+		}
+		fields[obj.Stable.Indices[indx]] = fmt.Sprintf("// Field (%d) '%s'\n%s\n", obj.Stable.Indices[indx], i.name, str)
+	}
+
+	tmpl := `indx := hh.Index()
+
+	{{.fields}}
+
+	err = ssz.MerkleizeStable(hh, indx, ::.activeFields(), {{.maxFields}})`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"fields":    strings.Join(fields, "\n"),
+		"maxFields": obj.Stable.MaxFields,
+	})
+}
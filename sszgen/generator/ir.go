@@ -15,6 +15,7 @@ type Value2 interface {
 
 type Uint struct {
 	Size uint64
+	Big  bool // the uint128/uint256 is represented with a *big.Int
 }
 
 func (u *Uint) isValue() {}
@@ -31,9 +32,10 @@ type Bool struct {
 func (b *Bool) isValue() {}
 
 type Bytes struct {
-	Size    Size
-	IsList  bool
-	IsGoDyn bool // this is a fixed byte array but that is represented as a vector
+	Size          Size
+	IsList        bool
+	IsGoDyn       bool // this is a fixed byte array but that is represented as a vector
+	IsProgressive bool // this is a progressive byte list (EIP-7916)
 }
 
 func (b *Bytes) IsFixed() bool {
@@ -48,6 +50,12 @@ type BitList struct {
 
 func (b *BitList) isValue() {}
 
+type BitVector struct {
+	Size uint64 // number of bits
+}
+
+func (b *BitVector) isValue() {}
+
 type Vector struct {
 	Elem  *Value
 	Size  Size
@@ -57,8 +65,9 @@ type Vector struct {
 func (v *Vector) isValue() {}
 
 type List struct {
-	Elem    *Value
-	MaxSize Size
+	Elem          *Value
+	MaxSize       Size
+	IsProgressive bool // this is a progressive list (EIP-7916)
 }
 
 func (l *List) isValue() {}
@@ -66,10 +75,101 @@ func (l *List) isValue() {}
 type Container struct {
 	ObjName string
 	Elems   []*Value
+	// Stable is set if the container is a StableContainer[N] or a Profile[B]
+	Stable *Stable
+	// Cache is the name of the ssz.HashCache field of the container if it has one
+	Cache string
 }
 
 func (c *Container) isValue() {}
 
+// Stable describes the layout of a StableContainer[N] or a Profile[B] (EIP-7495).
+// The fields of a stable container are all optional and keep the same index in the
+// merkle tree across forks. A profile is a subset of the fields of a stable container.
+type Stable struct {
+	// MaxFields is the capacity N of the stable container
+	MaxFields uint64
+	// NumFields is the number of fields defined in the stable container
+	NumFields int
+	// Indices is the index of each field of the container in the stable container
+	Indices []int
+	// Base is the name of the stable container of a profile
+	Base string
+}
+
+func (s *Stable) isProfile() bool {
+	return s.Base != ""
+}
+
+// stableEncoding returns the stable container info of the value if it is
+// serialized with an active fields bitvector prefix
+func (v *Value) stableEncoding() *Stable {
+	obj, ok := v.typ.(*Container)
+	if !ok || obj.Stable == nil {
+		return nil
+	}
+	for _, elem := range obj.Elems {
+		if _, ok := elem.typ.(*Optional); ok {
+			return obj.Stable
+		}
+	}
+	// a profile without optional fields is serialized as a container
+	return nil
+}
+
+// isStableOptional returns the element of an optional field if the
+// value is encoded inside a stable container or a profile. These fields
+// are encoded as the value itself without the presence byte.
+func (v *Value) isStableOptional(stable *Stable) (*Value, bool) {
+	if stable == nil {
+		return nil, false
+	}
+	obj, ok := v.typ.(*Optional)
+	if !ok {
+		return nil, false
+	}
+	return obj.Elem, true
+}
+
+// isFixedIn returns true if the value is encoded in the fixed part of a container.
+// The optional fields of a stable container are fixed if their value is fixed.
+func (v *Value) isFixedIn(stable *Stable) bool {
+	if elem, ok := v.isStableOptional(stable); ok {
+		return elem.isFixed()
+	}
+	return v.isFixed()
+}
+
+// ifPresent wraps the code so that it only runs if the optional field is present
+func (v *Value) ifPresent(stable *Stable, code string) string {
+	if _, ok := v.isStableOptional(stable); !ok {
+		return code
+	}
+	return fmt.Sprintf("if ::.%s != nil {\n%s\n}", v.name, code)
+}
+
+// hasOffsets returns true if the container encodes any field with an offset
+func (v *Value) hasOffsets() bool {
+	stable := v.stableEncoding()
+	for _, elem := range v.getObjs() {
+		if !elem.isFixedIn(stable) {
+			return true
+		}
+	}
+	return false
+}
+
+// numOptional returns the number of optional fields in the container
+func (v *Value) numOptional() int {
+	num := 0
+	for _, elem := range v.getObjs() {
+		if _, ok := elem.typ.(*Optional); ok {
+			num++
+		}
+	}
+	return num
+}
+
 type Time struct {
 }
 
@@ -81,6 +181,52 @@ type Reference struct {
 
 func (r *Reference) isValue() {}
 
+// Union is a SSZ Union[...] type. The selector is stored in the
+// Selector field of the struct and each of the Options is one of
+// the possible values of the union. If HasNone is set, the selector 0
+// is reserved for the None option and Options[i] has the selector i+1.
+type Union struct {
+	Selector string
+	HasNone  bool
+	Options  []*Value
+}
+
+func (u *Union) isValue() {}
+
+// selectorOf returns the selector of the i-th option of the union
+func (u *Union) selectorOf(i int) int {
+	if u.HasNone {
+		return i + 1
+	}
+	return i
+}
+
+// numSelectors returns the number of valid selectors of the union
+func (u *Union) numSelectors() int {
+	if u.HasNone {
+		return len(u.Options) + 1
+	}
+	return len(u.Options)
+}
+
+// Optional is a SSZ Optional[T] value represented as a pointer field. A nil
+// pointer is the None value.
+type Optional struct {
+	Elem *Value
+}
+
+func (o *Optional) isValue() {}
+
+// isObject returns true if the optional value is a struct
+// with its own ssz methods instead of a basic type
+func (o *Optional) isObject() bool {
+	switch o.Elem.typ.(type) {
+	case *Container, *Reference:
+		return true
+	}
+	return false
+}
+
 func getElem(v Value2) *Value {
 	switch obj := v.(type) {
 	case *List:
@@ -106,6 +252,18 @@ func (v *Value) isContainer() bool {
 	return false
 }
 
+// isListOfDynamic returns true if the value is a list or vector
+// whose elements are encoded with offsets
+func (v *Value) isListOfDynamic() bool {
+	switch obj := v.typ.(type) {
+	case *List:
+		return !obj.Elem.isFixed()
+	case *Vector:
+		return !obj.Elem.isFixed()
+	}
+	return false
+}
+
 func lowerFirst(s string) string {
 	if len(s) == 0 {
 		return s
@@ -125,6 +283,8 @@ func (v *Value) Type() string {
 		return "bytes"
 	case *BitList:
 		return "bitlist"
+	case *BitVector:
+		return "bitvector"
 	case *Vector:
 		return "vector"
 	case *List:
@@ -135,6 +295,10 @@ func (v *Value) Type() string {
 		return "reference"
 	case *Time:
 		return "time"
+	case *Union:
+		return "union"
+	case *Optional:
+		return "optional"
 	default:
 		panic(fmt.Errorf("unknown type %s", reflect.TypeOf(v.typ)))
 	}
@@ -27,7 +27,9 @@ func (e *env) marshal(name string, v *Value) string {
 		"marshal": v.marshalContainer(true),
 		"offset":  "",
 	}
-	if !v.isFixed() {
+	if _, ok := v.typ.(*Union); ok {
+		data["marshal"] = v.marshalUnion()
+	} else if v.hasOffsets() {
 		// offset is the position where the offset starts
 		data["offset"] = "offset := ::.fixedSize()\n"
 	}
@@ -53,6 +55,19 @@ func (v *Value) marshal() string {
 		})
 
 	case *Uint:
+		if obj.Big {
+			tmpl := `{
+				var val {{.type}}
+				if val, err = {{.type}}FromBig(::.{{.name}}); err != nil {
+					return
+				}
+				dst = ssz.MarshalValue(dst, val)
+			}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"name": v.name,
+				"type": uintVToLowerCaseName2(obj),
+			})
+		}
 		var name string
 		if v.ref != "" || v.obj != "" {
 			// alias to uint*
@@ -62,7 +77,7 @@ func (v *Value) marshal() string {
 		}
 		return fmt.Sprintf("dst = ssz.MarshalValue(dst, %s)", name)
 
-	case *BitList:
+	case *BitList, *BitVector:
 		return fmt.Sprintf("%sdst = append(dst, ::.%s...)", v.validate(), v.name)
 
 	case *Time:
@@ -77,9 +92,12 @@ func (v *Value) marshal() string {
 		}
 		return v.marshalList()
 
-	case *Container, *Reference:
+	case *Container, *Reference, *Union:
 		return v.marshalContainer(false)
 
+	case *Optional:
+		return v.marshalOptional()
+
 	default:
 		panic(fmt.Errorf("marshal not implemented for type %s", v.Type()))
 	}
@@ -168,35 +186,113 @@ func (v *Value) marshalContainer(start bool) string {
 
 	out := []string{}
 
+	// stable containers (and profiles with optional fields) are prefixed
+	// with the bitvector of the fields that are present
+	stable := v.stableEncoding()
+	if stable != nil {
+		if stable.isProfile() {
+			out = append(out, "// Optional fields\ndst = append(dst, ::.optionalFields()...)\n")
+		} else {
+			out = append(out, "// Active fields\ndst = append(dst, ::.activeFields()...)\n")
+		}
+	}
+
 	lastVariableIndx := -1
 	for indx, i := range v.getObjs() {
-		if !i.isFixed() {
+		if !i.isFixedIn(stable) {
 			lastVariableIndx = indx
 		}
 	}
 	for indx, i := range v.getObjs() {
 		var str string
-		if i.isFixed() {
+		if i.isFixedIn(stable) {
 			// write the content
-			str = fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshal())
+			str = fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshalIn(stable))
 		} else {
 			// write the offset
-			str = fmt.Sprintf("// Offset (%d) '%s'\ndst = ssz.WriteOffset(dst, offset)\n", indx, i.name)
+			offset := "dst = ssz.WriteOffset(dst, offset)"
 			// Update the offset for the next variable field.
 			// We don't need to update the offset if the current
 			// field is the last variable field in the container.
 			if indx != lastVariableIndx {
-				str += fmt.Sprintf("%s\n", i.size("offset"))
+				if _, ok := i.isStableOptional(stable); ok {
+					offset += fmt.Sprintf("\noffset += ::.%s.SizeSSZ()", i.name)
+				} else {
+					offset += "\n" + i.size("offset")
+				}
 			}
+			str = fmt.Sprintf("// Offset (%d) '%s'\n%s\n", indx, i.name, i.ifPresent(stable, offset))
 		}
 		out = append(out, str)
 	}
 
 	// write the dynamic parts
 	for indx, i := range v.getObjs() {
-		if !i.isFixed() {
-			out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshal()))
+		if !i.isFixedIn(stable) {
+			out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshalIn(stable)))
 		}
 	}
 	return strings.Join(out, "\n")
 }
+
+// marshalIn marshals a field of a container. The optional fields
+// of a stable container are encoded without the presence byte.
+func (v *Value) marshalIn(stable *Stable) string {
+	if _, ok := v.isStableOptional(stable); ok {
+		return v.ifPresent(stable, v.marshalOptionalElem())
+	}
+	return v.marshal()
+}
+
+// marshalUnion encodes the selector byte followed by the selected option
+func (v *Value) marshalUnion() string {
+	obj := v.typ.(*Union)
+
+	// the None option does not encode anything after the selector
+	cases := []string{}
+	for indx, opt := range obj.Options {
+		str := fmt.Sprintf("case %d:\n", obj.selectorOf(indx))
+		if opt.isListOfDynamic() {
+			// the offsets of the list elements are written from
+			// the start of the option
+			str += "var offset int\n"
+		}
+		cases = append(cases, str+opt.marshal())
+	}
+
+	tmpl := `if err = ssz.ValidateUnionSelector(::.{{.selector}}, {{.num}}); err != nil {
+		return
+	}
+	dst = ssz.MarshalValue(dst, ::.{{.selector}})
+
+	switch ::.{{.selector}} {
+	{{.cases}}
+	}`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"selector": obj.Selector,
+		"num":      obj.numSelectors(),
+		"cases":    strings.Join(cases, "\n"),
+	})
+}
+
+// marshalOptional encodes the presence byte followed by the value.
+// The None value does not encode anything.
+func (v *Value) marshalOptional() string {
+	tmpl := `if ::.{{.name}} != nil {
+		dst = append(dst, 1)
+		{{.marshal}}
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":    v.name,
+		"marshal": v.marshalOptionalElem(),
+	})
+}
+
+// marshalOptionalElem marshals the value of an optional field that is present
+func (v *Value) marshalOptionalElem() string {
+	if v.typ.(*Optional).isObject() {
+		return fmt.Sprintf("if dst, err = ::.%s.MarshalSSZTo(dst); err != nil {\nreturn\n}", v.name)
+	}
+	return fmt.Sprintf("dst = ssz.MarshalValue(dst, *::.%s)", v.name)
+}
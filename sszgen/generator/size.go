@@ -12,6 +12,13 @@ import (
 // Note that if any of the internal fields of the struct is nil, we will not fail, only not add up
 // that field to the size. It is up to other methods like marshal to fail on that scenario.
 func (e *env) size(name string, v *Value) string {
+	if _, ok := v.typ.(*Union); ok {
+		return e.sizeUnion(name, v)
+	}
+	if v.stableEncoding() != nil {
+		return e.sizeStable(name, v)
+	}
+
 	tmpl := `// fixedSize returns the fixed size of the {{.name}} object
 	func (:: *{{.name}}) fixedSize() int {
 		return int({{.fixed}})
@@ -24,16 +31,119 @@ func (e *env) size(name string, v *Value) string {
 		{{.dynamic}}
 		{{end}}
 		return
-	}`
+	}{{.activeFields}}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":         name,
+		"fixed":        v.fixedSizeForContainer(),
+		"dynamic":      v.sizeContainer("size", true),
+		"activeFields": v.activeFields(name),
+	})
+	return appendObjSignature(str, v)
+}
+
+// sizeStable creates the size functions of a stable container or a profile with
+// optional fields. The size of the fixed part depends on the fields that are present
+// and it does not include the bitvector prefix.
+func (e *env) sizeStable(name string, v *Value) string {
+	stable := v.stableEncoding()
+
+	fixed := []string{}
+	for indx, i := range v.getObjs() {
+		var str string
+		if elem, ok := i.isStableOptional(stable); ok {
+			if !elem.isFixed() {
+				str = "size += 4"
+			} else if i.typ.(*Optional).isObject() {
+				str = fmt.Sprintf("size += ::.%s.SizeSSZ()", i.name)
+			} else {
+				str = "size += " + elem.fixedSize()
+			}
+		} else {
+			acc := NewSizeAccumulator()
+			i.fixedSizeFieldAcc(acc)
+			str = "size += " + acc.String()
+		}
+		fixed = append(fixed, fmt.Sprintf("// Field (%d) '%s'\n%s", indx, i.name, i.ifPresent(stable, str)))
+	}
+
+	numBits := stable.MaxFields
+	if stable.isProfile() {
+		numBits = uint64(v.numOptional())
+	}
+
+	tmpl := `// fixedSize returns the size of the fixed part of the {{.name}} object
+	func (:: *{{.name}}) fixedSize() (size int) {
+		{{.fixed}}
+
+		return
+	}
+
+	// SizeSSZ returns the ssz encoded size in bytes for the {{.name}} object
+	func (:: *{{.name}}) SizeSSZ() (size int) {
+		size = {{.prefix}} + ::.fixedSize(){{if .dynamic}}
+
+		{{.dynamic}}
+		{{end}}
+		return
+	}{{.activeFields}}`
 
 	str := execTmpl(tmpl, map[string]interface{}{
-		"name":    name,
-		"fixed":   v.fixedSizeForContainer(),
-		"dynamic": v.sizeContainer("size", true),
+		"name":         name,
+		"fixed":        strings.Join(fixed, "\n\n"),
+		"prefix":       (numBits + 7) / 8,
+		"dynamic":      v.sizeContainer("size", true),
+		"activeFields": v.activeFields(name),
 	})
 	return appendObjSignature(str, v)
 }
 
+// activeFields creates the functions that return the active fields bitvector of a
+// stable container or a profile and the bitvector of the optional fields of a profile.
+func (v *Value) activeFields(name string) string {
+	obj := v.typ.(*Container)
+	if obj.Stable == nil {
+		return ""
+	}
+
+	active := []string{}
+	optional := []string{}
+	for indx, i := range obj.Elems {
+		if _, ok := i.typ.(*Optional); ok {
+			active = append(active, fmt.Sprintf("ssz.SetActiveField(activeFields, %d, ::.%s != nil)", obj.Stable.Indices[indx], i.name))
+			if obj.Stable.isProfile() {
+				optional = append(optional, fmt.Sprintf("ssz.SetActiveField(optionalFields, %d, ::.%s != nil)", len(optional), i.name))
+			}
+		} else {
+			active = append(active, fmt.Sprintf("ssz.SetActiveField(activeFields, %d, true)", obj.Stable.Indices[indx]))
+		}
+	}
+
+	tmpl := `
+
+	// activeFields returns the active fields bitvector of the {{.name}} object
+	func (:: *{{.name}}) activeFields() []byte {
+		activeFields := ssz.NewActiveFields({{.maxFields}})
+		{{.active}}
+		return activeFields
+	}{{if .optional}}
+
+	// optionalFields returns the bitvector of the optional fields of the {{.name}} object
+	func (:: *{{.name}}) optionalFields() []byte {
+		optionalFields := ssz.NewActiveFields({{.numOptional}})
+		{{.optional}}
+		return optionalFields
+	}{{end}}`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":        name,
+		"maxFields":   obj.Stable.MaxFields,
+		"active":      strings.Join(active, "\n"),
+		"numOptional": len(optional),
+		"optional":    strings.Join(optional, "\n"),
+	})
+}
+
 func (v *Value) fixedSizeForContainer() string {
 	acc := &SizeAccumulator{
 		Size: 0,
@@ -101,44 +211,49 @@ func (v *Value) fixedSizeForContainerAcc(acc *SizeAccumulator) {
 
 	subAcc := NewSizeAccumulator()
 	for _, f := range v.getObjs() {
-		switch obj := f.typ.(type) {
-		case *Vector:
-			if obj.Elem.isFixed() {
-				vectorAcc := NewSizeAccumulator()
-				obj.Elem.fixedSizeAcc(vectorAcc)
-
-				if obj.Size.Size != 0 {
-					// two cases: fixed size or variable size for the inner element
-					if vectorAcc.IsVariable() {
-						// variable size, accumulate on top of subAcc
-						subAcc.AddVar(fmt.Sprintf("(%d * %s)", obj.Size.Size, vectorAcc.String()))
-					} else {
-						// fixed size, we can precompute all the size
-						subAcc.AddInt(obj.Size.Size * vectorAcc.Size)
-					}
+		f.fixedSizeFieldAcc(subAcc)
+	}
+
+	acc.Merge(subAcc)
+}
+
+// fixedSizeFieldAcc accumulates the size of a field in the fixed part of a container
+func (v *Value) fixedSizeFieldAcc(acc *SizeAccumulator) {
+	switch obj := v.typ.(type) {
+	case *Vector:
+		if obj.Elem.isFixed() {
+			vectorAcc := NewSizeAccumulator()
+			obj.Elem.fixedSizeAcc(vectorAcc)
+
+			if obj.Size.Size != 0 {
+				// two cases: fixed size or variable size for the inner element
+				if vectorAcc.IsVariable() {
+					// variable size, accumulate on top of acc
+					acc.AddVar(fmt.Sprintf("(%d * %s)", obj.Size.Size, vectorAcc.String()))
 				} else {
-					// variable size, it is going to be an arithmetic expression
-					subAcc.AddVar(fmt.Sprintf("(%s * %s)", obj.Size.VarSize, vectorAcc.String()))
+					// fixed size, we can precompute all the size
+					acc.AddInt(obj.Size.Size * vectorAcc.Size)
 				}
 			} else {
-				if obj.Size.Size != 0 {
-					// known size at compilation time. precompute it.
-					subAcc.AddInt(obj.Size.Size * bytesPerLengthOffset)
-				} else {
-					// variable
-					subAcc.AddVar(fmt.Sprintf("(%s * %d)", obj.Size.VarSize, bytesPerLengthOffset))
-				}
+				// variable size, it is going to be an arithmetic expression
+				acc.AddVar(fmt.Sprintf("(%s * %s)", obj.Size.VarSize, vectorAcc.String()))
+			}
+		} else {
+			if obj.Size.Size != 0 {
+				// known size at compilation time. precompute it.
+				acc.AddInt(obj.Size.Size * bytesPerLengthOffset)
+			} else {
+				// variable
+				acc.AddVar(fmt.Sprintf("(%s * %d)", obj.Size.VarSize, bytesPerLengthOffset))
 			}
-
-		case *List:
-			// lists are variable size, so we don't add them to the fixed size
-			subAcc.AddInt(bytesPerLengthOffset)
-		default:
-			f.fixedSizeAcc(subAcc)
 		}
-	}
 
-	acc.Merge(subAcc)
+	case *List:
+		// lists are variable size, so we don't add them to the fixed size
+		acc.AddInt(bytesPerLengthOffset)
+	default:
+		v.fixedSizeAcc(acc)
+	}
 }
 
 func (v *Value) fixedSize() string {
@@ -169,8 +284,10 @@ func (v *Value) fixedSizeAcc(acc *SizeAccumulator) {
 				acc.AddVar(obj.Size.VarSize)
 			}
 		}
-	case *BitList:
+	case *BitList, *Union, *Optional:
 		acc.AddInt(bytesPerLengthOffset)
+	case *BitVector:
+		acc.AddInt((obj.Size + 7) / 8)
 	case *Time:
 		acc.AddInt(8)
 	case *Container:
@@ -205,11 +322,19 @@ func (v *Value) sizeContainer(name string, start bool) string {
 			"check": check,
 		})
 	}
+	stable := v.stableEncoding()
+
 	out := []string{}
 	for indx, v := range v.getObjs() {
-		if !v.isFixed() {
-			out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s", indx, v.name, v.size(name)))
+		if v.isFixedIn(stable) {
+			continue
 		}
+		size := v.size(name)
+		if _, ok := v.isStableOptional(stable); ok {
+			// the optional fields of a stable container do not have a presence byte
+			size = v.ifPresent(stable, fmt.Sprintf("%s += ::.%s.SizeSSZ()", name, v.name))
+		}
+		out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s", indx, v.name, size))
 	}
 	return strings.Join(out, "\n\n")
 }
@@ -225,12 +350,15 @@ func (v *Value) size(name string) string {
 	}
 
 	switch v.typ.(type) {
-	case *Container, *Reference:
+	case *Container, *Reference, *Union:
 		return v.sizeContainer(name, false)
 
 	case *BitList:
 		return fmt.Sprintf(name+" += len(::.%s)", v.name)
 
+	case *Optional:
+		return v.sizeOptional(name)
+
 	case *Bytes:
 		return fmt.Sprintf(name+" += len(::.%s)", v.name)
 
@@ -255,3 +383,53 @@ func (v *Value) size(name string) string {
 		panic(fmt.Errorf("size not implemented for type %s", v.Type()))
 	}
 }
+
+// sizeUnion creates the SizeSSZ function for a union, the size is one
+// byte for the selector plus the size of the selected option.
+func (e *env) sizeUnion(name string, v *Value) string {
+	obj := v.typ.(*Union)
+
+	cases := []string{}
+	for indx, opt := range obj.Options {
+		cases = append(cases, fmt.Sprintf("case %d:\n%s", obj.selectorOf(indx), opt.size("size")))
+	}
+
+	tmpl := `// SizeSSZ returns the ssz encoded size in bytes for the {{.name}} object
+	func (:: *{{.name}}) SizeSSZ() (size int) {
+		size = 1
+
+		switch ::.{{.selector}} {
+		{{.cases}}
+		}
+		return
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":     name,
+		"selector": obj.Selector,
+		"cases":    strings.Join(cases, "\n"),
+	})
+	return appendObjSignature(str, v)
+}
+
+// sizeOptional returns the size of an optional value, one byte
+// for the presence flag plus the size of the value if it is present.
+func (v *Value) sizeOptional(name string) string {
+	obj := v.typ.(*Optional)
+
+	var size string
+	if obj.isObject() {
+		size = fmt.Sprintf("::.%s.SizeSSZ()", v.name)
+	} else {
+		size = obj.Elem.fixedSize()
+	}
+
+	tmpl := `if ::.{{.name}} != nil {
+		{{.dst}} += 1 + {{.size}}
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"name": v.name,
+		"dst":  name,
+		"size": size,
+	})
+}
@@ -0,0 +1,352 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// marshalWriter creates a function that encodes the structs in SSZ format to an io.Writer.
+// The fields are written in order to a ssz.Writer which flushes its buffer once it is full.
+// Unions and stable containers are encoded in the buffer of the writer with MarshalSSZTo.
+func (e *env) marshalWriter(name string, v *Value) string {
+	tmpl := `// MarshalSSZWriter ssz marshals the {{.name}} object to a writer
+	func (:: *{{.name}}) MarshalSSZWriter(writer io.Writer) (err error) {
+		ww := ssz.NewWriter(writer)
+		dst := ww.Buffer()
+		{{.offset}}
+		{{.marshal}}
+		if _, err = ww.Commit(dst); err != nil {
+			return
+		}
+		return ww.Done()
+	}`
+
+	data := map[string]interface{}{
+		"name":    name,
+		"marshal": "if dst, err = ::.MarshalSSZTo(dst); err != nil {\nreturn\n}",
+		"offset":  "",
+	}
+	if v.isContainer() && v.stableEncoding() == nil {
+		if v.hasOffsets() {
+			// offset is the position where the offset starts
+			data["offset"] = "offset := ::.fixedSize()\n"
+		}
+		data["marshal"] = v.marshalWriterContainer()
+	}
+	str := execTmpl(tmpl, data)
+	return appendObjSignature(str, v)
+}
+
+func (v *Value) marshalWriterContainer() string {
+	out := []string{}
+
+	lastVariableIndx := -1
+	for indx, i := range v.getObjs() {
+		if !i.isFixed() {
+			lastVariableIndx = indx
+		}
+	}
+	for indx, i := range v.getObjs() {
+		var str string
+		if i.isFixed() {
+			// write the content
+			str = fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshalWriter())
+		} else {
+			// write the offset from the size of the variable field
+			str = fmt.Sprintf("// Offset (%d) '%s'\ndst = ssz.WriteOffset(dst, offset)\n", indx, i.name)
+			if indx != lastVariableIndx {
+				str += i.size("offset") + "\n"
+			}
+		}
+		out = append(out, str)
+	}
+
+	// write the dynamic parts
+	for indx, i := range v.getObjs() {
+		if !i.isFixed() {
+			out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshalWriter()))
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// marshalWriter marshals a field to the writer. Containers and lists are written
+// element by element, the other values are appended to the buffer of the writer.
+func (v *Value) marshalWriter() string {
+	switch v.typ.(type) {
+	case *Container:
+		tmpl := `{{ if .check }}if ::.{{.name}} == nil {
+			::.{{.name}} = new({{ref .obj}})
+		}
+		{{ end }}if dst, err = ww.WriteObject(dst, {{ if .addr }}&{{ end }}::.{{.name}}); err != nil {
+			return
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name":  v.name,
+			"obj":   v,
+			"check": v.isFixed() && !v.isListElem() && !v.noPtr,
+			"addr":  v.noPtr,
+		})
+
+	case *List, *Vector:
+		return v.marshalWriterList()
+
+	default:
+		return v.commitWriter(v.marshal())
+	}
+}
+
+func (v *Value) marshalWriterList() string {
+	inner := getElem(v.typ)
+	inner.name = v.name + "[ii]"
+
+	// bound check
+	str := v.validate()
+
+	if inner.isFixed() {
+		tmpl := `for ii := 0; ii < len(::.{{.name}}); ii++ {
+			{{.marshal}}
+		}`
+		str += execTmpl(tmpl, map[string]interface{}{
+			"name":    v.name,
+			"marshal": inner.marshalWriter(),
+		})
+		return str
+	}
+
+	// encode a list of dynamic objects:
+	// 1. write offsets for each
+	// 2. write each element
+
+	tmpl := `{
+		offset = 4 * len(::.{{.name}})
+		for ii := 0; ii < len(::.{{.name}}); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			{{.size}}
+		}
+	}
+	for ii := 0; ii < len(::.{{.name}}); ii++ {
+		{{.marshal}}
+	}`
+
+	str += execTmpl(tmpl, map[string]interface{}{
+		"name":    v.name,
+		"size":    inner.size("offset"),
+		"marshal": inner.marshalWriter(),
+	})
+	return str
+}
+
+// commitWriter commits the buffer to the writer after the value is encoded
+func (v *Value) commitWriter(marshal string) string {
+	return marshal + "\nif dst, err = ww.Commit(dst); err != nil {\nreturn\n}"
+}
+
+// unmarshalReader creates a function that decodes the structs in SSZ format from an io.Reader.
+// The fixed part is read first and the offsets are validated before the dynamic fields are
+// read one by one. The ssz-max limits are checked before a dynamic field is read.
+// Unions and stable containers are decoded from a buffer with UnmarshalSSZ.
+func (e *env) unmarshalReader(name string, v *Value) string {
+	tmpl := `// UnmarshalSSZReader ssz unmarshals the {{.name}} object from a reader with the size of the encoding
+	func (:: *{{.name}}) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+		rr := ssz.NewReader(reader)
+		{{.unmarshal}}
+	}`
+
+	data := map[string]interface{}{
+		"name":      name,
+		"unmarshal": "return rr.Decode(size, func(buf []byte) ([]byte, error) {\nreturn nil, ::.UnmarshalSSZ(buf)\n})",
+	}
+	if v.isContainer() && v.stableEncoding() == nil {
+		data["unmarshal"] = v.resetCache() + v.unmarshalReaderContainer()
+	}
+	str := execTmpl(tmpl, data)
+	return appendObjSignature(str, v)
+}
+
+func (v *Value) unmarshalReaderContainer() string {
+	var offsets []string
+	for indx, i := range v.getObjs() {
+		if !i.isFixed() {
+			offsets = append(offsets, "o"+strconv.Itoa(indx))
+		}
+	}
+
+	// the fixed part is decoded from a buffer
+	fixed := []string{}
+	for indx, i := range v.getObjs() {
+		if i.isFixed() {
+			fixed = append(fixed, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.unmarshal("buf")))
+		} else {
+			tmpl := `// Offset ({{.indx}}) '{{.name}}'
+			if o{{.indx}}, buf, err = marker.ReadOffset(buf); err != nil {
+				return nil, err
+			}`
+			fixed = append(fixed, execTmpl(tmpl, map[string]interface{}{
+				"indx": indx,
+				"name": i.name,
+			}))
+		}
+	}
+
+	// the dynamic parts are read with the size from the offsets
+	dynamic := []string{}
+	c := 0
+	for indx, i := range v.getObjs() {
+		if i.isFixed() {
+			continue
+		}
+		var size string
+		if c == len(offsets)-1 {
+			size = fmt.Sprintf("size-int(%s)", offsets[c])
+		} else {
+			size = fmt.Sprintf("int(%s-%s)", offsets[c+1], offsets[c])
+		}
+		dynamic = append(dynamic, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.unmarshalReader(size)))
+		c++
+	}
+
+	cmp := "<"
+	if v.isFixed() {
+		cmp = "!="
+	}
+
+	tmpl := `fixedSize := ::.fixedSize()
+	if size {{.cmp}} fixedSize {
+		return ssz.ErrSize
+	}
+	{{if .offsets}}
+		var {{.offsets}} uint64
+		marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+	{{end}}
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		{{.fixed}}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	{{.dynamic}}
+	return`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"cmp":     cmp,
+		"offsets": strings.Join(offsets, ", "),
+		"fixed":   strings.Join(fixed, "\n"),
+		"dynamic": strings.Join(dynamic, "\n"),
+	})
+}
+
+// unmarshalReader reads a dynamic field of the given size. Objects and lists of
+// objects are read element by element, the other values are decoded from a buffer.
+func (v *Value) unmarshalReader(size string) string {
+	switch obj := v.typ.(type) {
+	case *Container, *Reference, *Union:
+		tmpl := `if err = {{if .ptr}}ssz.ReadField(rr, &::.{{.name}}, {{.size}}){{else}}rr.ReadObject(&::.{{.name}}, {{.size}}){{end}}; err != nil {
+			return
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+			"size": size,
+			"ptr":  !v.noPtr,
+		})
+
+	case *List:
+		if str := v.unmarshalReaderList(size, obj.MaxSize); str != "" {
+			return str
+		}
+
+	case *Vector:
+		if str := v.unmarshalReaderList(size, obj.Size); str != "" {
+			return str
+		}
+	}
+
+	str := ""
+	if max := v.maxEncodingSize(); max != "" {
+		// check the limit before the value is read
+		sizeU64 := "uint64(" + size + ")"
+		if strings.HasPrefix(size, "int(") {
+			// the size of a field is the difference of two uint64 offsets
+			sizeU64 = strings.TrimPrefix(strings.TrimSuffix(size, ")"), "int(")
+		}
+		str = fmt.Sprintf("if %s > %s {\nreturn ssz.ErrListTooBig\n}\n", sizeU64, max)
+	}
+
+	tmpl := `if err = rr.Decode({{.size}}, func(buf []byte) (_ []byte, err error) {
+		{{.unmarshal}}
+		return buf, nil
+	}); err != nil {
+		return
+	}`
+	return str + execTmpl(tmpl, map[string]interface{}{
+		"size":      size,
+		"unmarshal": v.unmarshal("buf"),
+	})
+}
+
+// unmarshalReaderList reads the lists of objects and the lists of dynamic elements. It returns an
+// empty string for the lists of fixed basic values that are decoded from a buffer.
+func (v *Value) unmarshalReaderList(size string, max Size) string {
+	inner := getElem(v.typ)
+
+	_, isUnion := inner.typ.(*Union)
+	if (inner.isContainer() || isUnion) && !inner.noPtr {
+		fn := "ReadDynamicSliceSSZ"
+		if inner.isFixed() {
+			fn = "ReadSliceSSZ"
+		}
+		return fmt.Sprintf("if err = ssz.%s(rr, &::.%s, %s, %s); err != nil {\nreturn\n}", fn, v.name, size, max.MarshalTemplate())
+	}
+	if inner.isFixed() {
+		return ""
+	}
+
+	// read the offsets and then each element
+	inner.name = v.name + "[ii]"
+
+	tmpl := `{
+		var sizes []int
+		if sizes, err = rr.ReadOffsets({{.size}}, {{.max}}); err != nil {
+			return
+		}
+		num := len(sizes)
+		{{.create}}
+		for ii, size := range sizes {
+			{{.unmarshal}}
+		}
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"size":      size,
+		"max":       max,
+		"create":    v.createSlice(true),
+		"unmarshal": inner.unmarshalReader("size"),
+	})
+}
+
+// maxEncodingSize returns the maximum size of the encoding of a dynamic
+// value from its ssz-max tag or an empty string if it is not bounded.
+func (v *Value) maxEncodingSize() string {
+	switch obj := v.typ.(type) {
+	case *Bytes:
+		if obj.IsList {
+			return obj.Size.MarshalTemplate()
+		}
+	case *BitList:
+		return fmt.Sprintf("%d", obj.Size/8+1)
+	case *List:
+		inner := getElem(v.typ)
+		if inner.isFixed() {
+			elemSize, err := strconv.ParseUint(inner.fixedSize(), 10, 64)
+			if err == nil && obj.MaxSize.VarSize == "" {
+				return fmt.Sprintf("%d", obj.MaxSize.Size*elemSize)
+			}
+			if strings.Contains(inner.fixedSize(), " ") {
+				return fmt.Sprintf("%s*(%s)", obj.MaxSize.MarshalTemplate(), inner.fixedSize())
+			}
+			return fmt.Sprintf("%s*%s", obj.MaxSize.MarshalTemplate(), inner.fixedSize())
+		}
+	}
+	return ""
+}
@@ -93,6 +93,26 @@ func isBitList(tags map[string]string) bool {
 	return false
 }
 
+// handle tag structured like 'ssz:"union"' or 'ssz:"union,none"' on the
+// selector field of a union struct. The second value reports whether the
+// union has a None option with selector 0.
+func isUnionSelector(tags map[string]string) (bool, bool) {
+	v, ok := tags["ssz"]
+	if !ok {
+		return false, false
+	}
+	var isUnion, hasNone bool
+	for _, p := range strings.Split(v, ",") {
+		switch p {
+		case "union":
+			isUnion = true
+		case "none":
+			hasNone = true
+		}
+	}
+	return isUnion, isUnion && hasNone
+}
+
 var errDimNotFound = fmt.Errorf("no ssz-size or ssz-max tags found for element")
 
 func extractSSZDimensions(tag string) ([]*SSZDimension, error) {
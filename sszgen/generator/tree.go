@@ -5,6 +5,11 @@ func (e *env) getTree(name string, v *Value) string {
 	tmpl := `// GetTree ssz hashes the {{.name}} object
 	func (:: *{{.name}}) GetTree() (*ssz.Node, error) {
 		return ssz.ProofTree(::)
+	}
+
+	// GetTreeCtx ssz hashes the {{.name}} object and stops with the error of the context once it is done
+	func (:: *{{.name}}) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+		return ssz.ProofTreeWithContext(ctx, ::)
 	}`
 
 	data := map[string]interface{}{
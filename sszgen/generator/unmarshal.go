@@ -15,20 +15,27 @@ func (e *env) unmarshal(name string, v *Value) string {
 	
 	// UnmarshalSSZTail unmarshals the {{.name}} object and returns the remaining bufferº
 	func (:: *{{.name}}) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
-		{{.unmarshal}}
+		{{.reset}}{{.unmarshal}}
 	}`
 
-	str := execTmpl(tmpl, map[string]interface{}{
+	data := map[string]interface{}{
 		"name":      name,
-		"unmarshal": v.umarshalContainer(true, "buf"),
-	})
+		"reset":     v.resetCache(),
+		"unmarshal": "",
+	}
+	if _, ok := v.typ.(*Union); ok {
+		data["unmarshal"] = v.unmarshalUnion()
+	} else {
+		data["unmarshal"] = v.umarshalContainer(true, "buf")
+	}
+	str := execTmpl(tmpl, data)
 
 	return appendObjSignature(str, v)
 }
 
 func (v *Value) unmarshal(dst string) string {
 	switch obj := v.typ.(type) {
-	case *Container, *Reference:
+	case *Container, *Reference, *Union:
 		return v.umarshalContainer(false, dst)
 
 	case *Bytes:
@@ -66,6 +73,17 @@ func (v *Value) unmarshal(dst string) string {
 			"size": obj.Size,
 		})
 
+	case *BitVector:
+		tmpl := `if ::.{{.name}}, err = ssz.UnmarshalBitVector(::.{{.name}}, buf[:{{.size}}], {{.bits}}); err != nil {
+			return nil, err
+		}
+		buf = buf[{{.size}}:]`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+			"size": v.fixedSize(),
+			"bits": obj.Size,
+		})
+
 	case *Uint:
 		intType := uintVToLowerCaseName2(obj)
 
@@ -77,7 +95,13 @@ func (v *Value) unmarshal(dst string) string {
 		}
 
 		var tmpl string
-		if objRef != "" {
+		if obj.Big {
+			tmpl = `{
+				var val {{.type}}
+				val, buf = ssz.UnmarshallValue[{{.type}}](buf)
+				::.{{.name}} = val.BigInt()
+			}`
+		} else if objRef != "" {
 			tmpl = `{
 				var val {{.type}}
 				val, buf = ssz.UnmarshallValue[{{.type}}](buf)
@@ -127,6 +151,24 @@ func (v *Value) unmarshal(dst string) string {
 	case *List:
 		return v.unmarshalList(dst)
 
+	case *Optional:
+		// This is always a dynamic element type so we do not need to consume buffer
+		var tmpl string
+		if obj.isObject() {
+			tmpl = `if err = ssz.UnmarshalOptionalField(&::.{{.name}}, {{.dst}}); err != nil {
+				return
+			}`
+		} else {
+			tmpl = `if err = ssz.UnmarshalOptionalValue(&::.{{.name}}, {{.dst}}, {{.size}}); err != nil {
+				return
+			}`
+		}
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+			"dst":  dst,
+			"size": obj.Elem.fixedSize(),
+		})
+
 	default:
 		panic(fmt.Errorf("unmarshal not implemented for type %s", v.Type()))
 	}
@@ -186,7 +228,8 @@ func (v *Value) unmarshalList(dst string) string {
 
 	var tmpl string
 
-	if inner.isContainer() && !inner.noPtr {
+	_, isUnion := inner.typ.(*Union)
+	if (inner.isContainer() || isUnion) && !inner.noPtr {
 		tmpl = `if err = ssz.UnmarshalDynamicSliceSSZ(&::.{{.name}}, {{.dst}}, {{.max}}); err != nil {
 			return nil, err
 		}`
@@ -244,11 +287,16 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 		})
 	}
 
+	stable := v.stableEncoding()
+	if stable != nil {
+		str += v.unmarshalActiveFields()
+	}
+
 	var offsets []string
 	offsetsMatch := map[string]string{}
 
 	for indx, i := range v.getObjs() {
-		if !i.isFixed() {
+		if !i.isFixedIn(stable) {
 			name := "o" + strconv.Itoa(indx)
 			if len(offsets) != 0 {
 				offsetsMatch[name] = offsets[len(offsets)-1]
@@ -298,8 +346,8 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 	outs := []string{}
 	for indx, i := range v.getObjs() {
 		var res string
-		if i.isFixed() {
-			res = fmt.Sprintf("// Field (%d) '%s'\n%s\n\n", indx, i.name, i.unmarshal("buf"))
+		if i.isFixedIn(stable) {
+			res = fmt.Sprintf("// Field (%d) '%s'\n%s\n\n", indx, i.name, i.unmarshalIn(stable, "buf"))
 
 		} else {
 			// read the offset
@@ -326,21 +374,37 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 			// used anymore and the lint complains about it
 			data["isLastOffset"] = indx == len(v.getObjs())-1
 
-			tmpl := `// Offset ({{.indx}}) '{{.name}}'
-			if {{.offset}}, {{if .isLastOffset}}_ {{else}}buf {{end}}, err = marker.ReadOffset(buf); err != nil {
+			tmpl := `if {{.offset}}, {{if .isLastOffset}}_ {{else}}buf {{end}}, err = marker.ReadOffset(buf); err != nil {
 				return nil, err
 			}`
-			res = execTmpl(tmpl, data)
+			res = fmt.Sprintf("// Offset (%d) '%s'\n%s", indx, i.name, i.ifPresent(stable, execTmpl(tmpl, data)))
 		}
 		outs = append(outs, res)
 	}
 
+	if stable != nil {
+		// the fields that are not present do not have an offset, use the offset of
+		// the next field that is present so that the dynamic parts are bounded
+		var fill []string
+		next := "uint64(size)"
+		for c := len(offsets) - 1; c >= 0; c-- {
+			i := v.getObjs()[offsetIndx(offsets[c])]
+			if _, ok := i.isStableOptional(stable); ok {
+				fill = append(fill, fmt.Sprintf("if ::.%s == nil {\n%s = %s\n}", i.name, offsets[c], next))
+			}
+			next = offsets[c]
+		}
+		if len(fill) != 0 {
+			outs = append(outs, "// Fields that are not present\n"+strings.Join(fill, "\n"))
+		}
+	}
+
 	// Marshal the dynamic parts
 
 	c := 0
 
 	for indx, i := range v.getObjs() {
-		if !i.isFixed() {
+		if !i.isFixedIn(stable) {
 			from := offsets[c]
 			var to string
 			if c == len(offsets)-1 {
@@ -358,7 +422,7 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 				"name":      i.name,
 				"from":      from,
 				"to":        to,
-				"unmarshal": i.unmarshal(dst),
+				"unmarshal": i.unmarshalIn(stable, dst),
 			})
 			outs = append(outs, res)
 			c++
@@ -368,6 +432,10 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 	str += strings.Join(outs, "\n\n")
 
 	if len(offsets) != 0 {
+		if stable != nil && v.numOptional() == len(v.getObjs()) {
+			// none of the dynamic fields might be present
+			str += "\nif !marker.HasOffset {\nreturn buf, nil\n}"
+		}
 		// it is a dynamic element, we received the
 		// str += fmt.Sprintf("\nreturn tail[%s:], nil", offsets[len(offsets)-1])
 		str += "\nreturn"
@@ -402,10 +470,14 @@ func (v *Value) createSlice(useNumVariable bool) string {
 	inner := getElem(v.typ)
 	switch obj := inner.typ.(type) {
 	case *Uint:
+		if !isVectorCreate {
+			// [n]int arrays do not need to be created
+			return ""
+		}
 		// []int uses the Extend functions in the fastssz package
 		return fmt.Sprintf("::.%s = ssz.Extend(::.%s, %s)", v.name, v.name, size)
 
-	case *Container:
+	case *Container, *Union:
 		// []*(ref.)Struct{}
 		ptr := "*"
 		if inner.noPtr {
@@ -434,3 +506,117 @@ func (v *Value) createSlice(useNumVariable bool) string {
 		panic(fmt.Sprintf("create not implemented for %s type %s", v.name, inner.Type()))
 	}
 }
+
+// unmarshalUnion decodes the selector byte and the selected option which
+// takes the rest of the buffer.
+func (v *Value) unmarshalUnion() string {
+	obj := v.typ.(*Union)
+
+	cases := []string{}
+	for indx, opt := range obj.Options {
+		str := fmt.Sprintf("case %d:\n", obj.selectorOf(indx))
+
+		switch opt.typ.(type) {
+		case *Container, *Reference, *Union:
+			// containers validate the size of the buffer by themselves
+			str += opt.unmarshal("buf")
+		default:
+			if opt.isFixed() {
+				str += fmt.Sprintf("if len(buf) < int(%s) {\nreturn nil, ssz.ErrSize\n}\n", opt.fixedSize())
+				str += opt.unmarshal("buf")
+			} else {
+				// dynamic options consume the rest of the buffer
+				str += opt.unmarshal("buf") + "\nbuf = nil"
+			}
+		}
+		cases = append(cases, str)
+	}
+
+	tmpl := `if ::.{{.selector}}, buf, err = ssz.UnmarshalUnionSelector(buf, {{.num}}); err != nil {
+		return
+	}
+
+	switch ::.{{.selector}} {
+	{{.cases}}
+	}
+	return buf, nil`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"selector": obj.Selector,
+		"num":      obj.numSelectors(),
+		"cases":    strings.Join(cases, "\n"),
+	})
+}
+
+func offsetIndx(offset string) int {
+	indx, err := strconv.Atoi(strings.TrimPrefix(offset, "o"))
+	if err != nil {
+		panic(fmt.Errorf("BUG: invalid offset variable %s", offset))
+	}
+	return indx
+}
+
+// unmarshalActiveFields decodes the bitvector prefix of a stable container or a profile
+// and allocates the optional fields that are present.
+func (v *Value) unmarshalActiveFields() string {
+	stable := v.stableEncoding()
+
+	name := "activeFields"
+	numBits := stable.MaxFields
+	numFields := uint64(stable.NumFields)
+	if stable.isProfile() {
+		name = "optionalFields"
+		numBits = uint64(v.numOptional())
+		numFields = numBits
+	}
+
+	fields := []string{}
+	for indx, i := range v.getObjs() {
+		if _, ok := i.typ.(*Optional); !ok {
+			continue
+		}
+		bit := stable.Indices[indx]
+		if stable.isProfile() {
+			bit = len(fields)
+		}
+		fields = append(fields, fmt.Sprintf("ssz.SetOptional(&::.%s, ssz.IsActiveField(%s, %d))", i.name, name, bit))
+	}
+
+	tmpl := `var {{.name}} []byte
+	if {{.name}}, buf, err = ssz.UnmarshalActiveFields(buf, {{.numBits}}, {{.numFields}}); err != nil {
+		return nil, err
+	}
+	{{.fields}}
+
+	`
+	return execTmpl(tmpl, map[string]interface{}{
+		"name":      name,
+		"numBits":   numBits,
+		"numFields": numFields,
+		"fields":    strings.Join(fields, "\n"),
+	})
+}
+
+// unmarshalIn unmarshals a field of a container. The optional fields of a
+// stable container are decoded without the presence byte and are already allocated.
+func (v *Value) unmarshalIn(stable *Stable, dst string) string {
+	elem, ok := v.isStableOptional(stable)
+	if !ok {
+		return v.unmarshal(dst)
+	}
+
+	var str string
+	switch obj := elem.typ.(type) {
+	case *Uint:
+		str = fmt.Sprintf("*::.%s, buf = ssz.UnmarshallValue[%s](buf)", v.name, uintVToLowerCaseName2(obj))
+	case *Bool:
+		str = fmt.Sprintf("if err = ssz.IsValidBool(buf); err != nil {\nreturn\n}\n*::.%s, buf = ssz.UnmarshallValue[bool](buf)", v.name)
+	default:
+		if elem.isFixed() {
+			str = fmt.Sprintf("if buf, err = ::.%s.UnmarshalSSZTail(buf); err != nil {\nreturn\n}", v.name)
+		} else {
+			str = fmt.Sprintf("if err = ::.%s.UnmarshalSSZ(%s); err != nil {\nreturn\n}", v.name, dst)
+		}
+	}
+	return v.ifPresent(stable, str)
+}
@@ -42,6 +42,15 @@ func (v *Value) validate() string {
 	switch obj := v.typ.(type) {
 	case *BitList:
 		return validateBitListArray(v.name, NewSizeNum(obj.Size), false)
+	case *BitVector:
+		tmpl := `if err = ssz.ValidateBitvector(::.{{.name}}, {{.size}}); err != nil {
+			return
+		}
+		`
+		return execTmpl(tmpl, map[string]interface{}{
+			"name": v.name,
+			"size": obj.Size,
+		})
 	case *Bytes:
 		if obj.IsList {
 			// for lists of bytes, we need to validate the size of the buffer
@@ -0,0 +1,24 @@
+package testcases
+
+import (
+	"math/big"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/ferranbt/fastssz/sszgen/testcases/uint256"
+)
+
+//go:generate go run ../main.go --path bigint.go
+
+type BigUint ssz.Uint256
+
+type BigUints struct {
+	A ssz.Uint128
+	B ssz.Uint256
+	C uint256.Int
+	D *big.Int
+	E *big.Int       `ssz-size:"16"`
+	F []ssz.Uint128  `ssz-max:"5"`
+	G []uint256.Int  `ssz-max:"4"`
+	H [2]ssz.Uint256 `ssz-size:"2"`
+	I BigUint
+}
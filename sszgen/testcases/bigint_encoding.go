@@ -0,0 +1,569 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 2379ab5ccc9ea16f07ad46c9f5803341196ab5aa3c9b86558790bd7e5273993e
+// Version: 2.0.0
+package testcases
+
+import (
+	"context"
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/ferranbt/fastssz/sszgen/testcases/uint256"
+)
+
+// MarshalSSZ ssz marshals the BigUints object
+func (b *BigUints) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BigUints object to a target array
+func (b *BigUints) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := b.fixedSize()
+
+	// Field (0) 'A'
+	dst = ssz.MarshalValue(dst, b.A)
+
+	// Field (1) 'B'
+	dst = ssz.MarshalValue(dst, b.B)
+
+	// Field (2) 'C'
+	dst = ssz.MarshalValue(dst, ssz.Uint256(b.C))
+
+	// Field (3) 'D'
+	{
+		var val ssz.Uint256
+		if val, err = ssz.Uint256FromBig(b.D); err != nil {
+			return
+		}
+		dst = ssz.MarshalValue(dst, val)
+	}
+
+	// Field (4) 'E'
+	{
+		var val ssz.Uint128
+		if val, err = ssz.Uint128FromBig(b.E); err != nil {
+			return
+		}
+		dst = ssz.MarshalValue(dst, val)
+	}
+
+	// Offset (5) 'F'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.F) * 16
+
+	// Offset (6) 'G'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (7) 'H'
+	for ii := uint64(0); ii < 2; ii++ {
+		dst = ssz.MarshalValue(dst, b.H[ii])
+	}
+
+	// Field (8) 'I'
+	dst = ssz.MarshalValue(dst, ssz.Uint256(b.I))
+
+	// Field (5) 'F'
+	if size := uint64(len(b.F)); size > 5 {
+		err = ssz.ErrListTooBigFn("BigUints.F", size, 5)
+		return
+	}
+	for ii := 0; ii < len(b.F); ii++ {
+		dst = ssz.MarshalValue(dst, b.F[ii])
+	}
+
+	// Field (6) 'G'
+	if size := uint64(len(b.G)); size > 4 {
+		err = ssz.ErrListTooBigFn("BigUints.G", size, 4)
+		return
+	}
+	for ii := 0; ii < len(b.G); ii++ {
+		dst = ssz.MarshalValue(dst, ssz.Uint256(b.G[ii]))
+	}
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the BigUints object to a writer
+func (b *BigUints) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := b.fixedSize()
+
+	// Field (0) 'A'
+	dst = ssz.MarshalValue(dst, b.A)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'B'
+	dst = ssz.MarshalValue(dst, b.B)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'C'
+	dst = ssz.MarshalValue(dst, ssz.Uint256(b.C))
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'D'
+	{
+		var val ssz.Uint256
+		if val, err = ssz.Uint256FromBig(b.D); err != nil {
+			return
+		}
+		dst = ssz.MarshalValue(dst, val)
+	}
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'E'
+	{
+		var val ssz.Uint128
+		if val, err = ssz.Uint128FromBig(b.E); err != nil {
+			return
+		}
+		dst = ssz.MarshalValue(dst, val)
+	}
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (5) 'F'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.F) * 16
+
+	// Offset (6) 'G'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (7) 'H'
+	for ii := 0; ii < len(b.H); ii++ {
+		dst = ssz.MarshalValue(dst, b.H[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (8) 'I'
+	dst = ssz.MarshalValue(dst, ssz.Uint256(b.I))
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'F'
+	if size := uint64(len(b.F)); size > 5 {
+		err = ssz.ErrListTooBigFn("BigUints.F", size, 5)
+		return
+	}
+	for ii := 0; ii < len(b.F); ii++ {
+		dst = ssz.MarshalValue(dst, b.F[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'G'
+	if size := uint64(len(b.G)); size > 4 {
+		err = ssz.ErrListTooBigFn("BigUints.G", size, 4)
+		return
+	}
+	for ii := 0; ii < len(b.G); ii++ {
+		dst = ssz.MarshalValue(dst, ssz.Uint256(b.G[ii]))
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the BigUints object
+func (b *BigUints) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(b, buf)
+}
+
+// UnmarshalSSZTail unmarshals the BigUints object and returns the remaining bufferº
+func (b *BigUints) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o5, o6 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'A'
+	b.A, buf = ssz.UnmarshallValue[ssz.Uint128](buf)
+
+	// Field (1) 'B'
+	b.B, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+
+	// Field (2) 'C'
+	{
+		var val ssz.Uint256
+		val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+		b.C = uint256.Int(val)
+	}
+
+	// Field (3) 'D'
+	{
+		var val ssz.Uint256
+		val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+		b.D = val.BigInt()
+	}
+
+	// Field (4) 'E'
+	{
+		var val ssz.Uint128
+		val, buf = ssz.UnmarshallValue[ssz.Uint128](buf)
+		b.E = val.BigInt()
+	}
+
+	// Offset (5) 'F'
+	if o5, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (6) 'G'
+	if o6, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (7) 'H'
+
+	for ii := uint64(0); ii < 2; ii++ {
+		b.H[ii], buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+	}
+
+	// Field (8) 'I'
+	{
+		var val ssz.Uint256
+		val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+		b.I = BigUint(val)
+	}
+
+	// Field (5) 'F'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.F, tail[o5:o6], 16, 5, func(ii uint64, buf []byte) (err error) {
+		b.F[ii], buf = ssz.UnmarshallValue[ssz.Uint128](buf)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Field (6) 'G'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&b.G, tail[o6:], 32, 4, func(ii uint64, buf []byte) (err error) {
+		{
+			var val ssz.Uint256
+			val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+			b.G[ii] = uint256.Int(val)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the BigUints object from a reader with the size of the encoding
+func (b *BigUints) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o5, o6 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'A'
+		b.A, buf = ssz.UnmarshallValue[ssz.Uint128](buf)
+
+		// Field (1) 'B'
+		b.B, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+
+		// Field (2) 'C'
+		{
+			var val ssz.Uint256
+			val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+			b.C = uint256.Int(val)
+		}
+
+		// Field (3) 'D'
+		{
+			var val ssz.Uint256
+			val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+			b.D = val.BigInt()
+		}
+
+		// Field (4) 'E'
+		{
+			var val ssz.Uint128
+			val, buf = ssz.UnmarshallValue[ssz.Uint128](buf)
+			b.E = val.BigInt()
+		}
+
+		// Offset (5) 'F'
+		if o5, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (6) 'G'
+		if o6, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (7) 'H'
+
+		for ii := uint64(0); ii < 2; ii++ {
+			b.H[ii], buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+		}
+
+		// Field (8) 'I'
+		{
+			var val ssz.Uint256
+			val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+			b.I = BigUint(val)
+		}
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (5) 'F'
+	if o6-o5 > 80 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o6-o5), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&b.F, buf, 16, 5, func(ii uint64, buf []byte) (err error) {
+			b.F[ii], buf = ssz.UnmarshallValue[ssz.Uint128](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (6) 'G'
+	if uint64(size-int(o6)) > 128 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o6), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&b.G, buf, 32, 4, func(ii uint64, buf []byte) (err error) {
+			{
+				var val ssz.Uint256
+				val, buf = ssz.UnmarshallValue[ssz.Uint256](buf)
+				b.G[ii] = uint256.Int(val)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the BigUints object
+func (b *BigUints) fixedSize() int {
+	return int(232)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BigUints object
+func (b *BigUints) SizeSSZ() (size int) {
+	size = b.fixedSize()
+
+	// Field (5) 'F'
+	size += len(b.F) * 16
+
+	// Field (6) 'G'
+	size += len(b.G) * 32
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BigUints object
+func (b *BigUints) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootCtx ssz hashes the BigUints object and stops with the error of the context once it is done
+func (b *BigUints) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
+}
+
+// HashTreeRootWith ssz hashes the BigUints object with a hasher
+func (b *BigUints) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	ssz.PutUint128(hh, b.A)
+
+	// Field (1) 'B'
+	ssz.PutUint256(hh, b.B)
+
+	// Field (2) 'C'
+	ssz.PutUint256(hh, ssz.Uint256(b.C))
+
+	// Field (3) 'D'
+	{
+		var val ssz.Uint256
+		if val, err = ssz.Uint256FromBig(b.D); err != nil {
+			return
+		}
+		ssz.PutUint256(hh, val)
+	}
+
+	// Field (4) 'E'
+	{
+		var val ssz.Uint128
+		if val, err = ssz.Uint128FromBig(b.E); err != nil {
+			return
+		}
+		ssz.PutUint128(hh, val)
+	}
+
+	// Field (5) 'F'
+	{
+		if size := uint64(len(b.F)); size > 5 {
+			err = ssz.ErrListTooBigFn("BigUints.F", size, 5)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.F {
+			ssz.AppendUint128(hh, i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.F))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(5, numItems, 16))
+	}
+
+	// Field (6) 'G'
+	{
+		if size := uint64(len(b.G)); size > 4 {
+			err = ssz.ErrListTooBigFn("BigUints.G", size, 4)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.G {
+			ssz.AppendUint256(hh, ssz.Uint256(i))
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(b.G))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(4, numItems, 32))
+	}
+
+	// Field (7) 'H'
+	{
+		subIndx := hh.Index()
+		for _, i := range b.H {
+			ssz.AppendUint256(hh, i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (8) 'I'
+	ssz.PutUint256(hh, ssz.Uint256(b.I))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BigUints object
+func (b *BigUints) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// GetTreeCtx ssz hashes the BigUints object and stops with the error of the context once it is done
+func (b *BigUints) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
+}
+
+// Generalized indices of the BigUints fields
+const (
+	BigUintsGindexA = 16
+	BigUintsGindexB = 17
+	BigUintsGindexC = 18
+	BigUintsGindexD = 19
+	BigUintsGindexE = 20
+	BigUintsGindexF = 21
+	BigUintsGindexG = 22
+	BigUintsGindexH = 23
+	BigUintsGindexI = 24
+)
+
+// BigUintsGindexFElem returns the generalized index of the chunk of the i-th element of the F field,
+// or zero if it does not fit in an int
+func BigUintsGindexFElem(i int) int {
+	return ssz.ConcatGindices(BigUintsGindexF, ssz.ListElemGindex(i, 5, 16))
+}
+
+// BigUintsGindexGElem returns the generalized index of the chunk of the i-th element of the G field,
+// or zero if it does not fit in an int
+func BigUintsGindexGElem(i int) int {
+	return ssz.ConcatGindices(BigUintsGindexG, ssz.ListElemGindex(i, 4, 32))
+}
+
+// BigUintsGindexHElem returns the generalized index of the chunk of the i-th element of the H field,
+// or zero if it does not fit in an int
+func BigUintsGindexHElem(i int) int {
+	return ssz.ConcatGindices(BigUintsGindexH, ssz.VectorElemGindex(i, 2, 32))
+}
+
+// BigUintsGindex returns the generalized index of a field path in the BigUints object
+func BigUintsGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(BigUintsGindexA, rest, nil)
+	case "b":
+		return ssz.FieldGindex(BigUintsGindexB, rest, nil)
+	case "c":
+		return ssz.FieldGindex(BigUintsGindexC, rest, nil)
+	case "d":
+		return ssz.FieldGindex(BigUintsGindexD, rest, nil)
+	case "e":
+		return ssz.FieldGindex(BigUintsGindexE, rest, nil)
+	case "f":
+		return ssz.FieldGindex(BigUintsGindexF, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 5, 16, nil)
+		})
+	case "g":
+		return ssz.FieldGindex(BigUintsGindexG, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 4, 32, nil)
+		})
+	case "h":
+		return ssz.FieldGindex(BigUintsGindexH, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, 2, 32, nil)
+		})
+	case "i":
+		return ssz.FieldGindex(BigUintsGindexI, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("BigUints", field)
+}
+
+// ProveField returns a proof of the field path in the BigUints object
+func (b *BigUints) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BigUintsGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the BigUints object
+func (b *BigUints) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BigUintsGindex, paths...)
+}
@@ -0,0 +1,93 @@
+package testcases
+
+import (
+	"math/big"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/ferranbt/fastssz/sszgen/testcases/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBigUints_RoundTrip(t *testing.T) {
+	// 2^200 + 1
+	num := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 200), big.NewInt(1))
+
+	b := &BigUints{
+		A: ssz.Uint128{1, 2},
+		B: ssz.Uint256{1, 2, 3, 4},
+		C: uint256.Int{5, 6, 7, 8},
+		D: num,
+		E: big.NewInt(10),
+		F: []ssz.Uint128{{1, 0}, {2, 0}, {3, 0}},
+		G: []uint256.Int{{1, 0, 0, 1}},
+		H: [2]ssz.Uint256{{1}, {2}},
+		I: BigUint{9},
+	}
+
+	buf, err := b.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, buf, b.SizeSSZ())
+
+	b2 := &BigUints{}
+	require.NoError(t, b2.UnmarshalSSZ(buf))
+	require.Equal(t, b, b2)
+
+	root, err := b.HashTreeRoot()
+	require.NoError(t, err)
+
+	// the proof tree has the same root
+	tree, err := b.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, root[:], tree.Hash())
+}
+
+func TestBigUints_HashTreeRoot(t *testing.T) {
+	b := &BigUints{
+		A: ssz.Uint128{1, 2},
+		D: big.NewInt(0x0102),
+		F: []ssz.Uint128{{1, 0}, {2, 0}, {3, 0}},
+	}
+
+	tree, err := b.GetTree()
+	require.NoError(t, err)
+
+	// 9 fields padded to 16 leaves
+	field := func(indx int) []byte {
+		node, err := tree.Get(16 + indx)
+		require.NoError(t, err)
+		return node.Hash()
+	}
+
+	// uint128 is a little endian chunk
+	leaf := make([]byte, 32)
+	leaf[0], leaf[8] = 1, 2
+	require.Equal(t, leaf, field(0))
+
+	leaf = make([]byte, 32)
+	leaf[0], leaf[1] = 0x02, 0x01
+	require.Equal(t, leaf, field(3))
+
+	// two uint128 values are packed in a chunk and the limit is 3 chunks
+	chunks := make([]byte, 64)
+	chunks[0], chunks[16], chunks[32] = 1, 2, 3
+	expected := mixInLength(merkleize(chunks, 4), 3)
+	require.Equal(t, expected[:], field(5))
+}
+
+func TestBigUints_Overflow(t *testing.T) {
+	// the value does not fit in 128 bits
+	b := &BigUints{E: new(big.Int).Lsh(big.NewInt(1), 128)}
+
+	_, err := b.MarshalSSZ()
+	require.ErrorIs(t, err, ssz.ErrUintOverflow)
+
+	_, err = b.HashTreeRoot()
+	require.ErrorIs(t, err, ssz.ErrUintOverflow)
+
+	// negative values are not valid
+	b = &BigUints{D: big.NewInt(-1)}
+
+	_, err = b.MarshalSSZ()
+	require.ErrorIs(t, err, ssz.ErrUintOverflow)
+}
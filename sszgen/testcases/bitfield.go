@@ -0,0 +1,12 @@
+package testcases
+
+import ssz "github.com/ferranbt/fastssz"
+
+//go:generate go run ../main.go --path bitfield.go
+
+type Bitfields struct {
+	A ssz.Bitlist   `ssz-max:"10"`
+	B ssz.Bitvector `ssz-size:"4"`
+	C ssz.Bitvector `ssz-size:"300"`
+	D uint64
+}
@@ -0,0 +1,297 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: c681dd7008ffa876611fc1a4fc013769ffcc567e9b3410a5c664a764fd96d0ea
+// Version: 2.0.0
+package testcases
+
+import (
+	"context"
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the Bitfields object
+func (b *Bitfields) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitfields object to a target array
+func (b *Bitfields) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := b.fixedSize()
+
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if err = ssz.ValidateBitvector(b.B, 4); err != nil {
+		return
+	}
+	dst = append(dst, b.B...)
+
+	// Field (2) 'C'
+	if err = ssz.ValidateBitvector(b.C, 300); err != nil {
+		return
+	}
+	dst = append(dst, b.C...)
+
+	// Field (3) 'D'
+	dst = ssz.MarshalValue(dst, b.D)
+
+	// Field (0) 'A'
+	if size := ssz.BitlistLen(b.A); size > 10 {
+		err = ssz.ErrBytesLengthFn("Bitfields.A", size, 10)
+		return
+	}
+	dst = append(dst, b.A...)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the Bitfields object to a writer
+func (b *Bitfields) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := b.fixedSize()
+
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if err = ssz.ValidateBitvector(b.B, 4); err != nil {
+		return
+	}
+	dst = append(dst, b.B...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'C'
+	if err = ssz.ValidateBitvector(b.C, 300); err != nil {
+		return
+	}
+	dst = append(dst, b.C...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'D'
+	dst = ssz.MarshalValue(dst, b.D)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (0) 'A'
+	if size := ssz.BitlistLen(b.A); size > 10 {
+		err = ssz.ErrBytesLengthFn("Bitfields.A", size, 10)
+		return
+	}
+	dst = append(dst, b.A...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the Bitfields object
+func (b *Bitfields) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(b, buf)
+}
+
+// UnmarshalSSZTail unmarshals the Bitfields object and returns the remaining bufferº
+func (b *Bitfields) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Offset (0) 'A'
+	if o0, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'B'
+	if b.B, err = ssz.UnmarshalBitVector(b.B, buf[:1], 4); err != nil {
+		return nil, err
+	}
+	buf = buf[1:]
+
+	// Field (2) 'C'
+	if b.C, err = ssz.UnmarshalBitVector(b.C, buf[:38], 300); err != nil {
+		return nil, err
+	}
+	buf = buf[38:]
+
+	// Field (3) 'D'
+	b.D, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (0) 'A'
+	if b.A, err = ssz.UnmarshalBitList(b.A, tail[o0:], 10); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the Bitfields object from a reader with the size of the encoding
+func (b *Bitfields) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'A'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (1) 'B'
+		if b.B, err = ssz.UnmarshalBitVector(b.B, buf[:1], 4); err != nil {
+			return nil, err
+		}
+		buf = buf[1:]
+
+		// Field (2) 'C'
+		if b.C, err = ssz.UnmarshalBitVector(b.C, buf[:38], 300); err != nil {
+			return nil, err
+		}
+		buf = buf[38:]
+
+		// Field (3) 'D'
+		b.D, buf = ssz.UnmarshallValue[uint64](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'A'
+	if uint64(size-int(o0)) > 2 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o0), func(buf []byte) (_ []byte, err error) {
+		if b.A, err = ssz.UnmarshalBitList(b.A, buf, 10); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the Bitfields object
+func (b *Bitfields) fixedSize() int {
+	return int(51)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bitfields object
+func (b *Bitfields) SizeSSZ() (size int) {
+	size = b.fixedSize()
+
+	// Field (0) 'A'
+	size += len(b.A)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Bitfields object
+func (b *Bitfields) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootCtx ssz hashes the Bitfields object and stops with the error of the context once it is done
+func (b *Bitfields) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
+}
+
+// HashTreeRootWith ssz hashes the Bitfields object with a hasher
+func (b *Bitfields) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	if len(b.A) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.A, 10)
+
+	// Field (1) 'B'
+	if err = ssz.ValidateBitvector(b.B, 4); err != nil {
+		return
+	}
+	hh.PutBytes(b.B)
+
+	// Field (2) 'C'
+	if err = ssz.ValidateBitvector(b.C, 300); err != nil {
+		return
+	}
+	hh.PutBytes(b.C)
+
+	// Field (3) 'D'
+	hh.PutUint64(b.D)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bitfields object
+func (b *Bitfields) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// GetTreeCtx ssz hashes the Bitfields object and stops with the error of the context once it is done
+func (b *Bitfields) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
+}
+
+// Generalized indices of the Bitfields fields
+const (
+	BitfieldsGindexA = 4
+	BitfieldsGindexB = 5
+	BitfieldsGindexC = 6
+	BitfieldsGindexD = 7
+)
+
+// BitfieldsGindex returns the generalized index of a field path in the Bitfields object
+func BitfieldsGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(BitfieldsGindexA, rest, nil)
+	case "b":
+		return ssz.FieldGindex(BitfieldsGindexB, rest, nil)
+	case "c":
+		return ssz.FieldGindex(BitfieldsGindexC, rest, nil)
+	case "d":
+		return ssz.FieldGindex(BitfieldsGindexD, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Bitfields", field)
+}
+
+// ProveField returns a proof of the field path in the Bitfields object
+func (b *Bitfields) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BitfieldsGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Bitfields object
+func (b *Bitfields) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BitfieldsGindex, paths...)
+}
@@ -0,0 +1,75 @@
+package testcases
+
+import (
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitfields_RoundTrip(t *testing.T) {
+	b := &Bitfields{
+		A: ssz.NewBitlist(10),
+		B: ssz.NewBitvector(4),
+		C: ssz.NewBitvector(300),
+		D: 1,
+	}
+	b.A.SetBitAt(3, true)
+	b.B.SetBitAt(3, true)
+	b.C.SetBitAt(299, true)
+
+	buf, err := b.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, buf, b.SizeSSZ())
+
+	b2 := &Bitfields{}
+	require.NoError(t, b2.UnmarshalSSZ(buf))
+	require.Equal(t, b, b2)
+
+	root, err := b.HashTreeRoot()
+	require.NoError(t, err)
+
+	// the proof tree has the same root
+	tree, err := b.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, root[:], tree.Hash())
+
+	// Bitvector[300] is packed in two chunks
+	var chunks [64]byte
+	copy(chunks[:], b.C)
+	expected := merkleize(chunks[:], 2)
+
+	node, err := tree.Get(6)
+	require.NoError(t, err)
+	require.Equal(t, expected[:], node.Hash())
+}
+
+func TestBitfields_BitLength(t *testing.T) {
+	b := &Bitfields{
+		A: ssz.NewBitlist(10),
+		B: ssz.NewBitvector(4),
+		C: ssz.NewBitvector(300),
+	}
+
+	// the bits after the length of the bitvector must be zero
+	b.B.SetBitAt(4, true)
+	_, err := b.MarshalSSZ()
+	require.Error(t, err)
+
+	_, err = b.HashTreeRoot()
+	require.Error(t, err)
+
+	// the bitlist can not have more bits than the limit
+	b.B = ssz.NewBitvector(4)
+	b.A = ssz.NewBitlist(11)
+	_, err = b.MarshalSSZ()
+	require.Error(t, err)
+
+	b.A = ssz.NewBitlist(10)
+	buf, err := b.MarshalSSZ()
+	require.NoError(t, err)
+
+	// decode an invalid padding in 'B'
+	buf[4] = 0x10
+	require.Error(t, b.UnmarshalSSZ(buf))
+}
@@ -0,0 +1,34 @@
+package testcases
+
+import ssz "github.com/ferranbt/fastssz"
+
+//go:generate go run ../main.go --path cache.go
+
+type CacheState struct {
+	Slot       uint64
+	Root       [32]byte
+	Extra      []byte            `ssz-max:"256"`
+	Bits       []byte            `ssz:"bitlist" ssz-max:"2048"`
+	BlockRoots [][32]byte        `ssz-size:"64,32"`
+	Roots      [][]byte          `ssz-max:"128" ssz-size:"?,32"`
+	Validators []*CacheValidator `ssz-max:"1099511627776"`
+	Balances   []uint64          `ssz-max:"1099511627776"`
+	Slashings  []uint64          `ssz-size:"16"`
+	Header     *CacheHeader
+	Headers    []*CacheHeader `ssz-max:"16"`
+
+	cache ssz.HashCache
+}
+
+type CacheValidator struct {
+	Pubkey           [48]byte `ssz-size:"48"`
+	EffectiveBalance uint64
+	Slashed          bool
+
+	cache ssz.HashCache
+}
+
+type CacheHeader struct {
+	Slot      uint64
+	StateRoot [32]byte
+}
@@ -0,0 +1,1251 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: d991a53cce24cd29633bc4662dfbcd10ba53e0bfa8f0998a43c57ad127a8aa5d
+// Version: 2.0.0
+package testcases
+
+import (
+	"context"
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the CacheState object
+func (c *CacheState) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CacheState object to a target array
+func (c *CacheState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := c.fixedSize()
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, c.Slot)
+
+	// Field (1) 'Root'
+	dst = append(dst, c.Root[:]...)
+
+	// Offset (2) 'Extra'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Extra)
+
+	// Offset (3) 'Bits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Bits)
+
+	// Field (4) 'BlockRoots'
+	if size := uint64(len(c.BlockRoots)); size != 64 {
+		err = ssz.ErrVectorLengthFn("CacheState.BlockRoots", size, 64)
+		return
+	}
+	for ii := uint64(0); ii < 64; ii++ {
+		dst = append(dst, c.BlockRoots[ii][:]...)
+	}
+
+	// Offset (5) 'Roots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Roots) * 32
+
+	// Offset (6) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Validators) * 57
+
+	// Offset (7) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Balances) * 8
+
+	// Field (8) 'Slashings'
+	if size := uint64(len(c.Slashings)); size != 16 {
+		err = ssz.ErrVectorLengthFn("CacheState.Slashings", size, 16)
+		return
+	}
+	for ii := uint64(0); ii < 16; ii++ {
+		dst = ssz.MarshalValue(dst, c.Slashings[ii])
+	}
+
+	// Field (9) 'Header'
+	if c.Header == nil {
+		c.Header = new(CacheHeader)
+	}
+	if dst, err = c.Header.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (10) 'Headers'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'Extra'
+	if size := uint64(len(c.Extra)); size > 256 {
+		err = ssz.ErrBytesLengthFn("CacheState.Extra", size, 256)
+		return
+	}
+	dst = append(dst, c.Extra...)
+
+	// Field (3) 'Bits'
+	if size := ssz.BitlistLen(c.Bits); size > 2048 {
+		err = ssz.ErrBytesLengthFn("CacheState.Bits", size, 2048)
+		return
+	}
+	dst = append(dst, c.Bits...)
+
+	// Field (5) 'Roots'
+	if size := uint64(len(c.Roots)); size > 128 {
+		err = ssz.ErrListTooBigFn("CacheState.Roots", size, 128)
+		return
+	}
+	for ii := 0; ii < len(c.Roots); ii++ {
+		if size := uint64(len(c.Roots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("CacheState.Roots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, c.Roots[ii]...)
+	}
+
+	// Field (6) 'Validators'
+	if size := uint64(len(c.Validators)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("CacheState.Validators", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(c.Validators); ii++ {
+		if dst, err = c.Validators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'Balances'
+	if size := uint64(len(c.Balances)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("CacheState.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(c.Balances); ii++ {
+		dst = ssz.MarshalValue(dst, c.Balances[ii])
+	}
+
+	// Field (10) 'Headers'
+	if size := uint64(len(c.Headers)); size > 16 {
+		err = ssz.ErrListTooBigFn("CacheState.Headers", size, 16)
+		return
+	}
+	for ii := 0; ii < len(c.Headers); ii++ {
+		if dst, err = c.Headers[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the CacheState object to a writer
+func (c *CacheState) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := c.fixedSize()
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, c.Slot)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Root'
+	dst = append(dst, c.Root[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (2) 'Extra'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Extra)
+
+	// Offset (3) 'Bits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Bits)
+
+	// Field (4) 'BlockRoots'
+	if size := uint64(len(c.BlockRoots)); size != 64 {
+		err = ssz.ErrVectorLengthFn("CacheState.BlockRoots", size, 64)
+		return
+	}
+	for ii := 0; ii < len(c.BlockRoots); ii++ {
+		dst = append(dst, c.BlockRoots[ii][:]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Offset (5) 'Roots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Roots) * 32
+
+	// Offset (6) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Validators) * 57
+
+	// Offset (7) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Balances) * 8
+
+	// Field (8) 'Slashings'
+	if size := uint64(len(c.Slashings)); size != 16 {
+		err = ssz.ErrVectorLengthFn("CacheState.Slashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(c.Slashings); ii++ {
+		dst = ssz.MarshalValue(dst, c.Slashings[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (9) 'Header'
+	if c.Header == nil {
+		c.Header = new(CacheHeader)
+	}
+	if dst, err = ww.WriteObject(dst, c.Header); err != nil {
+		return
+	}
+
+	// Offset (10) 'Headers'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'Extra'
+	if size := uint64(len(c.Extra)); size > 256 {
+		err = ssz.ErrBytesLengthFn("CacheState.Extra", size, 256)
+		return
+	}
+	dst = append(dst, c.Extra...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'Bits'
+	if size := ssz.BitlistLen(c.Bits); size > 2048 {
+		err = ssz.ErrBytesLengthFn("CacheState.Bits", size, 2048)
+		return
+	}
+	dst = append(dst, c.Bits...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'Roots'
+	if size := uint64(len(c.Roots)); size > 128 {
+		err = ssz.ErrListTooBigFn("CacheState.Roots", size, 128)
+		return
+	}
+	for ii := 0; ii < len(c.Roots); ii++ {
+		if size := uint64(len(c.Roots[ii])); size != 32 {
+			err = ssz.ErrBytesLengthFn("CacheState.Roots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, c.Roots[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Validators'
+	if size := uint64(len(c.Validators)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("CacheState.Validators", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(c.Validators); ii++ {
+		if dst, err = ww.WriteObject(dst, c.Validators[ii]); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'Balances'
+	if size := uint64(len(c.Balances)); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("CacheState.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(c.Balances); ii++ {
+		dst = ssz.MarshalValue(dst, c.Balances[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (10) 'Headers'
+	if size := uint64(len(c.Headers)); size > 16 {
+		err = ssz.ErrListTooBigFn("CacheState.Headers", size, 16)
+		return
+	}
+	for ii := 0; ii < len(c.Headers); ii++ {
+		if dst, err = ww.WriteObject(dst, c.Headers[ii]); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the CacheState object
+func (c *CacheState) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(c, buf)
+}
+
+// UnmarshalSSZTail unmarshals the CacheState object and returns the remaining bufferº
+func (c *CacheState) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	c.cache.Reset()
+
+	size := len(buf)
+	fixedSize := c.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o2, o3, o5, o6, o7, o10 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'Slot'
+	c.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (1) 'Root'
+	buf = ssz.UnmarshalFixedBytes(c.Root[:], buf)
+
+	// Offset (2) 'Extra'
+	if o2, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (3) 'Bits'
+	if o3, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (4) 'BlockRoots'
+	c.BlockRoots = make([][32]byte, 64)
+	for ii := uint64(0); ii < 64; ii++ {
+		buf = ssz.UnmarshalFixedBytes(c.BlockRoots[ii][:], buf)
+	}
+
+	// Offset (5) 'Roots'
+	if o5, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (6) 'Validators'
+	if o6, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (7) 'Balances'
+	if o7, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (8) 'Slashings'
+	c.Slashings = ssz.Extend(c.Slashings, 16)
+	for ii := uint64(0); ii < 16; ii++ {
+		c.Slashings[ii], buf = ssz.UnmarshallValue[uint64](buf)
+	}
+
+	// Field (9) 'Header'
+	if buf, err = ssz.UnmarshalFieldTail(&c.Header, buf); err != nil {
+		return
+	}
+
+	// Offset (10) 'Headers'
+	if o10, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (2) 'Extra'
+	if c.Extra, err = ssz.UnmarshalDynamicBytes(c.Extra, tail[o2:o3], 256); err != nil {
+		return
+	}
+
+	// Field (3) 'Bits'
+	if c.Bits, err = ssz.UnmarshalBitList(c.Bits, tail[o3:o5], 2048); err != nil {
+		return nil, err
+	}
+
+	// Field (5) 'Roots'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&c.Roots, tail[o5:o6], 32, 128, func(ii uint64, buf []byte) (err error) {
+		c.Roots[ii], buf = ssz.UnmarshalBytes(c.Roots[ii], buf, 32)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Field (6) 'Validators'
+	if err = ssz.UnmarshalSliceSSZ(&c.Validators, tail[o6:o7], 1099511627776); err != nil {
+		return nil, err
+	}
+
+	// Field (7) 'Balances'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&c.Balances, tail[o7:o10], 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+		c.Balances[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Field (10) 'Headers'
+	if err = ssz.UnmarshalSliceSSZ(&c.Headers, tail[o10:], 16); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the CacheState object from a reader with the size of the encoding
+func (c *CacheState) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	c.cache.Reset()
+
+	fixedSize := c.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o2, o3, o5, o6, o7, o10 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Slot'
+		c.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (1) 'Root'
+		buf = ssz.UnmarshalFixedBytes(c.Root[:], buf)
+
+		// Offset (2) 'Extra'
+		if o2, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (3) 'Bits'
+		if o3, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (4) 'BlockRoots'
+		c.BlockRoots = make([][32]byte, 64)
+		for ii := uint64(0); ii < 64; ii++ {
+			buf = ssz.UnmarshalFixedBytes(c.BlockRoots[ii][:], buf)
+		}
+
+		// Offset (5) 'Roots'
+		if o5, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (6) 'Validators'
+		if o6, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (7) 'Balances'
+		if o7, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Field (8) 'Slashings'
+		c.Slashings = ssz.Extend(c.Slashings, 16)
+		for ii := uint64(0); ii < 16; ii++ {
+			c.Slashings[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		}
+
+		// Field (9) 'Header'
+		if buf, err = ssz.UnmarshalFieldTail(&c.Header, buf); err != nil {
+			return
+		}
+
+		// Offset (10) 'Headers'
+		if o10, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (2) 'Extra'
+	if o3-o2 > 256 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o3-o2), func(buf []byte) (_ []byte, err error) {
+		if c.Extra, err = ssz.UnmarshalDynamicBytes(c.Extra, buf, 256); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (3) 'Bits'
+	if o5-o3 > 257 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o5-o3), func(buf []byte) (_ []byte, err error) {
+		if c.Bits, err = ssz.UnmarshalBitList(c.Bits, buf, 2048); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (5) 'Roots'
+	if o6-o5 > 4096 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o6-o5), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&c.Roots, buf, 32, 128, func(ii uint64, buf []byte) (err error) {
+			c.Roots[ii], buf = ssz.UnmarshalBytes(c.Roots[ii], buf, 32)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (6) 'Validators'
+	if err = ssz.ReadSliceSSZ(rr, &c.Validators, int(o7-o6), 1099511627776); err != nil {
+		return
+	}
+
+	// Field (7) 'Balances'
+	if o10-o7 > 8796093022208 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o10-o7), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&c.Balances, buf, 8, 1099511627776, func(ii uint64, buf []byte) (err error) {
+			c.Balances[ii], buf = ssz.UnmarshallValue[uint64](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (10) 'Headers'
+	if err = ssz.ReadSliceSSZ(rr, &c.Headers, size-int(o10), 16); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the CacheState object
+func (c *CacheState) fixedSize() int {
+	return int(2280)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CacheState object
+func (c *CacheState) SizeSSZ() (size int) {
+	size = c.fixedSize()
+
+	// Field (2) 'Extra'
+	size += len(c.Extra)
+
+	// Field (3) 'Bits'
+	size += len(c.Bits)
+
+	// Field (5) 'Roots'
+	size += len(c.Roots) * 32
+
+	// Field (6) 'Validators'
+	size += len(c.Validators) * 57
+
+	// Field (7) 'Balances'
+	size += len(c.Balances) * 8
+
+	// Field (10) 'Headers'
+	size += len(c.Headers) * 40
+
+	return
+}
+
+// HashTreeRoot ssz hashes the CacheState object
+func (c *CacheState) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootCtx ssz hashes the CacheState object and stops with the error of the context once it is done
+func (c *CacheState) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
+// HashTreeRootWith ssz hashes the CacheState object with a hasher
+func (c *CacheState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(c.Slot)
+
+	// Field (1) 'Root'
+	hh.PutBytes(c.Root[:])
+
+	// Field (2) 'Extra'
+	if !c.cache.PutField(hh, 2) {
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(c.Extra))
+			if byteLen > 256 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(c.Extra)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+		}
+		c.cache.SetField(hh, 2)
+	}
+
+	// Field (3) 'Bits'
+	if !c.cache.PutField(hh, 3) {
+		if len(c.Bits) == 0 {
+			err = ssz.ErrEmptyBitlist
+			return
+		}
+		hh.PutBitlist(c.Bits, 2048)
+
+		c.cache.SetField(hh, 3)
+	}
+
+	// Field (4) 'BlockRoots'
+	{
+		if size := uint64(len(c.BlockRoots)); size != 64 {
+			err = ssz.ErrVectorLengthFn("CacheState.BlockRoots", size, 64)
+			return
+		}
+		if err = c.cache.PutVector(hh, 4, len(c.BlockRoots), 0, func(start, end int) (err error) {
+			for _, i := range c.BlockRoots[start:end] {
+				hh.Append(i[:])
+			}
+			return
+		}); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Roots'
+	{
+		if size := uint64(len(c.Roots)); size > 128 {
+			err = ssz.ErrListTooBigFn("CacheState.Roots", size, 128)
+			return
+		}
+		if err = c.cache.PutList(hh, 5, len(c.Roots), 0, 128, func(start, end int) (err error) {
+			for _, i := range c.Roots[start:end] {
+				if len(i) != 32 {
+					err = ssz.ErrBytesLength
+					return
+				}
+				hh.Append(i)
+			}
+			return
+		}); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Validators'
+	{
+		if size := uint64(len(c.Validators)); size > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = c.cache.PutList(hh, 6, len(c.Validators), 0, 1099511627776, func(start, end int) (err error) {
+			return ssz.PutObjects(hh, c.Validators[start:end])
+		}); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'Balances'
+	{
+		if size := uint64(len(c.Balances)); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("CacheState.Balances", size, 1099511627776)
+			return
+		}
+		if err = c.cache.PutList(hh, 7, len(c.Balances), 8, ssz.CalculateLimit(1099511627776, uint64(len(c.Balances)), 8), func(start, end int) (err error) {
+			for _, i := range c.Balances[start:end] {
+				hh.AppendUint64(i)
+			}
+			return
+		}); err != nil {
+			return
+		}
+	}
+
+	// Field (8) 'Slashings'
+	{
+		if size := uint64(len(c.Slashings)); size != 16 {
+			err = ssz.ErrVectorLengthFn("CacheState.Slashings", size, 16)
+			return
+		}
+		if err = c.cache.PutVector(hh, 8, len(c.Slashings), 8, func(start, end int) (err error) {
+			for _, i := range c.Slashings[start:end] {
+				hh.AppendUint64(i)
+			}
+			return
+		}); err != nil {
+			return
+		}
+	}
+
+	// Field (9) 'Header'
+	if c.Header == nil {
+		c.Header = new(CacheHeader)
+	}
+	if err = c.Header.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (10) 'Headers'
+	{
+		if size := uint64(len(c.Headers)); size > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = c.cache.PutList(hh, 10, len(c.Headers), 0, 16, func(start, end int) (err error) {
+			return ssz.PutObjects(hh, c.Headers[start:end])
+		}); err != nil {
+			return
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarkExtraDirty marks the Extra field of the CacheState object as changed since it was hashed
+func (c *CacheState) MarkExtraDirty() {
+	c.cache.MarkDirty(2)
+}
+
+// MarkBitsDirty marks the Bits field of the CacheState object as changed since it was hashed
+func (c *CacheState) MarkBitsDirty() {
+	c.cache.MarkDirty(3)
+}
+
+// MarkBlockRootsDirty marks the BlockRoots field of the CacheState object as changed since it was hashed.
+// If indices are given, only the elements at those indices changed.
+func (c *CacheState) MarkBlockRootsDirty(indices ...int) {
+	c.cache.MarkDirty(4, indices...)
+}
+
+// MarkRootsDirty marks the Roots field of the CacheState object as changed since it was hashed.
+// If indices are given, only the elements at those indices changed.
+func (c *CacheState) MarkRootsDirty(indices ...int) {
+	c.cache.MarkDirty(5, indices...)
+}
+
+// MarkValidatorsDirty marks the Validators field of the CacheState object as changed since it was hashed.
+// If indices are given, only the elements at those indices changed.
+func (c *CacheState) MarkValidatorsDirty(indices ...int) {
+	c.cache.MarkDirty(6, indices...)
+}
+
+// MarkBalancesDirty marks the Balances field of the CacheState object as changed since it was hashed.
+// If indices are given, only the elements at those indices changed.
+func (c *CacheState) MarkBalancesDirty(indices ...int) {
+	c.cache.MarkDirty(7, indices...)
+}
+
+// MarkSlashingsDirty marks the Slashings field of the CacheState object as changed since it was hashed.
+// If indices are given, only the elements at those indices changed.
+func (c *CacheState) MarkSlashingsDirty(indices ...int) {
+	c.cache.MarkDirty(8, indices...)
+}
+
+// MarkHeadersDirty marks the Headers field of the CacheState object as changed since it was hashed.
+// If indices are given, only the elements at those indices changed.
+func (c *CacheState) MarkHeadersDirty(indices ...int) {
+	c.cache.MarkDirty(10, indices...)
+}
+
+// ResetHashCache marks all the fields of the CacheState object as changed since it was hashed
+func (c *CacheState) ResetHashCache() {
+	c.cache.Reset()
+}
+
+// GetTree ssz hashes the CacheState object
+func (c *CacheState) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+// GetTreeCtx ssz hashes the CacheState object and stops with the error of the context once it is done
+func (c *CacheState) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the CacheState fields
+const (
+	CacheStateGindexSlot       = 16
+	CacheStateGindexRoot       = 17
+	CacheStateGindexExtra      = 18
+	CacheStateGindexBits       = 19
+	CacheStateGindexBlockRoots = 20
+	CacheStateGindexRoots      = 21
+	CacheStateGindexValidators = 22
+	CacheStateGindexBalances   = 23
+	CacheStateGindexSlashings  = 24
+	CacheStateGindexHeader     = 25
+	CacheStateGindexHeaders    = 26
+)
+
+// CacheStateGindexBlockRootsElem returns the generalized index of the chunk of the i-th element of the BlockRoots field,
+// or zero if it does not fit in an int
+func CacheStateGindexBlockRootsElem(i int) int {
+	return ssz.ConcatGindices(CacheStateGindexBlockRoots, ssz.VectorElemGindex(i, 64, 0))
+}
+
+// CacheStateGindexRootsElem returns the generalized index of the chunk of the i-th element of the Roots field,
+// or zero if it does not fit in an int
+func CacheStateGindexRootsElem(i int) int {
+	return ssz.ConcatGindices(CacheStateGindexRoots, ssz.ListElemGindex(i, 128, 0))
+}
+
+// CacheStateGindexValidatorsElem returns the generalized index of the chunk of the i-th element of the Validators field,
+// or zero if it does not fit in an int
+func CacheStateGindexValidatorsElem(i int) int {
+	return ssz.ConcatGindices(CacheStateGindexValidators, ssz.ListElemGindex(i, 1099511627776, 0))
+}
+
+// CacheStateGindexBalancesElem returns the generalized index of the chunk of the i-th element of the Balances field,
+// or zero if it does not fit in an int
+func CacheStateGindexBalancesElem(i int) int {
+	return ssz.ConcatGindices(CacheStateGindexBalances, ssz.ListElemGindex(i, 1099511627776, 8))
+}
+
+// CacheStateGindexSlashingsElem returns the generalized index of the chunk of the i-th element of the Slashings field,
+// or zero if it does not fit in an int
+func CacheStateGindexSlashingsElem(i int) int {
+	return ssz.ConcatGindices(CacheStateGindexSlashings, ssz.VectorElemGindex(i, 16, 8))
+}
+
+// CacheStateGindexHeadersElem returns the generalized index of the chunk of the i-th element of the Headers field,
+// or zero if it does not fit in an int
+func CacheStateGindexHeadersElem(i int) int {
+	return ssz.ConcatGindices(CacheStateGindexHeaders, ssz.ListElemGindex(i, 16, 0))
+}
+
+// CacheStateGindex returns the generalized index of a field path in the CacheState object
+func CacheStateGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "slot":
+		return ssz.FieldGindex(CacheStateGindexSlot, rest, nil)
+	case "root":
+		return ssz.FieldGindex(CacheStateGindexRoot, rest, nil)
+	case "extra":
+		return ssz.FieldGindex(CacheStateGindexExtra, rest, nil)
+	case "bits":
+		return ssz.FieldGindex(CacheStateGindexBits, rest, nil)
+	case "block_roots":
+		return ssz.FieldGindex(CacheStateGindexBlockRoots, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, 64, 0, nil)
+		})
+	case "roots":
+		return ssz.FieldGindex(CacheStateGindexRoots, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 128, 0, nil)
+		})
+	case "validators":
+		return ssz.FieldGindex(CacheStateGindexValidators, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1099511627776, 0, CacheValidatorGindex)
+		})
+	case "balances":
+		return ssz.FieldGindex(CacheStateGindexBalances, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1099511627776, 8, nil)
+		})
+	case "slashings":
+		return ssz.FieldGindex(CacheStateGindexSlashings, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, 16, 8, nil)
+		})
+	case "header":
+		return ssz.FieldGindex(CacheStateGindexHeader, rest, CacheHeaderGindex)
+	case "headers":
+		return ssz.FieldGindex(CacheStateGindexHeaders, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, CacheHeaderGindex)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("CacheState", field)
+}
+
+// ProveField returns a proof of the field path in the CacheState object
+func (c *CacheState) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, CacheStateGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the CacheState object
+func (c *CacheState) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, CacheStateGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the CacheValidator object
+func (c *CacheValidator) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CacheValidator object to a target array
+func (c *CacheValidator) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Pubkey'
+	dst = append(dst, c.Pubkey[:]...)
+
+	// Field (1) 'EffectiveBalance'
+	dst = ssz.MarshalValue(dst, c.EffectiveBalance)
+
+	// Field (2) 'Slashed'
+	dst = ssz.MarshalValue(dst, c.Slashed)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the CacheValidator object to a writer
+func (c *CacheValidator) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Pubkey'
+	dst = append(dst, c.Pubkey[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'EffectiveBalance'
+	dst = ssz.MarshalValue(dst, c.EffectiveBalance)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Slashed'
+	dst = ssz.MarshalValue(dst, c.Slashed)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the CacheValidator object
+func (c *CacheValidator) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(c, buf)
+}
+
+// UnmarshalSSZTail unmarshals the CacheValidator object and returns the remaining bufferº
+func (c *CacheValidator) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	c.cache.Reset()
+
+	size := len(buf)
+	fixedSize := c.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'Pubkey'
+	buf = ssz.UnmarshalFixedBytes(c.Pubkey[:], buf)
+
+	// Field (1) 'EffectiveBalance'
+	c.EffectiveBalance, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (2) 'Slashed'
+	if err = ssz.IsValidBool(buf); err != nil {
+		return
+	}
+	c.Slashed, buf = ssz.UnmarshallValue[bool](buf)
+
+	return buf, nil
+}
+
+// UnmarshalSSZReader ssz unmarshals the CacheValidator object from a reader with the size of the encoding
+func (c *CacheValidator) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	c.cache.Reset()
+
+	fixedSize := c.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Pubkey'
+		buf = ssz.UnmarshalFixedBytes(c.Pubkey[:], buf)
+
+		// Field (1) 'EffectiveBalance'
+		c.EffectiveBalance, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (2) 'Slashed'
+		if err = ssz.IsValidBool(buf); err != nil {
+			return
+		}
+		c.Slashed, buf = ssz.UnmarshallValue[bool](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the CacheValidator object
+func (c *CacheValidator) fixedSize() int {
+	return int(57)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CacheValidator object
+func (c *CacheValidator) SizeSSZ() (size int) {
+	size = c.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the CacheValidator object
+func (c *CacheValidator) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootCtx ssz hashes the CacheValidator object and stops with the error of the context once it is done
+func (c *CacheValidator) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
+// HashTreeRootWith ssz hashes the CacheValidator object with a hasher
+func (c *CacheValidator) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	if !c.cache.PutField(hh, 0) {
+		hh.PutBytes(c.Pubkey[:])
+		c.cache.SetField(hh, 0)
+	}
+
+	// Field (1) 'EffectiveBalance'
+	hh.PutUint64(c.EffectiveBalance)
+
+	// Field (2) 'Slashed'
+	hh.PutBool(c.Slashed)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarkPubkeyDirty marks the Pubkey field of the CacheValidator object as changed since it was hashed
+func (c *CacheValidator) MarkPubkeyDirty() {
+	c.cache.MarkDirty(0)
+}
+
+// ResetHashCache marks all the fields of the CacheValidator object as changed since it was hashed
+func (c *CacheValidator) ResetHashCache() {
+	c.cache.Reset()
+}
+
+// GetTree ssz hashes the CacheValidator object
+func (c *CacheValidator) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+// GetTreeCtx ssz hashes the CacheValidator object and stops with the error of the context once it is done
+func (c *CacheValidator) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the CacheValidator fields
+const (
+	CacheValidatorGindexPubkey           = 4
+	CacheValidatorGindexEffectiveBalance = 5
+	CacheValidatorGindexSlashed          = 6
+)
+
+// CacheValidatorGindex returns the generalized index of a field path in the CacheValidator object
+func CacheValidatorGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "pubkey":
+		return ssz.FieldGindex(CacheValidatorGindexPubkey, rest, nil)
+	case "effective_balance":
+		return ssz.FieldGindex(CacheValidatorGindexEffectiveBalance, rest, nil)
+	case "slashed":
+		return ssz.FieldGindex(CacheValidatorGindexSlashed, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("CacheValidator", field)
+}
+
+// ProveField returns a proof of the field path in the CacheValidator object
+func (c *CacheValidator) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, CacheValidatorGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the CacheValidator object
+func (c *CacheValidator) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, CacheValidatorGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the CacheHeader object
+func (c *CacheHeader) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CacheHeader object to a target array
+func (c *CacheHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, c.Slot)
+
+	// Field (1) 'StateRoot'
+	dst = append(dst, c.StateRoot[:]...)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the CacheHeader object to a writer
+func (c *CacheHeader) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, c.Slot)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'StateRoot'
+	dst = append(dst, c.StateRoot[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the CacheHeader object
+func (c *CacheHeader) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(c, buf)
+}
+
+// UnmarshalSSZTail unmarshals the CacheHeader object and returns the remaining bufferº
+func (c *CacheHeader) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := c.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'Slot'
+	c.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (1) 'StateRoot'
+	buf = ssz.UnmarshalFixedBytes(c.StateRoot[:], buf)
+
+	return buf, nil
+}
+
+// UnmarshalSSZReader ssz unmarshals the CacheHeader object from a reader with the size of the encoding
+func (c *CacheHeader) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Slot'
+		c.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (1) 'StateRoot'
+		buf = ssz.UnmarshalFixedBytes(c.StateRoot[:], buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the CacheHeader object
+func (c *CacheHeader) fixedSize() int {
+	return int(40)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CacheHeader object
+func (c *CacheHeader) SizeSSZ() (size int) {
+	size = c.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the CacheHeader object
+func (c *CacheHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootCtx ssz hashes the CacheHeader object and stops with the error of the context once it is done
+func (c *CacheHeader) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
+// HashTreeRootWith ssz hashes the CacheHeader object with a hasher
+func (c *CacheHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(c.Slot)
+
+	// Field (1) 'StateRoot'
+	hh.PutBytes(c.StateRoot[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the CacheHeader object
+func (c *CacheHeader) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+// GetTreeCtx ssz hashes the CacheHeader object and stops with the error of the context once it is done
+func (c *CacheHeader) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the CacheHeader fields
+const (
+	CacheHeaderGindexSlot      = 2
+	CacheHeaderGindexStateRoot = 3
+)
+
+// CacheHeaderGindex returns the generalized index of a field path in the CacheHeader object
+func CacheHeaderGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "slot":
+		return ssz.FieldGindex(CacheHeaderGindexSlot, rest, nil)
+	case "state_root":
+		return ssz.FieldGindex(CacheHeaderGindexStateRoot, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("CacheHeader", field)
+}
+
+// ProveField returns a proof of the field path in the CacheHeader object
+func (c *CacheHeader) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, CacheHeaderGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the CacheHeader object
+func (c *CacheHeader) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, CacheHeaderGindex, paths...)
+}
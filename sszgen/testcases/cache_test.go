@@ -0,0 +1,258 @@
+package testcases
+
+import (
+	"context"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+func newCacheState(numValidators int) *CacheState {
+	s := &CacheState{
+		Slot:       1,
+		Extra:      []byte{0x1, 0x2},
+		Bits:       []byte{0x5},
+		BlockRoots: make([][32]byte, 64),
+		Roots:      [][]byte{make([]byte, 32)},
+		Slashings:  make([]uint64, 16),
+		Header:     &CacheHeader{Slot: 1},
+	}
+	for i := range s.BlockRoots {
+		s.BlockRoots[i][0] = byte(i)
+	}
+	for i := 0; i < numValidators; i++ {
+		s.Validators = append(s.Validators, &CacheValidator{EffectiveBalance: uint64(i)})
+		s.Balances = append(s.Balances, uint64(i))
+	}
+	return s
+}
+
+// requireCachedRoot checks the cached root against the root of the tree,
+// which walks all the fields
+func requireCachedRoot(t *testing.T, s *CacheState) [32]byte {
+	t.Helper()
+
+	tree, err := ssz.ProofTree(s)
+	require.NoError(t, err)
+
+	root, err := s.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, tree.Hash(), root[:])
+	return root
+}
+
+func TestHashCache(t *testing.T) {
+	s := newCacheState(100)
+	root := requireCachedRoot(t, s)
+
+	// the fields that are not marked as dirty are not hashed again
+	s.Balances[3] = 1000
+	cached, err := s.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, cached)
+
+	s.MarkBalancesDirty(3)
+	require.NotEqual(t, root, requireCachedRoot(t, s))
+
+	s.Validators[10].EffectiveBalance = 5
+	s.MarkValidatorsDirty(10)
+	requireCachedRoot(t, s)
+
+	s.Validators[11].Pubkey[0] = 0x1
+	s.Validators[11].MarkPubkeyDirty()
+	s.MarkValidatorsDirty(11)
+	requireCachedRoot(t, s)
+
+	s.BlockRoots[63][1] = 0x1
+	s.Slashings[15] = 2
+	s.MarkBlockRootsDirty(63)
+	s.MarkSlashingsDirty(15)
+	requireCachedRoot(t, s)
+
+	s.Extra = append(s.Extra, 0x3)
+	s.MarkExtraDirty()
+	s.Bits = []byte{0x3}
+	s.MarkBitsDirty()
+	requireCachedRoot(t, s)
+
+	// the containers are always walked
+	s.Header.Slot = 5
+	requireCachedRoot(t, s)
+
+	// the lists can grow and shrink
+	for i := 0; i < 5; i++ {
+		s.Balances = append(s.Balances, 7)
+		s.Validators = append(s.Validators, &CacheValidator{})
+		requireCachedRoot(t, s)
+	}
+	s.Balances = s.Balances[:50]
+	s.Validators = s.Validators[:3]
+	s.Headers = append(s.Headers, &CacheHeader{})
+	requireCachedRoot(t, s)
+
+	s.Balances = nil
+	s.MarkBalancesDirty()
+	requireCachedRoot(t, s)
+}
+
+func TestHashCache_Workers(t *testing.T) {
+	s := newCacheState(5000)
+	hh := ssz.NewHasher(ssz.WithHashWorkers(4))
+	hashWithWorkers := func() {
+		hh.Reset()
+		require.NoError(t, s.HashTreeRootWith(hh))
+		tree, err := ssz.ProofTree(s)
+		require.NoError(t, err)
+		require.Equal(t, tree.Hash(), hh.Hash())
+	}
+	hashWithWorkers()
+
+	// a range of dirty validators is hashed by the workers at once
+	indices := []int{}
+	for i := 1000; i < 3000; i++ {
+		s.Validators[i].EffectiveBalance = 7
+		indices = append(indices, i)
+	}
+	s.MarkValidatorsDirty(indices...)
+	s.Validators[4000].Slashed = true
+	s.MarkValidatorsDirty(4000)
+	hashWithWorkers()
+}
+
+func TestHashCache_Unmarshal(t *testing.T) {
+	s := newCacheState(10)
+	// unmarshaling appends to the byte slices of the list
+	s.Roots = nil
+	root := requireCachedRoot(t, s)
+
+	other := newCacheState(20)
+	other.Balances[0] = 100
+	buf, err := other.MarshalSSZ()
+	require.NoError(t, err)
+
+	// the cache is reset with the new values
+	require.NoError(t, s.UnmarshalSSZ(buf))
+	require.NotEqual(t, root, requireCachedRoot(t, s))
+}
+
+func TestHashCache_Errors(t *testing.T) {
+	s := newCacheState(10)
+	requireCachedRoot(t, s)
+
+	s.Roots = append(s.Roots, []byte{0x1})
+	s.MarkRootsDirty(1)
+	_, err := s.HashTreeRoot()
+	require.ErrorIs(t, err, ssz.ErrBytesLength)
+
+	s.Roots[1] = make([]byte, 32)
+	s.MarkRootsDirty(1)
+	requireCachedRoot(t, s)
+}
+
+func TestHashCache_Copy(t *testing.T) {
+	s1 := newCacheState(100)
+	requireCachedRoot(t, s1)
+
+	// the copy shares the values but not the cache
+	s2 := *s1
+	s2.Balances = append([]uint64{}, s1.Balances...)
+	s2.Slashings = append([]uint64{}, s1.Slashings...)
+
+	s2.Slot = 2
+	s2.Balances[3] = 1000
+	s2.MarkBalancesDirty(3)
+	root2 := requireCachedRoot(t, &s2)
+
+	s1.Slashings[1] = 5
+	s1.MarkSlashingsDirty(1)
+	root1 := requireCachedRoot(t, s1)
+	require.NotEqual(t, root1, root2)
+
+	// a reset of the copy does not change the original either
+	s3 := *s1
+	s3.ResetHashCache()
+	s3.Extra = []byte{0x9}
+	requireCachedRoot(t, &s3)
+	require.Equal(t, root1, requireCachedRoot(t, s1))
+	require.Equal(t, root2, requireCachedRoot(t, &s2))
+}
+
+func TestHashCache_Context(t *testing.T) {
+	s := newCacheState(10)
+	root := requireCachedRoot(t, s)
+
+	// the context walker uses the cache of the hasher
+	s.Balances[3] = 1000
+	cached, err := s.HashTreeRootCtx(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, root, cached)
+
+	tree, err := s.GetTreeCtx(context.Background())
+	require.NoError(t, err)
+	require.NotEqual(t, root[:], tree.Hash())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s.MarkBalancesDirty(3)
+	_, err = s.HashTreeRootCtx(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	_, err = s.GetTreeCtx(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+
+	root = requireCachedRoot(t, s)
+	require.Equal(t, tree.Hash(), root[:])
+}
+
+func TestHashCache_ContextDone(t *testing.T) {
+	s := newCacheState(10)
+	requireCachedRoot(t, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// the walk with a done context does not merkleize the dirty
+	// fields and their roots are not cached
+	s.Validators[2].EffectiveBalance = 77
+	s.MarkValidatorsDirty(2)
+	s.Bits = []byte{0x7}
+	s.MarkBitsDirty()
+
+	w := ssz.NewContextWalker(ctx, ssz.NewHasher())
+	if err := s.HashTreeRootWith(w); err == nil {
+		require.ErrorIs(t, w.Err(), context.Canceled)
+	}
+	requireCachedRoot(t, s)
+}
+
+func BenchmarkHashCache(b *testing.B) {
+	s := newCacheState(1 << 16)
+	hh := ssz.NewHasher()
+
+	b.Run("Cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s.Balances[i%len(s.Balances)]++
+			s.MarkBalancesDirty(i % len(s.Balances))
+			if err := s.HashTreeRootWith(hh); err != nil {
+				b.Fatal(err)
+			}
+			hh.Reset()
+		}
+	})
+
+	b.Run("Full", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s.ResetHashCache()
+			for _, v := range s.Validators {
+				v.ResetHashCache()
+			}
+			if err := s.HashTreeRootWith(hh); err != nil {
+				b.Fatal(err)
+			}
+			hh.Reset()
+		}
+	})
+}
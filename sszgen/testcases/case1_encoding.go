@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -30,6 +33,31 @@ func (c *Case1A) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Case1A object to a writer
+func (c *Case1A) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := c.fixedSize()
+
+	// Offset (0) 'Foo'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Foo'
+	if size := uint64(len(c.Foo)); size > 2048 {
+		err = ssz.ErrBytesLengthFn("Case1A.Foo", size, 2048)
+		return
+	}
+	dst = append(dst, c.Foo...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Case1A object
 func (c *Case1A) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -60,6 +88,43 @@ func (c *Case1A) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the Case1A object from a reader with the size of the encoding
+func (c *Case1A) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'Foo'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'Foo'
+	if uint64(size-int(o0)) > 2048 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o0), func(buf []byte) (_ []byte, err error) {
+		if c.Foo, err = ssz.UnmarshalDynamicBytes(c.Foo, buf, 2048); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Case1A object
 func (c *Case1A) fixedSize() int {
 	return int(4)
@@ -80,6 +145,11 @@ func (c *Case1A) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the Case1A object and stops with the error of the context once it is done
+func (c *Case1A) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the Case1A object with a hasher
 func (c *Case1A) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -105,6 +175,39 @@ func (c *Case1A) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+// GetTreeCtx ssz hashes the Case1A object and stops with the error of the context once it is done
+func (c *Case1A) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the Case1A fields
+const (
+	Case1AGindexFoo = 1
+)
+
+// Case1AGindex returns the generalized index of a field path in the Case1A object
+func Case1AGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "foo":
+		return ssz.FieldGindex(Case1AGindexFoo, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Case1A", field)
+}
+
+// ProveField returns a proof of the field path in the Case1A object
+func (c *Case1A) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, Case1AGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Case1A object
+func (c *Case1A) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, Case1AGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the Case1B object
 func (c *Case1B) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -128,6 +231,31 @@ func (c *Case1B) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Case1B object to a writer
+func (c *Case1B) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := c.fixedSize()
+
+	// Offset (0) 'Bar'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Bar'
+	if size := uint64(len(c.Bar)); size > 32 {
+		err = ssz.ErrBytesLengthFn("Case1B.Bar", size, 32)
+		return
+	}
+	dst = append(dst, c.Bar...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Case1B object
 func (c *Case1B) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -158,6 +286,43 @@ func (c *Case1B) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the Case1B object from a reader with the size of the encoding
+func (c *Case1B) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'Bar'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'Bar'
+	if uint64(size-int(o0)) > 32 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o0), func(buf []byte) (_ []byte, err error) {
+		if c.Bar, err = ssz.UnmarshalDynamicBytes(c.Bar, buf, 32); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Case1B object
 func (c *Case1B) fixedSize() int {
 	return int(4)
@@ -178,6 +343,11 @@ func (c *Case1B) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the Case1B object and stops with the error of the context once it is done
+func (c *Case1B) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the Case1B object with a hasher
 func (c *Case1B) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -202,3 +372,36 @@ func (c *Case1B) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case1B) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// GetTreeCtx ssz hashes the Case1B object and stops with the error of the context once it is done
+func (c *Case1B) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the Case1B fields
+const (
+	Case1BGindexBar = 1
+)
+
+// Case1BGindex returns the generalized index of a field path in the Case1B object
+func Case1BGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "bar":
+		return ssz.FieldGindex(Case1BGindexBar, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Case1B", field)
+}
+
+// ProveField returns a proof of the field path in the Case1B object
+func (c *Case1B) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, Case1BGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Case1B object
+func (c *Case1B) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, Case1BGindex, paths...)
+}
@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -22,6 +25,23 @@ func (c *Case2A) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Case2A object to a writer
+func (c *Case2A) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'A'
+	dst = ssz.MarshalValue(dst, c.A)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Case2A object
 func (c *Case2A) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -41,6 +61,26 @@ func (c *Case2A) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Case2A object from a reader with the size of the encoding
+func (c *Case2A) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'A'
+		c.A, buf = ssz.UnmarshallValue[uint64](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Case2A object
 func (c *Case2A) fixedSize() int {
 	return int(8)
@@ -57,6 +97,11 @@ func (c *Case2A) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the Case2A object and stops with the error of the context once it is done
+func (c *Case2A) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the Case2A object with a hasher
 func (c *Case2A) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -73,6 +118,39 @@ func (c *Case2A) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+// GetTreeCtx ssz hashes the Case2A object and stops with the error of the context once it is done
+func (c *Case2A) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the Case2A fields
+const (
+	Case2AGindexA = 1
+)
+
+// Case2AGindex returns the generalized index of a field path in the Case2A object
+func Case2AGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(Case2AGindexA, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Case2A", field)
+}
+
+// ProveField returns a proof of the field path in the Case2A object
+func (c *Case2A) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, Case2AGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Case2A object
+func (c *Case2A) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, Case2AGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the Case2B object
 func (c *Case2B) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -91,6 +169,29 @@ func (c *Case2B) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Case2B object to a writer
+func (c *Case2B) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'A'
+	dst = ssz.MarshalValue(dst, c.A)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'B'
+	dst = ssz.MarshalValue(dst, c.B)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Case2B object
 func (c *Case2B) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -113,6 +214,29 @@ func (c *Case2B) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Case2B object from a reader with the size of the encoding
+func (c *Case2B) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'A'
+		c.A, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (1) 'B'
+		c.B, buf = ssz.UnmarshallValue[uint64](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Case2B object
 func (c *Case2B) fixedSize() int {
 	return int(16)
@@ -129,6 +253,11 @@ func (c *Case2B) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the Case2B object and stops with the error of the context once it is done
+func (c *Case2B) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the Case2B object with a hasher
 func (c *Case2B) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -147,3 +276,39 @@ func (c *Case2B) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case2B) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// GetTreeCtx ssz hashes the Case2B object and stops with the error of the context once it is done
+func (c *Case2B) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the Case2B fields
+const (
+	Case2BGindexA = 2
+	Case2BGindexB = 3
+)
+
+// Case2BGindex returns the generalized index of a field path in the Case2B object
+func Case2BGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(Case2BGindexA, rest, nil)
+	case "b":
+		return ssz.FieldGindex(Case2BGindexB, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Case2B", field)
+}
+
+// ProveField returns a proof of the field path in the Case2B object
+func (c *Case2B) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, Case2BGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Case2B object
+func (c *Case2B) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, Case2BGindex, paths...)
+}
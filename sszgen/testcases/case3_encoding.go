@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 	"github.com/ferranbt/fastssz/sszgen/testcases/other"
 )
@@ -20,6 +23,17 @@ func (c *Case3B) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Case3B object to a writer
+func (c *Case3B) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Case3B object
 func (c *Case3B) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -36,6 +50,24 @@ func (c *Case3B) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Case3B object from a reader with the size of the encoding
+func (c *Case3B) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Case3B object
 func (c *Case3B) fixedSize() int {
 	return int(0)
@@ -52,6 +84,11 @@ func (c *Case3B) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the Case3B object and stops with the error of the context once it is done
+func (c *Case3B) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the Case3B object with a hasher
 func (c *Case3B) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -65,6 +102,30 @@ func (c *Case3B) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+// GetTreeCtx ssz hashes the Case3B object and stops with the error of the context once it is done
+func (c *Case3B) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Case3BGindex returns the generalized index of a field path in the Case3B object
+func Case3BGindex(path string) (int, error) {
+	field, _, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	return 0, ssz.ErrUnknownFieldFn("Case3B", field)
+}
+
+// ProveField returns a proof of the field path in the Case3B object
+func (c *Case3B) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, Case3BGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Case3B object
+func (c *Case3B) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, Case3BGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the Case3A object
 func (c *Case3A) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -103,6 +164,43 @@ func (c *Case3A) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Case3A object to a writer
+func (c *Case3A) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'A'
+	if dst, err = ww.WriteObject(dst, &c.A); err != nil {
+		return
+	}
+
+	// Field (1) 'B'
+	if c.B == nil {
+		c.B = new(Case3B)
+	}
+	if dst, err = ww.WriteObject(dst, c.B); err != nil {
+		return
+	}
+
+	// Field (2) 'C'
+	if dst, err = ww.WriteObject(dst, &c.C); err != nil {
+		return
+	}
+
+	// Field (3) 'D'
+	if c.D == nil {
+		c.D = new(other.Case3B)
+	}
+	if dst, err = ww.WriteObject(dst, c.D); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Case3A object
 func (c *Case3A) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -139,6 +237,43 @@ func (c *Case3A) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Case3A object from a reader with the size of the encoding
+func (c *Case3A) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'A'
+		if buf, err = c.A.UnmarshalSSZTail(buf); err != nil {
+			return
+		}
+
+		// Field (1) 'B'
+		if buf, err = ssz.UnmarshalFieldTail(&c.B, buf); err != nil {
+			return
+		}
+
+		// Field (2) 'C'
+		if buf, err = c.C.UnmarshalSSZTail(buf); err != nil {
+			return
+		}
+
+		// Field (3) 'D'
+		if buf, err = ssz.UnmarshalFieldTail(&c.D, buf); err != nil {
+			return
+		}
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Case3A object
 func (c *Case3A) fixedSize() int {
 	return int(0)
@@ -155,6 +290,11 @@ func (c *Case3A) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the Case3A object and stops with the error of the context once it is done
+func (c *Case3A) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the Case3A object with a hasher
 func (c *Case3A) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -193,3 +333,45 @@ func (c *Case3A) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case3A) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// GetTreeCtx ssz hashes the Case3A object and stops with the error of the context once it is done
+func (c *Case3A) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the Case3A fields
+const (
+	Case3AGindexA = 4
+	Case3AGindexB = 5
+	Case3AGindexC = 6
+	Case3AGindexD = 7
+)
+
+// Case3AGindex returns the generalized index of a field path in the Case3A object
+func Case3AGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(Case3AGindexA, rest, Case3BGindex)
+	case "b":
+		return ssz.FieldGindex(Case3AGindexB, rest, Case3BGindex)
+	case "c":
+		return ssz.FieldGindex(Case3AGindexC, rest, Case3BGindex)
+	case "d":
+		return ssz.FieldGindex(Case3AGindexD, rest, Case3BGindex)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Case3A", field)
+}
+
+// ProveField returns a proof of the field path in the Case3A object
+func (c *Case3A) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, Case3AGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Case3A object
+func (c *Case3A) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, Case3AGindex, paths...)
+}
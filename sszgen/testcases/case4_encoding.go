@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 	"github.com/ferranbt/fastssz/sszgen/testcases/other"
 	alias "github.com/ferranbt/fastssz/sszgen/testcases/other2"
@@ -47,6 +50,52 @@ func (c *Case4) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Case4 object to a writer
+func (c *Case4) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'A'
+	if dst, err = ww.WriteObject(dst, &c.A); err != nil {
+		return
+	}
+
+	// Field (1) 'B'
+	if c.B == nil {
+		c.B = new(other.Case4Interface)
+	}
+	if dst, err = ww.WriteObject(dst, c.B); err != nil {
+		return
+	}
+
+	// Field (2) 'C'
+	dst = ssz.MarshalValue(dst, uint64(c.C))
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'D'
+	if size := uint64(len(c.D)); size != 96 {
+		err = ssz.ErrBytesLengthFn("Case4.D", size, 96)
+		return
+	}
+	dst = append(dst, c.D...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'E'
+	dst = append(dst, c.E[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Case4 object
 func (c *Case4) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -86,6 +135,46 @@ func (c *Case4) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Case4 object from a reader with the size of the encoding
+func (c *Case4) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'A'
+		if buf, err = c.A.UnmarshalSSZTail(buf); err != nil {
+			return
+		}
+
+		// Field (1) 'B'
+		if buf, err = ssz.UnmarshalFieldTail(&c.B, buf); err != nil {
+			return
+		}
+
+		// Field (2) 'C'
+		{
+			var val uint64
+			val, buf = ssz.UnmarshallValue[uint64](buf)
+			c.C = alias.Case4Slot(val)
+		}
+
+		// Field (3) 'D'
+		c.D, buf = ssz.UnmarshalBytes(c.D, buf, 96)
+
+		// Field (4) 'E'
+		buf = ssz.UnmarshalFixedBytes(c.E[:], buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Case4 object
 func (c *Case4) fixedSize() int {
 	return int(200)
@@ -102,6 +191,11 @@ func (c *Case4) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the Case4 object and stops with the error of the context once it is done
+func (c *Case4) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the Case4 object with a hasher
 func (c *Case4) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -140,3 +234,48 @@ func (c *Case4) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case4) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// GetTreeCtx ssz hashes the Case4 object and stops with the error of the context once it is done
+func (c *Case4) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the Case4 fields
+const (
+	Case4GindexA = 8
+	Case4GindexB = 9
+	Case4GindexC = 10
+	Case4GindexD = 11
+	Case4GindexE = 12
+)
+
+// Case4Gindex returns the generalized index of a field path in the Case4 object
+func Case4Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(Case4GindexA, rest, nil)
+	case "b":
+		return ssz.FieldGindex(Case4GindexB, rest, nil)
+	case "c":
+		return ssz.FieldGindex(Case4GindexC, rest, nil)
+	case "d":
+		return ssz.FieldGindex(Case4GindexD, rest, nil)
+	case "e":
+		return ssz.FieldGindex(Case4GindexE, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Case4", field)
+}
+
+// ProveField returns a proof of the field path in the Case4 object
+func (c *Case4) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, Case4Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Case4 object
+func (c *Case4) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, Case4Gindex, paths...)
+}
@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -58,6 +61,65 @@ func (c *Case5A) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Case5A object to a writer
+func (c *Case5A) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'A'
+	if size := uint64(len(c.A)); size != 2 {
+		err = ssz.ErrVectorLengthFn("Case5A.A", size, 2)
+		return
+	}
+	for ii := 0; ii < len(c.A); ii++ {
+		if size := uint64(len(c.A[ii])); size != 2 {
+			err = ssz.ErrBytesLengthFn("Case5A.A[ii]", size, 2)
+			return
+		}
+		dst = append(dst, c.A[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'B'
+	if size := uint64(len(c.B)); size != 2 {
+		err = ssz.ErrVectorLengthFn("Case5A.B", size, 2)
+		return
+	}
+	for ii := 0; ii < len(c.B); ii++ {
+		if size := uint64(len(c.B[ii])); size != 2 {
+			err = ssz.ErrBytesLengthFn("Case5A.B[ii]", size, 2)
+			return
+		}
+		dst = append(dst, c.B[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'C'
+	if size := uint64(len(c.C)); size != 2 {
+		err = ssz.ErrVectorLengthFn("Case5A.C", size, 2)
+		return
+	}
+	for ii := 0; ii < len(c.C); ii++ {
+		if size := uint64(len(c.C[ii])); size != 2 {
+			err = ssz.ErrBytesLengthFn("Case5A.C[ii]", size, 2)
+			return
+		}
+		dst = append(dst, c.C[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Case5A object
 func (c *Case5A) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -92,6 +154,41 @@ func (c *Case5A) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Case5A object from a reader with the size of the encoding
+func (c *Case5A) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'A'
+		c.A = make([][]byte, 2)
+		for ii := uint64(0); ii < 2; ii++ {
+			c.A[ii], buf = ssz.UnmarshalBytes(c.A[ii], buf, 2)
+		}
+
+		// Field (1) 'B'
+		c.B = make([]Case5Bytes, 2)
+		for ii := uint64(0); ii < 2; ii++ {
+			c.B[ii], buf = ssz.UnmarshalBytes(c.B[ii], buf, 2)
+		}
+
+		// Field (2) 'C'
+		c.C = make([][]byte, 2)
+		for ii := uint64(0); ii < 2; ii++ {
+			c.C[ii], buf = ssz.UnmarshalBytes(c.C[ii], buf, 2)
+		}
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Case5A object
 func (c *Case5A) fixedSize() int {
 	return int(12)
@@ -108,6 +205,11 @@ func (c *Case5A) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the Case5A object and stops with the error of the context once it is done
+func (c *Case5A) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the Case5A object with a hasher
 func (c *Case5A) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -171,3 +273,66 @@ func (c *Case5A) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case5A) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// GetTreeCtx ssz hashes the Case5A object and stops with the error of the context once it is done
+func (c *Case5A) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the Case5A fields
+const (
+	Case5AGindexA = 4
+	Case5AGindexB = 5
+	Case5AGindexC = 6
+)
+
+// Case5AGindexAElem returns the generalized index of the chunk of the i-th element of the A field,
+// or zero if it does not fit in an int
+func Case5AGindexAElem(i int) int {
+	return ssz.ConcatGindices(Case5AGindexA, ssz.VectorElemGindex(i, 2, 0))
+}
+
+// Case5AGindexBElem returns the generalized index of the chunk of the i-th element of the B field,
+// or zero if it does not fit in an int
+func Case5AGindexBElem(i int) int {
+	return ssz.ConcatGindices(Case5AGindexB, ssz.VectorElemGindex(i, 2, 0))
+}
+
+// Case5AGindexCElem returns the generalized index of the chunk of the i-th element of the C field,
+// or zero if it does not fit in an int
+func Case5AGindexCElem(i int) int {
+	return ssz.ConcatGindices(Case5AGindexC, ssz.VectorElemGindex(i, 2, 0))
+}
+
+// Case5AGindex returns the generalized index of a field path in the Case5A object
+func Case5AGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(Case5AGindexA, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, 2, 0, nil)
+		})
+	case "b":
+		return ssz.FieldGindex(Case5AGindexB, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, 2, 0, nil)
+		})
+	case "c":
+		return ssz.FieldGindex(Case5AGindexC, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, 2, 0, nil)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("Case5A", field)
+}
+
+// ProveField returns a proof of the field path in the Case5A object
+func (c *Case5A) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, Case5AGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Case5A object
+func (c *Case5A) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, Case5AGindex, paths...)
+}
@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -22,6 +25,23 @@ func (c *Case6) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Case6 object to a writer
+func (c *Case6) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'A'
+	dst = append(dst, c.A[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Case6 object
 func (c *Case6) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -41,6 +61,26 @@ func (c *Case6) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Case6 object from a reader with the size of the encoding
+func (c *Case6) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'A'
+		buf = ssz.UnmarshalFixedBytes(c.A[:], buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Case6 object
 func (c *Case6) fixedSize() int {
 	return int(32)
@@ -57,6 +97,11 @@ func (c *Case6) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the Case6 object and stops with the error of the context once it is done
+func (c *Case6) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the Case6 object with a hasher
 func (c *Case6) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -72,3 +117,36 @@ func (c *Case6) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case6) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// GetTreeCtx ssz hashes the Case6 object and stops with the error of the context once it is done
+func (c *Case6) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the Case6 fields
+const (
+	Case6GindexA = 1
+)
+
+// Case6Gindex returns the generalized index of a field path in the Case6 object
+func Case6Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(Case6GindexA, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Case6", field)
+}
+
+// ProveField returns a proof of the field path in the Case6 object
+func (c *Case6) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, Case6Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Case6 object
+func (c *Case6) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, Case6Gindex, paths...)
+}
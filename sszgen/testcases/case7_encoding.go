@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -36,6 +39,37 @@ func (c *Case7) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Case7 object to a writer
+func (c *Case7) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := c.fixedSize()
+
+	// Offset (0) 'BlobKzgs'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'BlobKzgs'
+	if size := uint64(len(c.BlobKzgs)); size > 16 {
+		err = ssz.ErrListTooBigFn("Case7.BlobKzgs", size, 16)
+		return
+	}
+	for ii := 0; ii < len(c.BlobKzgs); ii++ {
+		if size := uint64(len(c.BlobKzgs[ii])); size != 48 {
+			err = ssz.ErrBytesLengthFn("Case7.BlobKzgs[ii]", size, 48)
+			return
+		}
+		dst = append(dst, c.BlobKzgs[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Case7 object
 func (c *Case7) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -69,6 +103,46 @@ func (c *Case7) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the Case7 object from a reader with the size of the encoding
+func (c *Case7) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'BlobKzgs'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'BlobKzgs'
+	if uint64(size-int(o0)) > 768 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o0), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&c.BlobKzgs, buf, 48, 16, func(ii uint64, buf []byte) (err error) {
+			c.BlobKzgs[ii], buf = ssz.UnmarshalBytes(c.BlobKzgs[ii], buf, 48)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Case7 object
 func (c *Case7) fixedSize() int {
 	return int(4)
@@ -89,6 +163,11 @@ func (c *Case7) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the Case7 object and stops with the error of the context once it is done
+func (c *Case7) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the Case7 object with a hasher
 func (c *Case7) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -119,3 +198,44 @@ func (c *Case7) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case7) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// GetTreeCtx ssz hashes the Case7 object and stops with the error of the context once it is done
+func (c *Case7) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the Case7 fields
+const (
+	Case7GindexBlobKzgs = 1
+)
+
+// Case7GindexBlobKzgsElem returns the generalized index of the chunk of the i-th element of the BlobKzgs field,
+// or zero if it does not fit in an int
+func Case7GindexBlobKzgsElem(i int) int {
+	return ssz.ConcatGindices(Case7GindexBlobKzgs, ssz.ListElemGindex(i, 16, 0))
+}
+
+// Case7Gindex returns the generalized index of a field path in the Case7 object
+func Case7Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "blob_kzgs":
+		return ssz.FieldGindex(Case7GindexBlobKzgs, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 16, 0, nil)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("Case7", field)
+}
+
+// ProveField returns a proof of the field path in the Case7 object
+func (c *Case7) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, Case7Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Case7 object
+func (c *Case7) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, Case7Gindex, paths...)
+}
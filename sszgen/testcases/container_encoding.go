@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -28,6 +31,29 @@ func (v *Vec) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Vec object to a writer
+func (v *Vec) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Values'
+	if size := uint64(len(v.Values)); size != 6 {
+		err = ssz.ErrVectorLengthFn("Vec.Values", size, 6)
+		return
+	}
+	for ii := 0; ii < len(v.Values); ii++ {
+		dst = ssz.MarshalValue(dst, v.Values[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Vec object
 func (v *Vec) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(v, buf)
@@ -50,6 +76,29 @@ func (v *Vec) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Vec object from a reader with the size of the encoding
+func (v *Vec) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := v.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Values'
+		v.Values = ssz.Extend(v.Values, 6)
+		for ii := uint64(0); ii < 6; ii++ {
+			v.Values[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		}
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Vec object
 func (v *Vec) fixedSize() int {
 	return int(48)
@@ -66,6 +115,11 @@ func (v *Vec) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(v)
 }
 
+// HashTreeRootCtx ssz hashes the Vec object and stops with the error of the context once it is done
+func (v *Vec) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, v)
+}
+
 // HashTreeRootWith ssz hashes the Vec object with a hasher
 func (v *Vec) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -92,6 +146,47 @@ func (v *Vec) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(v)
 }
 
+// GetTreeCtx ssz hashes the Vec object and stops with the error of the context once it is done
+func (v *Vec) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, v)
+}
+
+// Generalized indices of the Vec fields
+const (
+	VecGindexValues = 1
+)
+
+// VecGindexValuesElem returns the generalized index of the chunk of the i-th element of the Values field,
+// or zero if it does not fit in an int
+func VecGindexValuesElem(i int) int {
+	return ssz.ConcatGindices(VecGindexValues, ssz.VectorElemGindex(i, 6, 8))
+}
+
+// VecGindex returns the generalized index of a field path in the Vec object
+func VecGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "values":
+		return ssz.FieldGindex(VecGindexValues, rest, func(path string) (int, error) {
+			return ssz.VectorGindex(path, 6, 8, nil)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("Vec", field)
+}
+
+// ProveField returns a proof of the field path in the Vec object
+func (v *Vec) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(v, VecGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Vec object
+func (v *Vec) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(v, VecGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the Vec2 object
 func (v *Vec2) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(v)
@@ -117,6 +212,33 @@ func (v *Vec2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Vec2 object to a writer
+func (v *Vec2) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := v.fixedSize()
+
+	// Offset (0) 'Values2'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Values2'
+	if size := uint64(len(v.Values2)); size > 100 {
+		err = ssz.ErrListTooBigFn("Vec2.Values2", size, 100)
+		return
+	}
+	for ii := 0; ii < len(v.Values2); ii++ {
+		dst = ssz.MarshalValue(dst, v.Values2[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Vec2 object
 func (v *Vec2) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(v, buf)
@@ -150,6 +272,46 @@ func (v *Vec2) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the Vec2 object from a reader with the size of the encoding
+func (v *Vec2) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := v.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'Values2'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'Values2'
+	if uint64(size-int(o0)) > 400 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o0), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&v.Values2, buf, 4, 100, func(ii uint64, buf []byte) (err error) {
+			v.Values2[ii], buf = ssz.UnmarshallValue[uint32](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Vec2 object
 func (v *Vec2) fixedSize() int {
 	return int(4)
@@ -170,6 +332,11 @@ func (v *Vec2) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(v)
 }
 
+// HashTreeRootCtx ssz hashes the Vec2 object and stops with the error of the context once it is done
+func (v *Vec2) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, v)
+}
+
 // HashTreeRootWith ssz hashes the Vec2 object with a hasher
 func (v *Vec2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -197,3 +364,44 @@ func (v *Vec2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (v *Vec2) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(v)
 }
+
+// GetTreeCtx ssz hashes the Vec2 object and stops with the error of the context once it is done
+func (v *Vec2) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, v)
+}
+
+// Generalized indices of the Vec2 fields
+const (
+	Vec2GindexValues2 = 1
+)
+
+// Vec2GindexValues2Elem returns the generalized index of the chunk of the i-th element of the Values2 field,
+// or zero if it does not fit in an int
+func Vec2GindexValues2Elem(i int) int {
+	return ssz.ConcatGindices(Vec2GindexValues2, ssz.ListElemGindex(i, 100, 4))
+}
+
+// Vec2Gindex returns the generalized index of a field path in the Vec2 object
+func Vec2Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "values2":
+		return ssz.FieldGindex(Vec2GindexValues2, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 100, 4, nil)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("Vec2", field)
+}
+
+// ProveField returns a proof of the field path in the Vec2 object
+func (v *Vec2) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(v, Vec2Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Vec2 object
+func (v *Vec2) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(v, Vec2Gindex, paths...)
+}
@@ -0,0 +1,38 @@
+package testcases
+
+import (
+	"context"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextWalker_Done(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	slot, value := uint64(5), uint64(7)
+	side, color := uint16(0x42), uint8(1)
+	elem := &StableElem{A: 7, Data: []byte{0x1}}
+
+	objs := []ssz.HashRoot{
+		&UnionA{Selector: 1, Elem: &UnionElem{A: 1}},
+		&UnionA{Selector: 3, List: []uint16{1, 2}},
+		&OptionalContainer{Value: &value, Elem: &OptionalElem{A: 3, B: []byte{0x1}}},
+		&StableShape{Side: &side, Color: &color},
+		&StableSquare{Side: side, Color: color},
+		&StableBlock{Slot: &slot, Elem: elem, Other: elem},
+		&StableBlockProfile{Slot: slot, Elem: elem, Other: elem},
+	}
+	for _, obj := range objs {
+		// the groups are not merkleized once the context is done
+		w := ssz.NewContextWalker(ctx, ssz.NewHasher())
+		if err := obj.HashTreeRootWith(w); err == nil {
+			require.ErrorIs(t, w.Err(), context.Canceled)
+		}
+
+		_, err := ssz.HashWithContext(ctx, obj)
+		require.ErrorIs(t, err, context.Canceled)
+	}
+}
@@ -0,0 +1,135 @@
+package testcases
+
+import (
+	"fmt"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+type treeObj interface {
+	ssz.HashRoot
+	GetTree() (*ssz.Node, error)
+}
+
+func rootOf(t *testing.T, obj ssz.HashRoot) []byte {
+	root, err := obj.HashTreeRoot()
+	require.NoError(t, err)
+	return root[:]
+}
+
+func TestGindex_Tree(t *testing.T) {
+	progressive := &ProgressiveContainer{
+		Slot:   1,
+		Data:   []byte{0x1, 0x2},
+		Values: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		Roots:  make([][32]byte, 7),
+		Elems:  []*ProgressiveElem{},
+	}
+	for i := range progressive.Roots {
+		progressive.Roots[i][0] = byte(i)
+	}
+	for i := 0; i < 6; i++ {
+		progressive.Elems = append(progressive.Elems, &ProgressiveElem{A: uint64(i), B: []byte{byte(i)}})
+	}
+
+	elem := &StableElem{A: 7, Data: []byte{0x1}}
+	slot := uint64(5)
+	stable := &StableBlock{Slot: &slot, Other: elem}
+	profile := &StableBlockProfile{Slot: 5, Other: elem}
+
+	optional := &OptionalContainer{Slot: 1, Elem: &OptionalElem{A: 3, B: []byte{0x1}}}
+	optionalRoot, err := optional.Elem.HashTreeRoot()
+	require.NoError(t, err)
+	optionalRoot = mixInSelector(optionalRoot, 1)
+
+	list := &ListP{}
+	for i := 0; i < 5; i++ {
+		list.Elems = append(list.Elems, &BytesWrapper{Bytes: make([]byte, 48)})
+		list.Elems[i].Bytes[0] = byte(i)
+	}
+
+	cases := []struct {
+		obj      treeObj
+		fn       func(string) (int, error)
+		path     string
+		expected []byte
+	}{
+		{progressive, ProgressiveContainerGindex, "slot", leafOf(1)},
+		{progressive, ProgressiveContainerGindex, "values[1]", leafOf(1, 2, 3, 4)},
+		{progressive, ProgressiveContainerGindex, "values[9]", leafOf(9, 10)},
+		{progressive, ProgressiveContainerGindex, "roots[5]", progressive.Roots[5][:]},
+		{progressive, ProgressiveContainerGindex, "elems[0]", rootOf(t, progressive.Elems[0])},
+		{progressive, ProgressiveContainerGindex, "elems[5].a", leafOf(5)},
+		{stable, StableBlockGindex, "slot", leafOf(5)},
+		{stable, StableBlockGindex, "other", rootOf(t, elem)},
+		{stable, StableBlockGindex, "other.a", leafOf(7)},
+		{profile, StableBlockProfileGindex, "other.a", leafOf(7)},
+		{optional, OptionalContainerGindex, "elem", optionalRoot[:]},
+		{optional, OptionalContainerGindex, "elem.a", leafOf(3)},
+		{list, ListPGindex, "elems[3]", rootOf(t, list.Elems[3])},
+	}
+
+	for _, c := range cases {
+		gindex, err := c.fn(c.path)
+		require.NoError(t, err, c.path)
+
+		tree, err := c.obj.GetTree()
+		require.NoError(t, err)
+		require.Equal(t, rootOf(t, c.obj), tree.Hash())
+
+		node, err := tree.Get(gindex)
+		require.NoError(t, err, c.path)
+		require.Equal(t, c.expected, node.Hash(), c.path)
+	}
+
+	// the stable fields keep their index across profiles
+	require.Equal(t, StableBlockGindexOther, StableBlockProfileGindexOther)
+	// the only field of a container is the root of the container
+	require.Equal(t, 1, ListPGindexElems)
+}
+
+func TestProveField(t *testing.T) {
+	obj := &ProgressiveContainer{
+		Slot:   1,
+		Values: []uint64{1, 2, 3, 4, 5, 6},
+		Elems:  []*ProgressiveElem{{A: 1}, {A: 2, B: []byte{0x1}}},
+	}
+	root := rootOf(t, obj)
+
+	proof, err := obj.ProveField("elems[1].a")
+	require.NoError(t, err)
+	require.Equal(t, leafOf(2), proof.Leaf)
+
+	ok, err := ssz.VerifyProof(root, proof)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	paths := []string{"slot", "values[5]", "elems[0]"}
+	multiproof, err := obj.ProveFields(paths...)
+	require.NoError(t, err)
+	require.Equal(t, leafOf(1), multiproof.Leaves[0])
+	require.Equal(t, leafOf(5, 6), multiproof.Leaves[1])
+	require.Equal(t, rootOf(t, obj.Elems[0]), multiproof.Leaves[2])
+
+	ok, err = ssz.VerifyMultiproof(root, multiproof.Hashes, multiproof.Leaves, multiproof.Indices)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, err = obj.ProveField("elems[0].c")
+	require.ErrorIs(t, err, ssz.ErrInvalidPath)
+	_, err = obj.ProveFields()
+	require.ErrorIs(t, err, ssz.ErrInvalidPath)
+}
+
+func TestGindex_ElemHelpers(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		gindex, err := ProgressiveContainerGindex(fmt.Sprintf("values[%d]", i))
+		require.NoError(t, err)
+		require.Equal(t, gindex, ProgressiveContainerGindexValuesElem(i))
+	}
+	gindex, err := ListPGindex("elems[3]")
+	require.NoError(t, err)
+	require.Equal(t, gindex, ListPGindexElemsElem(3))
+}
@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -83,6 +86,105 @@ func (i *IntegrationUint) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the IntegrationUint object to a writer
+func (i *IntegrationUint) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := i.fixedSize()
+
+	// Field (0) 'A'
+	dst = ssz.MarshalValue(dst, i.A)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'B'
+	dst = ssz.MarshalValue(dst, i.B)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'C'
+	dst = ssz.MarshalValue(dst, i.C)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'D'
+	dst = ssz.MarshalValue(dst, i.D)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (4) 'A1'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(i.A1) * 1
+
+	// Offset (5) 'A2'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(i.A2) * 2
+
+	// Offset (6) 'A3'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(i.A3) * 4
+
+	// Offset (7) 'A4'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (4) 'A1'
+	if size := uint64(len(i.A1)); size > 400 {
+		err = ssz.ErrListTooBigFn("IntegrationUint.A1", size, 400)
+		return
+	}
+	for ii := 0; ii < len(i.A1); ii++ {
+		dst = ssz.MarshalValue(dst, i.A1[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'A2'
+	if size := uint64(len(i.A2)); size > 400 {
+		err = ssz.ErrListTooBigFn("IntegrationUint.A2", size, 400)
+		return
+	}
+	for ii := 0; ii < len(i.A2); ii++ {
+		dst = ssz.MarshalValue(dst, i.A2[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'A3'
+	if size := uint64(len(i.A3)); size > 400 {
+		err = ssz.ErrListTooBigFn("IntegrationUint.A3", size, 400)
+		return
+	}
+	for ii := 0; ii < len(i.A3); ii++ {
+		dst = ssz.MarshalValue(dst, i.A3[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'A4'
+	if size := uint64(len(i.A4)); size > 400 {
+		err = ssz.ErrListTooBigFn("IntegrationUint.A4", size, 400)
+		return
+	}
+	for ii := 0; ii < len(i.A4); ii++ {
+		dst = ssz.MarshalValue(dst, i.A4[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the IntegrationUint object
 func (i *IntegrationUint) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(i, buf)
@@ -167,6 +269,118 @@ func (i *IntegrationUint) UnmarshalSSZTail(buf []byte) (rest []byte, err error)
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the IntegrationUint object from a reader with the size of the encoding
+func (i *IntegrationUint) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := i.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o4, o5, o6, o7 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'A'
+		i.A, buf = ssz.UnmarshallValue[uint8](buf)
+
+		// Field (1) 'B'
+		i.B, buf = ssz.UnmarshallValue[uint16](buf)
+
+		// Field (2) 'C'
+		i.C, buf = ssz.UnmarshallValue[uint32](buf)
+
+		// Field (3) 'D'
+		i.D, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (4) 'A1'
+		if o4, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (5) 'A2'
+		if o5, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (6) 'A3'
+		if o6, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (7) 'A4'
+		if o7, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (4) 'A1'
+	if o5-o4 > 400 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o5-o4), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&i.A1, buf, 1, 400, func(ii uint64, buf []byte) (err error) {
+			i.A1[ii], buf = ssz.UnmarshallValue[uint8](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (5) 'A2'
+	if o6-o5 > 800 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o6-o5), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&i.A2, buf, 2, 400, func(ii uint64, buf []byte) (err error) {
+			i.A2[ii], buf = ssz.UnmarshallValue[uint16](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (6) 'A3'
+	if o7-o6 > 1600 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o7-o6), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&i.A3, buf, 4, 400, func(ii uint64, buf []byte) (err error) {
+			i.A3[ii], buf = ssz.UnmarshallValue[uint32](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (7) 'A4'
+	if uint64(size-int(o7)) > 3200 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o7), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&i.A4, buf, 8, 400, func(ii uint64, buf []byte) (err error) {
+			i.A4[ii], buf = ssz.UnmarshallValue[uint64](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the IntegrationUint object
 func (i *IntegrationUint) fixedSize() int {
 	return int(31)
@@ -196,6 +410,11 @@ func (i *IntegrationUint) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
 }
 
+// HashTreeRootCtx ssz hashes the IntegrationUint object and stops with the error of the context once it is done
+func (i *IntegrationUint) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, i)
+}
+
 // HashTreeRootWith ssz hashes the IntegrationUint object with a hasher
 func (i *IntegrationUint) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -280,3 +499,89 @@ func (i *IntegrationUint) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (i *IntegrationUint) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+// GetTreeCtx ssz hashes the IntegrationUint object and stops with the error of the context once it is done
+func (i *IntegrationUint) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, i)
+}
+
+// Generalized indices of the IntegrationUint fields
+const (
+	IntegrationUintGindexA  = 8
+	IntegrationUintGindexB  = 9
+	IntegrationUintGindexC  = 10
+	IntegrationUintGindexD  = 11
+	IntegrationUintGindexA1 = 12
+	IntegrationUintGindexA2 = 13
+	IntegrationUintGindexA3 = 14
+	IntegrationUintGindexA4 = 15
+)
+
+// IntegrationUintGindexA1Elem returns the generalized index of the chunk of the i-th element of the A1 field,
+// or zero if it does not fit in an int
+func IntegrationUintGindexA1Elem(i int) int {
+	return ssz.ConcatGindices(IntegrationUintGindexA1, ssz.ListElemGindex(i, 400, 1))
+}
+
+// IntegrationUintGindexA2Elem returns the generalized index of the chunk of the i-th element of the A2 field,
+// or zero if it does not fit in an int
+func IntegrationUintGindexA2Elem(i int) int {
+	return ssz.ConcatGindices(IntegrationUintGindexA2, ssz.ListElemGindex(i, 400, 2))
+}
+
+// IntegrationUintGindexA3Elem returns the generalized index of the chunk of the i-th element of the A3 field,
+// or zero if it does not fit in an int
+func IntegrationUintGindexA3Elem(i int) int {
+	return ssz.ConcatGindices(IntegrationUintGindexA3, ssz.ListElemGindex(i, 400, 4))
+}
+
+// IntegrationUintGindexA4Elem returns the generalized index of the chunk of the i-th element of the A4 field,
+// or zero if it does not fit in an int
+func IntegrationUintGindexA4Elem(i int) int {
+	return ssz.ConcatGindices(IntegrationUintGindexA4, ssz.ListElemGindex(i, 400, 8))
+}
+
+// IntegrationUintGindex returns the generalized index of a field path in the IntegrationUint object
+func IntegrationUintGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(IntegrationUintGindexA, rest, nil)
+	case "b":
+		return ssz.FieldGindex(IntegrationUintGindexB, rest, nil)
+	case "c":
+		return ssz.FieldGindex(IntegrationUintGindexC, rest, nil)
+	case "d":
+		return ssz.FieldGindex(IntegrationUintGindexD, rest, nil)
+	case "a1":
+		return ssz.FieldGindex(IntegrationUintGindexA1, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 400, 1, nil)
+		})
+	case "a2":
+		return ssz.FieldGindex(IntegrationUintGindexA2, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 400, 2, nil)
+		})
+	case "a3":
+		return ssz.FieldGindex(IntegrationUintGindexA3, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 400, 4, nil)
+		})
+	case "a4":
+		return ssz.FieldGindex(IntegrationUintGindexA4, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 400, 8, nil)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("IntegrationUint", field)
+}
+
+// ProveField returns a proof of the field path in the IntegrationUint object
+func (i *IntegrationUint) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(i, IntegrationUintGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the IntegrationUint object
+func (i *IntegrationUint) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(i, IntegrationUintGindex, paths...)
+}
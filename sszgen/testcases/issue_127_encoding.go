@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -43,6 +46,44 @@ func (o *Obj2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Obj2 object to a writer
+func (o *Obj2) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := o.fixedSize()
+
+	// Offset (0) 'T1'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'T1'
+	if size := uint64(len(o.T1)); size > 1024 {
+		err = ssz.ErrListTooBigFn("Obj2.T1", size, 1024)
+		return
+	}
+	{
+		offset = 4 * len(o.T1)
+		for ii := 0; ii < len(o.T1); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(o.T1[ii])
+		}
+	}
+	for ii := 0; ii < len(o.T1); ii++ {
+		if size := uint64(len(o.T1[ii])); size > 256 {
+			err = ssz.ErrBytesLengthFn("Obj2.T1[ii]", size, 256)
+			return
+		}
+		dst = append(dst, o.T1[ii]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Obj2 object
 func (o *Obj2) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(o, buf)
@@ -78,6 +119,53 @@ func (o *Obj2) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the Obj2 object from a reader with the size of the encoding
+func (o *Obj2) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := o.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'T1'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'T1'
+	{
+		var sizes []int
+		if sizes, err = rr.ReadOffsets(size-int(o0), 1024); err != nil {
+			return
+		}
+		num := len(sizes)
+		o.T1 = make([]Data, num)
+		for ii, size := range sizes {
+			if uint64(size) > 256 {
+				return ssz.ErrListTooBig
+			}
+			if err = rr.Decode(size, func(buf []byte) (_ []byte, err error) {
+				if o.T1[ii], err = ssz.UnmarshalDynamicBytes(o.T1[ii], buf, 256); err != nil {
+					return
+				}
+				return buf, nil
+			}); err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Obj2 object
 func (o *Obj2) fixedSize() int {
 	return int(4)
@@ -101,6 +189,11 @@ func (o *Obj2) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(o)
 }
 
+// HashTreeRootCtx ssz hashes the Obj2 object and stops with the error of the context once it is done
+func (o *Obj2) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, o)
+}
+
 // HashTreeRootWith ssz hashes the Obj2 object with a hasher
 func (o *Obj2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -136,3 +229,44 @@ func (o *Obj2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (o *Obj2) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(o)
 }
+
+// GetTreeCtx ssz hashes the Obj2 object and stops with the error of the context once it is done
+func (o *Obj2) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, o)
+}
+
+// Generalized indices of the Obj2 fields
+const (
+	Obj2GindexT1 = 1
+)
+
+// Obj2GindexT1Elem returns the generalized index of the chunk of the i-th element of the T1 field,
+// or zero if it does not fit in an int
+func Obj2GindexT1Elem(i int) int {
+	return ssz.ConcatGindices(Obj2GindexT1, ssz.ListElemGindex(i, 1024, 0))
+}
+
+// Obj2Gindex returns the generalized index of a field path in the Obj2 object
+func Obj2Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "t1":
+		return ssz.FieldGindex(Obj2GindexT1, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1024, 0, nil)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("Obj2", field)
+}
+
+// ProveField returns a proof of the field path in the Obj2 object
+func (o *Obj2) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(o, Obj2Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Obj2 object
+func (o *Obj2) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(o, Obj2Gindex, paths...)
+}
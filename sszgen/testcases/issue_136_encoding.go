@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -24,6 +27,22 @@ func (i *Issue136) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Issue136 object to a writer
+func (i *Issue136) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'C'
+	if dst, err = ww.WriteObject(dst, &i.C); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Issue136 object
 func (i *Issue136) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(i, buf)
@@ -45,6 +64,28 @@ func (i *Issue136) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Issue136 object from a reader with the size of the encoding
+func (i *Issue136) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := i.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'C'
+		if buf, err = i.C.UnmarshalSSZTail(buf); err != nil {
+			return
+		}
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Issue136 object
 func (i *Issue136) fixedSize() int {
 	return int(0)
@@ -61,6 +102,11 @@ func (i *Issue136) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
 }
 
+// HashTreeRootCtx ssz hashes the Issue136 object and stops with the error of the context once it is done
+func (i *Issue136) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, i)
+}
+
 // HashTreeRootWith ssz hashes the Issue136 object with a hasher
 func (i *Issue136) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -78,3 +124,36 @@ func (i *Issue136) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (i *Issue136) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+// GetTreeCtx ssz hashes the Issue136 object and stops with the error of the context once it is done
+func (i *Issue136) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, i)
+}
+
+// Generalized indices of the Issue136 fields
+const (
+	Issue136GindexC = 1
+)
+
+// Issue136Gindex returns the generalized index of a field path in the Issue136 object
+func Issue136Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "c":
+		return ssz.FieldGindex(Issue136GindexC, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Issue136", field)
+}
+
+// ProveField returns a proof of the field path in the Issue136 object
+func (i *Issue136) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(i, Issue136Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Issue136 object
+func (i *Issue136) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(i, Issue136Gindex, paths...)
+}
@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -28,6 +31,35 @@ func (i *Issue153) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Issue153 object to a writer
+func (i *Issue153) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Value1'
+	dst = append(dst, i.Value1[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Value2'
+	dst = append(dst, i.Value2[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Value'
+	dst = append(dst, i.Value[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Issue153 object
 func (i *Issue153) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(i, buf)
@@ -53,6 +85,32 @@ func (i *Issue153) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Issue153 object from a reader with the size of the encoding
+func (i *Issue153) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := i.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Value1'
+		buf = ssz.UnmarshalFixedBytes(i.Value1[:], buf)
+
+		// Field (1) 'Value2'
+		buf = ssz.UnmarshalFixedBytes(i.Value2[:], buf)
+
+		// Field (2) 'Value'
+		buf = ssz.UnmarshalFixedBytes(i.Value[:], buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Issue153 object
 func (i *Issue153) fixedSize() int {
 	return int(128)
@@ -69,6 +127,11 @@ func (i *Issue153) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
 }
 
+// HashTreeRootCtx ssz hashes the Issue153 object and stops with the error of the context once it is done
+func (i *Issue153) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, i)
+}
+
 // HashTreeRootWith ssz hashes the Issue153 object with a hasher
 func (i *Issue153) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -90,3 +153,42 @@ func (i *Issue153) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (i *Issue153) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+// GetTreeCtx ssz hashes the Issue153 object and stops with the error of the context once it is done
+func (i *Issue153) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, i)
+}
+
+// Generalized indices of the Issue153 fields
+const (
+	Issue153GindexValue1 = 4
+	Issue153GindexValue2 = 5
+	Issue153GindexValue  = 6
+)
+
+// Issue153Gindex returns the generalized index of a field path in the Issue153 object
+func Issue153Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "value1":
+		return ssz.FieldGindex(Issue153GindexValue1, rest, nil)
+	case "value2":
+		return ssz.FieldGindex(Issue153GindexValue2, rest, nil)
+	case "value":
+		return ssz.FieldGindex(Issue153GindexValue, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Issue153", field)
+}
+
+// ProveField returns a proof of the field path in the Issue153 object
+func (i *Issue153) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(i, Issue153Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Issue153 object
+func (i *Issue153) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(i, Issue153Gindex, paths...)
+}
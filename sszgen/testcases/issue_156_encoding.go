@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -35,6 +38,45 @@ func (i *Issue156) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Issue156 object to a writer
+func (i *Issue156) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'A'
+	dst = append(dst, i.A[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'A2'
+	dst = append(dst, i.A2[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'A3'
+	dst = append(dst, i.A3[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'A4'
+	if size := uint64(len(i.A4)); size != 32 {
+		err = ssz.ErrBytesLengthFn("Issue156.A4", size, 32)
+		return
+	}
+	dst = append(dst, i.A4...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Issue156 object
 func (i *Issue156) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(i, buf)
@@ -63,6 +105,35 @@ func (i *Issue156) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Issue156 object from a reader with the size of the encoding
+func (i *Issue156) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := i.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'A'
+		buf = ssz.UnmarshalFixedBytes(i.A[:], buf)
+
+		// Field (1) 'A2'
+		buf = ssz.UnmarshalFixedBytes(i.A2[:], buf)
+
+		// Field (2) 'A3'
+		buf = ssz.UnmarshalFixedBytes(i.A3[:], buf)
+
+		// Field (3) 'A4'
+		i.A4, buf = ssz.UnmarshalBytes(i.A4, buf, 32)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Issue156 object
 func (i *Issue156) fixedSize() int {
 	return int(128)
@@ -79,6 +150,11 @@ func (i *Issue156) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
 }
 
+// HashTreeRootCtx ssz hashes the Issue156 object and stops with the error of the context once it is done
+func (i *Issue156) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, i)
+}
+
 // HashTreeRootWith ssz hashes the Issue156 object with a hasher
 func (i *Issue156) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -107,3 +183,45 @@ func (i *Issue156) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (i *Issue156) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+// GetTreeCtx ssz hashes the Issue156 object and stops with the error of the context once it is done
+func (i *Issue156) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, i)
+}
+
+// Generalized indices of the Issue156 fields
+const (
+	Issue156GindexA  = 4
+	Issue156GindexA2 = 5
+	Issue156GindexA3 = 6
+	Issue156GindexA4 = 7
+)
+
+// Issue156Gindex returns the generalized index of a field path in the Issue156 object
+func Issue156Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(Issue156GindexA, rest, nil)
+	case "a2":
+		return ssz.FieldGindex(Issue156GindexA2, rest, nil)
+	case "a3":
+		return ssz.FieldGindex(Issue156GindexA3, rest, nil)
+	case "a4":
+		return ssz.FieldGindex(Issue156GindexA4, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Issue156", field)
+}
+
+// ProveField returns a proof of the field path in the Issue156 object
+func (i *Issue156) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(i, Issue156Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Issue156 object
+func (i *Issue156) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(i, Issue156Gindex, paths...)
+}
@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -17,6 +20,9 @@ func (i *Issue158) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 
 	// Field (0) 'Field'
+	if i.Field == nil {
+		i.Field = new(Int)
+	}
 	if dst, err = i.Field.MarshalSSZTo(dst); err != nil {
 		return
 	}
@@ -24,6 +30,25 @@ func (i *Issue158) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Issue158 object to a writer
+func (i *Issue158) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Field'
+	if i.Field == nil {
+		i.Field = new(Int)
+	}
+	if dst, err = ww.WriteObject(dst, i.Field); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Issue158 object
 func (i *Issue158) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(i, buf)
@@ -38,13 +63,35 @@ func (i *Issue158) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	}
 
 	// Field (0) 'Field'
-	if buf, err = i.Field.UnmarshalSSZTail(buf); err != nil {
+	if buf, err = ssz.UnmarshalFieldTail(&i.Field, buf); err != nil {
 		return
 	}
 
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Issue158 object from a reader with the size of the encoding
+func (i *Issue158) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := i.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Field'
+		if buf, err = ssz.UnmarshalFieldTail(&i.Field, buf); err != nil {
+			return
+		}
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Issue158 object
 func (i *Issue158) fixedSize() int {
 	return int(0)
@@ -61,11 +108,19 @@ func (i *Issue158) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
 }
 
+// HashTreeRootCtx ssz hashes the Issue158 object and stops with the error of the context once it is done
+func (i *Issue158) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, i)
+}
+
 // HashTreeRootWith ssz hashes the Issue158 object with a hasher
 func (i *Issue158) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'Field'
+	if i.Field == nil {
+		i.Field = new(Int)
+	}
 	if err = i.Field.HashTreeRootWith(hh); err != nil {
 		return
 	}
@@ -78,3 +133,36 @@ func (i *Issue158) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (i *Issue158) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+// GetTreeCtx ssz hashes the Issue158 object and stops with the error of the context once it is done
+func (i *Issue158) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, i)
+}
+
+// Generalized indices of the Issue158 fields
+const (
+	Issue158GindexField = 1
+)
+
+// Issue158Gindex returns the generalized index of a field path in the Issue158 object
+func Issue158Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "field":
+		return ssz.FieldGindex(Issue158GindexField, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Issue158", field)
+}
+
+// ProveField returns a proof of the field path in the Issue158 object
+func (i *Issue158) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(i, Issue158Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Issue158 object
+func (i *Issue158) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(i, Issue158Gindex, paths...)
+}
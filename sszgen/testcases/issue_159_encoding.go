@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -25,6 +28,29 @@ func (i *Issue159[B]) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Issue159[B] object to a writer
+func (i *Issue159[B]) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Data'
+	dst = append(dst, i.Data[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Data2'
+	dst = append(dst, i.Data2[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Issue159[B] object
 func (i *Issue159[B]) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(i, buf)
@@ -47,6 +73,29 @@ func (i *Issue159[B]) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Issue159[B] object from a reader with the size of the encoding
+func (i *Issue159[B]) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := i.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Data'
+		buf = ssz.UnmarshalFixedBytes(i.Data[:], buf)
+
+		// Field (1) 'Data2'
+		buf = ssz.UnmarshalFixedBytes(i.Data2[:], buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Issue159[B] object
 func (i *Issue159[B]) fixedSize() int {
 	return int(90)
@@ -63,6 +112,11 @@ func (i *Issue159[B]) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
 }
 
+// HashTreeRootCtx ssz hashes the Issue159[B] object and stops with the error of the context once it is done
+func (i *Issue159[B]) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, i)
+}
+
 // HashTreeRootWith ssz hashes the Issue159[B] object with a hasher
 func (i *Issue159[B]) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -81,3 +135,8 @@ func (i *Issue159[B]) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (i *Issue159[B]) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+// GetTreeCtx ssz hashes the Issue159[B] object and stops with the error of the context once it is done
+func (i *Issue159[B]) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, i)
+}
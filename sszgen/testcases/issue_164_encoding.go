@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -22,6 +25,23 @@ func (i *Issue64) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Issue64 object to a writer
+func (i *Issue64) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'FeeRecipientAddress'
+	dst = append(dst, i.FeeRecipientAddress[:]...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Issue64 object
 func (i *Issue64) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(i, buf)
@@ -41,6 +61,26 @@ func (i *Issue64) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Issue64 object from a reader with the size of the encoding
+func (i *Issue64) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := i.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'FeeRecipientAddress'
+		buf = ssz.UnmarshalFixedBytes(i.FeeRecipientAddress[:], buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Issue64 object
 func (i *Issue64) fixedSize() int {
 	return int(120)
@@ -57,6 +97,11 @@ func (i *Issue64) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
 }
 
+// HashTreeRootCtx ssz hashes the Issue64 object and stops with the error of the context once it is done
+func (i *Issue64) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, i)
+}
+
 // HashTreeRootWith ssz hashes the Issue64 object with a hasher
 func (i *Issue64) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -72,3 +117,36 @@ func (i *Issue64) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (i *Issue64) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+// GetTreeCtx ssz hashes the Issue64 object and stops with the error of the context once it is done
+func (i *Issue64) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, i)
+}
+
+// Generalized indices of the Issue64 fields
+const (
+	Issue64GindexFeeRecipientAddress = 1
+)
+
+// Issue64Gindex returns the generalized index of a field path in the Issue64 object
+func Issue64Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "fee_recipient_address":
+		return ssz.FieldGindex(Issue64GindexFeeRecipientAddress, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Issue64", field)
+}
+
+// ProveField returns a proof of the field path in the Issue64 object
+func (i *Issue64) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(i, Issue64Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Issue64 object
+func (i *Issue64) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(i, Issue64Gindex, paths...)
+}
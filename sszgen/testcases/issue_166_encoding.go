@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -41,6 +44,45 @@ func (i *Issue165) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Issue165 object to a writer
+func (i *Issue165) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := i.fixedSize()
+
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(i.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'A'
+	if size := uint64(len(i.A)); size > 0 {
+		err = ssz.ErrBytesLengthFn("Issue165.A", size, 0)
+		return
+	}
+	dst = append(dst, i.A...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'B'
+	if size := uint64(len(i.B)); size > 0 {
+		err = ssz.ErrBytesLengthFn("Issue165.B", size, 0)
+		return
+	}
+	dst = append(dst, i.B...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Issue165 object
 func (i *Issue165) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(i, buf)
@@ -81,6 +123,60 @@ func (i *Issue165) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the Issue165 object from a reader with the size of the encoding
+func (i *Issue165) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := i.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0, o1 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'A'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (1) 'B'
+		if o1, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'A'
+	if o1-o0 > 0 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o1-o0), func(buf []byte) (_ []byte, err error) {
+		if i.A, err = ssz.UnmarshalDynamicBytes(i.A, buf, 0); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (1) 'B'
+	if uint64(size-int(o1)) > 0 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o1), func(buf []byte) (_ []byte, err error) {
+		if i.B, err = ssz.UnmarshalDynamicBytes(i.B, buf, 0); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Issue165 object
 func (i *Issue165) fixedSize() int {
 	return int(8)
@@ -104,6 +200,11 @@ func (i *Issue165) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
 }
 
+// HashTreeRootCtx ssz hashes the Issue165 object and stops with the error of the context once it is done
+func (i *Issue165) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, i)
+}
+
 // HashTreeRootWith ssz hashes the Issue165 object with a hasher
 func (i *Issue165) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -140,3 +241,39 @@ func (i *Issue165) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (i *Issue165) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+// GetTreeCtx ssz hashes the Issue165 object and stops with the error of the context once it is done
+func (i *Issue165) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, i)
+}
+
+// Generalized indices of the Issue165 fields
+const (
+	Issue165GindexA = 2
+	Issue165GindexB = 3
+)
+
+// Issue165Gindex returns the generalized index of a field path in the Issue165 object
+func Issue165Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(Issue165GindexA, rest, nil)
+	case "b":
+		return ssz.FieldGindex(Issue165GindexB, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Issue165", field)
+}
+
+// ProveField returns a proof of the field path in the Issue165 object
+func (i *Issue165) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(i, Issue165Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Issue165 object
+func (i *Issue165) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(i, Issue165Gindex, paths...)
+}
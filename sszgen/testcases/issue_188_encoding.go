@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -33,6 +36,37 @@ func (i *Issue188) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Issue188 object to a writer
+func (i *Issue188) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Name'
+	if size := uint64(len(i.Name)); size != 32 {
+		err = ssz.ErrBytesLengthFn("Issue188.Name", size, 32)
+		return
+	}
+	dst = append(dst, i.Name...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Address'
+	if size := uint64(len(i.Address)); size != 32 {
+		err = ssz.ErrBytesLengthFn("Issue188.Address", size, 32)
+		return
+	}
+	dst = append(dst, i.Address...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Issue188 object
 func (i *Issue188) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(i, buf)
@@ -55,6 +89,29 @@ func (i *Issue188) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Issue188 object from a reader with the size of the encoding
+func (i *Issue188) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := i.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Name'
+		i.Name, buf = ssz.UnmarshalBytes(i.Name, buf, 32)
+
+		// Field (1) 'Address'
+		i.Address, buf = ssz.UnmarshalBytes(i.Address, buf, 32)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Issue188 object
 func (i *Issue188) fixedSize() int {
 	return int(64)
@@ -71,6 +128,11 @@ func (i *Issue188) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
 }
 
+// HashTreeRootCtx ssz hashes the Issue188 object and stops with the error of the context once it is done
+func (i *Issue188) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, i)
+}
+
 // HashTreeRootWith ssz hashes the Issue188 object with a hasher
 func (i *Issue188) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -97,3 +159,39 @@ func (i *Issue188) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (i *Issue188) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+// GetTreeCtx ssz hashes the Issue188 object and stops with the error of the context once it is done
+func (i *Issue188) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, i)
+}
+
+// Generalized indices of the Issue188 fields
+const (
+	Issue188GindexName    = 2
+	Issue188GindexAddress = 3
+)
+
+// Issue188Gindex returns the generalized index of a field path in the Issue188 object
+func Issue188Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "name":
+		return ssz.FieldGindex(Issue188GindexName, rest, nil)
+	case "address":
+		return ssz.FieldGindex(Issue188GindexAddress, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Issue188", field)
+}
+
+// ProveField returns a proof of the field path in the Issue188 object
+func (i *Issue188) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(i, Issue188Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Issue188 object
+func (i *Issue188) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(i, Issue188Gindex, paths...)
+}
@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -22,6 +25,23 @@ func (i *Issue22) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Issue22 object to a writer
+func (i *Issue22) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Name'
+	dst = ssz.MarshalValue(dst, i.Name)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Issue22 object
 func (i *Issue22) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(i, buf)
@@ -44,6 +64,29 @@ func (i *Issue22) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Issue22 object from a reader with the size of the encoding
+func (i *Issue22) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := i.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Name'
+		if err = ssz.IsValidBool(buf); err != nil {
+			return
+		}
+		i.Name, buf = ssz.UnmarshallValue[bool](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Issue22 object
 func (i *Issue22) fixedSize() int {
 	return int(1)
@@ -60,6 +103,11 @@ func (i *Issue22) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
 }
 
+// HashTreeRootCtx ssz hashes the Issue22 object and stops with the error of the context once it is done
+func (i *Issue22) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, i)
+}
+
 // HashTreeRootWith ssz hashes the Issue22 object with a hasher
 func (i *Issue22) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -75,3 +123,36 @@ func (i *Issue22) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (i *Issue22) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+// GetTreeCtx ssz hashes the Issue22 object and stops with the error of the context once it is done
+func (i *Issue22) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, i)
+}
+
+// Generalized indices of the Issue22 fields
+const (
+	Issue22GindexName = 1
+)
+
+// Issue22Gindex returns the generalized index of a field path in the Issue22 object
+func Issue22Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "name":
+		return ssz.FieldGindex(Issue22GindexName, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Issue22", field)
+}
+
+// ProveField returns a proof of the field path in the Issue22 object
+func (i *Issue22) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(i, Issue22Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Issue22 object
+func (i *Issue22) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(i, Issue22Gindex, paths...)
+}
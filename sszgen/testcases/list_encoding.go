@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -26,6 +29,27 @@ func (b *BytesWrapper) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the BytesWrapper object to a writer
+func (b *BytesWrapper) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Bytes'
+	if size := uint64(len(b.Bytes)); size != 48 {
+		err = ssz.ErrBytesLengthFn("BytesWrapper.Bytes", size, 48)
+		return
+	}
+	dst = append(dst, b.Bytes...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the BytesWrapper object
 func (b *BytesWrapper) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(b, buf)
@@ -45,6 +69,26 @@ func (b *BytesWrapper) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the BytesWrapper object from a reader with the size of the encoding
+func (b *BytesWrapper) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := b.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Bytes'
+		b.Bytes, buf = ssz.UnmarshalBytes(b.Bytes, buf, 48)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the BytesWrapper object
 func (b *BytesWrapper) fixedSize() int {
 	return int(48)
@@ -61,6 +105,11 @@ func (b *BytesWrapper) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
+// HashTreeRootCtx ssz hashes the BytesWrapper object and stops with the error of the context once it is done
+func (b *BytesWrapper) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, b)
+}
+
 // HashTreeRootWith ssz hashes the BytesWrapper object with a hasher
 func (b *BytesWrapper) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -81,6 +130,39 @@ func (b *BytesWrapper) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
+// GetTreeCtx ssz hashes the BytesWrapper object and stops with the error of the context once it is done
+func (b *BytesWrapper) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, b)
+}
+
+// Generalized indices of the BytesWrapper fields
+const (
+	BytesWrapperGindexBytes = 1
+)
+
+// BytesWrapperGindex returns the generalized index of a field path in the BytesWrapper object
+func BytesWrapperGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "bytes":
+		return ssz.FieldGindex(BytesWrapperGindexBytes, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("BytesWrapper", field)
+}
+
+// ProveField returns a proof of the field path in the BytesWrapper object
+func (b *BytesWrapper) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(b, BytesWrapperGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the BytesWrapper object
+func (b *BytesWrapper) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(b, BytesWrapperGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the ListC object
 func (l *ListC) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(l)
@@ -108,6 +190,32 @@ func (l *ListC) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the ListC object to a writer
+func (l *ListC) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := l.fixedSize()
+
+	// Offset (0) 'Elems'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Elems'
+	if size := uint64(len(l.Elems)); size > 32 {
+		err = ssz.ErrListTooBigFn("ListC.Elems", size, 32)
+		return
+	}
+	for ii := 0; ii < len(l.Elems); ii++ {
+		if dst, err = ww.WriteObject(dst, &l.Elems[ii]); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the ListC object
 func (l *ListC) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(l, buf)
@@ -143,6 +251,48 @@ func (l *ListC) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the ListC object from a reader with the size of the encoding
+func (l *ListC) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := l.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'Elems'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'Elems'
+	if uint64(size-int(o0)) > 1536 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o0), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&l.Elems, buf, 48, 32, func(ii uint64, buf []byte) (err error) {
+			if buf, err = l.Elems[ii].UnmarshalSSZTail(buf); err != nil {
+				return
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the ListC object
 func (l *ListC) fixedSize() int {
 	return int(4)
@@ -163,6 +313,11 @@ func (l *ListC) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(l)
 }
 
+// HashTreeRootCtx ssz hashes the ListC object and stops with the error of the context once it is done
+func (l *ListC) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, l)
+}
+
 // HashTreeRootWith ssz hashes the ListC object with a hasher
 func (l *ListC) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -192,6 +347,47 @@ func (l *ListC) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(l)
 }
 
+// GetTreeCtx ssz hashes the ListC object and stops with the error of the context once it is done
+func (l *ListC) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, l)
+}
+
+// Generalized indices of the ListC fields
+const (
+	ListCGindexElems = 1
+)
+
+// ListCGindexElemsElem returns the generalized index of the chunk of the i-th element of the Elems field,
+// or zero if it does not fit in an int
+func ListCGindexElemsElem(i int) int {
+	return ssz.ConcatGindices(ListCGindexElems, ssz.ListElemGindex(i, 32, 0))
+}
+
+// ListCGindex returns the generalized index of a field path in the ListC object
+func ListCGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "elems":
+		return ssz.FieldGindex(ListCGindexElems, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 32, 0, BytesWrapperGindex)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("ListC", field)
+}
+
+// ProveField returns a proof of the field path in the ListC object
+func (l *ListC) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(l, ListCGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the ListC object
+func (l *ListC) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(l, ListCGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the ListP object
 func (l *ListP) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(l)
@@ -219,6 +415,32 @@ func (l *ListP) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the ListP object to a writer
+func (l *ListP) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := l.fixedSize()
+
+	// Offset (0) 'Elems'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Elems'
+	if size := uint64(len(l.Elems)); size > 32 {
+		err = ssz.ErrListTooBigFn("ListP.Elems", size, 32)
+		return
+	}
+	for ii := 0; ii < len(l.Elems); ii++ {
+		if dst, err = ww.WriteObject(dst, l.Elems[ii]); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the ListP object
 func (l *ListP) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(l, buf)
@@ -249,6 +471,35 @@ func (l *ListP) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the ListP object from a reader with the size of the encoding
+func (l *ListP) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := l.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'Elems'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'Elems'
+	if err = ssz.ReadSliceSSZ(rr, &l.Elems, size-int(o0), 32); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the ListP object
 func (l *ListP) fixedSize() int {
 	return int(4)
@@ -269,6 +520,11 @@ func (l *ListP) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(l)
 }
 
+// HashTreeRootCtx ssz hashes the ListP object and stops with the error of the context once it is done
+func (l *ListP) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, l)
+}
+
 // HashTreeRootWith ssz hashes the ListP object with a hasher
 func (l *ListP) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -281,10 +537,8 @@ func (l *ListP) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range l.Elems {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, l.Elems); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 32)
 	}
@@ -297,3 +551,44 @@ func (l *ListP) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (l *ListP) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(l)
 }
+
+// GetTreeCtx ssz hashes the ListP object and stops with the error of the context once it is done
+func (l *ListP) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, l)
+}
+
+// Generalized indices of the ListP fields
+const (
+	ListPGindexElems = 1
+)
+
+// ListPGindexElemsElem returns the generalized index of the chunk of the i-th element of the Elems field,
+// or zero if it does not fit in an int
+func ListPGindexElemsElem(i int) int {
+	return ssz.ConcatGindices(ListPGindexElems, ssz.ListElemGindex(i, 32, 0))
+}
+
+// ListPGindex returns the generalized index of a field path in the ListP object
+func ListPGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "elems":
+		return ssz.FieldGindex(ListPGindexElems, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 32, 0, BytesWrapperGindex)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("ListP", field)
+}
+
+// ProveField returns a proof of the field path in the ListP object
+func (l *ListP) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(l, ListPGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the ListP object
+func (l *ListP) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(l, ListPGindex, paths...)
+}
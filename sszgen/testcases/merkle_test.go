@@ -0,0 +1,68 @@
+package testcases
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// The reference merkleization of the consensus specs, which the tests use to
+// check the roots of the generated code without the hasher of the ssz package.
+
+// leafOf returns a chunk with the uint64 values packed in little endian
+func leafOf(values ...uint64) []byte {
+	buf := make([]byte, 32)
+	for i, v := range values {
+		binary.LittleEndian.PutUint64(buf[i*8:], v)
+	}
+	return buf
+}
+
+// hashChunks returns the hash of two chunks
+func hashChunks(a, b [32]byte) [32]byte {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+// merkleize returns the root of the chunks padded with zero chunks up to the limit
+func merkleize(chunks []byte, limit int) [32]byte {
+	layer := make([]byte, limit*32)
+	copy(layer, chunks)
+	for len(layer) > 32 {
+		next := make([]byte, len(layer)/2)
+		for i := 0; i < len(layer); i += 64 {
+			root := sha256.Sum256(layer[i : i+64])
+			copy(next[i/2:], root[:])
+		}
+		layer = next
+	}
+	var root [32]byte
+	copy(root[:], layer)
+	return root
+}
+
+// merkleizeProgressive is the reference implementation of the progressive
+// merkleization from EIP-7916
+func merkleizeProgressive(chunks []byte, numLeaves int) [32]byte {
+	if len(chunks) == 0 {
+		return [32]byte{}
+	}
+	size := numLeaves * 32
+	if size > len(chunks) {
+		size = len(chunks)
+	}
+	rest := merkleizeProgressive(chunks[size:], numLeaves*4)
+	return hashChunks(rest, merkleize(chunks[:size], numLeaves))
+}
+
+// mixInLength mixes in the length of a list
+func mixInLength(root [32]byte, num uint64) [32]byte {
+	var length [32]byte
+	copy(length[:], leafOf(num))
+	return hashChunks(root, length)
+}
+
+// mixInSelector mixes in the selector of a union or the presence of an optional value
+func mixInSelector(root [32]byte, selector uint8) [32]byte {
+	var chunk [32]byte
+	chunk[0] = selector
+	return hashChunks(root, chunk)
+}
@@ -0,0 +1,17 @@
+package testcases
+
+//go:generate go run ../main.go --path optional.go
+
+type OptionalElem struct {
+	A uint64
+	B []byte `ssz-max:"16"`
+}
+
+type OptionalContainer struct {
+	Slot  uint64
+	Value *uint64       `ssz:"optional"`
+	Flag  *bool         `ssz:"optional"`
+	Elem  *OptionalElem `ssz:"optional"`
+	Data  []byte        `ssz-max:"32"`
+	Small *uint16       `ssz:"optional"`
+}
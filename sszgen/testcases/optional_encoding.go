@@ -0,0 +1,733 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: a21eab14d85b31f39be1b9eddb1ea0a5a8fc5f3af6b85fdbe7ac20cd2e0d063d
+// Version: 2.0.0
+package testcases
+
+import (
+	"context"
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the OptionalElem object
+func (o *OptionalElem) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(o)
+}
+
+// MarshalSSZTo ssz marshals the OptionalElem object to a target array
+func (o *OptionalElem) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := o.fixedSize()
+
+	// Field (0) 'A'
+	dst = ssz.MarshalValue(dst, o.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := uint64(len(o.B)); size > 16 {
+		err = ssz.ErrBytesLengthFn("OptionalElem.B", size, 16)
+		return
+	}
+	dst = append(dst, o.B...)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the OptionalElem object to a writer
+func (o *OptionalElem) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := o.fixedSize()
+
+	// Field (0) 'A'
+	dst = ssz.MarshalValue(dst, o.A)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := uint64(len(o.B)); size > 16 {
+		err = ssz.ErrBytesLengthFn("OptionalElem.B", size, 16)
+		return
+	}
+	dst = append(dst, o.B...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the OptionalElem object
+func (o *OptionalElem) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(o, buf)
+}
+
+// UnmarshalSSZTail unmarshals the OptionalElem object and returns the remaining bufferº
+func (o *OptionalElem) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := o.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'A'
+	o.A, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Offset (1) 'B'
+	if o1, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'B'
+	if o.B, err = ssz.UnmarshalDynamicBytes(o.B, tail[o1:], 16); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the OptionalElem object from a reader with the size of the encoding
+func (o *OptionalElem) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := o.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o1 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'A'
+		o.A, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (1) 'B'
+		if o1, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (1) 'B'
+	if uint64(size-int(o1)) > 16 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o1), func(buf []byte) (_ []byte, err error) {
+		if o.B, err = ssz.UnmarshalDynamicBytes(o.B, buf, 16); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the OptionalElem object
+func (o *OptionalElem) fixedSize() int {
+	return int(12)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the OptionalElem object
+func (o *OptionalElem) SizeSSZ() (size int) {
+	size = o.fixedSize()
+
+	// Field (1) 'B'
+	size += len(o.B)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the OptionalElem object
+func (o *OptionalElem) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(o)
+}
+
+// HashTreeRootCtx ssz hashes the OptionalElem object and stops with the error of the context once it is done
+func (o *OptionalElem) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, o)
+}
+
+// HashTreeRootWith ssz hashes the OptionalElem object with a hasher
+func (o *OptionalElem) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(o.A)
+
+	// Field (1) 'B'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(o.B))
+		if byteLen > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(o.B)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (16+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the OptionalElem object
+func (o *OptionalElem) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(o)
+}
+
+// GetTreeCtx ssz hashes the OptionalElem object and stops with the error of the context once it is done
+func (o *OptionalElem) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, o)
+}
+
+// Generalized indices of the OptionalElem fields
+const (
+	OptionalElemGindexA = 2
+	OptionalElemGindexB = 3
+)
+
+// OptionalElemGindex returns the generalized index of a field path in the OptionalElem object
+func OptionalElemGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(OptionalElemGindexA, rest, nil)
+	case "b":
+		return ssz.FieldGindex(OptionalElemGindexB, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("OptionalElem", field)
+}
+
+// ProveField returns a proof of the field path in the OptionalElem object
+func (o *OptionalElem) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(o, OptionalElemGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the OptionalElem object
+func (o *OptionalElem) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(o, OptionalElemGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the OptionalContainer object
+func (o *OptionalContainer) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(o)
+}
+
+// MarshalSSZTo ssz marshals the OptionalContainer object to a target array
+func (o *OptionalContainer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := o.fixedSize()
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, o.Slot)
+
+	// Offset (1) 'Value'
+	dst = ssz.WriteOffset(dst, offset)
+	if o.Value != nil {
+		offset += 1 + 8
+	}
+
+	// Offset (2) 'Flag'
+	dst = ssz.WriteOffset(dst, offset)
+	if o.Flag != nil {
+		offset += 1 + 1
+	}
+
+	// Offset (3) 'Elem'
+	dst = ssz.WriteOffset(dst, offset)
+	if o.Elem != nil {
+		offset += 1 + o.Elem.SizeSSZ()
+	}
+
+	// Offset (4) 'Data'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(o.Data)
+
+	// Offset (5) 'Small'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Value'
+	if o.Value != nil {
+		dst = append(dst, 1)
+		dst = ssz.MarshalValue(dst, *o.Value)
+	}
+
+	// Field (2) 'Flag'
+	if o.Flag != nil {
+		dst = append(dst, 1)
+		dst = ssz.MarshalValue(dst, *o.Flag)
+	}
+
+	// Field (3) 'Elem'
+	if o.Elem != nil {
+		dst = append(dst, 1)
+		if dst, err = o.Elem.MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'Data'
+	if size := uint64(len(o.Data)); size > 32 {
+		err = ssz.ErrBytesLengthFn("OptionalContainer.Data", size, 32)
+		return
+	}
+	dst = append(dst, o.Data...)
+
+	// Field (5) 'Small'
+	if o.Small != nil {
+		dst = append(dst, 1)
+		dst = ssz.MarshalValue(dst, *o.Small)
+	}
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the OptionalContainer object to a writer
+func (o *OptionalContainer) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := o.fixedSize()
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, o.Slot)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (1) 'Value'
+	dst = ssz.WriteOffset(dst, offset)
+	if o.Value != nil {
+		offset += 1 + 8
+	}
+
+	// Offset (2) 'Flag'
+	dst = ssz.WriteOffset(dst, offset)
+	if o.Flag != nil {
+		offset += 1 + 1
+	}
+
+	// Offset (3) 'Elem'
+	dst = ssz.WriteOffset(dst, offset)
+	if o.Elem != nil {
+		offset += 1 + o.Elem.SizeSSZ()
+	}
+
+	// Offset (4) 'Data'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(o.Data)
+
+	// Offset (5) 'Small'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Value'
+	if o.Value != nil {
+		dst = append(dst, 1)
+		dst = ssz.MarshalValue(dst, *o.Value)
+	}
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Flag'
+	if o.Flag != nil {
+		dst = append(dst, 1)
+		dst = ssz.MarshalValue(dst, *o.Flag)
+	}
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'Elem'
+	if o.Elem != nil {
+		dst = append(dst, 1)
+		if dst, err = o.Elem.MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'Data'
+	if size := uint64(len(o.Data)); size > 32 {
+		err = ssz.ErrBytesLengthFn("OptionalContainer.Data", size, 32)
+		return
+	}
+	dst = append(dst, o.Data...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'Small'
+	if o.Small != nil {
+		dst = append(dst, 1)
+		dst = ssz.MarshalValue(dst, *o.Small)
+	}
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the OptionalContainer object
+func (o *OptionalContainer) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(o, buf)
+}
+
+// UnmarshalSSZTail unmarshals the OptionalContainer object and returns the remaining bufferº
+func (o *OptionalContainer) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := o.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o2, o3, o4, o5 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'Slot'
+	o.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Offset (1) 'Value'
+	if o1, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (2) 'Flag'
+	if o2, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (3) 'Elem'
+	if o3, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (4) 'Data'
+	if o4, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (5) 'Small'
+	if o5, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Value'
+	if err = ssz.UnmarshalOptionalValue(&o.Value, tail[o1:o2], 8); err != nil {
+		return
+	}
+
+	// Field (2) 'Flag'
+	if err = ssz.UnmarshalOptionalValue(&o.Flag, tail[o2:o3], 1); err != nil {
+		return
+	}
+
+	// Field (3) 'Elem'
+	if err = ssz.UnmarshalOptionalField(&o.Elem, tail[o3:o4]); err != nil {
+		return
+	}
+
+	// Field (4) 'Data'
+	if o.Data, err = ssz.UnmarshalDynamicBytes(o.Data, tail[o4:o5], 32); err != nil {
+		return
+	}
+
+	// Field (5) 'Small'
+	if err = ssz.UnmarshalOptionalValue(&o.Small, tail[o5:], 2); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the OptionalContainer object from a reader with the size of the encoding
+func (o *OptionalContainer) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := o.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o1, o2, o3, o4, o5 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Slot'
+		o.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (1) 'Value'
+		if o1, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (2) 'Flag'
+		if o2, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (3) 'Elem'
+		if o3, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (4) 'Data'
+		if o4, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (5) 'Small'
+		if o5, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (1) 'Value'
+	if err = rr.Decode(int(o2-o1), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalOptionalValue(&o.Value, buf, 8); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (2) 'Flag'
+	if err = rr.Decode(int(o3-o2), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalOptionalValue(&o.Flag, buf, 1); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (3) 'Elem'
+	if err = rr.Decode(int(o4-o3), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalOptionalField(&o.Elem, buf); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (4) 'Data'
+	if o5-o4 > 32 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o5-o4), func(buf []byte) (_ []byte, err error) {
+		if o.Data, err = ssz.UnmarshalDynamicBytes(o.Data, buf, 32); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (5) 'Small'
+	if err = rr.Decode(size-int(o5), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalOptionalValue(&o.Small, buf, 2); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the OptionalContainer object
+func (o *OptionalContainer) fixedSize() int {
+	return int(28)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the OptionalContainer object
+func (o *OptionalContainer) SizeSSZ() (size int) {
+	size = o.fixedSize()
+
+	// Field (1) 'Value'
+	if o.Value != nil {
+		size += 1 + 8
+	}
+
+	// Field (2) 'Flag'
+	if o.Flag != nil {
+		size += 1 + 1
+	}
+
+	// Field (3) 'Elem'
+	if o.Elem != nil {
+		size += 1 + o.Elem.SizeSSZ()
+	}
+
+	// Field (4) 'Data'
+	size += len(o.Data)
+
+	// Field (5) 'Small'
+	if o.Small != nil {
+		size += 1 + 2
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the OptionalContainer object
+func (o *OptionalContainer) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(o)
+}
+
+// HashTreeRootCtx ssz hashes the OptionalContainer object and stops with the error of the context once it is done
+func (o *OptionalContainer) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, o)
+}
+
+// HashTreeRootWith ssz hashes the OptionalContainer object with a hasher
+func (o *OptionalContainer) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(o.Slot)
+
+	// Field (1) 'Value'
+	{
+		subIndx := hh.Index()
+		if o.Value != nil {
+			hh.PutUint64(*o.Value)
+		}
+		if err = ssz.MerkleizeOptional(hh, subIndx, o.Value != nil); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'Flag'
+	{
+		subIndx := hh.Index()
+		if o.Flag != nil {
+			hh.PutBool(*o.Flag)
+		}
+		if err = ssz.MerkleizeOptional(hh, subIndx, o.Flag != nil); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'Elem'
+	{
+		subIndx := hh.Index()
+		if o.Elem != nil {
+			if err = o.Elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if err = ssz.MerkleizeOptional(hh, subIndx, o.Elem != nil); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'Data'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(o.Data))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(o.Data)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (5) 'Small'
+	{
+		subIndx := hh.Index()
+		if o.Small != nil {
+			hh.PutUint16(*o.Small)
+		}
+		if err = ssz.MerkleizeOptional(hh, subIndx, o.Small != nil); err != nil {
+			return
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the OptionalContainer object
+func (o *OptionalContainer) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(o)
+}
+
+// GetTreeCtx ssz hashes the OptionalContainer object and stops with the error of the context once it is done
+func (o *OptionalContainer) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, o)
+}
+
+// Generalized indices of the OptionalContainer fields
+const (
+	OptionalContainerGindexSlot  = 8
+	OptionalContainerGindexValue = 9
+	OptionalContainerGindexFlag  = 10
+	OptionalContainerGindexElem  = 11
+	OptionalContainerGindexData  = 12
+	OptionalContainerGindexSmall = 13
+)
+
+// OptionalContainerGindex returns the generalized index of a field path in the OptionalContainer object
+func OptionalContainerGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "slot":
+		return ssz.FieldGindex(OptionalContainerGindexSlot, rest, nil)
+	case "value":
+		return ssz.FieldGindex(OptionalContainerGindexValue, rest, nil)
+	case "flag":
+		return ssz.FieldGindex(OptionalContainerGindexFlag, rest, nil)
+	case "elem":
+		return ssz.FieldGindex(OptionalContainerGindexElem, rest, func(path string) (int, error) {
+			return ssz.OptionalGindex(path, OptionalElemGindex)
+		})
+	case "data":
+		return ssz.FieldGindex(OptionalContainerGindexData, rest, nil)
+	case "small":
+		return ssz.FieldGindex(OptionalContainerGindexSmall, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("OptionalContainer", field)
+}
+
+// ProveField returns a proof of the field path in the OptionalContainer object
+func (o *OptionalContainer) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(o, OptionalContainerGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the OptionalContainer object
+func (o *OptionalContainer) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(o, OptionalContainerGindex, paths...)
+}
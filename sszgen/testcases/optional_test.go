@@ -0,0 +1,105 @@
+package testcases
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptional_Encoding(t *testing.T) {
+	value := uint64(5)
+	flag := true
+
+	o := &OptionalContainer{
+		Slot:  1,
+		Value: &value,
+		Flag:  &flag,
+		Data:  []byte{},
+	}
+
+	buf, err := o.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, buf, o.SizeSSZ())
+
+	// the Value is encoded with a presence byte and the
+	// empty fields after Flag do not encode anything
+	require.Equal(t, []byte{0x01, 0x05, 0, 0, 0, 0, 0, 0, 0, 0x01, 0x01}, buf[o.fixedSize():])
+
+	o2 := &OptionalContainer{}
+	require.NoError(t, o2.UnmarshalSSZ(buf))
+	require.Equal(t, o, o2)
+}
+
+func TestOptional_RoundTrip(t *testing.T) {
+	value := uint64(10)
+	flag := false
+	small := uint16(3)
+
+	cases := []*OptionalContainer{
+		{Data: []byte{}},
+		{Value: &value, Flag: &flag, Data: []byte{}, Small: &small},
+		{Elem: &OptionalElem{A: 1, B: []byte{0x1, 0x2}}, Data: []byte{0x3}},
+		{Slot: 1, Value: &value, Flag: &flag, Elem: &OptionalElem{B: []byte{}}, Data: []byte{0x4}, Small: &small},
+	}
+	for _, c := range cases {
+		buf, err := c.MarshalSSZ()
+		require.NoError(t, err)
+
+		o := &OptionalContainer{}
+		require.NoError(t, o.UnmarshalSSZ(buf))
+		require.Equal(t, c, o)
+
+		root, err := c.HashTreeRoot()
+		require.NoError(t, err)
+
+		// the proof tree has the same root
+		tree, err := c.GetTree()
+		require.NoError(t, err)
+		require.Equal(t, root[:], tree.Hash())
+	}
+}
+
+func TestOptional_HashTreeRoot(t *testing.T) {
+	value := uint64(5)
+
+	var leaf [32]byte
+	binary.LittleEndian.PutUint64(leaf[:], value)
+
+	// Optional[T] mixes in 1 if the value is present and 0 otherwise
+	require.Equal(t, mixInSelector(leaf, 1), optionalFieldRoot(t, &OptionalContainer{Value: &value}))
+	require.Equal(t, mixInSelector([32]byte{}, 0), optionalFieldRoot(t, &OptionalContainer{}))
+}
+
+// optionalFieldRoot returns the root of the 'Value' field from the proof tree
+func optionalFieldRoot(t *testing.T, o *OptionalContainer) [32]byte {
+	tree, err := o.GetTree()
+	require.NoError(t, err)
+
+	// 6 fields padded to 8 leaves, 'Value' is the second one
+	node, err := tree.Get(9)
+	require.NoError(t, err)
+
+	var root [32]byte
+	copy(root[:], node.Hash())
+	return root
+}
+
+func TestOptional_InvalidPresence(t *testing.T) {
+	o := &OptionalContainer{}
+	buf, err := o.MarshalSSZ()
+	require.NoError(t, err)
+
+	// the presence byte of the last field must be 0x01
+	require.Error(t, o.UnmarshalSSZ(append(buf, 0x02, 0x01, 0x00)))
+
+	// the value must have the expected size
+	require.Error(t, o.UnmarshalSSZ(append(buf, 0x01, 0x01)))
+
+	// the boolean value must be valid
+	fixed := append([]byte{}, buf[:o.fixedSize()]...)
+	binary.LittleEndian.PutUint32(fixed[16:], uint32(o.fixedSize()+2))
+	binary.LittleEndian.PutUint32(fixed[20:], uint32(o.fixedSize()+2))
+	binary.LittleEndian.PutUint32(fixed[24:], uint32(o.fixedSize()+2))
+	require.Error(t, o.UnmarshalSSZ(append(fixed, 0x01, 0x02)))
+}
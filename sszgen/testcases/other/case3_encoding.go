@@ -4,6 +4,9 @@
 package other
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -19,6 +22,17 @@ func (c *Case3B) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Case3B object to a writer
+func (c *Case3B) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Case3B object
 func (c *Case3B) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -35,6 +49,24 @@ func (c *Case3B) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Case3B object from a reader with the size of the encoding
+func (c *Case3B) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Case3B object
 func (c *Case3B) fixedSize() int {
 	return int(0)
@@ -51,6 +83,11 @@ func (c *Case3B) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the Case3B object and stops with the error of the context once it is done
+func (c *Case3B) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the Case3B object with a hasher
 func (c *Case3B) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -63,3 +100,27 @@ func (c *Case3B) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *Case3B) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// GetTreeCtx ssz hashes the Case3B object and stops with the error of the context once it is done
+func (c *Case3B) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Case3BGindex returns the generalized index of a field path in the Case3B object
+func Case3BGindex(path string) (int, error) {
+	field, _, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	return 0, ssz.ErrUnknownFieldFn("Case3B", field)
+}
+
+// ProveField returns a proof of the field path in the Case3B object
+func (c *Case3B) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, Case3BGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Case3B object
+func (c *Case3B) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, Case3BGindex, paths...)
+}
@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -32,6 +35,33 @@ func (p *PR1512) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the PR1512 object to a writer
+func (p *PR1512) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := p.fixedSize()
+
+	// Offset (0) 'D'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'D'
+	if size := uint64(len(p.D)); size > 32 {
+		err = ssz.ErrListTooBigFn("PR1512.D", size, 32)
+		return
+	}
+	for ii := 0; ii < len(p.D); ii++ {
+		dst = append(dst, p.D[ii][:]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the PR1512 object
 func (p *PR1512) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(p, buf)
@@ -65,6 +95,46 @@ func (p *PR1512) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the PR1512 object from a reader with the size of the encoding
+func (p *PR1512) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := p.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o0 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Offset (0) 'D'
+		if o0, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (0) 'D'
+	if uint64(size-int(o0)) > 1536 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o0), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&p.D, buf, 48, 32, func(ii uint64, buf []byte) (err error) {
+			buf = ssz.UnmarshalFixedBytes(p.D[ii][:], buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the PR1512 object
 func (p *PR1512) fixedSize() int {
 	return int(4)
@@ -85,6 +155,11 @@ func (p *PR1512) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(p)
 }
 
+// HashTreeRootCtx ssz hashes the PR1512 object and stops with the error of the context once it is done
+func (p *PR1512) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, p)
+}
+
 // HashTreeRootWith ssz hashes the PR1512 object with a hasher
 func (p *PR1512) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -111,3 +186,44 @@ func (p *PR1512) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (p *PR1512) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(p)
 }
+
+// GetTreeCtx ssz hashes the PR1512 object and stops with the error of the context once it is done
+func (p *PR1512) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, p)
+}
+
+// Generalized indices of the PR1512 fields
+const (
+	PR1512GindexD = 1
+)
+
+// PR1512GindexDElem returns the generalized index of the chunk of the i-th element of the D field,
+// or zero if it does not fit in an int
+func PR1512GindexDElem(i int) int {
+	return ssz.ConcatGindices(PR1512GindexD, ssz.ListElemGindex(i, 32, 0))
+}
+
+// PR1512Gindex returns the generalized index of a field path in the PR1512 object
+func PR1512Gindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "d":
+		return ssz.FieldGindex(PR1512GindexD, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 32, 0, nil)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("PR1512", field)
+}
+
+// ProveField returns a proof of the field path in the PR1512 object
+func (p *PR1512) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(p, PR1512Gindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the PR1512 object
+func (p *PR1512) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(p, PR1512Gindex, paths...)
+}
@@ -0,0 +1,53 @@
+package testcases
+
+import (
+	"reflect"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileWalker(t *testing.T) {
+	for _, c := range treeObjCases() {
+		name := reflect.TypeOf(c).Elem().Name()
+
+		tree, err := c.GetTree()
+		require.NoError(t, err, name)
+		root := tree.Hash()
+
+		for _, hh := range []ssz.HashWalker{ssz.NewHasher(), &ssz.Wrapper{}} {
+			p := ssz.NewProfileWalker(hh)
+			require.NoError(t, c.HashTreeRootWith(p), name)
+			require.Equal(t, root, p.Hash(), name)
+
+			// the groups are subtrees of the object
+			for _, g := range p.Report().Groups {
+				require.NotZero(t, g.Gindex, name)
+				_, err := tree.Get(g.Gindex)
+				require.NoError(t, err, name)
+			}
+		}
+	}
+}
+
+func TestProfileWalker_FieldPaths(t *testing.T) {
+	s := newCacheState(100)
+
+	p := ssz.NewProfileWalker(ssz.NewHasher(), ssz.WithMaxLevel(1), ssz.WithFieldPaths(CacheStateGindex, "validators", "balances"))
+	require.NoError(t, s.HashTreeRootWith(p))
+
+	fields := map[string]ssz.MerkleizeStats{}
+	for _, g := range p.Report().Groups {
+		require.LessOrEqual(t, g.Level, 1)
+		if g.Path != "" {
+			fields[g.Path] = g
+		}
+	}
+	require.Len(t, fields, 2)
+
+	require.Equal(t, CacheStateGindexValidators, fields["validators"].Gindex)
+	require.Equal(t, uint64(100), fields["validators"].Chunks)
+	require.Equal(t, CacheStateGindexBalances, fields["balances"].Gindex)
+	require.Equal(t, uint64(25), fields["balances"].Chunks)
+}
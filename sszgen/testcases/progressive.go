@@ -0,0 +1,16 @@
+package testcases
+
+//go:generate go run ../main.go --path progressive.go
+
+type ProgressiveElem struct {
+	A uint64
+	B []byte `ssz-max:"8"`
+}
+
+type ProgressiveContainer struct {
+	Slot   uint64
+	Data   []byte             `ssz:"progressive"`
+	Values []uint64           `ssz:"progressive"`
+	Roots  [][32]byte         `ssz:"progressive"`
+	Elems  []*ProgressiveElem `ssz:"progressive"`
+}
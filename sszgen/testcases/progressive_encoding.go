@@ -0,0 +1,732 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: e015c69c75953ba7ed2aba47e7546cbd4ee218ee71c7fe715711d7735198c679
+// Version: 2.0.0
+package testcases
+
+import (
+	"context"
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the ProgressiveElem object
+func (p *ProgressiveElem) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the ProgressiveElem object to a target array
+func (p *ProgressiveElem) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := p.fixedSize()
+
+	// Field (0) 'A'
+	dst = ssz.MarshalValue(dst, p.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := uint64(len(p.B)); size > 8 {
+		err = ssz.ErrBytesLengthFn("ProgressiveElem.B", size, 8)
+		return
+	}
+	dst = append(dst, p.B...)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the ProgressiveElem object to a writer
+func (p *ProgressiveElem) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := p.fixedSize()
+
+	// Field (0) 'A'
+	dst = ssz.MarshalValue(dst, p.A)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := uint64(len(p.B)); size > 8 {
+		err = ssz.ErrBytesLengthFn("ProgressiveElem.B", size, 8)
+		return
+	}
+	dst = append(dst, p.B...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the ProgressiveElem object
+func (p *ProgressiveElem) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(p, buf)
+}
+
+// UnmarshalSSZTail unmarshals the ProgressiveElem object and returns the remaining bufferº
+func (p *ProgressiveElem) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := p.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'A'
+	p.A, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Offset (1) 'B'
+	if o1, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'B'
+	if p.B, err = ssz.UnmarshalDynamicBytes(p.B, tail[o1:], 8); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the ProgressiveElem object from a reader with the size of the encoding
+func (p *ProgressiveElem) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := p.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o1 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'A'
+		p.A, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (1) 'B'
+		if o1, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (1) 'B'
+	if uint64(size-int(o1)) > 8 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o1), func(buf []byte) (_ []byte, err error) {
+		if p.B, err = ssz.UnmarshalDynamicBytes(p.B, buf, 8); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the ProgressiveElem object
+func (p *ProgressiveElem) fixedSize() int {
+	return int(12)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ProgressiveElem object
+func (p *ProgressiveElem) SizeSSZ() (size int) {
+	size = p.fixedSize()
+
+	// Field (1) 'B'
+	size += len(p.B)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ProgressiveElem object
+func (p *ProgressiveElem) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootCtx ssz hashes the ProgressiveElem object and stops with the error of the context once it is done
+func (p *ProgressiveElem) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, p)
+}
+
+// HashTreeRootWith ssz hashes the ProgressiveElem object with a hasher
+func (p *ProgressiveElem) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(p.A)
+
+	// Field (1) 'B'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(p.B))
+		if byteLen > 8 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(p.B)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (8+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ProgressiveElem object
+func (p *ProgressiveElem) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// GetTreeCtx ssz hashes the ProgressiveElem object and stops with the error of the context once it is done
+func (p *ProgressiveElem) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, p)
+}
+
+// Generalized indices of the ProgressiveElem fields
+const (
+	ProgressiveElemGindexA = 2
+	ProgressiveElemGindexB = 3
+)
+
+// ProgressiveElemGindex returns the generalized index of a field path in the ProgressiveElem object
+func ProgressiveElemGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(ProgressiveElemGindexA, rest, nil)
+	case "b":
+		return ssz.FieldGindex(ProgressiveElemGindexB, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("ProgressiveElem", field)
+}
+
+// ProveField returns a proof of the field path in the ProgressiveElem object
+func (p *ProgressiveElem) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(p, ProgressiveElemGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the ProgressiveElem object
+func (p *ProgressiveElem) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(p, ProgressiveElemGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the ProgressiveContainer object
+func (p *ProgressiveContainer) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the ProgressiveContainer object to a target array
+func (p *ProgressiveContainer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := p.fixedSize()
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, p.Slot)
+
+	// Offset (1) 'Data'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.Data)
+
+	// Offset (2) 'Values'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.Values) * 8
+
+	// Offset (3) 'Roots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.Roots) * 32
+
+	// Offset (4) 'Elems'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if size := uint64(len(p.Data)); size > ssz.ProgressiveListLimit {
+		err = ssz.ErrBytesLengthFn("ProgressiveContainer.Data", size, ssz.ProgressiveListLimit)
+		return
+	}
+	dst = append(dst, p.Data...)
+
+	// Field (2) 'Values'
+	if size := uint64(len(p.Values)); size > ssz.ProgressiveListLimit {
+		err = ssz.ErrListTooBigFn("ProgressiveContainer.Values", size, ssz.ProgressiveListLimit)
+		return
+	}
+	for ii := 0; ii < len(p.Values); ii++ {
+		dst = ssz.MarshalValue(dst, p.Values[ii])
+	}
+
+	// Field (3) 'Roots'
+	if size := uint64(len(p.Roots)); size > ssz.ProgressiveListLimit {
+		err = ssz.ErrListTooBigFn("ProgressiveContainer.Roots", size, ssz.ProgressiveListLimit)
+		return
+	}
+	for ii := 0; ii < len(p.Roots); ii++ {
+		dst = append(dst, p.Roots[ii][:]...)
+	}
+
+	// Field (4) 'Elems'
+	if size := uint64(len(p.Elems)); size > ssz.ProgressiveListLimit {
+		err = ssz.ErrListTooBigFn("ProgressiveContainer.Elems", size, ssz.ProgressiveListLimit)
+		return
+	}
+	{
+		offset = 4 * len(p.Elems)
+		for ii := 0; ii < len(p.Elems); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += p.Elems[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(p.Elems); ii++ {
+		if dst, err = p.Elems[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the ProgressiveContainer object to a writer
+func (p *ProgressiveContainer) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := p.fixedSize()
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, p.Slot)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (1) 'Data'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.Data)
+
+	// Offset (2) 'Values'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.Values) * 8
+
+	// Offset (3) 'Roots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.Roots) * 32
+
+	// Offset (4) 'Elems'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if size := uint64(len(p.Data)); size > ssz.ProgressiveListLimit {
+		err = ssz.ErrBytesLengthFn("ProgressiveContainer.Data", size, ssz.ProgressiveListLimit)
+		return
+	}
+	dst = append(dst, p.Data...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Values'
+	if size := uint64(len(p.Values)); size > ssz.ProgressiveListLimit {
+		err = ssz.ErrListTooBigFn("ProgressiveContainer.Values", size, ssz.ProgressiveListLimit)
+		return
+	}
+	for ii := 0; ii < len(p.Values); ii++ {
+		dst = ssz.MarshalValue(dst, p.Values[ii])
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'Roots'
+	if size := uint64(len(p.Roots)); size > ssz.ProgressiveListLimit {
+		err = ssz.ErrListTooBigFn("ProgressiveContainer.Roots", size, ssz.ProgressiveListLimit)
+		return
+	}
+	for ii := 0; ii < len(p.Roots); ii++ {
+		dst = append(dst, p.Roots[ii][:]...)
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'Elems'
+	if size := uint64(len(p.Elems)); size > ssz.ProgressiveListLimit {
+		err = ssz.ErrListTooBigFn("ProgressiveContainer.Elems", size, ssz.ProgressiveListLimit)
+		return
+	}
+	{
+		offset = 4 * len(p.Elems)
+		for ii := 0; ii < len(p.Elems); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += p.Elems[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(p.Elems); ii++ {
+		if dst, err = ww.WriteObject(dst, p.Elems[ii]); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the ProgressiveContainer object
+func (p *ProgressiveContainer) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(p, buf)
+}
+
+// UnmarshalSSZTail unmarshals the ProgressiveContainer object and returns the remaining bufferº
+func (p *ProgressiveContainer) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := p.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o2, o3, o4 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'Slot'
+	p.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Offset (1) 'Data'
+	if o1, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (2) 'Values'
+	if o2, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (3) 'Roots'
+	if o3, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (4) 'Elems'
+	if o4, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Data'
+	if p.Data, err = ssz.UnmarshalDynamicBytes(p.Data, tail[o1:o2], ssz.ProgressiveListLimit); err != nil {
+		return
+	}
+
+	// Field (2) 'Values'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&p.Values, tail[o2:o3], 8, ssz.ProgressiveListLimit, func(ii uint64, buf []byte) (err error) {
+		p.Values[ii], buf = ssz.UnmarshallValue[uint64](buf)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Field (3) 'Roots'
+	if err = ssz.UnmarshalSliceWithIndexCallback(&p.Roots, tail[o3:o4], 32, ssz.ProgressiveListLimit, func(ii uint64, buf []byte) (err error) {
+		buf = ssz.UnmarshalFixedBytes(p.Roots[ii][:], buf)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Field (4) 'Elems'
+	if err = ssz.UnmarshalDynamicSliceSSZ(&p.Elems, tail[o4:], ssz.ProgressiveListLimit); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the ProgressiveContainer object from a reader with the size of the encoding
+func (p *ProgressiveContainer) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := p.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o1, o2, o3, o4 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Slot'
+		p.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (1) 'Data'
+		if o1, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (2) 'Values'
+		if o2, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (3) 'Roots'
+		if o3, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (4) 'Elems'
+		if o4, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (1) 'Data'
+	if o2-o1 > ssz.ProgressiveListLimit {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o2-o1), func(buf []byte) (_ []byte, err error) {
+		if p.Data, err = ssz.UnmarshalDynamicBytes(p.Data, buf, ssz.ProgressiveListLimit); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (2) 'Values'
+	if o3-o2 > ssz.ProgressiveListLimit*8 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o3-o2), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&p.Values, buf, 8, ssz.ProgressiveListLimit, func(ii uint64, buf []byte) (err error) {
+			p.Values[ii], buf = ssz.UnmarshallValue[uint64](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (3) 'Roots'
+	if o4-o3 > ssz.ProgressiveListLimit*32 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(int(o4-o3), func(buf []byte) (_ []byte, err error) {
+		if err = ssz.UnmarshalSliceWithIndexCallback(&p.Roots, buf, 32, ssz.ProgressiveListLimit, func(ii uint64, buf []byte) (err error) {
+			buf = ssz.UnmarshalFixedBytes(p.Roots[ii][:], buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (4) 'Elems'
+	if err = ssz.ReadDynamicSliceSSZ(rr, &p.Elems, size-int(o4), ssz.ProgressiveListLimit); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the ProgressiveContainer object
+func (p *ProgressiveContainer) fixedSize() int {
+	return int(24)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ProgressiveContainer object
+func (p *ProgressiveContainer) SizeSSZ() (size int) {
+	size = p.fixedSize()
+
+	// Field (1) 'Data'
+	size += len(p.Data)
+
+	// Field (2) 'Values'
+	size += len(p.Values) * 8
+
+	// Field (3) 'Roots'
+	size += len(p.Roots) * 32
+
+	// Field (4) 'Elems'
+	for ii := 0; ii < len(p.Elems); ii++ {
+		size += 4
+		size += p.Elems[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ProgressiveContainer object
+func (p *ProgressiveContainer) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootCtx ssz hashes the ProgressiveContainer object and stops with the error of the context once it is done
+func (p *ProgressiveContainer) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, p)
+}
+
+// HashTreeRootWith ssz hashes the ProgressiveContainer object with a hasher
+func (p *ProgressiveContainer) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(p.Slot)
+
+	// Field (1) 'Data'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(p.Data))
+		if byteLen > ssz.ProgressiveListLimit {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(p.Data)
+		if err = ssz.MerkleizeProgressiveWithMixin(hh, elemIndx, byteLen); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'Values'
+	{
+		if size := uint64(len(p.Values)); size > ssz.ProgressiveListLimit {
+			err = ssz.ErrListTooBigFn("ProgressiveContainer.Values", size, ssz.ProgressiveListLimit)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range p.Values {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(p.Values))
+		if err = ssz.MerkleizeProgressiveWithMixin(hh, subIndx, numItems); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'Roots'
+	{
+		if size := uint64(len(p.Roots)); size > ssz.ProgressiveListLimit {
+			err = ssz.ErrListTooBigFn("ProgressiveContainer.Roots", size, ssz.ProgressiveListLimit)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range p.Roots {
+			hh.Append(i[:])
+		}
+		numItems := uint64(len(p.Roots))
+		if err = ssz.MerkleizeProgressiveWithMixin(hh, subIndx, numItems); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'Elems'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(p.Elems))
+		if num > ssz.ProgressiveListLimit {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		if err = ssz.PutObjects(hh, p.Elems); err != nil {
+			return
+		}
+		if err = ssz.MerkleizeProgressiveWithMixin(hh, subIndx, num); err != nil {
+			return
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ProgressiveContainer object
+func (p *ProgressiveContainer) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(p)
+}
+
+// GetTreeCtx ssz hashes the ProgressiveContainer object and stops with the error of the context once it is done
+func (p *ProgressiveContainer) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, p)
+}
+
+// Generalized indices of the ProgressiveContainer fields
+const (
+	ProgressiveContainerGindexSlot   = 8
+	ProgressiveContainerGindexData   = 9
+	ProgressiveContainerGindexValues = 10
+	ProgressiveContainerGindexRoots  = 11
+	ProgressiveContainerGindexElems  = 12
+)
+
+// ProgressiveContainerGindexValuesElem returns the generalized index of the chunk of the i-th element of the Values field,
+// or zero if it does not fit in an int
+func ProgressiveContainerGindexValuesElem(i int) int {
+	return ssz.ConcatGindices(ProgressiveContainerGindexValues, ssz.ProgressiveListElemGindex(i, 8))
+}
+
+// ProgressiveContainerGindexRootsElem returns the generalized index of the chunk of the i-th element of the Roots field,
+// or zero if it does not fit in an int
+func ProgressiveContainerGindexRootsElem(i int) int {
+	return ssz.ConcatGindices(ProgressiveContainerGindexRoots, ssz.ProgressiveListElemGindex(i, 0))
+}
+
+// ProgressiveContainerGindexElemsElem returns the generalized index of the chunk of the i-th element of the Elems field,
+// or zero if it does not fit in an int
+func ProgressiveContainerGindexElemsElem(i int) int {
+	return ssz.ConcatGindices(ProgressiveContainerGindexElems, ssz.ProgressiveListElemGindex(i, 0))
+}
+
+// ProgressiveContainerGindex returns the generalized index of a field path in the ProgressiveContainer object
+func ProgressiveContainerGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "slot":
+		return ssz.FieldGindex(ProgressiveContainerGindexSlot, rest, nil)
+	case "data":
+		return ssz.FieldGindex(ProgressiveContainerGindexData, rest, nil)
+	case "values":
+		return ssz.FieldGindex(ProgressiveContainerGindexValues, rest, func(path string) (int, error) {
+			return ssz.ProgressiveListGindex(path, 8, nil)
+		})
+	case "roots":
+		return ssz.FieldGindex(ProgressiveContainerGindexRoots, rest, func(path string) (int, error) {
+			return ssz.ProgressiveListGindex(path, 0, nil)
+		})
+	case "elems":
+		return ssz.FieldGindex(ProgressiveContainerGindexElems, rest, func(path string) (int, error) {
+			return ssz.ProgressiveListGindex(path, 0, ProgressiveElemGindex)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("ProgressiveContainer", field)
+}
+
+// ProveField returns a proof of the field path in the ProgressiveContainer object
+func (p *ProgressiveContainer) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(p, ProgressiveContainerGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the ProgressiveContainer object
+func (p *ProgressiveContainer) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(p, ProgressiveContainerGindex, paths...)
+}
@@ -0,0 +1,67 @@
+package testcases
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressive_RoundTrip(t *testing.T) {
+	cases := []*ProgressiveContainer{
+		{Data: []byte{}, Values: []uint64{}, Roots: [][32]byte{}, Elems: []*ProgressiveElem{}},
+		{Slot: 1, Data: []byte{0x1}, Values: []uint64{1}, Roots: [][32]byte{{0x1}}, Elems: []*ProgressiveElem{{A: 1, B: []byte{}}}},
+		{
+			Data:   make([]byte, 200),
+			Values: make([]uint64, 90),
+			Roots:  make([][32]byte, 6),
+			Elems:  []*ProgressiveElem{{A: 1, B: []byte{0x1}}, {A: 2, B: []byte{}}},
+		},
+	}
+	for _, c := range cases {
+		buf, err := c.MarshalSSZ()
+		require.NoError(t, err)
+		require.Len(t, buf, c.SizeSSZ())
+
+		o := &ProgressiveContainer{}
+		require.NoError(t, o.UnmarshalSSZ(buf))
+		require.Equal(t, c, o)
+
+		root, err := c.HashTreeRoot()
+		require.NoError(t, err)
+
+		// the proof tree has the same root
+		tree, err := c.GetTree()
+		require.NoError(t, err)
+		require.Equal(t, root[:], tree.Hash())
+	}
+}
+
+func TestProgressive_HashTreeRoot(t *testing.T) {
+	// the number of values covers partial and complete subtrees (1, 4, 16 chunks)
+	for _, num := range []int{0, 1, 4, 5, 20, 21, 84, 85} {
+		values := make([]uint64, num)
+		for i := range values {
+			values[i] = uint64(i + 1)
+		}
+		p := &ProgressiveContainer{Values: values}
+
+		chunks := make([]byte, (num*8+31)/32*32)
+		for i, val := range values {
+			binary.LittleEndian.PutUint64(chunks[i*8:], val)
+		}
+		expected := mixInLength(merkleizeProgressive(chunks, 1), uint64(num))
+
+		// 5 fields padded to 8 leaves, 'Values' is the third one
+		tree, err := p.GetTree()
+		require.NoError(t, err)
+
+		node, err := tree.Get(10)
+		require.NoError(t, err)
+		require.Equal(t, expected[:], node.Hash())
+
+		root, err := p.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, root[:], tree.Hash())
+	}
+}
@@ -0,0 +1,103 @@
+package testcases
+
+import (
+	"reflect"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+// treeObjCases are objects with every kind of layout
+func treeObjCases() []treeObj {
+	bitlist := ssz.NewBitlist(10)
+	bitlist.SetBitAt(3, true)
+	value := uint64(10)
+
+	return []treeObj{
+		&Case5A{
+			A: [][]byte{{0x1, 0x2}, {0x3, 0x4}},
+			B: []Case5Bytes{{0x1, 0x2}, {0x3, 0x4}},
+			C: Case5Roots{{0x1, 0x2}, {0x3, 0x4}},
+		},
+		&Case7{BlobKzgs: [][]byte{make([]byte, 48), make([]byte, 48)}},
+		&Vec{Values: []uint64{1, 2, 3, 4, 5, 6}},
+		&IntegrationUint{A: 1, B: 2, C: 3, D: 4, A1: []uint8{1}, A2: []uint16{1, 2}, A3: []uint32{1, 2, 3}, A4: []uint64{1, 2, 3, 4}},
+		&Issue153{Value1: [32]byte{0x1}, Value2: [48]byte{0x2}, Value: Data152{0x3}},
+		&Bitfields{A: bitlist, B: ssz.NewBitvector(4), C: ssz.NewBitvector(300), D: 1},
+		&ListP{Elems: []*BytesWrapper{{Bytes: make([]byte, 48)}, {Bytes: make([]byte, 48)}}},
+		&OptionalContainer{Value: &value, Elem: &OptionalElem{A: 1, B: []byte{0x1}}, Data: []byte{0x2}},
+		&ProgressiveContainer{
+			Data:   []byte{0x1},
+			Values: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9},
+			Roots:  [][32]byte{{0x1}, {0x2}, {0x3}, {0x4}, {0x5}, {0x6}},
+			Elems:  []*ProgressiveElem{{A: 1, B: []byte{0x1}}, {A: 2, B: []byte{}}},
+		},
+		&UnionContainer{
+			A:      &UnionA{Selector: 1, Elem: &UnionElem{A: 1}},
+			B:      &UnionB{Selector: 1, Data: []byte{0x1}},
+			Unions: []*UnionA{{Selector: 2, Value: 1}, {Selector: 3, List: []uint16{1}}},
+		},
+		&StableBlock{Slot: &value, Elem: &StableElem{A: 1, Data: []byte{0x1}}},
+		&StableBlockProfile{Slot: 1, Other: &StableElem{A: 2}},
+	}
+}
+
+func TestProvingHasher(t *testing.T) {
+	for _, c := range treeObjCases() {
+		name := reflect.TypeOf(c).Elem().Name()
+
+		tree, err := c.GetTree()
+		require.NoError(t, err, name)
+		root := tree.Hash()
+
+		// every node of the tree that is not inside a zero subtree
+		indices := []int{}
+		for queue := []int{1}; len(queue) != 0; queue = queue[1:] {
+			gindex := queue[0]
+			if _, err := tree.Get(gindex); err != nil {
+				continue
+			}
+			indices = append(indices, gindex)
+			queue = append(queue, 2*gindex, 2*gindex+1)
+		}
+
+		for _, gindex := range indices {
+			expected, err := tree.Prove(gindex)
+			require.NoError(t, err, name)
+
+			proof, err := ssz.NewProvingHasher(gindex).Prove(c)
+			require.NoError(t, err, name)
+			require.Equal(t, expected, proof, "%s %d", name, gindex)
+
+			ok, err := ssz.VerifyProof(root, proof)
+			require.NoError(t, err, name)
+			require.True(t, ok, name)
+		}
+
+		// prove the leaves of the tree at once
+		leaves := []int{}
+		for _, gindex := range indices {
+			if _, err := tree.Get(2 * gindex); err != nil {
+				leaves = append(leaves, gindex)
+			}
+		}
+		expected, err := tree.ProveMulti(leaves)
+		require.NoError(t, err, name)
+
+		multiproof, err := ssz.NewProvingHasher(leaves...).ProveMulti(c)
+		require.NoError(t, err, name)
+		require.Equal(t, expected, multiproof, name)
+	}
+}
+
+func TestProvingHasher_NotFound(t *testing.T) {
+	obj := &Vec{Values: []uint64{1, 2, 3, 4, 5, 6}}
+
+	// the leaves of the vector are at depth 1
+	_, err := ssz.NewProvingHasher(4).Prove(obj)
+	require.Error(t, err)
+
+	_, err = ssz.NewProvingHasher(2, 3).Prove(obj)
+	require.Error(t, err)
+}
@@ -0,0 +1,90 @@
+package testcases
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+type reflectObj interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+func TestReflect_Generated(t *testing.T) {
+	bitlist := ssz.NewBitlist(10)
+	bitlist.SetBitAt(3, true)
+
+	cases := []reflectObj{
+		&Case1A{Foo: Bytes{0x1, 0x2}},
+		&Case2B{Case2A: Case2A{A: 1}, B: 2},
+		&Case5A{
+			A: [][]byte{{0x1, 0x2}, {0x3, 0x4}},
+			B: []Case5Bytes{{0x1, 0x2}, {0x3, 0x4}},
+			C: Case5Roots{{0x1, 0x2}, {0x3, 0x4}},
+		},
+		&Case6{A: [32]byte{0x1}},
+		&Case7{BlobKzgs: [][]byte{make([]byte, 48), make([]byte, 48)}},
+		&Vec{Values: []uint64{1, 2, 3, 4, 5, 6}},
+		&Vec2{Values2: []uint32{1, 2, 3}},
+		&IntegrationUint{A: 1, B: 2, C: 3, D: 4, A1: []uint8{1}, A2: []uint16{1, 2}, A3: []uint32{1, 2, 3}, A4: []uint64{1, 2, 3, 4}},
+		&Obj2{T1: []Data{{0x1}, {0x2, 0x3}, {}}},
+		&Issue153{Value1: [32]byte{0x1}, Value2: [48]byte{0x2}, Value: Data152{0x3}},
+		&Issue188{Name: make([]byte, 32), Address: make([]byte, 32)},
+		&Issue22{Name: true},
+		&ListC{Elems: []BytesWrapper{{Bytes: make([]byte, 48)}}},
+		&ListP{Elems: []*BytesWrapper{{Bytes: make([]byte, 48)}, {Bytes: make([]byte, 48)}}},
+		&PR1512{D: []Data152{{0x1}, {0x2}}},
+		&TimeType{Timestamp: time.Unix(1000, 0).UTC(), Int: 1},
+		&Bitfields{A: bitlist, B: ssz.NewBitvector(4), C: ssz.NewBitvector(300), D: 1},
+		&Uints{Uint8: 1, Uint16: 2, Uint32: 3, Uint64: 4},
+	}
+
+	for _, c := range cases {
+		name := reflect.TypeOf(c).Elem().Name()
+
+		// marshal
+		expected, err := c.MarshalSSZ()
+		require.NoError(t, err, name)
+
+		buf, err := ssz.Marshal(c)
+		require.NoError(t, err, name)
+		require.Equal(t, expected, buf, name)
+
+		// unmarshal
+		obj := reflect.New(reflect.TypeOf(c).Elem()).Interface().(reflectObj)
+		require.NoError(t, obj.UnmarshalSSZ(expected), name)
+
+		obj2 := reflect.New(reflect.TypeOf(c).Elem()).Interface()
+		require.NoError(t, ssz.Unmarshal(expected, obj2), name)
+		require.Equal(t, obj, obj2, name)
+
+		// hash tree root
+		root, err := c.HashTreeRoot()
+		require.NoError(t, err, name)
+
+		root2, err := ssz.HashTreeRoot(c)
+		require.NoError(t, err, name)
+		require.Equal(t, root, root2, name)
+
+		// proofs of the nodes at the top of the tree
+		tree, err := c.GetTree()
+		require.NoError(t, err, name)
+
+		for gindex := 1; gindex < 16; gindex++ {
+			if _, err := tree.Get(gindex); err != nil {
+				continue
+			}
+			expected, err := tree.Prove(gindex)
+			require.NoError(t, err, name)
+
+			proof, err := ssz.Prove(c, gindex)
+			require.NoError(t, err, name)
+			require.Equal(t, expected, proof, name)
+		}
+	}
+}
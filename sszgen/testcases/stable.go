@@ -0,0 +1,42 @@
+package testcases
+
+//go:generate go run ../main.go --path stable.go
+
+type StableShape struct {
+	_      struct{} `ssz:"stable-container" ssz-max:"4"`
+	Side   *uint16  `ssz:"optional"`
+	Color  *uint8   `ssz:"optional"`
+	Radius *uint16  `ssz:"optional"`
+}
+
+type StableSquare struct {
+	_     struct{} `ssz:"profile" ssz-base:"StableShape"`
+	Side  uint16
+	Color uint8
+}
+
+type StableCircle struct {
+	_      struct{} `ssz:"profile" ssz-base:"StableShape"`
+	Color  uint8
+	Radius uint16
+}
+
+type StableElem struct {
+	A    uint64
+	Data []byte `ssz-max:"8"`
+}
+
+type StableBlock struct {
+	_     struct{}    `ssz:"stable-container" ssz-max:"8"`
+	Slot  *uint64     `ssz:"optional"`
+	Elem  *StableElem `ssz:"optional"`
+	Flag  *bool       `ssz:"optional"`
+	Other *StableElem `ssz:"optional"`
+}
+
+type StableBlockProfile struct {
+	_     struct{} `ssz:"profile" ssz-base:"StableBlock"`
+	Slot  uint64
+	Elem  *StableElem `ssz:"optional"`
+	Other *StableElem
+}
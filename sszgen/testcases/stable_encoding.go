@@ -0,0 +1,1340 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 3818cf4c35a57c6bbc842deca98b844a279a4ad7a5d17f077b55c9bdcd6848c1
+// Version: 2.0.0
+package testcases
+
+import (
+	"context"
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the StableShape object
+func (s *StableShape) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the StableShape object to a target array
+func (s *StableShape) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Active fields
+	dst = append(dst, s.activeFields()...)
+
+	// Field (0) 'Side'
+	if s.Side != nil {
+		dst = ssz.MarshalValue(dst, *s.Side)
+	}
+
+	// Field (1) 'Color'
+	if s.Color != nil {
+		dst = ssz.MarshalValue(dst, *s.Color)
+	}
+
+	// Field (2) 'Radius'
+	if s.Radius != nil {
+		dst = ssz.MarshalValue(dst, *s.Radius)
+	}
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the StableShape object to a writer
+func (s *StableShape) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	if dst, err = s.MarshalSSZTo(dst); err != nil {
+		return
+	}
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the StableShape object
+func (s *StableShape) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(s, buf)
+}
+
+// UnmarshalSSZTail unmarshals the StableShape object and returns the remaining bufferº
+func (s *StableShape) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	var activeFields []byte
+	if activeFields, buf, err = ssz.UnmarshalActiveFields(buf, 4, 3); err != nil {
+		return nil, err
+	}
+	ssz.SetOptional(&s.Side, ssz.IsActiveField(activeFields, 0))
+	ssz.SetOptional(&s.Color, ssz.IsActiveField(activeFields, 1))
+	ssz.SetOptional(&s.Radius, ssz.IsActiveField(activeFields, 2))
+
+	size := len(buf)
+	fixedSize := s.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'Side'
+	if s.Side != nil {
+		*s.Side, buf = ssz.UnmarshallValue[uint16](buf)
+	}
+
+	// Field (1) 'Color'
+	if s.Color != nil {
+		*s.Color, buf = ssz.UnmarshallValue[uint8](buf)
+	}
+
+	// Field (2) 'Radius'
+	if s.Radius != nil {
+		*s.Radius, buf = ssz.UnmarshallValue[uint16](buf)
+	}
+
+	return buf, nil
+}
+
+// UnmarshalSSZReader ssz unmarshals the StableShape object from a reader with the size of the encoding
+func (s *StableShape) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	return rr.Decode(size, func(buf []byte) ([]byte, error) {
+		return nil, s.UnmarshalSSZ(buf)
+	})
+}
+
+// fixedSize returns the size of the fixed part of the StableShape object
+func (s *StableShape) fixedSize() (size int) {
+	// Field (0) 'Side'
+	if s.Side != nil {
+		size += 2
+	}
+
+	// Field (1) 'Color'
+	if s.Color != nil {
+		size += 1
+	}
+
+	// Field (2) 'Radius'
+	if s.Radius != nil {
+		size += 2
+	}
+
+	return
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the StableShape object
+func (s *StableShape) SizeSSZ() (size int) {
+	size = 1 + s.fixedSize()
+	return
+}
+
+// activeFields returns the active fields bitvector of the StableShape object
+func (s *StableShape) activeFields() []byte {
+	activeFields := ssz.NewActiveFields(4)
+	ssz.SetActiveField(activeFields, 0, s.Side != nil)
+	ssz.SetActiveField(activeFields, 1, s.Color != nil)
+	ssz.SetActiveField(activeFields, 2, s.Radius != nil)
+	return activeFields
+}
+
+// HashTreeRoot ssz hashes the StableShape object
+func (s *StableShape) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootCtx ssz hashes the StableShape object and stops with the error of the context once it is done
+func (s *StableShape) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, s)
+}
+
+// HashTreeRootWith ssz hashes the StableShape object with a hasher
+func (s *StableShape) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Side'
+	if s.Side != nil {
+		hh.PutUint16(*s.Side)
+	} else {
+		ssz.PutZeroChunk(hh)
+	}
+
+	// Field (1) 'Color'
+	if s.Color != nil {
+		hh.PutUint8(*s.Color)
+	} else {
+		ssz.PutZeroChunk(hh)
+	}
+
+	// Field (2) 'Radius'
+	if s.Radius != nil {
+		hh.PutUint16(*s.Radius)
+	} else {
+		ssz.PutZeroChunk(hh)
+	}
+
+	err = ssz.MerkleizeStable(hh, indx, s.activeFields(), 4)
+	return
+}
+
+// GetTree ssz hashes the StableShape object
+func (s *StableShape) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// GetTreeCtx ssz hashes the StableShape object and stops with the error of the context once it is done
+func (s *StableShape) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, s)
+}
+
+// Generalized indices of the StableShape fields
+const (
+	StableShapeGindexSide   = 8
+	StableShapeGindexColor  = 9
+	StableShapeGindexRadius = 10
+)
+
+// StableShapeGindex returns the generalized index of a field path in the StableShape object
+func StableShapeGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "side":
+		return ssz.FieldGindex(StableShapeGindexSide, rest, nil)
+	case "color":
+		return ssz.FieldGindex(StableShapeGindexColor, rest, nil)
+	case "radius":
+		return ssz.FieldGindex(StableShapeGindexRadius, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("StableShape", field)
+}
+
+// ProveField returns a proof of the field path in the StableShape object
+func (s *StableShape) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(s, StableShapeGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the StableShape object
+func (s *StableShape) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(s, StableShapeGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the StableSquare object
+func (s *StableSquare) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the StableSquare object to a target array
+func (s *StableSquare) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Side'
+	dst = ssz.MarshalValue(dst, s.Side)
+
+	// Field (1) 'Color'
+	dst = ssz.MarshalValue(dst, s.Color)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the StableSquare object to a writer
+func (s *StableSquare) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Side'
+	dst = ssz.MarshalValue(dst, s.Side)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Color'
+	dst = ssz.MarshalValue(dst, s.Color)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the StableSquare object
+func (s *StableSquare) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(s, buf)
+}
+
+// UnmarshalSSZTail unmarshals the StableSquare object and returns the remaining bufferº
+func (s *StableSquare) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := s.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'Side'
+	s.Side, buf = ssz.UnmarshallValue[uint16](buf)
+
+	// Field (1) 'Color'
+	s.Color, buf = ssz.UnmarshallValue[uint8](buf)
+
+	return buf, nil
+}
+
+// UnmarshalSSZReader ssz unmarshals the StableSquare object from a reader with the size of the encoding
+func (s *StableSquare) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := s.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Side'
+		s.Side, buf = ssz.UnmarshallValue[uint16](buf)
+
+		// Field (1) 'Color'
+		s.Color, buf = ssz.UnmarshallValue[uint8](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the StableSquare object
+func (s *StableSquare) fixedSize() int {
+	return int(3)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the StableSquare object
+func (s *StableSquare) SizeSSZ() (size int) {
+	size = s.fixedSize()
+	return
+}
+
+// activeFields returns the active fields bitvector of the StableSquare object
+func (s *StableSquare) activeFields() []byte {
+	activeFields := ssz.NewActiveFields(4)
+	ssz.SetActiveField(activeFields, 0, true)
+	ssz.SetActiveField(activeFields, 1, true)
+	return activeFields
+}
+
+// HashTreeRoot ssz hashes the StableSquare object
+func (s *StableSquare) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootCtx ssz hashes the StableSquare object and stops with the error of the context once it is done
+func (s *StableSquare) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, s)
+}
+
+// HashTreeRootWith ssz hashes the StableSquare object with a hasher
+func (s *StableSquare) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Side'
+	hh.PutUint16(s.Side)
+
+	// Field (1) 'Color'
+	hh.PutUint8(s.Color)
+
+	// Field (2) is not part of the profile
+	ssz.PutZeroChunk(hh)
+
+	err = ssz.MerkleizeStable(hh, indx, s.activeFields(), 4)
+	return
+}
+
+// GetTree ssz hashes the StableSquare object
+func (s *StableSquare) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// GetTreeCtx ssz hashes the StableSquare object and stops with the error of the context once it is done
+func (s *StableSquare) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, s)
+}
+
+// Generalized indices of the StableSquare fields
+const (
+	StableSquareGindexSide  = 8
+	StableSquareGindexColor = 9
+)
+
+// StableSquareGindex returns the generalized index of a field path in the StableSquare object
+func StableSquareGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "side":
+		return ssz.FieldGindex(StableSquareGindexSide, rest, nil)
+	case "color":
+		return ssz.FieldGindex(StableSquareGindexColor, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("StableSquare", field)
+}
+
+// ProveField returns a proof of the field path in the StableSquare object
+func (s *StableSquare) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(s, StableSquareGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the StableSquare object
+func (s *StableSquare) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(s, StableSquareGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the StableCircle object
+func (s *StableCircle) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the StableCircle object to a target array
+func (s *StableCircle) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Color'
+	dst = ssz.MarshalValue(dst, s.Color)
+
+	// Field (1) 'Radius'
+	dst = ssz.MarshalValue(dst, s.Radius)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the StableCircle object to a writer
+func (s *StableCircle) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Color'
+	dst = ssz.MarshalValue(dst, s.Color)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Radius'
+	dst = ssz.MarshalValue(dst, s.Radius)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the StableCircle object
+func (s *StableCircle) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(s, buf)
+}
+
+// UnmarshalSSZTail unmarshals the StableCircle object and returns the remaining bufferº
+func (s *StableCircle) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := s.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'Color'
+	s.Color, buf = ssz.UnmarshallValue[uint8](buf)
+
+	// Field (1) 'Radius'
+	s.Radius, buf = ssz.UnmarshallValue[uint16](buf)
+
+	return buf, nil
+}
+
+// UnmarshalSSZReader ssz unmarshals the StableCircle object from a reader with the size of the encoding
+func (s *StableCircle) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := s.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Color'
+		s.Color, buf = ssz.UnmarshallValue[uint8](buf)
+
+		// Field (1) 'Radius'
+		s.Radius, buf = ssz.UnmarshallValue[uint16](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the StableCircle object
+func (s *StableCircle) fixedSize() int {
+	return int(3)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the StableCircle object
+func (s *StableCircle) SizeSSZ() (size int) {
+	size = s.fixedSize()
+	return
+}
+
+// activeFields returns the active fields bitvector of the StableCircle object
+func (s *StableCircle) activeFields() []byte {
+	activeFields := ssz.NewActiveFields(4)
+	ssz.SetActiveField(activeFields, 1, true)
+	ssz.SetActiveField(activeFields, 2, true)
+	return activeFields
+}
+
+// HashTreeRoot ssz hashes the StableCircle object
+func (s *StableCircle) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootCtx ssz hashes the StableCircle object and stops with the error of the context once it is done
+func (s *StableCircle) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, s)
+}
+
+// HashTreeRootWith ssz hashes the StableCircle object with a hasher
+func (s *StableCircle) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) is not part of the profile
+	ssz.PutZeroChunk(hh)
+
+	// Field (1) 'Color'
+	hh.PutUint8(s.Color)
+
+	// Field (2) 'Radius'
+	hh.PutUint16(s.Radius)
+
+	err = ssz.MerkleizeStable(hh, indx, s.activeFields(), 4)
+	return
+}
+
+// GetTree ssz hashes the StableCircle object
+func (s *StableCircle) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// GetTreeCtx ssz hashes the StableCircle object and stops with the error of the context once it is done
+func (s *StableCircle) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, s)
+}
+
+// Generalized indices of the StableCircle fields
+const (
+	StableCircleGindexColor  = 9
+	StableCircleGindexRadius = 10
+)
+
+// StableCircleGindex returns the generalized index of a field path in the StableCircle object
+func StableCircleGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "color":
+		return ssz.FieldGindex(StableCircleGindexColor, rest, nil)
+	case "radius":
+		return ssz.FieldGindex(StableCircleGindexRadius, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("StableCircle", field)
+}
+
+// ProveField returns a proof of the field path in the StableCircle object
+func (s *StableCircle) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(s, StableCircleGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the StableCircle object
+func (s *StableCircle) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(s, StableCircleGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the StableElem object
+func (s *StableElem) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the StableElem object to a target array
+func (s *StableElem) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := s.fixedSize()
+
+	// Field (0) 'A'
+	dst = ssz.MarshalValue(dst, s.A)
+
+	// Offset (1) 'Data'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if size := uint64(len(s.Data)); size > 8 {
+		err = ssz.ErrBytesLengthFn("StableElem.Data", size, 8)
+		return
+	}
+	dst = append(dst, s.Data...)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the StableElem object to a writer
+func (s *StableElem) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := s.fixedSize()
+
+	// Field (0) 'A'
+	dst = ssz.MarshalValue(dst, s.A)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (1) 'Data'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if size := uint64(len(s.Data)); size > 8 {
+		err = ssz.ErrBytesLengthFn("StableElem.Data", size, 8)
+		return
+	}
+	dst = append(dst, s.Data...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the StableElem object
+func (s *StableElem) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(s, buf)
+}
+
+// UnmarshalSSZTail unmarshals the StableElem object and returns the remaining bufferº
+func (s *StableElem) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := s.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'A'
+	s.A, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Offset (1) 'Data'
+	if o1, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'Data'
+	if s.Data, err = ssz.UnmarshalDynamicBytes(s.Data, tail[o1:], 8); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the StableElem object from a reader with the size of the encoding
+func (s *StableElem) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := s.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o1 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'A'
+		s.A, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (1) 'Data'
+		if o1, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (1) 'Data'
+	if uint64(size-int(o1)) > 8 {
+		return ssz.ErrListTooBig
+	}
+	if err = rr.Decode(size-int(o1), func(buf []byte) (_ []byte, err error) {
+		if s.Data, err = ssz.UnmarshalDynamicBytes(s.Data, buf, 8); err != nil {
+			return
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the StableElem object
+func (s *StableElem) fixedSize() int {
+	return int(12)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the StableElem object
+func (s *StableElem) SizeSSZ() (size int) {
+	size = s.fixedSize()
+
+	// Field (1) 'Data'
+	size += len(s.Data)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the StableElem object
+func (s *StableElem) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootCtx ssz hashes the StableElem object and stops with the error of the context once it is done
+func (s *StableElem) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, s)
+}
+
+// HashTreeRootWith ssz hashes the StableElem object with a hasher
+func (s *StableElem) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(s.A)
+
+	// Field (1) 'Data'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(s.Data))
+		if byteLen > 8 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(s.Data)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (8+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the StableElem object
+func (s *StableElem) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// GetTreeCtx ssz hashes the StableElem object and stops with the error of the context once it is done
+func (s *StableElem) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, s)
+}
+
+// Generalized indices of the StableElem fields
+const (
+	StableElemGindexA    = 2
+	StableElemGindexData = 3
+)
+
+// StableElemGindex returns the generalized index of a field path in the StableElem object
+func StableElemGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(StableElemGindexA, rest, nil)
+	case "data":
+		return ssz.FieldGindex(StableElemGindexData, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("StableElem", field)
+}
+
+// ProveField returns a proof of the field path in the StableElem object
+func (s *StableElem) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(s, StableElemGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the StableElem object
+func (s *StableElem) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(s, StableElemGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the StableBlock object
+func (s *StableBlock) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the StableBlock object to a target array
+func (s *StableBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := s.fixedSize()
+
+	// Active fields
+	dst = append(dst, s.activeFields()...)
+
+	// Field (0) 'Slot'
+	if s.Slot != nil {
+		dst = ssz.MarshalValue(dst, *s.Slot)
+	}
+
+	// Offset (1) 'Elem'
+	if s.Elem != nil {
+		dst = ssz.WriteOffset(dst, offset)
+		offset += s.Elem.SizeSSZ()
+	}
+
+	// Field (2) 'Flag'
+	if s.Flag != nil {
+		dst = ssz.MarshalValue(dst, *s.Flag)
+	}
+
+	// Offset (3) 'Other'
+	if s.Other != nil {
+		dst = ssz.WriteOffset(dst, offset)
+	}
+
+	// Field (1) 'Elem'
+	if s.Elem != nil {
+		if dst, err = s.Elem.MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'Other'
+	if s.Other != nil {
+		if dst, err = s.Other.MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the StableBlock object to a writer
+func (s *StableBlock) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	if dst, err = s.MarshalSSZTo(dst); err != nil {
+		return
+	}
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the StableBlock object
+func (s *StableBlock) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(s, buf)
+}
+
+// UnmarshalSSZTail unmarshals the StableBlock object and returns the remaining bufferº
+func (s *StableBlock) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	var activeFields []byte
+	if activeFields, buf, err = ssz.UnmarshalActiveFields(buf, 8, 4); err != nil {
+		return nil, err
+	}
+	ssz.SetOptional(&s.Slot, ssz.IsActiveField(activeFields, 0))
+	ssz.SetOptional(&s.Elem, ssz.IsActiveField(activeFields, 1))
+	ssz.SetOptional(&s.Flag, ssz.IsActiveField(activeFields, 2))
+	ssz.SetOptional(&s.Other, ssz.IsActiveField(activeFields, 3))
+
+	size := len(buf)
+	fixedSize := s.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o3 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'Slot'
+	if s.Slot != nil {
+		*s.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+	}
+
+	// Offset (1) 'Elem'
+	if s.Elem != nil {
+		if o1, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+	}
+
+	// Field (2) 'Flag'
+	if s.Flag != nil {
+		if err = ssz.IsValidBool(buf); err != nil {
+			return
+		}
+		*s.Flag, buf = ssz.UnmarshallValue[bool](buf)
+	}
+
+	// Offset (3) 'Other'
+	if s.Other != nil {
+		if o3, _, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+	}
+
+	// Fields that are not present
+	if s.Other == nil {
+		o3 = uint64(size)
+	}
+	if s.Elem == nil {
+		o1 = o3
+	}
+
+	// Field (1) 'Elem'
+	if s.Elem != nil {
+		if err = s.Elem.UnmarshalSSZ(tail[o1:o3]); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'Other'
+	if s.Other != nil {
+		if err = s.Other.UnmarshalSSZ(tail[o3:]); err != nil {
+			return
+		}
+	}
+
+	if !marker.HasOffset {
+		return buf, nil
+	}
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the StableBlock object from a reader with the size of the encoding
+func (s *StableBlock) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	return rr.Decode(size, func(buf []byte) ([]byte, error) {
+		return nil, s.UnmarshalSSZ(buf)
+	})
+}
+
+// fixedSize returns the size of the fixed part of the StableBlock object
+func (s *StableBlock) fixedSize() (size int) {
+	// Field (0) 'Slot'
+	if s.Slot != nil {
+		size += 8
+	}
+
+	// Field (1) 'Elem'
+	if s.Elem != nil {
+		size += 4
+	}
+
+	// Field (2) 'Flag'
+	if s.Flag != nil {
+		size += 1
+	}
+
+	// Field (3) 'Other'
+	if s.Other != nil {
+		size += 4
+	}
+
+	return
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the StableBlock object
+func (s *StableBlock) SizeSSZ() (size int) {
+	size = 1 + s.fixedSize()
+
+	// Field (1) 'Elem'
+	if s.Elem != nil {
+		size += s.Elem.SizeSSZ()
+	}
+
+	// Field (3) 'Other'
+	if s.Other != nil {
+		size += s.Other.SizeSSZ()
+	}
+
+	return
+}
+
+// activeFields returns the active fields bitvector of the StableBlock object
+func (s *StableBlock) activeFields() []byte {
+	activeFields := ssz.NewActiveFields(8)
+	ssz.SetActiveField(activeFields, 0, s.Slot != nil)
+	ssz.SetActiveField(activeFields, 1, s.Elem != nil)
+	ssz.SetActiveField(activeFields, 2, s.Flag != nil)
+	ssz.SetActiveField(activeFields, 3, s.Other != nil)
+	return activeFields
+}
+
+// HashTreeRoot ssz hashes the StableBlock object
+func (s *StableBlock) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootCtx ssz hashes the StableBlock object and stops with the error of the context once it is done
+func (s *StableBlock) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, s)
+}
+
+// HashTreeRootWith ssz hashes the StableBlock object with a hasher
+func (s *StableBlock) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	if s.Slot != nil {
+		hh.PutUint64(*s.Slot)
+	} else {
+		ssz.PutZeroChunk(hh)
+	}
+
+	// Field (1) 'Elem'
+	if s.Elem != nil {
+		if err = s.Elem.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else {
+		ssz.PutZeroChunk(hh)
+	}
+
+	// Field (2) 'Flag'
+	if s.Flag != nil {
+		hh.PutBool(*s.Flag)
+	} else {
+		ssz.PutZeroChunk(hh)
+	}
+
+	// Field (3) 'Other'
+	if s.Other != nil {
+		if err = s.Other.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else {
+		ssz.PutZeroChunk(hh)
+	}
+
+	err = ssz.MerkleizeStable(hh, indx, s.activeFields(), 8)
+	return
+}
+
+// GetTree ssz hashes the StableBlock object
+func (s *StableBlock) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// GetTreeCtx ssz hashes the StableBlock object and stops with the error of the context once it is done
+func (s *StableBlock) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, s)
+}
+
+// Generalized indices of the StableBlock fields
+const (
+	StableBlockGindexSlot  = 16
+	StableBlockGindexElem  = 17
+	StableBlockGindexFlag  = 18
+	StableBlockGindexOther = 19
+)
+
+// StableBlockGindex returns the generalized index of a field path in the StableBlock object
+func StableBlockGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "slot":
+		return ssz.FieldGindex(StableBlockGindexSlot, rest, nil)
+	case "elem":
+		return ssz.FieldGindex(StableBlockGindexElem, rest, StableElemGindex)
+	case "flag":
+		return ssz.FieldGindex(StableBlockGindexFlag, rest, nil)
+	case "other":
+		return ssz.FieldGindex(StableBlockGindexOther, rest, StableElemGindex)
+	}
+	return 0, ssz.ErrUnknownFieldFn("StableBlock", field)
+}
+
+// ProveField returns a proof of the field path in the StableBlock object
+func (s *StableBlock) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(s, StableBlockGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the StableBlock object
+func (s *StableBlock) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(s, StableBlockGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the StableBlockProfile object
+func (s *StableBlockProfile) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the StableBlockProfile object to a target array
+func (s *StableBlockProfile) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := s.fixedSize()
+
+	// Optional fields
+	dst = append(dst, s.optionalFields()...)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, s.Slot)
+
+	// Offset (1) 'Elem'
+	if s.Elem != nil {
+		dst = ssz.WriteOffset(dst, offset)
+		offset += s.Elem.SizeSSZ()
+	}
+
+	// Offset (2) 'Other'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Elem'
+	if s.Elem != nil {
+		if dst, err = s.Elem.MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'Other'
+	if dst, err = s.Other.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the StableBlockProfile object to a writer
+func (s *StableBlockProfile) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	if dst, err = s.MarshalSSZTo(dst); err != nil {
+		return
+	}
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the StableBlockProfile object
+func (s *StableBlockProfile) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(s, buf)
+}
+
+// UnmarshalSSZTail unmarshals the StableBlockProfile object and returns the remaining bufferº
+func (s *StableBlockProfile) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	var optionalFields []byte
+	if optionalFields, buf, err = ssz.UnmarshalActiveFields(buf, 1, 1); err != nil {
+		return nil, err
+	}
+	ssz.SetOptional(&s.Elem, ssz.IsActiveField(optionalFields, 0))
+
+	size := len(buf)
+	fixedSize := s.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o2 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'Slot'
+	s.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Offset (1) 'Elem'
+	if s.Elem != nil {
+		if o1, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+	}
+
+	// Offset (2) 'Other'
+	if o2, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Fields that are not present
+	if s.Elem == nil {
+		o1 = o2
+	}
+
+	// Field (1) 'Elem'
+	if s.Elem != nil {
+		if err = s.Elem.UnmarshalSSZ(tail[o1:o2]); err != nil {
+			return
+		}
+	}
+
+	// Field (2) 'Other'
+	if err = ssz.UnmarshalField(&s.Other, tail[o2:]); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the StableBlockProfile object from a reader with the size of the encoding
+func (s *StableBlockProfile) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	return rr.Decode(size, func(buf []byte) ([]byte, error) {
+		return nil, s.UnmarshalSSZ(buf)
+	})
+}
+
+// fixedSize returns the size of the fixed part of the StableBlockProfile object
+func (s *StableBlockProfile) fixedSize() (size int) {
+	// Field (0) 'Slot'
+	size += 8
+
+	// Field (1) 'Elem'
+	if s.Elem != nil {
+		size += 4
+	}
+
+	// Field (2) 'Other'
+	size += 4
+
+	return
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the StableBlockProfile object
+func (s *StableBlockProfile) SizeSSZ() (size int) {
+	size = 1 + s.fixedSize()
+
+	// Field (1) 'Elem'
+	if s.Elem != nil {
+		size += s.Elem.SizeSSZ()
+	}
+
+	// Field (2) 'Other'
+	if s.Other == nil {
+		s.Other = new(StableElem)
+	}
+	size += s.Other.SizeSSZ()
+
+	return
+}
+
+// activeFields returns the active fields bitvector of the StableBlockProfile object
+func (s *StableBlockProfile) activeFields() []byte {
+	activeFields := ssz.NewActiveFields(8)
+	ssz.SetActiveField(activeFields, 0, true)
+	ssz.SetActiveField(activeFields, 1, s.Elem != nil)
+	ssz.SetActiveField(activeFields, 3, true)
+	return activeFields
+}
+
+// optionalFields returns the bitvector of the optional fields of the StableBlockProfile object
+func (s *StableBlockProfile) optionalFields() []byte {
+	optionalFields := ssz.NewActiveFields(1)
+	ssz.SetActiveField(optionalFields, 0, s.Elem != nil)
+	return optionalFields
+}
+
+// HashTreeRoot ssz hashes the StableBlockProfile object
+func (s *StableBlockProfile) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootCtx ssz hashes the StableBlockProfile object and stops with the error of the context once it is done
+func (s *StableBlockProfile) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, s)
+}
+
+// HashTreeRootWith ssz hashes the StableBlockProfile object with a hasher
+func (s *StableBlockProfile) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(s.Slot)
+
+	// Field (1) 'Elem'
+	if s.Elem != nil {
+		if err = s.Elem.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	} else {
+		ssz.PutZeroChunk(hh)
+	}
+
+	// Field (2) is not part of the profile
+	ssz.PutZeroChunk(hh)
+
+	// Field (3) 'Other'
+	if err = s.Other.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	err = ssz.MerkleizeStable(hh, indx, s.activeFields(), 8)
+	return
+}
+
+// GetTree ssz hashes the StableBlockProfile object
+func (s *StableBlockProfile) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// GetTreeCtx ssz hashes the StableBlockProfile object and stops with the error of the context once it is done
+func (s *StableBlockProfile) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, s)
+}
+
+// Generalized indices of the StableBlockProfile fields
+const (
+	StableBlockProfileGindexSlot  = 16
+	StableBlockProfileGindexElem  = 17
+	StableBlockProfileGindexOther = 19
+)
+
+// StableBlockProfileGindex returns the generalized index of a field path in the StableBlockProfile object
+func StableBlockProfileGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "slot":
+		return ssz.FieldGindex(StableBlockProfileGindexSlot, rest, nil)
+	case "elem":
+		return ssz.FieldGindex(StableBlockProfileGindexElem, rest, StableElemGindex)
+	case "other":
+		return ssz.FieldGindex(StableBlockProfileGindexOther, rest, StableElemGindex)
+	}
+	return 0, ssz.ErrUnknownFieldFn("StableBlockProfile", field)
+}
+
+// ProveField returns a proof of the field path in the StableBlockProfile object
+func (s *StableBlockProfile) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(s, StableBlockProfileGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the StableBlockProfile object
+func (s *StableBlockProfile) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(s, StableBlockProfileGindex, paths...)
+}
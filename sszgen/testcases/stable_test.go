@@ -0,0 +1,166 @@
+package testcases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStableContainer_Encoding(t *testing.T) {
+	side, color, radius := uint16(0x42), uint8(1), uint16(0x42)
+
+	cases := []struct {
+		shape   *StableShape
+		profile interface {
+			MarshalSSZ() ([]byte, error)
+			HashTreeRoot() ([32]byte, error)
+		}
+		shapeBytes   []byte
+		profileBytes []byte
+	}{
+		{
+			shape:        &StableShape{Side: &side, Color: &color},
+			profile:      &StableSquare{Side: side, Color: color},
+			shapeBytes:   []byte{0x03, 0x42, 0x00, 0x01},
+			profileBytes: []byte{0x42, 0x00, 0x01},
+		},
+		{
+			shape:        &StableShape{Color: &color, Radius: &radius},
+			profile:      &StableCircle{Color: color, Radius: radius},
+			shapeBytes:   []byte{0x06, 0x01, 0x42, 0x00},
+			profileBytes: []byte{0x01, 0x42, 0x00},
+		},
+	}
+
+	for _, c := range cases {
+		buf, err := c.shape.MarshalSSZ()
+		require.NoError(t, err)
+		require.Equal(t, c.shapeBytes, buf)
+
+		shape := &StableShape{}
+		require.NoError(t, shape.UnmarshalSSZ(buf))
+		require.Equal(t, c.shape, shape)
+
+		buf, err = c.profile.MarshalSSZ()
+		require.NoError(t, err)
+		require.Equal(t, c.profileBytes, buf)
+
+		// the profile has the same root as the stable container
+		shapeRoot, err := c.shape.HashTreeRoot()
+		require.NoError(t, err)
+		profileRoot, err := c.profile.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, shapeRoot, profileRoot)
+	}
+}
+
+func TestStableContainer_HashTreeRoot(t *testing.T) {
+	side, color := uint16(0x42), uint8(1)
+
+	var sideChunk, colorChunk, activeFields, zero [32]byte
+	sideChunk[0] = 0x42
+	colorChunk[0] = 0x01
+	activeFields[0] = 0x03
+
+	// the fields are padded to 4 and mixed in with the active fields
+	fields := hashChunks(hashChunks(sideChunk, colorChunk), hashChunks(zero, zero))
+	expected := hashChunks(fields, activeFields)
+
+	shape := &StableShape{Side: &side, Color: &color}
+	root, err := shape.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	// the proof tree keeps the fields at the same generalized indices
+	tree, err := shape.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, expected[:], tree.Hash())
+
+	for gindex, chunk := range map[int][32]byte{8: sideChunk, 9: colorChunk, 10: zero, 3: activeFields} {
+		node, err := tree.Get(gindex)
+		require.NoError(t, err)
+		require.Equal(t, chunk[:], node.Hash())
+	}
+
+	square, err := (&StableSquare{Side: side, Color: color}).GetTree()
+	require.NoError(t, err)
+	require.Equal(t, expected[:], square.Hash())
+}
+
+func TestStableContainer_Dynamic(t *testing.T) {
+	slot := uint64(10)
+	flag := true
+
+	cases := []*StableBlock{
+		{},
+		{Slot: &slot},
+		{Flag: &flag},
+		{Elem: &StableElem{A: 1, Data: []byte{0x1}}},
+		{Other: &StableElem{A: 2, Data: []byte{}}},
+		{Slot: &slot, Elem: &StableElem{A: 1, Data: []byte{0x1, 0x2}}, Flag: &flag, Other: &StableElem{A: 2, Data: []byte{0x3}}},
+	}
+	for _, c := range cases {
+		buf, err := c.MarshalSSZ()
+		require.NoError(t, err)
+		require.Len(t, buf, c.SizeSSZ())
+
+		b := &StableBlock{}
+		require.NoError(t, b.UnmarshalSSZ(buf))
+		require.Equal(t, c, b)
+
+		root, err := c.HashTreeRoot()
+		require.NoError(t, err)
+
+		tree, err := c.GetTree()
+		require.NoError(t, err)
+		require.Equal(t, root[:], tree.Hash())
+	}
+}
+
+func TestStableContainer_Profile(t *testing.T) {
+	slot := uint64(10)
+
+	cases := []*StableBlockProfile{
+		{Slot: slot, Other: &StableElem{A: 2, Data: []byte{}}},
+		{Slot: slot, Elem: &StableElem{A: 1, Data: []byte{0x1}}, Other: &StableElem{A: 2, Data: []byte{0x3}}},
+	}
+	for _, c := range cases {
+		buf, err := c.MarshalSSZ()
+		require.NoError(t, err)
+		require.Len(t, buf, c.SizeSSZ())
+
+		p := &StableBlockProfile{}
+		require.NoError(t, p.UnmarshalSSZ(buf))
+		require.Equal(t, c, p)
+
+		// the profile has the same root as the stable container
+		block := &StableBlock{Slot: &c.Slot, Elem: c.Elem, Other: c.Other}
+		blockRoot, err := block.HashTreeRoot()
+		require.NoError(t, err)
+
+		root, err := c.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, blockRoot, root)
+
+		tree, err := c.GetTree()
+		require.NoError(t, err)
+		require.Equal(t, root[:], tree.Hash())
+	}
+}
+
+func TestStableContainer_InvalidActiveFields(t *testing.T) {
+	// the fourth field of the stable container is not defined
+	require.Error(t, new(StableShape).UnmarshalSSZ([]byte{0x08}))
+
+	// not enough bytes for the active fields
+	require.Error(t, new(StableShape).UnmarshalSSZ([]byte{}))
+
+	// not enough bytes for the fields that are active
+	require.Error(t, new(StableShape).UnmarshalSSZ([]byte{0x01, 0x42}))
+
+	// the buffer is not consumed
+	require.Error(t, new(StableShape).UnmarshalSSZ([]byte{0x02, 0x01, 0x01}))
+
+	// the optional fields bitvector of a profile only has one field
+	require.Error(t, new(StableBlockProfile).UnmarshalSSZ([]byte{0x02}))
+}
@@ -0,0 +1,128 @@
+package testcases
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+type streamObj interface {
+	ssz.Marshaler
+	ssz.WriterMarshaler
+	ssz.Unmarshaler
+	ssz.ReaderUnmarshaler
+}
+
+func streamCases() []streamObj {
+	value := uint64(10)
+
+	return []streamObj{
+		&ListP{Elems: []*BytesWrapper{{Bytes: make([]byte, 48)}, {Bytes: make([]byte, 48)}}},
+		&OptionalContainer{Value: &value, Elem: &OptionalElem{A: 1, B: []byte{0x1}}, Data: []byte{0x2}},
+		&ProgressiveContainer{
+			Data:   []byte{0x1},
+			Values: []uint64{1, 2, 3},
+			Roots:  [][32]byte{{0x1}},
+			Elems:  []*ProgressiveElem{{A: 1, B: []byte{0x1}}, {A: 2, B: []byte{}}},
+		},
+		&UnionContainer{
+			A:      &UnionA{Selector: 1, Elem: &UnionElem{A: 1}},
+			B:      &UnionB{Selector: 1, Data: []byte{0x1}},
+			Unions: []*UnionA{{Selector: 2, Value: 1}, {Selector: 3, List: []uint16{1}}},
+		},
+		&StableBlock{Slot: &value, Elem: &StableElem{A: 1, Data: []byte{0x1}}},
+	}
+}
+
+func TestMarshalSSZWriter(t *testing.T) {
+	for _, c := range streamCases() {
+		expected, err := c.MarshalSSZ()
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, c.MarshalSSZWriter(&buf))
+		require.Equal(t, expected, buf.Bytes())
+	}
+}
+
+// chunkWriter records the size of each write
+type chunkWriter struct {
+	bytes.Buffer
+	writes []int
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	c.writes = append(c.writes, len(p))
+	return c.Buffer.Write(p)
+}
+
+func TestMarshalSSZWriter_Chunks(t *testing.T) {
+	p := &ProgressiveContainer{
+		Data:   make([]byte, 10),
+		Values: make([]uint64, 100000),
+		Roots:  [][32]byte{},
+		Elems:  []*ProgressiveElem{},
+	}
+	for i := range p.Values {
+		p.Values[i] = uint64(i)
+	}
+
+	expected, err := p.MarshalSSZ()
+	require.NoError(t, err)
+
+	w := &chunkWriter{}
+	require.NoError(t, p.MarshalSSZWriter(w))
+	require.Equal(t, expected, w.Bytes())
+
+	// the encoding is written in chunks of the buffer size
+	require.Greater(t, len(w.writes), 1)
+	for _, size := range w.writes {
+		require.LessOrEqual(t, size, 32*1024+8)
+	}
+}
+
+func TestUnmarshalSSZReader(t *testing.T) {
+	for _, c := range streamCases() {
+		buf, err := c.MarshalSSZ()
+		require.NoError(t, err)
+
+		expected := reflect.New(reflect.TypeOf(c).Elem()).Interface().(streamObj)
+		require.NoError(t, expected.UnmarshalSSZ(buf))
+
+		obj := reflect.New(reflect.TypeOf(c).Elem()).Interface().(streamObj)
+		require.NoError(t, obj.UnmarshalSSZReader(bytes.NewReader(buf), len(buf)))
+		require.Equal(t, expected, obj)
+	}
+}
+
+func TestUnmarshalSSZReader_Size(t *testing.T) {
+	buf, err := (&ListP{Elems: []*BytesWrapper{{Bytes: make([]byte, 48)}}}).MarshalSSZ()
+	require.NoError(t, err)
+
+	// the size must match the encoding
+	require.Error(t, new(ListP).UnmarshalSSZReader(bytes.NewReader(buf), len(buf)+1))
+	require.Error(t, new(ListP).UnmarshalSSZReader(bytes.NewReader(buf), 2))
+}
+
+func TestUnmarshalSSZReader_MaxSize(t *testing.T) {
+	// only the fixed part is available, the list must be
+	// rejected from its size before it is read
+	offset := binary.LittleEndian.AppendUint32(nil, 4)
+
+	// 33 elements of 48 bytes with a limit of 32
+	size := 4 + 33*48
+	require.Error(t, new(ListP).UnmarshalSSZReader(bytes.NewReader(offset), size))
+	require.ErrorIs(t, new(ListC).UnmarshalSSZReader(bytes.NewReader(offset), size), ssz.ErrListTooBig)
+
+	// 5 offsets of dynamic elements with a limit of 4
+	unions := &UnionContainer{A: &UnionA{}, B: &UnionB{}}
+	buf, err := unions.MarshalSSZ()
+	require.NoError(t, err)
+	buf = binary.LittleEndian.AppendUint32(buf, 5*4)
+
+	require.ErrorIs(t, new(UnionContainer).UnmarshalSSZReader(bytes.NewReader(buf), len(buf)+1024), ssz.ErrListTooBig)
+}
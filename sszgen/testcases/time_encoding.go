@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -25,6 +28,29 @@ func (t *TimeType) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the TimeType object to a writer
+func (t *TimeType) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Timestamp'
+	dst = ssz.MarshalTime(dst, t.Timestamp)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Int'
+	dst = ssz.MarshalValue(dst, t.Int)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the TimeType object
 func (t *TimeType) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(t, buf)
@@ -47,6 +73,29 @@ func (t *TimeType) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the TimeType object from a reader with the size of the encoding
+func (t *TimeType) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := t.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Timestamp'
+		t.Timestamp, buf = ssz.UnmarshalTime(buf)
+
+		// Field (1) 'Int'
+		t.Int, buf = ssz.UnmarshallValue[uint64](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the TimeType object
 func (t *TimeType) fixedSize() int {
 	return int(16)
@@ -63,6 +112,11 @@ func (t *TimeType) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(t)
 }
 
+// HashTreeRootCtx ssz hashes the TimeType object and stops with the error of the context once it is done
+func (t *TimeType) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, t)
+}
+
 // HashTreeRootWith ssz hashes the TimeType object with a hasher
 func (t *TimeType) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -82,6 +136,42 @@ func (t *TimeType) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(t)
 }
 
+// GetTreeCtx ssz hashes the TimeType object and stops with the error of the context once it is done
+func (t *TimeType) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, t)
+}
+
+// Generalized indices of the TimeType fields
+const (
+	TimeTypeGindexTimestamp = 2
+	TimeTypeGindexInt       = 3
+)
+
+// TimeTypeGindex returns the generalized index of a field path in the TimeType object
+func TimeTypeGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "timestamp":
+		return ssz.FieldGindex(TimeTypeGindexTimestamp, rest, nil)
+	case "int":
+		return ssz.FieldGindex(TimeTypeGindexInt, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("TimeType", field)
+}
+
+// ProveField returns a proof of the field path in the TimeType object
+func (t *TimeType) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(t, TimeTypeGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the TimeType object
+func (t *TimeType) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(t, TimeTypeGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the TimeRawType object
 func (t *TimeRawType) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(t)
@@ -100,6 +190,29 @@ func (t *TimeRawType) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the TimeRawType object to a writer
+func (t *TimeRawType) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Timestamp'
+	dst = ssz.MarshalValue(dst, t.Timestamp)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Int'
+	dst = ssz.MarshalValue(dst, t.Int)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the TimeRawType object
 func (t *TimeRawType) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(t, buf)
@@ -122,6 +235,29 @@ func (t *TimeRawType) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the TimeRawType object from a reader with the size of the encoding
+func (t *TimeRawType) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := t.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Timestamp'
+		t.Timestamp, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (1) 'Int'
+		t.Int, buf = ssz.UnmarshallValue[uint64](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the TimeRawType object
 func (t *TimeRawType) fixedSize() int {
 	return int(16)
@@ -138,6 +274,11 @@ func (t *TimeRawType) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(t)
 }
 
+// HashTreeRootCtx ssz hashes the TimeRawType object and stops with the error of the context once it is done
+func (t *TimeRawType) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, t)
+}
+
 // HashTreeRootWith ssz hashes the TimeRawType object with a hasher
 func (t *TimeRawType) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -156,3 +297,39 @@ func (t *TimeRawType) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (t *TimeRawType) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(t)
 }
+
+// GetTreeCtx ssz hashes the TimeRawType object and stops with the error of the context once it is done
+func (t *TimeRawType) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, t)
+}
+
+// Generalized indices of the TimeRawType fields
+const (
+	TimeRawTypeGindexTimestamp = 2
+	TimeRawTypeGindexInt       = 3
+)
+
+// TimeRawTypeGindex returns the generalized index of a field path in the TimeRawType object
+func TimeRawTypeGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "timestamp":
+		return ssz.FieldGindex(TimeRawTypeGindexTimestamp, rest, nil)
+	case "int":
+		return ssz.FieldGindex(TimeRawTypeGindexInt, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("TimeRawType", field)
+}
+
+// ProveField returns a proof of the field path in the TimeRawType object
+func (t *TimeRawType) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(t, TimeRawTypeGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the TimeRawType object
+func (t *TimeRawType) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(t, TimeRawTypeGindex, paths...)
+}
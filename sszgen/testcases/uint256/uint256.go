@@ -0,0 +1,7 @@
+// Package uint256 has the same layout as the Int type
+// from github.com/holiman/uint256
+package uint256
+
+// Int is a 256 bits unsigned integer represented as
+// 64 bits words in little endian order
+type Int [4]uint64
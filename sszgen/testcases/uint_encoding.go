@@ -4,6 +4,9 @@
 package testcases
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -31,6 +34,41 @@ func (u *Uints) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Uints object to a writer
+func (u *Uints) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Uint8'
+	dst = ssz.MarshalValue(dst, uint8(u.Uint8))
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Uint16'
+	dst = ssz.MarshalValue(dst, uint16(u.Uint16))
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Uint32'
+	dst = ssz.MarshalValue(dst, uint32(u.Uint32))
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'Uint64'
+	dst = ssz.MarshalValue(dst, uint64(u.Uint64))
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Uints object
 func (u *Uints) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(u, buf)
@@ -75,6 +113,51 @@ func (u *Uints) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Uints object from a reader with the size of the encoding
+func (u *Uints) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := u.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Uint8'
+		{
+			var val uint8
+			val, buf = ssz.UnmarshallValue[uint8](buf)
+			u.Uint8 = Uint8(val)
+		}
+
+		// Field (1) 'Uint16'
+		{
+			var val uint16
+			val, buf = ssz.UnmarshallValue[uint16](buf)
+			u.Uint16 = Uint16(val)
+		}
+
+		// Field (2) 'Uint32'
+		{
+			var val uint32
+			val, buf = ssz.UnmarshallValue[uint32](buf)
+			u.Uint32 = Uint32(val)
+		}
+
+		// Field (3) 'Uint64'
+		{
+			var val uint64
+			val, buf = ssz.UnmarshallValue[uint64](buf)
+			u.Uint64 = Uint64(val)
+		}
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Uints object
 func (u *Uints) fixedSize() int {
 	return int(15)
@@ -91,6 +174,11 @@ func (u *Uints) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(u)
 }
 
+// HashTreeRootCtx ssz hashes the Uints object and stops with the error of the context once it is done
+func (u *Uints) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, u)
+}
+
 // HashTreeRootWith ssz hashes the Uints object with a hasher
 func (u *Uints) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -115,3 +203,45 @@ func (u *Uints) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (u *Uints) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(u)
 }
+
+// GetTreeCtx ssz hashes the Uints object and stops with the error of the context once it is done
+func (u *Uints) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, u)
+}
+
+// Generalized indices of the Uints fields
+const (
+	UintsGindexUint8  = 4
+	UintsGindexUint16 = 5
+	UintsGindexUint32 = 6
+	UintsGindexUint64 = 7
+)
+
+// UintsGindex returns the generalized index of a field path in the Uints object
+func UintsGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "uint8":
+		return ssz.FieldGindex(UintsGindexUint8, rest, nil)
+	case "uint16":
+		return ssz.FieldGindex(UintsGindexUint16, rest, nil)
+	case "uint32":
+		return ssz.FieldGindex(UintsGindexUint32, rest, nil)
+	case "uint64":
+		return ssz.FieldGindex(UintsGindexUint64, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Uints", field)
+}
+
+// ProveField returns a proof of the field path in the Uints object
+func (u *Uints) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(u, UintsGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Uints object
+func (u *Uints) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(u, UintsGindex, paths...)
+}
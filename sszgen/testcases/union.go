@@ -0,0 +1,30 @@
+package testcases
+
+//go:generate go run ../main.go --path union.go
+
+type UnionElem struct {
+	A uint64
+	B uint32
+}
+
+// UnionA is a Union[None, UnionElem, uint64, List[uint16, 8]]
+type UnionA struct {
+	Selector uint8 `ssz:"union,none"`
+	Elem     *UnionElem
+	Value    uint64
+	List     []uint16 `ssz-max:"8"`
+}
+
+// UnionB is a Union[uint32, List[byte, 32]]
+type UnionB struct {
+	Selector uint8 `ssz:"union"`
+	Value    uint32
+	Data     []byte `ssz-max:"32"`
+}
+
+type UnionContainer struct {
+	Slot   uint64
+	A      *UnionA
+	B      *UnionB
+	Unions []*UnionA `ssz-max:"4"`
+}
@@ -0,0 +1,842 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: efbf8da74734420d2a8ed10445e32e25b074305efe656b42b99a32b3a5aac1bd
+// Version: 2.0.0
+package testcases
+
+import (
+	"context"
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the UnionElem object
+func (u *UnionElem) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(u)
+}
+
+// MarshalSSZTo ssz marshals the UnionElem object to a target array
+func (u *UnionElem) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalValue(dst, u.A)
+
+	// Field (1) 'B'
+	dst = ssz.MarshalValue(dst, u.B)
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the UnionElem object to a writer
+func (u *UnionElem) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'A'
+	dst = ssz.MarshalValue(dst, u.A)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'B'
+	dst = ssz.MarshalValue(dst, u.B)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the UnionElem object
+func (u *UnionElem) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(u, buf)
+}
+
+// UnmarshalSSZTail unmarshals the UnionElem object and returns the remaining bufferº
+func (u *UnionElem) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := u.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	// Field (0) 'A'
+	u.A, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Field (1) 'B'
+	u.B, buf = ssz.UnmarshallValue[uint32](buf)
+
+	return buf, nil
+}
+
+// UnmarshalSSZReader ssz unmarshals the UnionElem object from a reader with the size of the encoding
+func (u *UnionElem) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := u.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'A'
+		u.A, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Field (1) 'B'
+		u.B, buf = ssz.UnmarshallValue[uint32](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the UnionElem object
+func (u *UnionElem) fixedSize() int {
+	return int(12)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the UnionElem object
+func (u *UnionElem) SizeSSZ() (size int) {
+	size = u.fixedSize()
+	return
+}
+
+// HashTreeRoot ssz hashes the UnionElem object
+func (u *UnionElem) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(u)
+}
+
+// HashTreeRootCtx ssz hashes the UnionElem object and stops with the error of the context once it is done
+func (u *UnionElem) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, u)
+}
+
+// HashTreeRootWith ssz hashes the UnionElem object with a hasher
+func (u *UnionElem) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(u.A)
+
+	// Field (1) 'B'
+	hh.PutUint32(u.B)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the UnionElem object
+func (u *UnionElem) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(u)
+}
+
+// GetTreeCtx ssz hashes the UnionElem object and stops with the error of the context once it is done
+func (u *UnionElem) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, u)
+}
+
+// Generalized indices of the UnionElem fields
+const (
+	UnionElemGindexA = 2
+	UnionElemGindexB = 3
+)
+
+// UnionElemGindex returns the generalized index of a field path in the UnionElem object
+func UnionElemGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "a":
+		return ssz.FieldGindex(UnionElemGindexA, rest, nil)
+	case "b":
+		return ssz.FieldGindex(UnionElemGindexB, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("UnionElem", field)
+}
+
+// ProveField returns a proof of the field path in the UnionElem object
+func (u *UnionElem) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(u, UnionElemGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the UnionElem object
+func (u *UnionElem) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(u, UnionElemGindex, paths...)
+}
+
+// MarshalSSZ ssz marshals the UnionA object
+func (u *UnionA) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(u)
+}
+
+// MarshalSSZTo ssz marshals the UnionA object to a target array
+func (u *UnionA) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	if err = ssz.ValidateUnionSelector(u.Selector, 4); err != nil {
+		return
+	}
+	dst = ssz.MarshalValue(dst, u.Selector)
+
+	switch u.Selector {
+	case 1:
+		if u.Elem == nil {
+			u.Elem = new(UnionElem)
+		}
+		if dst, err = u.Elem.MarshalSSZTo(dst); err != nil {
+			return
+		}
+	case 2:
+		dst = ssz.MarshalValue(dst, u.Value)
+	case 3:
+		if size := uint64(len(u.List)); size > 8 {
+			err = ssz.ErrListTooBigFn("UnionA.List", size, 8)
+			return
+		}
+		for ii := 0; ii < len(u.List); ii++ {
+			dst = ssz.MarshalValue(dst, u.List[ii])
+		}
+	}
+	return
+}
+
+// MarshalSSZWriter ssz marshals the UnionA object to a writer
+func (u *UnionA) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	if dst, err = u.MarshalSSZTo(dst); err != nil {
+		return
+	}
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the UnionA object
+func (u *UnionA) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(u, buf)
+}
+
+// UnmarshalSSZTail unmarshals the UnionA object and returns the remaining bufferº
+func (u *UnionA) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	if u.Selector, buf, err = ssz.UnmarshalUnionSelector(buf, 4); err != nil {
+		return
+	}
+
+	switch u.Selector {
+	case 1:
+		if buf, err = ssz.UnmarshalFieldTail(&u.Elem, buf); err != nil {
+			return
+		}
+	case 2:
+		if len(buf) < int(8) {
+			return nil, ssz.ErrSize
+		}
+		u.Value, buf = ssz.UnmarshallValue[uint64](buf)
+	case 3:
+		if err = ssz.UnmarshalSliceWithIndexCallback(&u.List, buf, 2, 8, func(ii uint64, buf []byte) (err error) {
+			u.List[ii], buf = ssz.UnmarshallValue[uint16](buf)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		buf = nil
+	}
+	return buf, nil
+}
+
+// UnmarshalSSZReader ssz unmarshals the UnionA object from a reader with the size of the encoding
+func (u *UnionA) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	return rr.Decode(size, func(buf []byte) ([]byte, error) {
+		return nil, u.UnmarshalSSZ(buf)
+	})
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the UnionA object
+func (u *UnionA) SizeSSZ() (size int) {
+	size = 1
+
+	switch u.Selector {
+	case 1:
+		if u.Elem == nil {
+			u.Elem = new(UnionElem)
+		}
+		size += u.Elem.SizeSSZ()
+	case 2:
+		size += 8
+	case 3:
+		size += len(u.List) * 2
+	}
+	return
+}
+
+// HashTreeRoot ssz hashes the UnionA object
+func (u *UnionA) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(u)
+}
+
+// HashTreeRootCtx ssz hashes the UnionA object and stops with the error of the context once it is done
+func (u *UnionA) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, u)
+}
+
+// HashTreeRootWith ssz hashes the UnionA object with a hasher
+func (u *UnionA) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	if err = ssz.ValidateUnionSelector(u.Selector, 4); err != nil {
+		return
+	}
+	indx := hh.Index()
+
+	switch u.Selector {
+	case 1:
+		if u.Elem == nil {
+			u.Elem = new(UnionElem)
+		}
+		if err = u.Elem.HashTreeRootWith(hh); err != nil {
+			return
+		}
+	case 2:
+		hh.PutUint64(u.Value)
+	case 3:
+		{
+			if size := uint64(len(u.List)); size > 8 {
+				err = ssz.ErrListTooBigFn("UnionA.List", size, 8)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range u.List {
+				hh.AppendUint16(i)
+			}
+			hh.FillUpTo32()
+			numItems := uint64(len(u.List))
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(8, numItems, 2))
+		}
+	}
+
+	err = ssz.MerkleizeWithSelector(hh, indx, u.Selector)
+	return
+}
+
+// GetTree ssz hashes the UnionA object
+func (u *UnionA) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(u)
+}
+
+// GetTreeCtx ssz hashes the UnionA object and stops with the error of the context once it is done
+func (u *UnionA) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, u)
+}
+
+// MarshalSSZ ssz marshals the UnionB object
+func (u *UnionB) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(u)
+}
+
+// MarshalSSZTo ssz marshals the UnionB object to a target array
+func (u *UnionB) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	if err = ssz.ValidateUnionSelector(u.Selector, 2); err != nil {
+		return
+	}
+	dst = ssz.MarshalValue(dst, u.Selector)
+
+	switch u.Selector {
+	case 0:
+		dst = ssz.MarshalValue(dst, u.Value)
+	case 1:
+		if size := uint64(len(u.Data)); size > 32 {
+			err = ssz.ErrBytesLengthFn("UnionB.Data", size, 32)
+			return
+		}
+		dst = append(dst, u.Data...)
+	}
+	return
+}
+
+// MarshalSSZWriter ssz marshals the UnionB object to a writer
+func (u *UnionB) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	if dst, err = u.MarshalSSZTo(dst); err != nil {
+		return
+	}
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the UnionB object
+func (u *UnionB) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(u, buf)
+}
+
+// UnmarshalSSZTail unmarshals the UnionB object and returns the remaining bufferº
+func (u *UnionB) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	if u.Selector, buf, err = ssz.UnmarshalUnionSelector(buf, 2); err != nil {
+		return
+	}
+
+	switch u.Selector {
+	case 0:
+		if len(buf) < int(4) {
+			return nil, ssz.ErrSize
+		}
+		u.Value, buf = ssz.UnmarshallValue[uint32](buf)
+	case 1:
+		if u.Data, err = ssz.UnmarshalDynamicBytes(u.Data, buf, 32); err != nil {
+			return
+		}
+		buf = nil
+	}
+	return buf, nil
+}
+
+// UnmarshalSSZReader ssz unmarshals the UnionB object from a reader with the size of the encoding
+func (u *UnionB) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	return rr.Decode(size, func(buf []byte) ([]byte, error) {
+		return nil, u.UnmarshalSSZ(buf)
+	})
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the UnionB object
+func (u *UnionB) SizeSSZ() (size int) {
+	size = 1
+
+	switch u.Selector {
+	case 0:
+		size += 4
+	case 1:
+		size += len(u.Data)
+	}
+	return
+}
+
+// HashTreeRoot ssz hashes the UnionB object
+func (u *UnionB) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(u)
+}
+
+// HashTreeRootCtx ssz hashes the UnionB object and stops with the error of the context once it is done
+func (u *UnionB) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, u)
+}
+
+// HashTreeRootWith ssz hashes the UnionB object with a hasher
+func (u *UnionB) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	if err = ssz.ValidateUnionSelector(u.Selector, 2); err != nil {
+		return
+	}
+	indx := hh.Index()
+
+	switch u.Selector {
+	case 0:
+		hh.PutUint32(u.Value)
+	case 1:
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(u.Data))
+			if byteLen > 32 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(u.Data)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+		}
+	}
+
+	err = ssz.MerkleizeWithSelector(hh, indx, u.Selector)
+	return
+}
+
+// GetTree ssz hashes the UnionB object
+func (u *UnionB) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(u)
+}
+
+// GetTreeCtx ssz hashes the UnionB object and stops with the error of the context once it is done
+func (u *UnionB) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, u)
+}
+
+// MarshalSSZ ssz marshals the UnionContainer object
+func (u *UnionContainer) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(u)
+}
+
+// MarshalSSZTo ssz marshals the UnionContainer object to a target array
+func (u *UnionContainer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := u.fixedSize()
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, u.Slot)
+
+	// Offset (1) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+	if u.A == nil {
+		u.A = new(UnionA)
+	}
+	offset += u.A.SizeSSZ()
+
+	// Offset (2) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+	if u.B == nil {
+		u.B = new(UnionB)
+	}
+	offset += u.B.SizeSSZ()
+
+	// Offset (3) 'Unions'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'A'
+	if dst, err = u.A.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'B'
+	if dst, err = u.B.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'Unions'
+	if size := uint64(len(u.Unions)); size > 4 {
+		err = ssz.ErrListTooBigFn("UnionContainer.Unions", size, 4)
+		return
+	}
+	{
+		offset = 4 * len(u.Unions)
+		for ii := 0; ii < len(u.Unions); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += u.Unions[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(u.Unions); ii++ {
+		if dst, err = u.Unions[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// MarshalSSZWriter ssz marshals the UnionContainer object to a writer
+func (u *UnionContainer) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := u.fixedSize()
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalValue(dst, u.Slot)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Offset (1) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+	if u.A == nil {
+		u.A = new(UnionA)
+	}
+	offset += u.A.SizeSSZ()
+
+	// Offset (2) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+	if u.B == nil {
+		u.B = new(UnionB)
+	}
+	offset += u.B.SizeSSZ()
+
+	// Offset (3) 'Unions'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'A'
+	if dst, err = u.A.MarshalSSZTo(dst); err != nil {
+		return
+	}
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'B'
+	if dst, err = u.B.MarshalSSZTo(dst); err != nil {
+		return
+	}
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'Unions'
+	if size := uint64(len(u.Unions)); size > 4 {
+		err = ssz.ErrListTooBigFn("UnionContainer.Unions", size, 4)
+		return
+	}
+	{
+		offset = 4 * len(u.Unions)
+		for ii := 0; ii < len(u.Unions); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += u.Unions[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(u.Unions); ii++ {
+		if dst, err = u.Unions[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+		if dst, err = ww.Commit(dst); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
+// UnmarshalSSZ ssz unmarshals the UnionContainer object
+func (u *UnionContainer) UnmarshalSSZ(buf []byte) error {
+	return ssz.UnmarshalSSZ(u, buf)
+}
+
+// UnmarshalSSZTail unmarshals the UnionContainer object and returns the remaining bufferº
+func (u *UnionContainer) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
+	size := len(buf)
+	fixedSize := u.fixedSize()
+	if size < fixedSize {
+		return nil, ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o2, o3 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	// Field (0) 'Slot'
+	u.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+	// Offset (1) 'A'
+	if o1, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (2) 'B'
+	if o2, buf, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Offset (3) 'Unions'
+	if o3, _, err = marker.ReadOffset(buf); err != nil {
+		return nil, err
+	}
+
+	// Field (1) 'A'
+	if err = ssz.UnmarshalField(&u.A, tail[o1:o2]); err != nil {
+		return
+	}
+
+	// Field (2) 'B'
+	if err = ssz.UnmarshalField(&u.B, tail[o2:o3]); err != nil {
+		return
+	}
+
+	// Field (3) 'Unions'
+	if err = ssz.UnmarshalDynamicSliceSSZ(&u.Unions, tail[o3:], 4); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// UnmarshalSSZReader ssz unmarshals the UnionContainer object from a reader with the size of the encoding
+func (u *UnionContainer) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := u.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o1, o2, o3 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Slot'
+		u.Slot, buf = ssz.UnmarshallValue[uint64](buf)
+
+		// Offset (1) 'A'
+		if o1, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (2) 'B'
+		if o2, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		// Offset (3) 'Unions'
+		if o3, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (1) 'A'
+	if err = ssz.ReadField(rr, &u.A, int(o2-o1)); err != nil {
+		return
+	}
+
+	// Field (2) 'B'
+	if err = ssz.ReadField(rr, &u.B, int(o3-o2)); err != nil {
+		return
+	}
+
+	// Field (3) 'Unions'
+	if err = ssz.ReadDynamicSliceSSZ(rr, &u.Unions, size-int(o3), 4); err != nil {
+		return
+	}
+
+	return
+}
+
+// fixedSize returns the fixed size of the UnionContainer object
+func (u *UnionContainer) fixedSize() int {
+	return int(20)
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the UnionContainer object
+func (u *UnionContainer) SizeSSZ() (size int) {
+	size = u.fixedSize()
+
+	// Field (1) 'A'
+	if u.A == nil {
+		u.A = new(UnionA)
+	}
+	size += u.A.SizeSSZ()
+
+	// Field (2) 'B'
+	if u.B == nil {
+		u.B = new(UnionB)
+	}
+	size += u.B.SizeSSZ()
+
+	// Field (3) 'Unions'
+	for ii := 0; ii < len(u.Unions); ii++ {
+		size += 4
+		size += u.Unions[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the UnionContainer object
+func (u *UnionContainer) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(u)
+}
+
+// HashTreeRootCtx ssz hashes the UnionContainer object and stops with the error of the context once it is done
+func (u *UnionContainer) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, u)
+}
+
+// HashTreeRootWith ssz hashes the UnionContainer object with a hasher
+func (u *UnionContainer) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(u.Slot)
+
+	// Field (1) 'A'
+	if err = u.A.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'B'
+	if err = u.B.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (3) 'Unions'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(u.Unions))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range u.Unions {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the UnionContainer object
+func (u *UnionContainer) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(u)
+}
+
+// GetTreeCtx ssz hashes the UnionContainer object and stops with the error of the context once it is done
+func (u *UnionContainer) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, u)
+}
+
+// Generalized indices of the UnionContainer fields
+const (
+	UnionContainerGindexSlot   = 4
+	UnionContainerGindexA      = 5
+	UnionContainerGindexB      = 6
+	UnionContainerGindexUnions = 7
+)
+
+// UnionContainerGindexUnionsElem returns the generalized index of the chunk of the i-th element of the Unions field,
+// or zero if it does not fit in an int
+func UnionContainerGindexUnionsElem(i int) int {
+	return ssz.ConcatGindices(UnionContainerGindexUnions, ssz.ListElemGindex(i, 4, 0))
+}
+
+// UnionContainerGindex returns the generalized index of a field path in the UnionContainer object
+func UnionContainerGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "slot":
+		return ssz.FieldGindex(UnionContainerGindexSlot, rest, nil)
+	case "a":
+		return ssz.FieldGindex(UnionContainerGindexA, rest, nil)
+	case "b":
+		return ssz.FieldGindex(UnionContainerGindexB, rest, nil)
+	case "unions":
+		return ssz.FieldGindex(UnionContainerGindexUnions, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 4, 0, nil)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("UnionContainer", field)
+}
+
+// ProveField returns a proof of the field path in the UnionContainer object
+func (u *UnionContainer) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(u, UnionContainerGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the UnionContainer object
+func (u *UnionContainer) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(u, UnionContainerGindex, paths...)
+}
@@ -0,0 +1,121 @@
+package testcases
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnion_None(t *testing.T) {
+	u := &UnionA{Selector: 0}
+
+	buf, err := u.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x00}, buf)
+
+	root, err := u.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, mixInSelector([32]byte{}, 0), root)
+
+	u2 := &UnionA{}
+	require.NoError(t, u2.UnmarshalSSZ(buf))
+	require.Equal(t, u, u2)
+
+	// the None option does not have any data after the selector
+	require.Error(t, u2.UnmarshalSSZ([]byte{0x00, 0x01}))
+}
+
+func TestUnion_Basic(t *testing.T) {
+	u := &UnionA{Selector: 2, Value: 5}
+
+	buf, err := u.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x02, 0x05, 0, 0, 0, 0, 0, 0, 0}, buf)
+
+	var leaf [32]byte
+	binary.LittleEndian.PutUint64(leaf[:], 5)
+
+	root, err := u.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, mixInSelector(leaf, 2), root)
+
+	u2 := &UnionA{}
+	require.NoError(t, u2.UnmarshalSSZ(buf))
+	require.Equal(t, u, u2)
+}
+
+func TestUnion_Options(t *testing.T) {
+	cases := []*UnionA{
+		{Selector: 1, Elem: &UnionElem{A: 1, B: 2}},
+		{Selector: 3, List: []uint16{1, 2, 3}},
+		{Selector: 3, List: []uint16{}},
+	}
+	for _, c := range cases {
+		buf, err := c.MarshalSSZ()
+		require.NoError(t, err)
+		require.Equal(t, c.Selector, buf[0])
+
+		u := &UnionA{}
+		require.NoError(t, u.UnmarshalSSZ(buf))
+		require.Equal(t, c, u)
+
+		root, err := c.HashTreeRoot()
+		require.NoError(t, err)
+
+		if c.Elem != nil {
+			// the root of the union mixes in the root of the selected option
+			elemRoot, err := c.Elem.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, mixInSelector(elemRoot, c.Selector), root)
+		}
+
+		// the proof tree has the same root
+		tree, err := c.GetTree()
+		require.NoError(t, err)
+		require.Equal(t, root[:], tree.Hash())
+	}
+}
+
+func TestUnion_InvalidSelector(t *testing.T) {
+	_, err := (&UnionA{Selector: 4}).MarshalSSZ()
+	require.Error(t, err)
+
+	_, err = (&UnionA{Selector: 4}).HashTreeRoot()
+	require.Error(t, err)
+
+	require.Error(t, new(UnionA).UnmarshalSSZ([]byte{0x04}))
+	require.Error(t, new(UnionA).UnmarshalSSZ([]byte{}))
+	require.Error(t, new(UnionB).UnmarshalSSZ([]byte{0x02}))
+
+	// fixed size option with a short buffer
+	require.Error(t, new(UnionA).UnmarshalSSZ([]byte{0x02, 0x01}))
+}
+
+func TestUnion_Container(t *testing.T) {
+	c := &UnionContainer{
+		Slot: 10,
+		A:    &UnionA{Selector: 2, Value: 3},
+		B:    &UnionB{Selector: 1, Data: []byte{0x01, 0x02, 0x03}},
+		Unions: []*UnionA{
+			{Selector: 0},
+			{Selector: 1, Elem: &UnionElem{A: 5}},
+			{Selector: 3, List: []uint16{4, 5}},
+		},
+	}
+
+	buf, err := c.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, buf, c.SizeSSZ())
+
+	c2 := &UnionContainer{}
+	require.NoError(t, c2.UnmarshalSSZ(buf))
+	require.Equal(t, c, c2)
+
+	root, err := c.HashTreeRoot()
+	require.NoError(t, err)
+
+	tree, err := c.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, root[:], tree.Hash())
+}
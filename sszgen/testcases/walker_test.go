@@ -0,0 +1,52 @@
+package testcases
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/ferranbt/fastssz/sszgen/testcases/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+// baseWalker only has the methods of a HashWalker, like the
+// implementations written before the ExtendedHashWalker methods
+type baseWalker struct {
+	ssz.HashWalker
+}
+
+func TestExtendedHashWalker(t *testing.T) {
+	// the uint128 and uint256 values are hashed with the HashWalker methods
+	b := &BigUints{
+		A: ssz.Uint128{1, 2},
+		C: uint256.Int{5, 6, 7, 8},
+		D: big.NewInt(10),
+		F: []ssz.Uint128{{1, 0}, {2, 0}, {3, 0}},
+		G: []uint256.Int{{1, 0, 0, 1}},
+	}
+	expected, err := b.HashTreeRoot()
+	require.NoError(t, err)
+
+	hh := ssz.NewHasher()
+	require.NoError(t, b.HashTreeRootWith(&baseWalker{hh}))
+	root, err := hh.HashRoot()
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	// the types that are not merkleized by the walker return an error
+	value, side := uint64(1), uint16(2)
+	objs := []ssz.HashRootProof{
+		&UnionA{Selector: 1, Elem: &UnionElem{A: 1}},
+		&OptionalContainer{Value: &value},
+		&ProgressiveContainer{Values: []uint64{1}},
+		&StableShape{Side: &side},
+	}
+	for _, obj := range objs {
+		require.ErrorIs(t, obj.HashTreeRootWith(&baseWalker{ssz.NewHasher()}), ssz.ErrUnsupportedHashWalker)
+
+		// also when the walker is wrapped
+		w := ssz.NewContextWalker(context.Background(), &baseWalker{ssz.NewHasher()})
+		require.ErrorIs(t, obj.HashTreeRootWith(w), ssz.ErrUnsupportedHashWalker)
+	}
+}
@@ -0,0 +1,269 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// writerBufferSize is the size of the buffer that the Writer fills
+// before it writes to the underlying io.Writer
+const writerBufferSize = 32 * 1024
+
+// Writer is a buffered writer used by the generated MarshalSSZWriter functions.
+// The objects append their encoding to the buffer of the writer and commit it
+// once a field is encoded. The buffer is written to the underlying io.Writer
+// when it is full, so the full encoding of an object is never held in memory.
+type Writer struct {
+	w     io.Writer
+	buf   []byte
+	depth int
+}
+
+// NewWriter creates a Writer for w. If w is already a Writer (i.e. a nested
+// object is being written) it returns the same Writer.
+func NewWriter(w io.Writer) *Writer {
+	if ww, ok := w.(*Writer); ok {
+		ww.depth++
+		return ww
+	}
+	return &Writer{
+		w:   w,
+		buf: make([]byte, 0, writerBufferSize),
+	}
+}
+
+// Buffer returns the pending buffer to append the encoding
+func (w *Writer) Buffer() []byte {
+	return w.buf
+}
+
+// Commit sets the pending buffer and writes it if it is full.
+// It returns the buffer to continue appending the encoding.
+func (w *Writer) Commit(dst []byte) ([]byte, error) {
+	w.buf = dst
+	if len(w.buf) >= writerBufferSize {
+		if err := w.Flush(); err != nil {
+			return nil, err
+		}
+	}
+	return w.buf, nil
+}
+
+// WriteObject commits the buffer and writes the object. The object is streamed
+// if it implements WriterMarshaler, otherwise it is encoded in the buffer.
+// It returns the buffer to continue appending the encoding.
+func (w *Writer) WriteObject(dst []byte, m interface {
+	MarshalSSZTo(dst []byte) ([]byte, error)
+}) ([]byte, error) {
+	mw, ok := m.(WriterMarshaler)
+	if !ok {
+		var err error
+		if dst, err = m.MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+		return w.Commit(dst)
+	}
+	if _, err := w.Commit(dst); err != nil {
+		return nil, err
+	}
+	if err := mw.MarshalSSZWriter(w); err != nil {
+		return nil, err
+	}
+	return w.buf, nil
+}
+
+// Write implements the io.Writer interface
+func (w *Writer) Write(p []byte) (int, error) {
+	if _, err := w.Commit(append(w.buf, p...)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Flush writes the pending buffer to the underlying io.Writer
+func (w *Writer) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	_, err := w.w.Write(w.buf)
+	w.buf = w.buf[:0]
+	return err
+}
+
+// Done is called once an object is written. It flushes the pending buffer
+// if the object is not nested inside another one.
+func (w *Writer) Done() error {
+	if w.depth > 0 {
+		w.depth--
+		return nil
+	}
+	return w.Flush()
+}
+
+// readerChunkSize is the maximum number of bytes that the Reader reads at
+// once, so the buffer only grows as the content of the stream arrives and a
+// size declared by an untrusted stream is not allocated upfront
+const readerChunkSize = 32 * 1024
+
+// Reader is used by the generated UnmarshalSSZReader functions to decode an object
+// from an io.Reader. The fields are read one by one in a buffer that is reused
+// between them, so the full encoding of an object is never held in memory.
+type Reader struct {
+	r   io.Reader
+	buf []byte
+}
+
+// NewReader creates a Reader for r. If r is already a Reader (i.e. a nested
+// object is being read) it returns the same Reader.
+func NewReader(r io.Reader) *Reader {
+	if rr, ok := r.(*Reader); ok {
+		return rr
+	}
+	return &Reader{
+		r: r,
+	}
+}
+
+// Read implements the io.Reader interface
+func (r *Reader) Read(p []byte) (int, error) {
+	return r.r.Read(p)
+}
+
+// Decode reads size bytes and decodes them with fn. The buffer is reused by
+// the next reads and fn must copy any content that it keeps.
+func (r *Reader) Decode(size int, fn func(buf []byte) ([]byte, error)) error {
+	if size < 0 {
+		return ErrSize
+	}
+	buf := r.buf[:0]
+	for len(buf) < size {
+		start := len(buf)
+		buf = append(buf, make([]byte, min(size-start, readerChunkSize))...)
+		if _, err := io.ReadFull(r.r, buf[start:]); err != nil {
+			r.buf = buf
+			return err
+		}
+	}
+	r.buf = buf
+	_, err := fn(buf)
+	return err
+}
+
+// ReadObject reads an object of the given size. The object is streamed
+// if it implements ReaderUnmarshaler, otherwise it is decoded from a buffer.
+func (r *Reader) ReadObject(m interface {
+	UnmarshalSSZ(buf []byte) error
+}, size int) error {
+	if mr, ok := m.(ReaderUnmarshaler); ok {
+		return mr.UnmarshalSSZReader(r, size)
+	}
+	return r.Decode(size, func(buf []byte) ([]byte, error) {
+		return nil, m.UnmarshalSSZ(buf)
+	})
+}
+
+// ReadOffsets reads the offsets of a list of size bytes with dynamic elements
+// and returns the size of each element. The number of elements is validated
+// against maxItems before the offsets are read.
+func (r *Reader) ReadOffsets(size int, maxItems uint64) ([]int, error) {
+	if size == 0 {
+		return nil, nil
+	}
+	if size < bytesPerLengthOffset {
+		return nil, ErrSize
+	}
+
+	var first [bytesPerLengthOffset]byte
+	if _, err := io.ReadFull(r.r, first[:]); err != nil {
+		return nil, err
+	}
+	offset := uint64(binary.LittleEndian.Uint32(first[:]))
+	if offset > uint64(size) {
+		return nil, ErrOffset
+	}
+	num, ok := DivideInt(offset, bytesPerLengthOffset)
+	if !ok || num == 0 {
+		return nil, ErrInvalidVariableOffset
+	}
+	if num > maxItems {
+		return nil, ErrListTooBig
+	}
+
+	marker := NewOffsetMarker(uint64(size), offset)
+
+	var offsets []uint64
+	err := r.Decode(int(offset)-bytesPerLengthOffset, func(buf []byte) (_ []byte, err error) {
+		// the offsets are allocated once they are read
+		offsets = make([]uint64, num+1)
+		offsets[num] = uint64(size)
+		offsets[0], _, _ = marker.ReadOffset(first[:])
+		for ii := uint64(1); ii < num; ii++ {
+			if offsets[ii], buf, err = marker.ReadOffset(buf); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sizes := make([]int, num)
+	for ii := range sizes {
+		sizes[ii] = int(offsets[ii+1] - offsets[ii])
+	}
+	return sizes, nil
+}
+
+// ReadField reads an object of the given size into the field
+// and allocates it if it is nil.
+func ReadField[T any, PT PtrConstraint[T]](r *Reader, field *PT, size int) error {
+	if *field == nil {
+		*field = PT(new(T))
+	}
+	return r.ReadObject(*field, size)
+}
+
+// ReadSliceSSZ reads a list of fixed size objects. The number of elements
+// is validated against maxItems before the elements are read, and the slice
+// grows as the elements are read.
+func ReadSliceSSZ[T any, PT PtrConstraint[T]](r *Reader, slice *[]PT, size int, maxItems uint64) error {
+	var zero T
+	itemSize := PT(&zero).SizeSSZ()
+
+	num, err := DivideInt2(uint64(size), uint64(itemSize), maxItems)
+	if err != nil {
+		return err
+	}
+	res := Extend(*slice, 0)
+	for ii := uint64(0); ii < num; ii++ {
+		if len(res) < cap(res) {
+			// reuse the object of the previous content of the slice
+			res = res[:ii+1]
+		} else {
+			res = append(res, nil)
+		}
+		if err := ReadField(r, &res[ii], itemSize); err != nil {
+			*slice = res
+			return err
+		}
+	}
+	*slice = res
+	return nil
+}
+
+// ReadDynamicSliceSSZ reads a list of dynamic objects. The number of elements
+// is validated against maxItems before the elements are read.
+func ReadDynamicSliceSSZ[T any, PT PtrConstraint[T]](r *Reader, slice *[]PT, size int, maxItems uint64) error {
+	sizes, err := r.ReadOffsets(size, maxItems)
+	if err != nil {
+		return err
+	}
+	*slice = Extend(*slice, uint64(len(sizes)))
+	for ii, size := range sizes {
+		if err := ReadField(r, &(*slice)[ii], size); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package ssz
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type bufferObj []byte
+
+func (b bufferObj) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, b...), nil
+}
+
+func TestWriter_Commit(t *testing.T) {
+	var out bytes.Buffer
+	w := NewWriter(&out)
+
+	// the buffer is not written until it is full
+	dst, err := w.Commit(append(w.Buffer(), 0x1, 0x2))
+	require.NoError(t, err)
+	require.Zero(t, out.Len())
+
+	dst, err = w.Commit(append(dst, make([]byte, writerBufferSize)...))
+	require.NoError(t, err)
+	require.Equal(t, writerBufferSize+2, out.Len())
+	require.Empty(t, dst)
+}
+
+func TestWriter_Nested(t *testing.T) {
+	var out bytes.Buffer
+	w := NewWriter(&out)
+
+	// a nested writer reuses the same buffer
+	nested := NewWriter(w)
+	require.Same(t, w, nested)
+
+	_, err := nested.Commit(append(nested.Buffer(), 0x1))
+	require.NoError(t, err)
+
+	// only the outermost object flushes the buffer
+	require.NoError(t, nested.Done())
+	require.Zero(t, out.Len())
+
+	require.NoError(t, w.Done())
+	require.Equal(t, []byte{0x1}, out.Bytes())
+}
+
+func TestWriter_WriteObject(t *testing.T) {
+	var out bytes.Buffer
+	w := NewWriter(&out)
+
+	// objects without MarshalSSZWriter are encoded in the buffer
+	dst, err := w.WriteObject(append(w.Buffer(), 0x1), bufferObj{0x2, 0x3})
+	require.NoError(t, err)
+
+	_, err = w.Commit(append(dst, 0x4))
+	require.NoError(t, err)
+
+	require.NoError(t, w.Done())
+	require.Equal(t, []byte{0x1, 0x2, 0x3, 0x4}, out.Bytes())
+}
+
+func TestReader_ReadOffsets(t *testing.T) {
+	// two elements of 1 and 2 bytes
+	buf := []byte{8, 0, 0, 0, 9, 0, 0, 0, 0x1, 0x2, 0x3}
+
+	r := NewReader(bytes.NewReader(buf))
+	sizes, err := r.ReadOffsets(len(buf), 2)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, sizes)
+
+	// the remaining bytes are the elements
+	require.NoError(t, r.Decode(3, func(buf []byte) ([]byte, error) {
+		require.Equal(t, []byte{0x1, 0x2, 0x3}, buf)
+		return nil, nil
+	}))
+
+	// the number of elements is checked before the offsets are read
+	_, err = NewReader(bytes.NewReader(buf)).ReadOffsets(len(buf), 1)
+	require.ErrorIs(t, err, ErrListTooBig)
+
+	// the offsets must be increasing
+	_, err = NewReader(bytes.NewReader([]byte{8, 0, 0, 0, 7, 0, 0, 0})).ReadOffsets(8, 2)
+	require.ErrorIs(t, err, ErrOffsetNotIncreasing)
+
+	// the first offset must be within the size
+	_, err = NewReader(bytes.NewReader([]byte{12, 0, 0, 0})).ReadOffsets(8, 3)
+	require.ErrorIs(t, err, ErrOffset)
+}
+
+func TestReader_Nested(t *testing.T) {
+	r := NewReader(bytes.NewReader(nil))
+	require.Same(t, r, NewReader(r))
+}
+
+type uint64Obj struct {
+	v uint64
+}
+
+func (u *uint64Obj) SizeSSZ() int {
+	return 8
+}
+
+func (u *uint64Obj) UnmarshalSSZ(buf []byte) error {
+	_, err := u.UnmarshalSSZTail(buf)
+	return err
+}
+
+func (u *uint64Obj) UnmarshalSSZTail(buf []byte) ([]byte, error) {
+	if len(buf) < 8 {
+		return nil, ErrSize
+	}
+	u.v = binary.LittleEndian.Uint64(buf)
+	return buf[8:], nil
+}
+
+func TestReader_Truncated(t *testing.T) {
+	// the stream declares a huge length but ends after two elements
+	buf := []byte{1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0}
+
+	r := NewReader(bytes.NewReader(buf))
+	err := r.Decode(1<<40, func(buf []byte) ([]byte, error) {
+		return nil, nil
+	})
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.LessOrEqual(t, cap(r.buf), 2*readerChunkSize)
+
+	var slice []*uint64Obj
+	err = ReadSliceSSZ(NewReader(bytes.NewReader(buf)), &slice, 8<<40, 1<<40)
+	require.ErrorIs(t, err, io.EOF)
+	require.Len(t, slice, 3)
+	require.Equal(t, uint64(2), slice[1].v)
+
+	// the decoded objects of the slice are reused
+	first := slice[0]
+	require.NoError(t, ReadSliceSSZ(NewReader(bytes.NewReader(buf)), &slice, len(buf), 2))
+	require.Len(t, slice, 2)
+	require.Same(t, first, slice[0])
+}
@@ -4,6 +4,9 @@
 package tests
 
 import (
+	"context"
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -32,6 +35,39 @@ func (m *Metadata) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Metadata object to a writer
+func (m *Metadata) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'Version'
+	dst = ssz.MarshalValue(dst, m.Version)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'CodeHash'
+	if size := uint64(len(m.CodeHash)); size != 32 {
+		err = ssz.ErrBytesLengthFn("Metadata.CodeHash", size, 32)
+		return
+	}
+	dst = append(dst, m.CodeHash...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'CodeLength'
+	dst = ssz.MarshalValue(dst, m.CodeLength)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Metadata object
 func (m *Metadata) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(m, buf)
@@ -57,6 +93,32 @@ func (m *Metadata) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Metadata object from a reader with the size of the encoding
+func (m *Metadata) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := m.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Version'
+		m.Version, buf = ssz.UnmarshallValue[uint8](buf)
+
+		// Field (1) 'CodeHash'
+		m.CodeHash, buf = ssz.UnmarshalBytes(m.CodeHash, buf, 32)
+
+		// Field (2) 'CodeLength'
+		m.CodeLength, buf = ssz.UnmarshallValue[uint16](buf)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Metadata object
 func (m *Metadata) fixedSize() int {
 	return int(35)
@@ -73,6 +135,11 @@ func (m *Metadata) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(m)
 }
 
+// HashTreeRootCtx ssz hashes the Metadata object and stops with the error of the context once it is done
+func (m *Metadata) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, m)
+}
+
 // HashTreeRootWith ssz hashes the Metadata object with a hasher
 func (m *Metadata) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -99,6 +166,45 @@ func (m *Metadata) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(m)
 }
 
+// GetTreeCtx ssz hashes the Metadata object and stops with the error of the context once it is done
+func (m *Metadata) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, m)
+}
+
+// Generalized indices of the Metadata fields
+const (
+	MetadataGindexVersion    = 4
+	MetadataGindexCodeHash   = 5
+	MetadataGindexCodeLength = 6
+)
+
+// MetadataGindex returns the generalized index of a field path in the Metadata object
+func MetadataGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "version":
+		return ssz.FieldGindex(MetadataGindexVersion, rest, nil)
+	case "code_hash":
+		return ssz.FieldGindex(MetadataGindexCodeHash, rest, nil)
+	case "code_length":
+		return ssz.FieldGindex(MetadataGindexCodeLength, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Metadata", field)
+}
+
+// ProveField returns a proof of the field path in the Metadata object
+func (m *Metadata) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(m, MetadataGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Metadata object
+func (m *Metadata) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(m, MetadataGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the Chunk object
 func (c *Chunk) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -121,6 +227,33 @@ func (c *Chunk) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the Chunk object to a writer
+func (c *Chunk) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+
+	// Field (0) 'FIO'
+	dst = ssz.MarshalValue(dst, c.FIO)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Code'
+	if size := uint64(len(c.Code)); size != 32 {
+		err = ssz.ErrBytesLengthFn("Chunk.Code", size, 32)
+		return
+	}
+	dst = append(dst, c.Code...)
+	if dst, err = ww.Commit(dst); err != nil {
+		return
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the Chunk object
 func (c *Chunk) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -143,6 +276,29 @@ func (c *Chunk) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return buf, nil
 }
 
+// UnmarshalSSZReader ssz unmarshals the Chunk object from a reader with the size of the encoding
+func (c *Chunk) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size != fixedSize {
+		return ssz.ErrSize
+	}
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'FIO'
+		c.FIO, buf = ssz.UnmarshallValue[uint8](buf)
+
+		// Field (1) 'Code'
+		c.Code, buf = ssz.UnmarshalBytes(c.Code, buf, 32)
+
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the Chunk object
 func (c *Chunk) fixedSize() int {
 	return int(33)
@@ -159,6 +315,11 @@ func (c *Chunk) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the Chunk object and stops with the error of the context once it is done
+func (c *Chunk) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the Chunk object with a hasher
 func (c *Chunk) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -182,6 +343,42 @@ func (c *Chunk) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+// GetTreeCtx ssz hashes the Chunk object and stops with the error of the context once it is done
+func (c *Chunk) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the Chunk fields
+const (
+	ChunkGindexFIO  = 2
+	ChunkGindexCode = 3
+)
+
+// ChunkGindex returns the generalized index of a field path in the Chunk object
+func ChunkGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "fio":
+		return ssz.FieldGindex(ChunkGindexFIO, rest, nil)
+	case "code":
+		return ssz.FieldGindex(ChunkGindexCode, rest, nil)
+	}
+	return 0, ssz.ErrUnknownFieldFn("Chunk", field)
+}
+
+// ProveField returns a proof of the field path in the Chunk object
+func (c *Chunk) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, ChunkGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the Chunk object
+func (c *Chunk) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, ChunkGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the CodeTrieSmall object
 func (c *CodeTrieSmall) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -217,6 +414,40 @@ func (c *CodeTrieSmall) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the CodeTrieSmall object to a writer
+func (c *CodeTrieSmall) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := c.fixedSize()
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if dst, err = ww.WriteObject(dst, c.Metadata); err != nil {
+		return
+	}
+
+	// Offset (1) 'Chunks'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Chunks'
+	if size := uint64(len(c.Chunks)); size > 4 {
+		err = ssz.ErrListTooBigFn("CodeTrieSmall.Chunks", size, 4)
+		return
+	}
+	for ii := 0; ii < len(c.Chunks); ii++ {
+		if dst, err = ww.WriteObject(dst, c.Chunks[ii]); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the CodeTrieSmall object
 func (c *CodeTrieSmall) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -252,6 +483,40 @@ func (c *CodeTrieSmall) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the CodeTrieSmall object from a reader with the size of the encoding
+func (c *CodeTrieSmall) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o1 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Metadata'
+		if buf, err = ssz.UnmarshalFieldTail(&c.Metadata, buf); err != nil {
+			return
+		}
+
+		// Offset (1) 'Chunks'
+		if o1, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (1) 'Chunks'
+	if err = ssz.ReadSliceSSZ(rr, &c.Chunks, size-int(o1), 4); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the CodeTrieSmall object
 func (c *CodeTrieSmall) fixedSize() int {
 	return int(39)
@@ -272,6 +537,11 @@ func (c *CodeTrieSmall) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the CodeTrieSmall object and stops with the error of the context once it is done
+func (c *CodeTrieSmall) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the CodeTrieSmall object with a hasher
 func (c *CodeTrieSmall) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -292,10 +562,8 @@ func (c *CodeTrieSmall) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range c.Chunks {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, c.Chunks); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 4)
 	}
@@ -309,6 +577,50 @@ func (c *CodeTrieSmall) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+// GetTreeCtx ssz hashes the CodeTrieSmall object and stops with the error of the context once it is done
+func (c *CodeTrieSmall) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the CodeTrieSmall fields
+const (
+	CodeTrieSmallGindexMetadata = 2
+	CodeTrieSmallGindexChunks   = 3
+)
+
+// CodeTrieSmallGindexChunksElem returns the generalized index of the chunk of the i-th element of the Chunks field,
+// or zero if it does not fit in an int
+func CodeTrieSmallGindexChunksElem(i int) int {
+	return ssz.ConcatGindices(CodeTrieSmallGindexChunks, ssz.ListElemGindex(i, 4, 0))
+}
+
+// CodeTrieSmallGindex returns the generalized index of a field path in the CodeTrieSmall object
+func CodeTrieSmallGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "metadata":
+		return ssz.FieldGindex(CodeTrieSmallGindexMetadata, rest, MetadataGindex)
+	case "chunks":
+		return ssz.FieldGindex(CodeTrieSmallGindexChunks, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 4, 0, ChunkGindex)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("CodeTrieSmall", field)
+}
+
+// ProveField returns a proof of the field path in the CodeTrieSmall object
+func (c *CodeTrieSmall) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, CodeTrieSmallGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the CodeTrieSmall object
+func (c *CodeTrieSmall) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, CodeTrieSmallGindex, paths...)
+}
+
 // MarshalSSZ ssz marshals the CodeTrieBig object
 func (c *CodeTrieBig) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -344,6 +656,40 @@ func (c *CodeTrieBig) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZWriter ssz marshals the CodeTrieBig object to a writer
+func (c *CodeTrieBig) MarshalSSZWriter(writer io.Writer) (err error) {
+	ww := ssz.NewWriter(writer)
+	dst := ww.Buffer()
+	offset := c.fixedSize()
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if dst, err = ww.WriteObject(dst, c.Metadata); err != nil {
+		return
+	}
+
+	// Offset (1) 'Chunks'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Chunks'
+	if size := uint64(len(c.Chunks)); size > 1024 {
+		err = ssz.ErrListTooBigFn("CodeTrieBig.Chunks", size, 1024)
+		return
+	}
+	for ii := 0; ii < len(c.Chunks); ii++ {
+		if dst, err = ww.WriteObject(dst, c.Chunks[ii]); err != nil {
+			return
+		}
+	}
+
+	if _, err = ww.Commit(dst); err != nil {
+		return
+	}
+	return ww.Done()
+}
+
 // UnmarshalSSZ ssz unmarshals the CodeTrieBig object
 func (c *CodeTrieBig) UnmarshalSSZ(buf []byte) error {
 	return ssz.UnmarshalSSZ(c, buf)
@@ -379,6 +725,40 @@ func (c *CodeTrieBig) UnmarshalSSZTail(buf []byte) (rest []byte, err error) {
 	return
 }
 
+// UnmarshalSSZReader ssz unmarshals the CodeTrieBig object from a reader with the size of the encoding
+func (c *CodeTrieBig) UnmarshalSSZReader(reader io.Reader, size int) (err error) {
+	rr := ssz.NewReader(reader)
+	fixedSize := c.fixedSize()
+	if size < fixedSize {
+		return ssz.ErrSize
+	}
+
+	var o1 uint64
+	marker := ssz.NewOffsetMarker(uint64(size), uint64(fixedSize))
+
+	if err = rr.Decode(fixedSize, func(buf []byte) (_ []byte, err error) {
+		// Field (0) 'Metadata'
+		if buf, err = ssz.UnmarshalFieldTail(&c.Metadata, buf); err != nil {
+			return
+		}
+
+		// Offset (1) 'Chunks'
+		if o1, buf, err = marker.ReadOffset(buf); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}); err != nil {
+		return
+	}
+
+	// Field (1) 'Chunks'
+	if err = ssz.ReadSliceSSZ(rr, &c.Chunks, size-int(o1), 1024); err != nil {
+		return
+	}
+
+	return
+}
+
 // fixedSize returns the fixed size of the CodeTrieBig object
 func (c *CodeTrieBig) fixedSize() int {
 	return int(39)
@@ -399,6 +779,11 @@ func (c *CodeTrieBig) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
 }
 
+// HashTreeRootCtx ssz hashes the CodeTrieBig object and stops with the error of the context once it is done
+func (c *CodeTrieBig) HashTreeRootCtx(ctx context.Context) ([32]byte, error) {
+	return ssz.HashWithContext(ctx, c)
+}
+
 // HashTreeRootWith ssz hashes the CodeTrieBig object with a hasher
 func (c *CodeTrieBig) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
@@ -419,10 +804,8 @@ func (c *CodeTrieBig) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range c.Chunks {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if err = ssz.PutObjects(hh, c.Chunks); err != nil {
+			return
 		}
 		hh.MerkleizeWithMixin(subIndx, num, 1024)
 	}
@@ -435,3 +818,47 @@ func (c *CodeTrieBig) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (c *CodeTrieBig) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// GetTreeCtx ssz hashes the CodeTrieBig object and stops with the error of the context once it is done
+func (c *CodeTrieBig) GetTreeCtx(ctx context.Context) (*ssz.Node, error) {
+	return ssz.ProofTreeWithContext(ctx, c)
+}
+
+// Generalized indices of the CodeTrieBig fields
+const (
+	CodeTrieBigGindexMetadata = 2
+	CodeTrieBigGindexChunks   = 3
+)
+
+// CodeTrieBigGindexChunksElem returns the generalized index of the chunk of the i-th element of the Chunks field,
+// or zero if it does not fit in an int
+func CodeTrieBigGindexChunksElem(i int) int {
+	return ssz.ConcatGindices(CodeTrieBigGindexChunks, ssz.ListElemGindex(i, 1024, 0))
+}
+
+// CodeTrieBigGindex returns the generalized index of a field path in the CodeTrieBig object
+func CodeTrieBigGindex(path string) (int, error) {
+	field, rest, err := ssz.SplitFieldPath(path)
+	if err != nil {
+		return 0, err
+	}
+	switch field {
+	case "metadata":
+		return ssz.FieldGindex(CodeTrieBigGindexMetadata, rest, MetadataGindex)
+	case "chunks":
+		return ssz.FieldGindex(CodeTrieBigGindexChunks, rest, func(path string) (int, error) {
+			return ssz.ListGindex(path, 1024, 0, ChunkGindex)
+		})
+	}
+	return 0, ssz.ErrUnknownFieldFn("CodeTrieBig", field)
+}
+
+// ProveField returns a proof of the field path in the CodeTrieBig object
+func (c *CodeTrieBig) ProveField(path string) (*ssz.Proof, error) {
+	return ssz.ProveField(c, CodeTrieBigGindex, path)
+}
+
+// ProveFields returns a multiproof of the field paths in the CodeTrieBig object
+func (c *CodeTrieBig) ProveFields(paths ...string) (*ssz.Multiproof, error) {
+	return ssz.ProveFields(c, CodeTrieBigGindex, paths...)
+}
@@ -1,6 +1,7 @@
 package ssz
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -19,6 +20,10 @@ func isByteArray(t reflect.Type) bool {
 	return t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Uint8
 }
 
+func isUintArray(t reflect.Type) bool {
+	return t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Uint64
+}
+
 func customHook(f reflect.Type, t reflect.Type, data interface{}) (interface{}, error) {
 	if f.Kind() != reflect.String {
 		return data, nil
@@ -61,6 +66,21 @@ func customHook(f reflect.Type, t reflect.Type, data interface{}) (interface{},
 		return v.Interface(), nil
 	}
 
+	if isUintArray(t) {
+		// uint128 and uint256 as 64 bits words in little endian order
+		if len(elem) > 8*t.Len() {
+			return nil, fmt.Errorf("incorrect uint length: %d %d", 8*t.Len(), len(elem))
+		}
+		buf := make([]byte, 8*t.Len())
+		copy(buf, elem)
+
+		v := reflect.New(t).Elem()
+		for i := 0; i < t.Len(); i++ {
+			v.Index(i).SetUint(binary.LittleEndian.Uint64(buf[8*i:]))
+		}
+		return v.Interface(), nil
+	}
+
 	var v reflect.Value
 	if t.Kind() == reflect.Ptr {
 		v = reflect.New(t.Elem())
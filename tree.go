@@ -1,6 +1,7 @@
 package ssz
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
@@ -9,18 +10,25 @@ import (
 	"math"
 
 	"github.com/emicklei/dot"
+	"github.com/ferranbt/fastssz/gindex"
 )
 
 // Proof represents a merkle proof against a general index.
+// Index is zero if the general index does not fit in an int,
+// in which case it is only found in Path.
 type Proof struct {
 	Index  int
+	Path   gindex.Path
 	Leaf   []byte
 	Hashes [][]byte
 }
 
 // Multiproof represents a merkle proof of several leaves.
+// Indices is nil if any of the general indices does not fit
+// in an int, in which case they are only found in Paths.
 type Multiproof struct {
 	Indices []int
+	Paths   []gindex.Path
 	Leaves  [][]byte
 	Hashes  [][]byte
 }
@@ -28,15 +36,21 @@ type Multiproof struct {
 // Compress returns a new proof with zero hashes omitted.
 // See `CompressedMultiproof` for more info.
 func (p *Multiproof) Compress() *CompressedMultiproof {
+	return p.CompressWith(DefaultTreeHash)
+}
+
+// CompressWith is the same as Compress for the zero hashes of a TreeHash.
+func (p *Multiproof) CompressWith(t *TreeHash) *CompressedMultiproof {
 	compressed := &CompressedMultiproof{
 		Indices:    p.Indices,
+		Paths:      p.Paths,
 		Leaves:     p.Leaves,
 		Hashes:     make([][]byte, 0, len(p.Hashes)),
 		ZeroLevels: make([]int, 0, len(p.Hashes)),
 	}
 
 	for _, h := range p.Hashes {
-		if l, ok := zeroHashLevels[string(h)]; ok {
+		if l, ok := t.zeroLevel(h); ok {
 			compressed.ZeroLevels = append(compressed.ZeroLevels, l)
 			compressed.Hashes = append(compressed.Hashes, nil)
 		} else {
@@ -52,6 +66,7 @@ func (p *Multiproof) Compress() *CompressedMultiproof {
 // contains information which helps the verifier fill in those hashes.
 type CompressedMultiproof struct {
 	Indices    []int
+	Paths      []gindex.Path
 	Leaves     [][]byte
 	Hashes     [][]byte
 	ZeroLevels []int // Stores the level for every omitted zero hash in the proof
@@ -60,8 +75,14 @@ type CompressedMultiproof struct {
 // Decompress returns a new multiproof, filling in the omitted
 // zero hashes. See `CompressedMultiProof` for more info.
 func (c *CompressedMultiproof) Decompress() *Multiproof {
+	return c.DecompressWith(DefaultTreeHash)
+}
+
+// DecompressWith is the same as Decompress for the zero hashes of a TreeHash.
+func (c *CompressedMultiproof) DecompressWith(t *TreeHash) *Multiproof {
 	p := &Multiproof{
 		Indices: c.Indices,
+		Paths:   c.Paths,
 		Leaves:  c.Leaves,
 		Hashes:  make([][]byte, len(c.Hashes)),
 	}
@@ -69,7 +90,7 @@ func (c *CompressedMultiproof) Decompress() *Multiproof {
 	zc := 0
 	for i, h := range c.Hashes {
 		if h == nil {
-			p.Hashes[i] = zeroHashes[c.ZeroLevels[zc]][:]
+			p.Hashes[i] = t.ZeroHash(c.ZeroLevels[zc])
 			zc++
 		} else {
 			p.Hashes[i] = c.Hashes[i]
@@ -86,6 +107,9 @@ type Node struct {
 	right   *Node
 	isEmpty bool
 
+	// tree is the TreeHash that hashes the node, the default one if nil
+	tree *TreeHash
+
 	value []byte
 }
 
@@ -175,6 +199,46 @@ func NewNodeWithLR(left, right *Node) *Node {
 // TreeFromChunks constructs a tree from leaf values.
 // The number of leaves should be a power of 2.
 func TreeFromChunks(chunks [][]byte) (*Node, error) {
+	return DefaultTreeHash.TreeFromChunks(chunks)
+}
+
+// TreeFromNodes constructs a tree from leaf nodes.
+// This is useful for merging subtrees.
+// The limit should be a power of 2.
+// Adjacent sibling nodes will be filled with zero order hashes that have been precomputed based on the tree depth.
+func TreeFromNodes(leaves []*Node, limit int) (*Node, error) {
+	return DefaultTreeHash.TreeFromNodes(leaves, limit)
+}
+
+func TreeFromNodesWithMixin(leaves []*Node, num, limit int) (*Node, error) {
+	return DefaultTreeHash.TreeFromNodesWithMixin(leaves, num, limit)
+}
+
+// TreeFromNodesProgressive constructs a tree with the progressive layout of EIP-7916.
+// The leaves are split in subtrees of 1, 4, 16... leaves and each subtree is
+// the right child of a node whose left child holds the following subtrees.
+func TreeFromNodesProgressive(leaves []*Node) (*Node, error) {
+	return DefaultTreeHash.TreeFromNodesProgressive(leaves)
+}
+
+// TreeFromNodesWithActiveFields constructs the tree of a stable container (EIP-7495).
+// The fields are padded up to limit and mixed in with the active fields bitvector.
+func TreeFromNodesWithActiveFields(leaves []*Node, activeFields []byte, limit int) (*Node, error) {
+	return DefaultTreeHash.TreeFromNodesWithActiveFields(leaves, activeFields, limit)
+}
+
+// NewEmptyNode initializes the root of a subtree of the given depth with zero leaves.
+func (t *TreeHash) NewEmptyNode(depth int) *Node {
+	return &Node{value: t.ZeroHash(depth), isEmpty: true, tree: t}
+}
+
+// NewNodeWithLR initializes a branch node hashed with the TreeHash.
+func (t *TreeHash) NewNodeWithLR(left, right *Node) *Node {
+	return &Node{left: left, right: right, tree: t}
+}
+
+// TreeFromChunks is the same as TreeFromChunks with the TreeHash.
+func (t *TreeHash) TreeFromChunks(chunks [][]byte) (*Node, error) {
 	numLeaves := len(chunks)
 	if !isPowerOfTwo(numLeaves) {
 		return nil, errors.New("number of leaves should be a power of 2")
@@ -184,22 +248,21 @@ func TreeFromChunks(chunks [][]byte) (*Node, error) {
 	for i, c := range chunks {
 		leaves[i] = NewNodeWithValue(c)
 	}
-	return TreeFromNodes(leaves, numLeaves)
+	return t.TreeFromNodes(leaves, numLeaves)
 }
 
-// TreeFromNodes constructs a tree from leaf nodes.
-// This is useful for merging subtrees.
-// The limit should be a power of 2.
-// Adjacent sibling nodes will be filled with zero order hashes that have been precomputed based on the tree depth.
-func TreeFromNodes(leaves []*Node, limit int) (*Node, error) {
+// TreeFromNodes is the same as TreeFromNodes with the TreeHash.
+func (t *TreeHash) TreeFromNodes(leaves []*Node, limit int) (*Node, error) {
 	numLeaves := len(leaves)
 
-	depth := floorLog2(limit)
-	zeroOrderHashes := getZeroOrderHashes(depth)
+	depth := 0
+	if limit > 1 {
+		depth = floorLog2(limit)
+	}
 
 	// there are no leaves, return a zero order hash node
 	if numLeaves == 0 {
-		return NewEmptyNode(zeroOrderHashes[0]), nil
+		return t.NewEmptyNode(depth), nil
 	}
 
 	// now we know numLeaves are at least 1.
@@ -212,10 +275,10 @@ func TreeFromNodes(leaves []*Node, limit int) (*Node, error) {
 	if limit == 2 {
 		// but we only have 1 leaf, add a zero order hash as the right node
 		if numLeaves == 1 {
-			return NewNodeWithLR(leaves[0], NewEmptyNode(zeroOrderHashes[1])), nil
+			return t.NewNodeWithLR(leaves[0], t.NewEmptyNode(0)), nil
 		}
 		// otherwise return the two nodes we have
-		return NewNodeWithLR(leaves[0], leaves[1]), nil
+		return t.NewNodeWithLR(leaves[0], leaves[1]), nil
 	}
 
 	if !isPowerOfTwo(limit) {
@@ -246,11 +309,15 @@ func TreeFromNodes(leaves []*Node, limit int) (*Node, error) {
 				}
 				// node with empty right node, add zero order hash as right node and mark right node as empty
 				if nodes[leftIndex] != nil && nodes[rightIndex] == nil {
-					nodes[i] = NewNodeWithLR(nodes[leftIndex], NewEmptyNode(zeroOrderHashes[k+1]))
+					nodes[i] = t.NewNodeWithLR(nodes[leftIndex], t.NewEmptyNode(depth-k-1))
 				}
 				// node with left and right child
 				if nodes[leftIndex] != nil && nodes[rightIndex] != nil {
-					nodes[i] = NewNodeWithLR(nodes[leftIndex], nodes[rightIndex])
+					nodes[i] = t.NewNodeWithLR(nodes[leftIndex], nodes[rightIndex])
+				}
+				if zero := t.ZeroHash(depth - k - 1); isZeroNode(nodes[i].left, zero) && isZeroNode(nodes[i].right, zero) {
+					// root of a subtree with zero leaves, no need to hash it
+					nodes[i].value = t.ZeroHash(depth - k)
 				}
 			}
 		}
@@ -267,24 +334,77 @@ func TreeFromNodes(leaves []*Node, limit int) (*Node, error) {
 	return nodes[1], nil
 }
 
-func TreeFromNodesWithMixin(leaves []*Node, num, limit int) (*Node, error) {
+// isZeroNode returns true if the hash of the node is the given zero hash
+func isZeroNode(n *Node, zero []byte) bool {
+	return n.value != nil && bytes.Equal(n.value, zero)
+}
+
+// TreeFromNodesWithMixin is the same as TreeFromNodesWithMixin with the TreeHash.
+func (t *TreeHash) TreeFromNodesWithMixin(leaves []*Node, num, limit int) (*Node, error) {
 	if !isPowerOfTwo(limit) {
 		return nil, errors.New("size of tree should be a power of 2")
 	}
 
-	mainTree, err := TreeFromNodes(leaves, limit)
+	mainTree, err := t.TreeFromNodes(leaves, limit)
 	if err != nil {
 		return nil, err
 	}
 
 	// Mixin len
 	countLeaf := LeafFromUint64(uint64(num))
-	node := NewNodeWithLR(mainTree, countLeaf)
+	node := t.NewNodeWithLR(mainTree, countLeaf)
+	return node, nil
+}
+
+// TreeFromNodesProgressive is the same as TreeFromNodesProgressive with the TreeHash.
+func (t *TreeHash) TreeFromNodesProgressive(leaves []*Node) (*Node, error) {
+	return t.treeFromNodesProgressive(leaves, 1)
+}
+
+func (t *TreeHash) treeFromNodesProgressive(leaves []*Node, limit int) (*Node, error) {
+	if len(leaves) == 0 {
+		return t.TreeFromNodes(leaves, 1)
+	}
+
+	num := min(len(leaves), limit)
+	subtree, err := t.TreeFromNodes(leaves[:num], limit)
+	if err != nil {
+		return nil, err
+	}
+	rest, err := t.treeFromNodesProgressive(leaves[num:], limit*4)
+	if err != nil {
+		return nil, err
+	}
+	return t.NewNodeWithLR(rest, subtree), nil
+}
+
+// TreeFromNodesWithActiveFields is the same as TreeFromNodesWithActiveFields with the TreeHash.
+func (t *TreeHash) TreeFromNodesWithActiveFields(leaves []*Node, activeFields []byte, limit int) (*Node, error) {
+	mainTree, err := t.TreeFromNodes(leaves, int(nextPowerOfTwo(uint64(limit))))
+	if err != nil {
+		return nil, err
+	}
+
+	// Bitvector[limit] with the active fields
+	chunks := (len(activeFields) + 31) / 32
+	activeLeaves := make([]*Node, 0, chunks)
+	for i := 0; i < len(activeFields); i += 32 {
+		activeLeaves = append(activeLeaves, LeafFromBytes(activeFields[i:min(len(activeFields), i+32)]))
+	}
+	activeTree, err := t.TreeFromNodes(activeLeaves, int(nextPowerOfTwo(uint64(chunks))))
+	if err != nil {
+		return nil, err
+	}
+
+	node := t.NewNodeWithLR(mainTree, activeTree)
 	return node, nil
 }
 
 // Get fetches a node with the given general index.
 func (n *Node) Get(index int) (*Node, error) {
+	if index < 1 {
+		return nil, errors.New("Node not found in tree")
+	}
 	pathLen := getPathLength(index)
 	cur := n
 	for i := pathLen - 1; i >= 0; i-- {
@@ -301,13 +421,190 @@ func (n *Node) Get(index int) (*Node, error) {
 	return cur, nil
 }
 
+// GetPath is the same as Get for general indices of any depth.
+func (n *Node) GetPath(path gindex.Path) (*Node, error) {
+	cur := n
+	for i := 0; i < path.Depth(); i++ {
+		if path.Step(i) {
+			cur = cur.right
+		} else {
+			cur = cur.left
+		}
+		if cur == nil {
+			return nil, errors.New("Node not found in tree")
+		}
+	}
+
+	return cur, nil
+}
+
+// Set replaces the node at the given general index. The cached hashes of
+// the branch nodes on the path to the root are invalidated so that the
+// next call to Hash only rehashes the modified branches. Empty subtrees
+// on the path are expanded into zero order hash nodes one level below.
+// The tree is modified in place, use With to keep the previous version.
+func (n *Node) Set(index int, node *Node) error {
+	if index < 1 {
+		return fmt.Errorf("invalid generalized index %d", index)
+	}
+	if node == nil {
+		return errors.New("cannot set a nil node")
+	}
+	if index == 1 {
+		*n = *node
+		return nil
+	}
+
+	pathLen := getPathLength(index)
+	cur := n
+	for i := pathLen - 1; i >= 0; i-- {
+		if cur.left == nil && cur.right == nil {
+			if !cur.isEmpty {
+				return errors.New("Node not found in tree")
+			}
+			if err := cur.expand(); err != nil {
+				return err
+			}
+		}
+		// the hash of this branch changes, drop the cached value
+		cur.value = nil
+
+		isRight := getPosAtLevel(index, i)
+		if i == 0 {
+			if isRight {
+				cur.right = node
+			} else {
+				cur.left = node
+			}
+			break
+		}
+		if isRight {
+			cur = cur.right
+		} else {
+			cur = cur.left
+		}
+		if cur == nil {
+			return errors.New("Node not found in tree")
+		}
+	}
+
+	return nil
+}
+
+// SetLeaf replaces the node at the given general index with a leaf
+// holding the 32 bytes value.
+func (n *Node) SetLeaf(index int, value []byte) error {
+	if len(value) != 32 {
+		return fmt.Errorf("leaf value must be 32 bytes but got %d", len(value))
+	}
+	return n.Set(index, NewNodeWithValue(value))
+}
+
+// With returns a new version of the tree with the node at the given general
+// index replaced. Only the branch nodes on the path to the root are copied,
+// every other subtree is shared with the receiver, which is left untouched.
+// Shared subtrees must not be modified in place with Set afterwards.
+func (n *Node) With(index int, node *Node) (*Node, error) {
+	if index < 1 {
+		return nil, fmt.Errorf("invalid generalized index %d", index)
+	}
+	if node == nil {
+		return nil, errors.New("cannot set a nil node")
+	}
+	if index == 1 {
+		return node, nil
+	}
+
+	root, err := n.copyBranch()
+	if err != nil {
+		return nil, err
+	}
+
+	pathLen := getPathLength(index)
+	cur := root
+	for i := pathLen - 1; i >= 0; i-- {
+		isRight := getPosAtLevel(index, i)
+		if i == 0 {
+			if isRight {
+				cur.right = node
+			} else {
+				cur.left = node
+			}
+			break
+		}
+
+		next := cur.left
+		if isRight {
+			next = cur.right
+		}
+		if next == nil {
+			return nil, errors.New("Node not found in tree")
+		}
+		if next, err = next.copyBranch(); err != nil {
+			return nil, err
+		}
+		if isRight {
+			cur.right = next
+		} else {
+			cur.left = next
+		}
+		cur = next
+	}
+
+	return root, nil
+}
+
+// WithLeaf returns a new version of the tree with the node at the given
+// general index replaced by a leaf holding the 32 bytes value.
+func (n *Node) WithLeaf(index int, value []byte) (*Node, error) {
+	if len(value) != 32 {
+		return nil, fmt.Errorf("leaf value must be 32 bytes but got %d", len(value))
+	}
+	return n.With(index, NewNodeWithValue(value))
+}
+
+// copyBranch returns a copy of a branch node without its cached hash.
+// Empty nodes are expanded in the copy.
+func (n *Node) copyBranch() (*Node, error) {
+	if n.left == nil && n.right == nil {
+		if !n.isEmpty {
+			return nil, errors.New("Node not found in tree")
+		}
+		cp := &Node{value: n.value, isEmpty: true, tree: n.tree}
+		if err := cp.expand(); err != nil {
+			return nil, err
+		}
+		return cp, nil
+	}
+	return &Node{left: n.left, right: n.right, tree: n.tree}, nil
+}
+
+// expand turns an empty node into a branch node with two empty children.
+func (n *Node) expand() error {
+	t := n.treeHash()
+	level, ok := t.zeroLevel(n.value)
+	if !ok || level == 0 {
+		return errors.New("Node not found in tree")
+	}
+	n.left = t.NewEmptyNode(level - 1)
+	n.right = t.NewEmptyNode(level - 1)
+	n.isEmpty = false
+	n.value = nil
+	return nil
+}
+
 // Hash returns the hash of the subtree with the given Node as its root.
 // If root has no children, it returns root's value (not its hash).
+// Branches whose hash is cached are not hashed again.
 func (n *Node) Hash() []byte {
 	// TODO: handle special cases: empty root, one non-empty node
 	return hashNode(n)
 }
 
+// hashNode returns the hash of the node. The branches of its subtree whose
+// hash is not cached are hashed level by level, starting from the leaves,
+// so that the hash function of the TreeHash is called with all the pairs of
+// chunks of the same level at once.
 func hashNode(n *Node) []byte {
 	if n.left == nil && n.right == nil {
 		return n.value
@@ -322,35 +619,69 @@ func hashNode(n *Node) []byte {
 		return n.value
 	}
 
-	if n.right.isEmpty {
-		result := hashFn(append(hashNode(n.left), n.right.value...))
-		n.value = result // Set the hash result on each node so that proofs can be generated for any level
-		return result
+	// levels[i] are the branches whose children are hashed after the
+	// branches of the level i-1 are hashed
+	levels := [][]*Node{}
+	var visit func(n *Node) int
+	visit = func(n *Node) int {
+		if n.value != nil || (n.left == nil && n.right == nil) {
+			return -1
+		}
+		if n.left == nil || n.right == nil {
+			panic("Tree incomplete")
+		}
+		level := visit(n.left)
+		if right := visit(n.right); right > level {
+			level = right
+		}
+		level++
+		if level == len(levels) {
+			levels = append(levels, nil)
+		}
+		levels[level] = append(levels[level], n)
+		return level
 	}
+	visit(n)
 
-	result := hashFn(append(hashNode(n.left), hashNode(n.right)...))
-	n.value = result
-	return result
+	for _, level := range levels {
+		hashLevel(level)
+	}
+	return n.value
 }
 
-// getZeroOrderHashes precomputes zero order hashes to create an easy map lookup
-// for zero leafs and their parent nodes.
-func getZeroOrderHashes(depth int) map[int][]byte {
-	zeroOrderHashes := make(map[int][]byte)
-
-	emptyValue := make([]byte, 32)
-	zeroOrderHashes[depth] = emptyValue
-
-	for i := depth - 1; i >= 0; i-- {
-		zeroOrderHashes[i] = hashFn(append(zeroOrderHashes[i+1], zeroOrderHashes[i+1]...))
+// treeHash returns the TreeHash that hashes the node
+func (n *Node) treeHash() *TreeHash {
+	if n.tree == nil {
+		return DefaultTreeHash
 	}
+	return n.tree
+}
 
-	return zeroOrderHashes
+// hashLevel hashes the branches of a level with the TreeHash that built them
+func hashLevel(nodes []*Node) {
+	t := nodes[0].treeHash()
+	for _, n := range nodes {
+		if n.treeHash() != t {
+			// the tree mixes nodes built by different TreeHash objects
+			byHash := map[*TreeHash][]*Node{}
+			for _, n := range nodes {
+				byHash[n.treeHash()] = append(byHash[n.treeHash()], n)
+			}
+			for t, nodes := range byHash {
+				t.hashBranches(nodes)
+			}
+			return
+		}
+	}
+	t.hashBranches(nodes)
 }
 
 // Prove returns a list of sibling values and hashes needed
 // to compute the root hash for a given general index.
 func (n *Node) Prove(index int) (*Proof, error) {
+	if index < 1 {
+		return nil, errors.New("Node not found in tree")
+	}
 	pathLen := getPathLength(index)
 	proof := &Proof{Index: index}
 	hashes := make([][]byte, 0, pathLen)
@@ -381,6 +712,33 @@ func (n *Node) Prove(index int) (*Proof, error) {
 	return proof, nil
 }
 
+// ProvePath is the same as Prove for general indices of any depth.
+func (n *Node) ProvePath(path gindex.Path) (*Proof, error) {
+	pathLen := path.Depth()
+	hashes := make([][]byte, pathLen)
+
+	cur := n
+	for i := 0; i < pathLen; i++ {
+		// the hashes are ordered from the leaf up to the root
+		if path.Step(i) {
+			hashes[pathLen-1-i] = hashNode(cur.left)
+			cur = cur.right
+		} else {
+			hashes[pathLen-1-i] = hashNode(cur.right)
+			cur = cur.left
+		}
+		if cur == nil {
+			return nil, errors.New("Node not found in tree")
+		}
+	}
+
+	proof := &Proof{Path: path, Leaf: hashNode(cur), Hashes: hashes}
+	if index, ok := pathToIndex(path); ok {
+		proof.Index = index
+	}
+	return proof, nil
+}
+
 func (n *Node) ProveMulti(indices []int) (*Multiproof, error) {
 	reqIndices := getRequiredIndices(indices)
 	proof := &Multiproof{Indices: indices, Leaves: make([][]byte, len(indices)), Hashes: make([][]byte, len(reqIndices))}
@@ -390,7 +748,8 @@ func (n *Node) ProveMulti(indices []int) (*Multiproof, error) {
 		if err != nil {
 			return nil, err
 		}
-		proof.Leaves[i] = node.value
+		// intermediate nodes are proven with the hash of their subtree
+		proof.Leaves[i] = hashNode(node)
 	}
 
 	for i, gi := range reqIndices {
@@ -404,6 +763,40 @@ func (n *Node) ProveMulti(indices []int) (*Multiproof, error) {
 	return proof, nil
 }
 
+// ProveMultiPaths is the same as ProveMulti for general indices of any depth.
+func (n *Node) ProveMultiPaths(paths []gindex.Path) (*Multiproof, error) {
+	reqPaths := gindex.HelperPaths(paths)
+	proof := &Multiproof{Paths: paths, Leaves: make([][]byte, len(paths)), Hashes: make([][]byte, len(reqPaths))}
+
+	for i, path := range paths {
+		node, err := n.GetPath(path)
+		if err != nil {
+			return nil, err
+		}
+		proof.Leaves[i] = hashNode(node)
+	}
+
+	for i, path := range reqPaths {
+		cur, err := n.GetPath(path)
+		if err != nil {
+			return nil, err
+		}
+		proof.Hashes[i] = hashNode(cur)
+	}
+
+	proof.Indices = make([]int, len(paths))
+	for i, path := range paths {
+		index, ok := pathToIndex(path)
+		if !ok {
+			proof.Indices = nil
+			break
+		}
+		proof.Indices[i] = index
+	}
+
+	return proof, nil
+}
+
 func LeafFromUint64(i uint64) *Node {
 	buf := make([]byte, 32)
 	binary.LittleEndian.PutUint64(buf[:8], i)
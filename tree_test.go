@@ -7,6 +7,8 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/ferranbt/fastssz/gindex"
+	"github.com/minio/sha256-simd"
 	"github.com/stretchr/testify/require"
 )
 
@@ -102,7 +104,7 @@ func TestSparseTreeWithLeavesWithOtherNodes(t *testing.T) {
 }
 
 func TestHashTree(t *testing.T) {
-	expectedRootHex := "6621edd5d039d27d1ced186d57691a04903ac79b389187c2d453b5d3cd65180e"
+	expectedRootHex := "9df049e621dda3474af3e4cb97adba0d49a4a901e47abba0f3e84e2c437bb1e8"
 	expectedRoot, err := hex.DecodeString(expectedRootHex)
 	if err != nil {
 		t.Errorf("Failed to decode hex string\n")
@@ -129,7 +131,7 @@ func TestHashTree(t *testing.T) {
 func TestProve(t *testing.T) {
 	expectedProofHex := []string{
 		"0000",
-		"5db57a86b859d1c286b5f1f585048bf8f6b5e626573a8dc728ed5080f6f43e2c",
+		"d4be37dc31d2bab22ea2e010ceb995b9d5a11a91a3c3297e8d6e840c8f2d70d3",
 	}
 	chunks := [][]byte{
 		{0x01, 0x01},
@@ -192,6 +194,20 @@ func TestProveMulti(t *testing.T) {
 	}
 }
 
+func TestProve_ZeroIndex(t *testing.T) {
+	r, err := TreeFromChunks([][]byte{{0x01}, {0x02}})
+	require.NoError(t, err)
+
+	_, err = r.Get(0)
+	require.EqualError(t, err, "Node not found in tree")
+
+	_, err = r.Prove(0)
+	require.EqualError(t, err, "Node not found in tree")
+
+	_, err = r.ProveMulti([]int{0})
+	require.EqualError(t, err, "Node not found in tree")
+}
+
 func TestGetRequiredIndices(t *testing.T) {
 	indices := []int{10, 48, 49}
 	expected := []int{25, 13, 11, 7, 4}
@@ -208,26 +224,26 @@ func TestGetRequiredIndices(t *testing.T) {
 
 func TestProveRepeated(t *testing.T) {
 	expectedProofHex := []string{
-		"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
-		"2dba5dbc339e7316aea2683faf839c1b7b1ee2313db792112588118df066aa35",
-		"5310a330e8f970388503c73349d80b45cd764db615f1bced2801dcd4524a2ff4",
-		"80d1bf4dd6c1f75bba022337a3f0842078f5c2e7f3f59dfd33ccbb8e963367b2",
-		"1492e66e89e186840231850712161255d203b5bbf48d21242f0b51519b5eb3d4",
-		"03a82289eea21de37e72ad6c07865dcab3f2cd681ad47c1cd0ea30e1751ad996",
-		"35603b6278eb5d320c99eeb68354d448493e1ab9857cb0bddb9f7fa72250a3a8",
-		"8ff9103704f4e7dfee6106551eb439d3ac6bc5cc4873ced8ec33eaf2d42f4c31",
-		"259ca0ef3ecb66bb9f02e2ca9de6c7ff13951ad824ece4c680555cfef4321d17",
-		"4f52a2051143520841633a6e53f1ad5948a584dcdbc8ea206d8008d1cfe104a9",
-		"7ef919cf6137226a4c132f3bcab47a11aa1dfe78a357c19c0c804508829f2623",
-		"cbafa51c68b69bc206500c4733c2cc4cc6b67f712cc5fbad5b2d365998ba37a0",
-		"e11746324aa6ce20024a6e4796ae38d2dce7d5e015071a4a2cc96c9b71fafb32",
-		"e3b4036e156dd6ccf9e41e36b011fd00f79645e361d02a9484eaba96e3be7179",
-		"a3cbeb34d17bf5aa47054abd93e0ea1c992eef8359ad6a0f596ea48e455d540a",
-		"d1f9c8fa1339b232013cc9585b380372614a869fd0fd2e3d07bec2f3c96b4c6d",
-		"162947673d0323a56dcccedf09d1c45dfe40c1ddcdb14f69880ae36f60ee434f",
-		"62c0a299966c9ec0a031d01d8bd5330b191461a5dd13a4ac5dd662097c6fc099",
-		"c324ca782716eac179133ee5f4b315c2a9e6e922aead7963a95302412f5e0001",
-		"dcb5df19b4aa726ff826a34a97704b11e111c3cc519ebbe9133f189e05766dfa",
+		"f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a92759fb4b",
+		"db56114e00fdd4c1f85c892bf35ac9a89289aaecb1ebd0a96cde606a748b5d71",
+		"c78009fdf07fc56a11f122370658a353aaa542ed63e44c4bc15ff4cd105ab33c",
+		"536d98837f2dd165a55d5eeae91485954472d56f246df256bf3cae19352a123c",
+		"9efde052aa15429fae05bad4d0b1d7c64da64d03d7a1854a588c2cb8430c0d30",
+		"d88ddfeed400a8755596b21942c1497e114c302e6118290f91e6772976041fa1",
+		"87eb0ddba57e35f6d286673802a4af5975e22506c7cf4c64bb6be5ee11527f2c",
+		"26846476fd5fc54a5d43385167c95144f2643f533cc85bb9d16b782f8d7db193",
+		"506d86582d252405b840018792cad2bf1259f1ef5aa5f887e13cb2f0094f51e1",
+		"ffff0ad7e659772f9534c195c815efc4014ef1e1daed4404c06385d11192e92b",
+		"6cf04127db05441cd833107a52be852868890e4317e6a02ab47683aa75964220",
+		"b7d05f875f140027ef5118a2247bbb84ce8f2f0f1123623085daf7960c329f5f",
+		"df6af5f5bbdb6be9ef8aa618e4bf8073960867171e29676f8b284dea6a08a85e",
+		"b58d900f5e182e3c50ef74969ea16c7726c549757cc23523c369587da7293784",
+		"d49a7502ffcfb0340b1d7885688500ca308161a7f96b62df9d083b71fcc8f2bb",
+		"8fe6b1689256c0d385f42f5bbe2027a22c1996e110ba97c171d3e5948de92beb",
+		"8d0d63c39ebade8509e0ae3c9c3876fb5fa112be18f905ecacfecb92057603ab",
+		"95eec8b2e541cad4e91de38385f2e046619f54496c2382cb6cacd5b98c26f5a4",
+		"f893e908917775b62bff23294dbbe3a1cd8e6cc1c35b4801887b646a6f81f17f",
+		"69da17e1feaaca6a158c875e319ce3fd516a1dfbb4fce963775bb3322677e4bb",
 	}
 
 	chunks := make([][]byte, 1048576)
@@ -289,3 +305,332 @@ func BenchmarkProve(b *testing.B) {
 		}
 	}
 }
+
+func TestSetLeaf(t *testing.T) {
+	leaves := func(n int, vals map[int]uint64) []*Node {
+		res := make([]*Node, n)
+		for i := 0; i < n; i++ {
+			res[i] = LeafFromUint64(uint64(i + 1))
+			if v, ok := vals[i]; ok {
+				res[i] = LeafFromUint64(v)
+			}
+		}
+		return res
+	}
+
+	r, err := TreeFromNodesWithMixin(leaves(5, nil), 5, 16)
+	require.NoError(t, err)
+	r.Hash()
+
+	// the right half of the list is not modified and keeps its cached hash
+	sibling, err := r.Get(5)
+	require.NoError(t, err)
+	cached := sibling.value
+	require.NotNil(t, cached)
+
+	// list leaves start at gindex 32
+	require.NoError(t, r.SetLeaf(33, LeafFromUint64(100).value))
+
+	expected, err := TreeFromNodesWithMixin(leaves(5, map[int]uint64{1: 100}), 5, 16)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), r.Hash())
+
+	sibling, err = r.Get(5)
+	require.NoError(t, err)
+	require.Equal(t, &cached[0], &sibling.value[0])
+
+	proof, err := r.Prove(33)
+	require.NoError(t, err)
+	ok, err := VerifyProof(r.Hash(), proof)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestSetLeaf_EmptySubtree(t *testing.T) {
+	r, err := TreeFromNodesWithMixin([]*Node{LeafFromUint64(1)}, 1, 16)
+	require.NoError(t, err)
+	r.Hash()
+
+	// append a second and a ninth item to the list and update the length
+	require.NoError(t, r.SetLeaf(33, LeafFromUint64(2).value))
+	require.NoError(t, r.SetLeaf(40, LeafFromUint64(9).value))
+	require.NoError(t, r.SetLeaf(3, LeafFromUint64(9).value))
+
+	items := []*Node{LeafFromUint64(1), LeafFromUint64(2)}
+	for i := 0; i < 6; i++ {
+		items = append(items, EmptyLeaf())
+	}
+	items = append(items, LeafFromUint64(9))
+
+	expected, err := TreeFromNodesWithMixin(items, 9, 16)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), r.Hash())
+}
+
+func TestSet(t *testing.T) {
+	r, err := TreeFromNodes([]*Node{LeafFromUint64(1), LeafFromUint64(2)}, 4)
+	require.NoError(t, err)
+	r.Hash()
+
+	sub := NewNodeWithLR(LeafFromUint64(3), LeafFromUint64(4))
+	require.NoError(t, r.Set(3, sub))
+
+	expected, err := TreeFromNodes([]*Node{LeafFromUint64(1), LeafFromUint64(2), LeafFromUint64(3), LeafFromUint64(4)}, 4)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), r.Hash())
+
+	// replace the root
+	require.NoError(t, r.Set(1, LeafFromUint64(5)))
+	require.Equal(t, LeafFromUint64(5).value, r.Hash())
+
+	// cannot walk below a leaf
+	require.Error(t, r.Set(2, LeafFromUint64(6)))
+	require.Error(t, r.SetLeaf(1, []byte{0x1}))
+}
+
+func TestWith(t *testing.T) {
+	items := func(vals ...uint64) []*Node {
+		res := make([]*Node, len(vals))
+		for i, v := range vals {
+			res[i] = LeafFromUint64(v)
+		}
+		return res
+	}
+
+	r, err := TreeFromNodesWithMixin(items(1, 2, 3, 4, 5), 5, 16)
+	require.NoError(t, err)
+	root := r.Hash()
+
+	r2, err := r.WithLeaf(33, LeafFromUint64(100).value)
+	require.NoError(t, err)
+
+	// the previous version is untouched
+	require.Equal(t, root, r.Hash())
+
+	expected, err := TreeFromNodesWithMixin(items(1, 100, 3, 4, 5), 5, 16)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), r2.Hash())
+
+	// untouched subtrees are shared between both versions
+	for _, index := range []int{3, 5, 9, 17, 32} {
+		a, err := r.Get(index)
+		require.NoError(t, err)
+		b, err := r2.Get(index)
+		require.NoError(t, err)
+		require.Same(t, a, b)
+	}
+
+	// the path to the modified leaf is copied
+	for _, index := range []int{1, 2, 4, 8, 16, 33} {
+		a, err := r.Get(index)
+		require.NoError(t, err)
+		b, err := r2.Get(index)
+		require.NoError(t, err)
+		require.NotSame(t, a, b)
+	}
+
+	// append to the list on a third version
+	r3, err := r2.WithLeaf(37, LeafFromUint64(6).value)
+	require.NoError(t, err)
+	r3, err = r3.WithLeaf(3, LeafFromUint64(6).value)
+	require.NoError(t, err)
+
+	expected, err = TreeFromNodesWithMixin(items(1, 100, 3, 4, 5, 6), 6, 16)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), r3.Hash())
+	require.Equal(t, root, r.Hash())
+
+	_, err = r.With(66, LeafFromUint64(1))
+	require.Error(t, err)
+}
+
+func TestProvePath_Deep(t *testing.T) {
+	// a list of containers with a list field, both with a limit of 2^40
+	container := func(a, b uint64) *Node {
+		inner, err := TreeFromNodesWithMixin([]*Node{LeafFromUint64(a), LeafFromUint64(b)}, 2, 1<<40)
+		require.NoError(t, err)
+		return NewNodeWithLR(inner, LeafFromUint64(a+b))
+	}
+	r, err := TreeFromNodesWithMixin([]*Node{container(1, 2), container(3, 4)}, 2, 1<<40)
+	require.NoError(t, err)
+
+	// second element of the list field of the second container
+	elem := gindex.ConcatPaths(gindex.NewPath(2), gindex.NewPath(1<<40|1), gindex.NewPath(2), gindex.NewPath(2), gindex.NewPath(1<<40|1))
+	require.Equal(t, 83, elem.Depth())
+
+	proof, err := r.ProvePath(elem)
+	require.NoError(t, err)
+	require.Zero(t, proof.Index)
+	require.Equal(t, LeafFromUint64(4).value, proof.Leaf)
+
+	ok, err := VerifyProof(r.Hash(), proof)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// the proofs of indices that fit in an int are the same
+	proof, err = r.ProvePath(gindex.NewPath(5))
+	require.NoError(t, err)
+	expected, err := r.Prove(5)
+	require.NoError(t, err)
+	require.Equal(t, expected, &Proof{Index: proof.Index, Leaf: proof.Leaf, Hashes: proof.Hashes})
+
+	// the sum field of the first container and the length of the inner list
+	sum := gindex.ConcatPaths(gindex.NewPath(2), gindex.NewPath(1<<40), gindex.NewPath(3))
+	length := gindex.ConcatPaths(gindex.NewPath(2), gindex.NewPath(1<<40|1), gindex.NewPath(2), gindex.NewPath(3))
+	paths := []gindex.Path{elem, sum, length}
+
+	multiproof, err := r.ProveMultiPaths(paths)
+	require.NoError(t, err)
+	require.Nil(t, multiproof.Indices)
+
+	ok, err = VerifyMultiproofPaths(r.Hash(), multiproof.Hashes, multiproof.Leaves, paths)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	decompressed := multiproof.Compress().Decompress()
+	ok, err = VerifyMultiproofPaths(r.Hash(), decompressed.Hashes, decompressed.Leaves, decompressed.Paths)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// a different leaf does not verify
+	multiproof.Leaves[0] = LeafFromUint64(5).value
+	ok, err = VerifyMultiproofPaths(r.Hash(), multiproof.Hashes, multiproof.Leaves, paths)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+// hashPairs hashes the tree one pair of nodes at a time
+func hashPairs(t *TreeHash, n *Node) []byte {
+	if n.left == nil && n.right == nil {
+		return n.value
+	}
+	return t.Hash(hashPairs(t, n.left), hashPairs(t, n.right))
+}
+
+func TestHashTree_Batched(t *testing.T) {
+	chunks := func(n int) [][]byte {
+		res := make([][]byte, n)
+		for i := range res {
+			res[i] = make([]byte, 32)
+			binary.LittleEndian.PutUint64(res[i], uint64(i+1))
+		}
+		return res
+	}
+
+	cases := map[string]*TreeHash{
+		"default":  DefaultTreeHash,
+		"native":   NewTreeHash(NativeHashWrapper(sha256.New())),
+		"parallel": NewTreeHash(sha256HashFn, WithParallelHashing(4)),
+	}
+	for name, th := range cases {
+		t.Run(name, func(t *testing.T) {
+			// subtrees of different depths and with zero subtrees
+			small, err := th.TreeFromChunks(chunks(4))
+			require.NoError(t, err)
+			leaves := []*Node{}
+			for _, c := range chunks(3000) {
+				leaves = append(leaves, LeafFromBytes(c))
+			}
+			big, err := th.TreeFromNodesWithMixin(leaves, 3000, 4096)
+			require.NoError(t, err)
+			root, err := th.TreeFromNodes([]*Node{small, big, th.NewEmptyNode(3)}, 4)
+			require.NoError(t, err)
+
+			expected := hashPairs(th, root)
+			require.Equal(t, expected, root.Hash())
+		})
+	}
+
+	// leaves that are not padded to 32 bytes
+	root := NewNodeWithLR(
+		NewNodeWithLR(LeafFromBytes([]byte{0x1}), LeafFromBytes([]byte{0x2, 0x3})),
+		NewNodeWithLR(LeafFromBytes(make([]byte, 32)), LeafFromBytes(make([]byte, 32))),
+	)
+	require.Equal(t, hashPairs(DefaultTreeHash, root), root.Hash())
+}
+
+func BenchmarkHashTree(b *testing.B) {
+	leaves := make([][]byte, 1<<16)
+	for i := range leaves {
+		leaves[i] = make([]byte, 32)
+		binary.LittleEndian.PutUint64(leaves[i], uint64(i))
+	}
+
+	cases := map[string]*TreeHash{
+		"default":  DefaultTreeHash,
+		"parallel": NewTreeHash(sha256HashFn, WithParallelHashing(1024)),
+	}
+	for name, th := range cases {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				r, err := th.TreeFromChunks(leaves)
+				if err != nil {
+					b.Fatal(err)
+				}
+				b.StartTimer()
+
+				r.Hash()
+			}
+		})
+	}
+}
+
+// sparseChunks returns chunks with runs of zero chunks between the values
+func sparseChunks(r *rand.Rand, n int) [][]byte {
+	res := make([][]byte, n)
+	for i := range res {
+		res[i] = make([]byte, 32)
+		if r.Intn(8) == 0 {
+			r.Read(res[i])
+		}
+	}
+	return res
+}
+
+func TestHashTree_ZeroSubtrees(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, num := range []int{1, 7, 64, 1000, 1024} {
+		chunks := sparseChunks(r, num)
+		chunks[0] = make([]byte, 32)
+
+		leaves := []*Node{}
+		for _, c := range chunks {
+			leaves = append(leaves, LeafFromBytes(c))
+		}
+		root, err := TreeFromNodes(leaves, 1024)
+		require.NoError(t, err)
+		require.Equal(t, hashPairs(DefaultTreeHash, root), root.Hash())
+
+		// the leaves of the zero subtrees can still be proven
+		proof, err := root.Prove(1024)
+		require.NoError(t, err)
+		require.Equal(t, make([]byte, 32), proof.Leaf)
+		ok, err := VerifyProof(root.Hash(), proof)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		// and changed
+		require.NoError(t, root.SetLeaf(1024, bytes.Repeat([]byte{0x1}, 32)))
+		require.Equal(t, hashPairs(DefaultTreeHash, root), root.Hash())
+	}
+}
+
+func BenchmarkHashTree_ZeroSubtrees(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	chunks := sparseChunks(r, 1<<16)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		root, err := TreeFromChunks(chunks)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+
+		root.Hash()
+	}
+}
@@ -1,11 +1,18 @@
 package ssz
 
-var _ HashWalker = (*Wrapper)(nil)
+import "fmt"
+
+var _ ExtendedHashWalker = (*Wrapper)(nil)
 
 // ProofTree hashes a HashRoot object with a Hasher from
 // the default HasherPool
 func ProofTree(v HashRootProof) (*Node, error) {
-	w := &Wrapper{}
+	return DefaultTreeHash.ProofTree(v)
+}
+
+// ProofTree is the same as ProofTree with the TreeHash.
+func (t *TreeHash) ProofTree(v HashRootProof) (*Node, error) {
+	w := &Wrapper{hash: t}
 	if err := v.HashTreeRootWith(w); err != nil {
 		return nil, err
 	}
@@ -15,6 +22,16 @@ func ProofTree(v HashRootProof) (*Node, error) {
 type Wrapper struct {
 	nodes []*Node
 	buf   []byte
+
+	// hash builds the tree, the default TreeHash if nil
+	hash *TreeHash
+}
+
+func (w *Wrapper) treeHash() *TreeHash {
+	if w.hash == nil {
+		return DefaultTreeHash
+	}
+	return w.hash
 }
 
 /// --- wrapper implements the HashWalker interface ---
@@ -43,6 +60,14 @@ func (w *Wrapper) AppendUint8(i uint8) {
 	w.buf = MarshalValue(w.buf, i)
 }
 
+func (w *Wrapper) AppendUint128(i Uint128) {
+	w.buf = MarshalValue(w.buf, i)
+}
+
+func (w *Wrapper) AppendUint256(i Uint256) {
+	w.buf = MarshalValue(w.buf, i)
+}
+
 func (w *Wrapper) AppendBytes32(b []byte) {
 	w.buf = append(w.buf, b...)
 	w.FillUpTo32()
@@ -68,7 +93,63 @@ func (w *Wrapper) MerkleizeWithMixin(indx int, num, limit uint64) {
 		w.appendBytesAsNodes(w.buf)
 		w.buf = w.buf[:0]
 	}
-	w.CommitWithMixin(indx, int(num), int(limit))
+	// the limit in chunks of the packed values is not always a power of
+	// two, like the 7 chunks of a list of 100 uint16, but the tree is
+	w.CommitWithMixin(indx, int(num), int(nextPowerOfTwo(limit)))
+}
+
+func (w *Wrapper) MerkleizeProgressive(indx int) {
+	if len(w.buf) != 0 {
+		w.appendBytesAsNodes(w.buf)
+		w.buf = w.buf[:0]
+	}
+	res, err := w.treeHash().TreeFromNodesProgressive(w.nodes[indx:])
+	if err != nil {
+		panic(err)
+	}
+	// remove the old nodes
+	w.nodes = w.nodes[:indx]
+
+	// add the new node
+	w.AddNode(res)
+}
+
+func (w *Wrapper) MerkleizeProgressiveWithMixin(indx int, num uint64) {
+	w.MerkleizeProgressive(indx)
+
+	// Mixin len
+	w.nodes[indx] = w.treeHash().NewNodeWithLR(w.nodes[indx], LeafFromUint64(num))
+}
+
+func (w *Wrapper) MerkleizeWithSelector(indx int, selector uint8) {
+	if selector > maxUnionSelector {
+		panic(fmt.Sprintf("BUG: union selector '%d' out of bounds", selector))
+	}
+	w.MerkleizeWithMixin(indx, uint64(selector), 1)
+}
+
+func (w *Wrapper) MerkleizeOptional(indx int, present bool) {
+	var selector uint8
+	if present {
+		selector = 1
+	}
+	w.MerkleizeWithSelector(indx, selector)
+}
+
+func (w *Wrapper) MerkleizeStable(indx int, activeFields []byte, limit uint64) {
+	if len(w.buf) != 0 {
+		w.appendBytesAsNodes(w.buf)
+		w.buf = w.buf[:0]
+	}
+	res, err := w.treeHash().TreeFromNodesWithActiveFields(w.nodes[indx:], activeFields, int(limit))
+	if err != nil {
+		panic(err)
+	}
+	// remove the old nodes
+	w.nodes = w.nodes[:indx]
+
+	// add the new node
+	w.AddNode(res)
 }
 
 func (w *Wrapper) PutBitlist(bb []byte, maxSize uint64) {
@@ -102,10 +183,22 @@ func (w *Wrapper) PutBytes(b []byte) {
 	w.AddBytes(b)
 }
 
+func (w *Wrapper) PutZeroChunk() {
+	w.AddEmpty()
+}
+
 func (w *Wrapper) PutUint16(i uint16) {
 	w.AddUint16(i)
 }
 
+func (w *Wrapper) PutUint256(i Uint256) {
+	w.AddNode(LeafFromBytes(MarshalValue(nil, i)))
+}
+
+func (w *Wrapper) PutUint128(i Uint128) {
+	w.AddNode(LeafFromBytes(MarshalValue(nil, i)))
+}
+
 func (w *Wrapper) PutUint64(i uint64) {
 	w.AddUint64(i)
 }
@@ -193,7 +286,7 @@ func (w *Wrapper) Hash() []byte {
 
 func (w *Wrapper) Commit(i int) {
 	// create tree from nodes
-	res, err := TreeFromNodes(w.nodes[i:], w.getLimit(i))
+	res, err := w.treeHash().TreeFromNodes(w.nodes[i:], w.getLimit(i))
 	if err != nil {
 		panic(err)
 	}
@@ -205,7 +298,7 @@ func (w *Wrapper) Commit(i int) {
 
 func (w *Wrapper) CommitWithMixin(i, num, limit int) {
 	// create tree from nodes
-	res, err := TreeFromNodesWithMixin(w.nodes[i:], num, limit)
+	res, err := w.treeHash().TreeFromNodesWithMixin(w.nodes[i:], num, limit)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,43 @@
+package ssz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mixinTestObj is a list of 100 uint16 values, which are packed
+// in 7 chunks, a limit that is not a power of two
+type mixinTestObj struct {
+	vals []uint16
+}
+
+func (m *mixinTestObj) HashTreeRootWith(hh HashWalker) error {
+	indx := hh.Index()
+	for _, v := range m.vals {
+		hh.AppendUint16(v)
+	}
+	hh.FillUpTo32()
+	num := uint64(len(m.vals))
+	hh.MerkleizeWithMixin(indx, num, CalculateLimit(100, num, 2))
+	return nil
+}
+
+func TestWrapper_MerkleizeWithMixin(t *testing.T) {
+	for _, num := range []int{0, 1, 16, 17, 100} {
+		obj := &mixinTestObj{}
+		for i := 0; i < num; i++ {
+			obj.vals = append(obj.vals, uint16(i+1))
+		}
+
+		hh := NewHasher()
+		require.NoError(t, obj.HashTreeRootWith(hh))
+		root, err := hh.HashRoot()
+		require.NoError(t, err)
+
+		// the tree is padded to the next power of two of the limit
+		tree, err := ProofTree(obj)
+		require.NoError(t, err)
+		require.Equal(t, root[:], tree.Hash(), "%d values", num)
+	}
+}